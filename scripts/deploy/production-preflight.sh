@@ -69,11 +69,13 @@ cd "$root_dir"
 
 compose_file="$compose_dir/compose.yml"
 migrate_script="$compose_dir/migrate_online.sh"
+username_collisions_sql="$compose_dir/username_collisions.sql"
 
 [[ -n "$env_file" ]] || fail "必须传入 --env-file，或使用 --example 只检查样例结构"
 [[ -f "$env_file" ]] || fail "env 文件不存在: $env_file"
 [[ -f "$compose_file" ]] || fail "compose 文件不存在: $compose_file"
 [[ -f "$migrate_script" ]] || fail "migration 脚本不存在: $migrate_script"
+[[ -f "$username_collisions_sql" ]] || fail "用户名冲突检查 SQL 不存在: $username_collisions_sql"
 
 required_keys=(
 	PROJECT_SLUG
@@ -176,6 +178,15 @@ if [[ "$runtime_check" -eq 1 ]]; then
 	done
 	ok "Compose 运行服务存在"
 
+	# 规范化后重名的账号会让用户名唯一索引迁移失败，发布前在 Postgres 容器里用 psql 检查。
+	collisions="$("${compose_cmd[@]}" exec -T postgres sh -c 'psql -v ON_ERROR_STOP=1 -qtAX -F " | " -U "$POSTGRES_USER" -d "$POSTGRES_DB"' <"$username_collisions_sql")" ||
+		fail "用户名冲突检查执行失败"
+	if [[ -n "$collisions" ]]; then
+		printf '%s\n' "$collisions" >&2
+		fail "以上账号的用户名规范化后重名（表 | key | id:username），先改名再迁移"
+	fi
+	ok "规范化后没有重名的用户名"
+
 	if command -v curl >/dev/null 2>&1; then
 		app_port="$(value_of APP_HTTP_PORT)"
 		app_port="${app_port:-8200}"
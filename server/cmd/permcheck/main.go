@@ -0,0 +1,98 @@
+// server/cmd/permcheck/main.go
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/service"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// permcheck 校验 JSON-RPC 方法引用的权限码都已在代码里注册，CI 中不带参数运行即可；
+// 发现未注册的引用时以退出码 1 结束。
+// 传 -dsn（或设置 POSTGRES_DSN）时额外对比数据库 admin_permissions，
+// 列出未同步和已成为孤儿的权限码；这部分只输出不影响退出码，同步由服务启动时完成。
+func main() {
+	dsnFlag := flag.String("dsn", "", "postgres dsn, defaults to $POSTGRES_DSN; when set, also diff against admin_permissions")
+	flag.Parse()
+
+	registered := biz.AdminPermissionKeys()
+	fmt.Printf("registered permissions: %d\n", len(registered))
+
+	unregistered := service.UnregisteredMethodPermissions()
+	for _, line := range unregistered {
+		fmt.Printf("UNREGISTERED %s\n", line)
+	}
+
+	dsn := strings.TrimSpace(*dsnFlag)
+	if dsn == "" {
+		dsn = strings.TrimSpace(os.Getenv("POSTGRES_DSN"))
+	}
+	if dsn != "" {
+		if err := diffDB(dsn); err != nil {
+			fail("diff against db failed: %v", err)
+		}
+	}
+
+	if len(unregistered) > 0 {
+		fail("%d method(s) reference unregistered permissions", len(unregistered))
+	}
+	fmt.Println("permcheck ok")
+}
+
+func diffDB(dsn string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	rows, err := db.QueryContext(ctx, `SELECT key, name, "group", description, builtin FROM admin_permissions ORDER BY key`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var inDB []biz.RBACPermissionSummary
+	for rows.Next() {
+		var p biz.RBACPermissionSummary
+		if err := rows.Scan(&p.Key, &p.Name, &p.Group, &p.Description, &p.Builtin); err != nil {
+			return err
+		}
+		inDB = append(inDB, p)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	diff := biz.DiffAdminPermissions(inDB)
+	for _, p := range diff.Missing {
+		fmt.Printf("MISSING  %s (%s)\n", p.Key, p.Name)
+	}
+	for _, p := range diff.Changed {
+		fmt.Printf("CHANGED  %s (%s)\n", p.Key, p.Name)
+	}
+	for _, p := range diff.Orphaned {
+		fmt.Printf("ORPHANED %s (%s)\n", p.Key, p.Name)
+	}
+	if diff.Empty() {
+		fmt.Println("db permissions in sync")
+	}
+	return nil
+}
+
+func fail(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "permcheck: "+format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,223 @@
+// server/cmd/rbacpolicy/main.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// rbacpolicy 通过 JSON-RPC 导出/导入 RBAC 策略 YAML，便于把角色与绑定纳入版本管理：
+//
+//	rbacpolicy -server http://127.0.0.1:8200 export -o rbac.yaml
+//	rbacpolicy -server http://127.0.0.1:8200 import -f rbac.yaml          # 只预览差异
+//	rbacpolicy -server http://127.0.0.1:8200 import -f rbac.yaml -apply   # 事务内应用
+//
+// 令牌取 -token 或 $ADMIN_TOKEN（admin_login 返回的 access_token）。
+// 导入默认不删除文件之外的自定义角色，需要时加 -prune。
+func main() {
+	serverFlag := flag.String("server", "http://127.0.0.1:8200", "server base url")
+	tokenFlag := flag.String("token", "", "admin access token, defaults to $ADMIN_TOKEN")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: rbacpolicy [-server url] [-token t] export [-o file] | import -f file [-apply] [-prune]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	token := strings.TrimSpace(*tokenFlag)
+	if token == "" {
+		token = strings.TrimSpace(os.Getenv("ADMIN_TOKEN"))
+	}
+	if token == "" {
+		fail("missing -token or $ADMIN_TOKEN")
+	}
+	c := &client{base: strings.TrimRight(*serverFlag, "/"), token: token, http: &http.Client{Timeout: 60 * time.Second}}
+
+	args := flag.Args()
+	if len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	switch args[0] {
+	case "export":
+		runExport(c, args[1:])
+	case "import":
+		runImport(c, args[1:])
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func runExport(c *client, args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	out := fs.String("o", "", "output file, defaults to stdout")
+	_ = fs.Parse(args)
+
+	var data struct {
+		YAML string `json:"yaml"`
+	}
+	if err := c.call("export_policy", map[string]any{}, &data); err != nil {
+		fail("export failed: %v", err)
+	}
+	if *out == "" {
+		fmt.Print(data.YAML)
+		return
+	}
+	if err := os.WriteFile(*out, []byte(data.YAML), 0o644); err != nil {
+		fail("write %s failed: %v", *out, err)
+	}
+	fmt.Printf("exported to %s\n", *out)
+}
+
+type policyRole struct {
+	Key         string   `json:"key"`
+	Parents     []string `json:"parents"`
+	Permissions []string `json:"permissions"`
+}
+
+type policyPlan struct {
+	DryRun      bool         `json:"dry_run"`
+	InSync      bool         `json:"in_sync"`
+	CreateRoles []policyRole `json:"create_roles"`
+	UpdateRoles []struct {
+		Key    string     `json:"key"`
+		Before policyRole `json:"before"`
+		After  policyRole `json:"after"`
+	} `json:"update_roles"`
+	DeleteRoles []string `json:"delete_roles"`
+	Bindings    []struct {
+		Admin  string   `json:"admin"`
+		Before []string `json:"before"`
+		After  []string `json:"after"`
+	} `json:"bindings"`
+	UnknownAdmins []string `json:"unknown_admins"`
+}
+
+func runImport(c *client, args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	file := fs.String("f", "", "policy yaml file")
+	apply := fs.Bool("apply", false, "apply the plan; without it only the diff is printed")
+	prune := fs.Bool("prune", false, "delete custom roles missing from the file")
+	_ = fs.Parse(args)
+
+	if *file == "" {
+		fail("import requires -f")
+	}
+	raw, err := os.ReadFile(*file)
+	if err != nil {
+		fail("read %s failed: %v", *file, err)
+	}
+
+	var plan policyPlan
+	err = c.call("import_policy", map[string]any{
+		"yaml":    string(raw),
+		"dry_run": !*apply,
+		"prune":   *prune,
+	}, &plan)
+	if err != nil {
+		fail("import failed: %v", err)
+	}
+
+	if plan.InSync {
+		fmt.Println("policy in sync, nothing to do")
+		return
+	}
+	for _, r := range plan.CreateRoles {
+		fmt.Printf("CREATE   %s parents=%v permissions=%v\n", r.Key, r.Parents, r.Permissions)
+	}
+	for _, u := range plan.UpdateRoles {
+		fmt.Printf("UPDATE   %s\n", u.Key)
+		if fmt.Sprint(u.Before.Parents) != fmt.Sprint(u.After.Parents) {
+			fmt.Printf("         parents %v -> %v\n", u.Before.Parents, u.After.Parents)
+		}
+		if fmt.Sprint(u.Before.Permissions) != fmt.Sprint(u.After.Permissions) {
+			fmt.Printf("         permissions %v -> %v\n", u.Before.Permissions, u.After.Permissions)
+		}
+	}
+	for _, key := range plan.DeleteRoles {
+		fmt.Printf("DELETE   %s\n", key)
+	}
+	for _, b := range plan.Bindings {
+		fmt.Printf("BIND     %s %v -> %v\n", b.Admin, b.Before, b.After)
+	}
+	for _, name := range plan.UnknownAdmins {
+		fmt.Printf("SKIPPED  %s (admin not found)\n", name)
+	}
+	if plan.DryRun {
+		fmt.Println("dry run, re-run with -apply to apply")
+	} else {
+		fmt.Println("policy applied")
+	}
+}
+
+type client struct {
+	base  string
+	token string
+	http  *http.Client
+}
+
+// call 调用 /rpc/rbac 并把 result.data 解到 out；业务码非 0 时返回服务端的 message。
+func (c *client) call(method string, params map[string]any, out any) error {
+	body, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      "rbacpolicy",
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, c.base+"/rpc/rbac", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	payload, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("http %d: %s", resp.StatusCode, strings.TrimSpace(string(payload)))
+	}
+
+	var reply struct {
+		Error  string `json:"error"`
+		Result struct {
+			Code    int             `json:"code"`
+			Message string          `json:"message"`
+			Data    json.RawMessage `json:"data"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(payload, &reply); err != nil {
+		return fmt.Errorf("decode reply: %w", err)
+	}
+	if reply.Error != "" {
+		return fmt.Errorf("%s", reply.Error)
+	}
+	if reply.Result.Code != 0 {
+		return fmt.Errorf("code=%d %s", reply.Result.Code, reply.Result.Message)
+	}
+	if len(reply.Result.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(reply.Result.Data, out)
+}
+
+func fail(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "rbacpolicy: "+format+"\n", args...)
+	os.Exit(1)
+}
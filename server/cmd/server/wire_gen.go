@@ -31,18 +31,51 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger, tr
 	}
 	authRepo := data.NewAuthRepo(dataData, logger)
 	tokenGenerator := data.NewTokenGenerator(confData, logger)
-	authUsecase := biz.NewAuthUsecase(authRepo, tokenGenerator, logger, tracerProvider)
+	authPolicy := data.NewAuthPolicy(confData, logger)
+	authUsecase := biz.NewAuthUsecase(authRepo, tokenGenerator, authPolicy, logger, tracerProvider)
 	adminAuthRepo := data.NewAdminAuthRepo(dataData, logger)
 	adminTokenGenerator := data.NewAdminTokenGenerator(confData, logger)
 	adminAuthUsecase := biz.NewAdminAuthUsecase(adminAuthRepo, adminTokenGenerator, logger, tracerProvider)
 	userAdminRepo := data.NewUserAdminRepo(dataData, logger)
-	userAdminUsecase := biz.NewUserAdminUsecase(userAdminRepo, logger, tracerProvider)
+	auditRepo := data.NewAuditRepo(dataData, logger)
+	userAdminUsecase := biz.NewUserAdminUsecase(userAdminRepo, auditRepo, logger, tracerProvider)
+	userImportRepo := data.NewUserImportRepo(dataData, logger)
+	userImportUsecase := biz.NewUserImportUsecase(userImportRepo, authRepo, auditRepo, logger, tracerProvider)
+	userProfileRepo := data.NewUserProfileRepo(dataData, logger)
+	userProfileUsecase := biz.NewUserProfileUsecase(userProfileRepo, auditRepo, logger, tracerProvider)
+	userDeletionRepo := data.NewUserDeletionRepo(dataData, logger)
+	userDeletionUsecase := biz.NewUserDeletionUsecase(userDeletionRepo, authRepo, auditRepo, authPolicy, logger, tracerProvider)
+	loginEventRepo := data.NewLoginEventRepo(dataData, logger)
+	loginHistoryUsecase := biz.NewLoginHistoryUsecase(loginEventRepo, authRepo, adminAuthRepo, authPolicy, logger, tracerProvider)
+	userRBACRepo := data.NewUserRBACRepo(dataData, logger)
+	userRBACUsecase := biz.NewUserRBACUsecase(userRBACRepo, auditRepo, logger, tracerProvider)
+	organizationRepo := data.NewOrganizationRepo(dataData, logger)
+	adminAccessResolver := biz.NewAdminAccessResolver(adminAuthRepo, authPolicy, logger)
 	rbacRepo := data.NewRBACRepo(dataData, logger)
-	rbacUsecase := biz.NewRBACUsecase(rbacRepo)
-	jsonrpcService := service.NewJsonrpcService(authUsecase, adminAuthUsecase, userAdminUsecase, rbacUsecase, adminAuthRepo, logger)
+	organizationUsecase := biz.NewOrganizationUsecase(organizationRepo, authRepo, adminAccessResolver, rbacRepo, auditRepo, tokenGenerator, adminTokenGenerator, logger, tracerProvider)
+	inviteRepo := data.NewInviteRepo(dataData, logger)
+	inviteUsecase := biz.NewInviteUsecase(inviteRepo, auditRepo, logger, tracerProvider)
+	verificationRepo := data.NewVerificationRepo(dataData, logger)
+	mailer := data.NewMailer(confData, logger)
+	smsSender := data.NewSMSSender(confData, logger)
+	verificationUsecase := biz.NewVerificationUsecase(verificationRepo, authRepo, mailer, smsSender, authPolicy, logger, tracerProvider)
+	userDataUsecase := biz.NewUserDataUsecase(authUsecase, userProfileUsecase, loginHistoryUsecase, userRBACUsecase, organizationUsecase, inviteUsecase, verificationUsecase, auditRepo, logger, tracerProvider)
+	rbacUsecase := biz.NewRBACUsecase(rbacRepo, auditRepo, adminAccessResolver, logger, tracerProvider)
+	accessPolicyRepo := data.NewAccessPolicyRepo(dataData, logger)
+	accessPolicyUsecase, err := biz.NewAccessPolicyUsecase(accessPolicyRepo, auditRepo, logger, tracerProvider)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	impersonationTokenGenerator := data.NewImpersonationTokenGenerator(confData, logger)
+	impersonationUsecase := biz.NewImpersonationUsecase(authRepo, auditRepo, impersonationTokenGenerator, logger, tracerProvider)
+	adminAccountRepo := data.NewAdminAccountRepo(dataData, logger)
+	adminAccountUsecase := biz.NewAdminAccountUsecase(adminAccountRepo, adminAccessResolver, rbacRepo, auditRepo, logger, tracerProvider)
+	jsonrpcService := service.NewJsonrpcService(authUsecase, adminAuthUsecase, userAdminUsecase, userImportUsecase, userProfileUsecase, userDeletionUsecase, userDataUsecase, rbacUsecase, userRBACUsecase, accessPolicyUsecase, organizationUsecase, impersonationUsecase, inviteUsecase, verificationUsecase, loginHistoryUsecase, adminAccountUsecase, adminAccessResolver, logger)
 	grpcServer := server.NewGRPCServer(confServer, logger, jsonrpcService, tracerProvider, dataData)
 	httpServer := server.NewHTTPServer(confServer, logger, jsonrpcService, tracerProvider, dataData, confData)
-	app := newApp(logger, grpcServer, httpServer)
+	jobServer := server.NewJobServer(loginHistoryUsecase, adminAccountUsecase, userDeletionUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, jobServer)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -0,0 +1,184 @@
+// server/cmd/usernamecheck/main.go
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"server/internal/biz"
+	_ "server/internal/data/model/ent" // 注册字段校验器
+	entadminuser "server/internal/data/model/ent/adminuser"
+	entuser "server/internal/data/model/ent/user"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// usernamecheck 在执行“用户名规范化”迁移前扫描 users / admin_users，
+// 找出规范化（NFKC + 大小写折叠）后会撞到同一个 key、或超出 username_normalized 长度上限的账号。
+// 迁移用 SQL 的 lower 近似回填，服务启动时 data.SyncUsernameNormalized 再按 Go 的 biz.NormalizeUsername 修正；
+// 这里按 Go 规则检查，比生产用的 deploy/compose/prod/username_collisions.sql 更精确。-backfill 可在不重启服务时提前修正取值。
+// 默认只读取 username 列，迁移前后都可以运行；发现问题时以退出码 1 结束，方便接进发布脚本。
+type account struct {
+	Table    string
+	ID       int64
+	Username string
+}
+
+// normalizedValidators 与 ent schema 的 username_normalized 校验保持一致（MaxLen 按字节计）。
+var normalizedValidators = map[string]func(string) error{
+	"users":       entuser.UsernameNormalizedValidator,
+	"admin_users": entadminuser.UsernameNormalizedValidator,
+}
+
+func main() {
+	dsnFlag := flag.String("dsn", "", "postgres dsn, defaults to $POSTGRES_DSN")
+	backfill := flag.Bool("backfill", false, "write username_normalized for every account when there are no collisions")
+	flag.Parse()
+
+	dsn := strings.TrimSpace(*dsnFlag)
+	if dsn == "" {
+		dsn = strings.TrimSpace(os.Getenv("POSTGRES_DSN"))
+	}
+	if dsn == "" {
+		fail("postgres dsn is empty, pass -dsn or set POSTGRES_DSN")
+	}
+
+	timeout := 30 * time.Second
+	if *backfill {
+		// 逐行更新，大表需要更久。
+		timeout = 10 * time.Minute
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		fail("open db failed: %v", err)
+	}
+	defer db.Close()
+
+	var all []account
+	for _, table := range []string{"users", "admin_users"} {
+		rows, err := loadAccounts(ctx, db, table)
+		if err != nil {
+			fail("load %s failed: %v", table, err)
+		}
+		all = append(all, rows...)
+	}
+
+	groups := map[string][]account{}
+	var nonconforming, tooLong []account
+	for _, a := range all {
+		key := biz.NormalizeUsername(a.Username)
+		groups[key] = append(groups[key], a)
+		if _, _, err := biz.ValidateUsername(a.Username); err != nil {
+			nonconforming = append(nonconforming, a)
+		}
+		if err := normalizedValidators[a.Table](key); err != nil {
+			tooLong = append(tooLong, a)
+		}
+	}
+
+	keys := make([]string, 0, len(groups))
+	for k, g := range groups {
+		if len(g) > 1 {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	fmt.Printf("scanned %d accounts\n", len(all))
+
+	// 不符合新规则的老账号仍可登录，这里只提示，不算失败。
+	if len(nonconforming) > 0 {
+		fmt.Printf("\n%d accounts do not match the new username rules (still allowed to log in):\n", len(nonconforming))
+		for _, a := range nonconforming {
+			fmt.Printf("  %s id=%d username=%q\n", a.Table, a.ID, a.Username)
+		}
+	}
+
+	if len(tooLong) > 0 {
+		fmt.Printf("\n❌ %d accounts exceed the username_normalized length limit after folding, rename before applying the migration:\n", len(tooLong))
+		for _, a := range tooLong {
+			fmt.Printf("  %s id=%d username=%q key=%q\n", a.Table, a.ID, a.Username, biz.NormalizeUsername(a.Username))
+		}
+	}
+
+	if len(keys) > 0 {
+		fmt.Printf("\n❌ %d normalized username collisions, rename before applying the migration:\n", len(keys))
+		for _, k := range keys {
+			fmt.Printf("  key=%q\n", k)
+			for _, a := range groups[k] {
+				fmt.Printf("    %s id=%d username=%q\n", a.Table, a.ID, a.Username)
+			}
+		}
+	}
+	if len(keys) > 0 || len(tooLong) > 0 {
+		os.Exit(1)
+	}
+	fmt.Println("\n✅ no normalized username collisions")
+
+	if *backfill {
+		n, err := backfillNormalized(ctx, db, all)
+		if err != nil {
+			fail("backfill failed: %v", err)
+		}
+		fmt.Printf("backfilled username_normalized for %d accounts\n", n)
+	}
+}
+
+// backfillNormalized 在一个事务里把 username_normalized 写成 biz.NormalizeUsername 的结果，只更新值不同（含 NULL）的行，
+// 重复执行是幂等的，用于修正迁移里由 SQL 近似计算出的值。
+func backfillNormalized(ctx context.Context, db *sql.DB, accounts []account) (n int, err error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	for _, a := range accounts {
+		res, err := tx.ExecContext(ctx,
+			"UPDATE "+a.Table+" SET username_normalized = $1 WHERE id = $2 AND username_normalized IS DISTINCT FROM $1",
+			biz.NormalizeUsername(a.Username), a.ID,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("%s id=%d: %w", a.Table, a.ID, err)
+		}
+		if affected, err := res.RowsAffected(); err == nil {
+			n += int(affected)
+		}
+	}
+	return n, tx.Commit()
+}
+
+func loadAccounts(ctx context.Context, db *sql.DB, table string) ([]account, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, username FROM "+table+" ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []account
+	for rows.Next() {
+		a := account{Table: table}
+		if err := rows.Scan(&a.ID, &a.Username); err != nil {
+			return nil, err
+		}
+		out = append(out, a)
+	}
+	return out, rows.Err()
+}
+
+func fail(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "usernamecheck: "+format+"\n", args...)
+	os.Exit(1)
+}
@@ -10,6 +10,7 @@ ATLAS_BIN="${ATLAS_BIN:-/usr/local/bin/atlas}"
 POSTGRES_SERVICE="${POSTGRES_SERVICE:-postgres}"
 POSTGRES_HOST="${POSTGRES_HOST:-127.0.0.1}"
 MIGRATION_LOCK_FILE="${MIGRATION_LOCK_FILE:-/tmp/atlas-migrate.lock}"
+USERNAME_COLLISIONS_SQL="$SCRIPT_DIR/username_collisions.sql"
 
 APPLY_MODE=0
 STATUS_ONLY=0
@@ -20,8 +21,8 @@ usage() {
   sh migrate_online.sh [--apply] [--status-only] [--help]
 
 行为:
-  默认执行: status + dry-run
-  --apply:  执行 status + dry-run + 正式 apply
+  默认执行: status + 用户名冲突检查 + dry-run
+  --apply:  执行 status + 用户名冲突检查 + dry-run + 正式 apply
   --status-only: 仅查看当前迁移状态
 
 可选环境变量:
@@ -67,6 +68,11 @@ if [ ! -d "$MIG_DIR" ]; then
 	exit 1
 fi
 
+if [ ! -f "$USERNAME_COLLISIONS_SQL" ]; then
+	echo "ERROR: 用户名冲突检查 SQL 不存在: $USERNAME_COLLISIONS_SQL" >&2
+	exit 1
+fi
+
 if ! command -v "$ATLAS_BIN" >/dev/null 2>&1; then
 	echo "ERROR: 未找到宿主机 Atlas: $ATLAS_BIN" >&2
 	echo "请先在服务器安装 Atlas 到 /usr/local/bin/atlas，不要使用 arigaio/atlas 容器执行线上迁移。" >&2
@@ -151,18 +157,27 @@ echo "==> compose 文件: $COMPOSE_FILE"
 echo "==> Postgres 容器: $POSTGRES_CID"
 echo "==> Atlas: $ATLAS_BIN"
 
-echo "==> [1/3] 查看当前迁移状态"
+echo "==> [1/4] 查看当前迁移状态"
 atlas_run migrate status --dir "file://$MIG_DIR" --url "$DB_URL"
 
 if [ "$STATUS_ONLY" -eq 1 ]; then
 	exit 0
 fi
 
-echo "==> [2/3] dry-run 预演"
+# 用户名唯一索引迁移遇到规范化后重名的账号会失败，提前在容器内用 psql 列出来，宿主机不需要 Go。
+echo "==> [2/4] 检查规范化后重名的用户名"
+COLLISIONS=$(docker exec -i "$POSTGRES_CID" sh -c 'psql -v ON_ERROR_STOP=1 -qtAX -F " | " -U "$POSTGRES_USER" -d "$POSTGRES_DB"' <"$USERNAME_COLLISIONS_SQL")
+if [ -n "$COLLISIONS" ]; then
+	echo "ERROR: 以下账号的用户名规范化后重名，请先改名再迁移（表 | key | id:username）:" >&2
+	printf '%s\n' "$COLLISIONS" >&2
+	exit 1
+fi
+
+echo "==> [3/4] dry-run 预演"
 atlas_run migrate apply --dry-run --dir "file://$MIG_DIR" --url "$DB_URL"
 
 if [ "$APPLY_MODE" -eq 1 ]; then
-	echo "==> [3/3] 正式执行迁移"
+	echo "==> [4/4] 正式执行迁移"
 	atlas_run migrate apply --dir "file://$MIG_DIR" --url "$DB_URL"
 else
 	echo "==> 未执行正式迁移。传入 --apply 可一键落库。"
@@ -179,6 +179,8 @@ sync_remote_migration_assets() {
 
 	ssh "$REMOTE_TARGET" "mkdir -p ${REMOTE_DIR}/${REMOTE_MIGRATE_DIR_NAME}"
 	rsync -avz -e "ssh" "$LOCAL_MIGRATE_SCRIPT" "${REMOTE_TARGET}:${REMOTE_DIR}/${REMOTE_MIGRATE_SCRIPT_NAME}"
+	# migrate_online.sh 从自身所在目录读取用户名冲突检查 SQL。
+	rsync -avz -e "ssh" "$SCRIPT_DIR/username_collisions.sql" "${REMOTE_TARGET}:${REMOTE_DIR}/username_collisions.sql"
 	rsync -avz -e "ssh" --delete --exclude '.DS_Store' "$LOCAL_MIGRATE_DIR/" "${REMOTE_TARGET}:${REMOTE_DIR}/${REMOTE_MIGRATE_DIR_NAME}/"
 }
 
@@ -0,0 +1,12 @@
+-- 列出用户名规范化后会撞到同一个 key 的账号（规则同迁移 20261018120418 的 SQL 回填），没有输出即通过。
+-- 只读 username 列，迁移前后都可以执行。
+-- 由 migrate_online.sh 与 scripts/deploy/production-preflight.sh --runtime 通过 psql 执行，宿主机不需要 Go。
+SELECT t.tbl, t.key, string_agg(t.id::text || ':' || t.username, ', ' ORDER BY t.id)
+FROM (
+  SELECT 'users' AS tbl, id, username, lower(normalize(btrim(username), NFKC)) AS key FROM users
+  UNION ALL
+  SELECT 'admin_users', id, username, lower(normalize(btrim(username), NFKC)) FROM admin_users
+) t
+GROUP BY t.tbl, t.key
+HAVING COUNT(*) > 1
+ORDER BY t.tbl, t.key;
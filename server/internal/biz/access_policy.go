@@ -0,0 +1,324 @@
+// server/internal/biz/access_policy.go
+package biz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	PermissionAccessPolicyRead  = "admin.policy.read"
+	PermissionAccessPolicyWrite = "admin.policy.write"
+)
+
+var _ = RegisterAdminPermissions(
+	AdminPermission{
+		Key:         PermissionAccessPolicyRead,
+		Name:        "查看访问策略",
+		Group:       "权限",
+		Description: "允许查看挂在接口上的访问策略表达式",
+	},
+	AdminPermission{
+		Key:         PermissionAccessPolicyWrite,
+		Name:        "管理访问策略",
+		Group:       "权限",
+		Description: "允许新增、修改、删除与试算访问策略",
+	},
+)
+
+const (
+	AuditActionAccessPolicyUpsert = "access_policy.upsert"
+	AuditActionAccessPolicyDelete = "access_policy.delete"
+)
+
+// DefaultAccessPolicyCacheTTL 策略进程内缓存的有效期；本进程的写操作会立即失效，其他实例最多延迟这么久。
+const DefaultAccessPolicyCacheTTL = 5 * time.Second
+
+// AccessPolicy 是挂在 url.method 上的一条策略；Enabled=false 时保留配置但不参与判断。
+type AccessPolicy struct {
+	ID          int
+	URL         string
+	Method      string
+	Expression  string
+	Description string
+	Enabled     bool
+	UpdatedBy   int
+	UpdatedAt   time.Time
+}
+
+type AccessPolicyRepo interface {
+	ListAccessPolicies(ctx context.Context) ([]AccessPolicy, error)
+	// UpsertAccessPolicy 以 (url, method) 为键写入或覆盖。
+	UpsertAccessPolicy(ctx context.Context, in *AccessPolicy) (*AccessPolicy, error)
+	// DeleteAccessPolicy 不存在时返回 ErrAccessPolicyNotFound。
+	DeleteAccessPolicy(ctx context.Context, url, method string) (*AccessPolicy, error)
+}
+
+type accessPolicyEntry struct {
+	policy     *CompiledPolicy
+	id         int
+	compileErr error
+}
+
+type AccessPolicyUsecase struct {
+	repo   AccessPolicyRepo
+	engine *PolicyEngine
+	audit  AuditRepo
+	log    *log.Helper
+	tracer trace.Tracer
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu       sync.Mutex
+	entries  map[string]accessPolicyEntry
+	loadedAt time.Time
+	// gen 在每次失效时递增；加载期间发生过写操作时结果不写回缓存。
+	gen uint64
+}
+
+func NewAccessPolicyUsecase(
+	repo AccessPolicyRepo,
+	audit AuditRepo,
+	logger log.Logger,
+	tp *tracesdk.TracerProvider,
+) (*AccessPolicyUsecase, error) {
+	engine, err := NewPolicyEngine()
+	if err != nil {
+		return nil, err
+	}
+
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.access_policy")
+	} else {
+		tr = otel.Tracer("biz.access_policy")
+	}
+
+	return &AccessPolicyUsecase{
+		repo:   repo,
+		engine: engine,
+		audit:  audit,
+		log:    log.NewHelper(log.With(logger, "module", "biz.access_policy")),
+		tracer: tr,
+		ttl:    DefaultAccessPolicyCacheTTL,
+		now:    time.Now,
+	}, nil
+}
+
+func (uc *AccessPolicyUsecase) Tracer() trace.Tracer {
+	if uc.tracer != nil {
+		return uc.tracer
+	}
+	return otel.Tracer("biz.access_policy")
+}
+
+func (uc *AccessPolicyUsecase) List(ctx context.Context) ([]AccessPolicy, error) {
+	ctx, span := uc.Tracer().Start(ctx, "access_policy.list")
+	defer span.End()
+
+	list, err := uc.repo.ListAccessPolicies(ctx)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "List", "", err)
+	}
+	span.SetStatus(codes.Ok, "OK")
+	return list, nil
+}
+
+// Upsert 保存前先编译表达式，语法或类型不对直接拒绝，不会落库。
+// url.method 是否为真实接口由调用方（service 层）校验。
+func (uc *AccessPolicyUsecase) Upsert(ctx context.Context, in AccessPolicy) (*AccessPolicy, error) {
+	in.URL = strings.TrimSpace(in.URL)
+	in.Method = strings.TrimSpace(in.Method)
+	in.Description = strings.TrimSpace(in.Description)
+	key := in.URL + "." + in.Method
+	ctx, span := uc.Tracer().Start(ctx, "access_policy.upsert",
+		trace.WithAttributes(attribute.String("access_policy.key", key)),
+	)
+	defer span.End()
+
+	if in.URL == "" || in.Method == "" {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+	compiled, err := uc.engine.Compile(in.Expression)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "Upsert", key, err)
+	}
+	in.Expression = compiled.Expression()
+	if c, ok := GetClaimsFromContext(ctx); ok && c != nil {
+		in.UpdatedBy = c.UserID
+	}
+
+	saved, err := uc.repo.UpsertAccessPolicy(ctx, &in)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "Upsert", key, err)
+	}
+	uc.Invalidate()
+
+	uc.recordPolicyAudit(ctx, AuditActionAccessPolicyUpsert, saved, map[string]any{
+		"expression": saved.Expression,
+		"enabled":    saved.Enabled,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return saved, nil
+}
+
+func (uc *AccessPolicyUsecase) Delete(ctx context.Context, url, method string) error {
+	key := url + "." + method
+	ctx, span := uc.Tracer().Start(ctx, "access_policy.delete",
+		trace.WithAttributes(attribute.String("access_policy.key", key)),
+	)
+	defer span.End()
+
+	removed, err := uc.repo.DeleteAccessPolicy(ctx, url, method)
+	if err != nil {
+		return uc.fail(ctx, span, "Delete", key, err)
+	}
+	uc.Invalidate()
+
+	uc.recordPolicyAudit(ctx, AuditActionAccessPolicyDelete, removed, map[string]any{
+		"expression": removed.Expression,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return nil
+}
+
+// Test 试算一条表达式而不保存；编译失败返回 ErrAccessPolicyInvalid，求值出错原样返回。
+func (uc *AccessPolicyUsecase) Test(ctx context.Context, expression string, in AccessPolicyInput) (bool, error) {
+	compiled, err := uc.engine.Compile(expression)
+	if err != nil {
+		return false, err
+	}
+	if in.Now.IsZero() {
+		in.Now = uc.now()
+	}
+	return compiled.Evaluate(in)
+}
+
+// Check 在 RBAC 放行后执行 url.method 上的策略；没有策略或策略已停用时放行。
+// 任何无法得出 true 的情况（结果为 false、求值出错、存量表达式编译失败）都返回 ErrAccessPolicyDenied；
+// 策略加载失败返回其他错误，由调用方按内部错误处理，同样不放行。
+func (uc *AccessPolicyUsecase) Check(ctx context.Context, url, method string, in AccessPolicyInput) error {
+	if uc == nil {
+		return nil
+	}
+	entries, err := uc.load(ctx)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("load access policies failed err=%v", err)
+		return err
+	}
+	key := url + "." + method
+	e, ok := entries[key]
+	if !ok {
+		return nil
+	}
+	if e.compileErr != nil {
+		uc.log.WithContext(ctx).Errorf("access policy not compilable policy=%s id=%d err=%v", key, e.id, e.compileErr)
+		return ErrAccessPolicyDenied
+	}
+
+	if in.Now.IsZero() {
+		in.Now = uc.now()
+	}
+	allowed, err := e.policy.Evaluate(in)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("access policy evaluate failed policy=%s id=%d err=%v", key, e.id, err)
+		return ErrAccessPolicyDenied
+	}
+	if !allowed {
+		return ErrAccessPolicyDenied
+	}
+	return nil
+}
+
+// Invalidate 丢弃已编译的策略，下一次 Check 重新加载。
+func (uc *AccessPolicyUsecase) Invalidate() {
+	if uc == nil {
+		return
+	}
+	uc.mu.Lock()
+	uc.gen++
+	uc.entries = nil
+	uc.mu.Unlock()
+}
+
+func (uc *AccessPolicyUsecase) load(ctx context.Context) (map[string]accessPolicyEntry, error) {
+	now := uc.now()
+	uc.mu.Lock()
+	gen := uc.gen
+	if uc.entries != nil && now.Sub(uc.loadedAt) < uc.ttl {
+		entries := uc.entries
+		uc.mu.Unlock()
+		return entries, nil
+	}
+	uc.mu.Unlock()
+
+	list, err := uc.repo.ListAccessPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[string]accessPolicyEntry, len(list))
+	for _, p := range list {
+		if !p.Enabled {
+			continue
+		}
+		compiled, err := uc.engine.Compile(p.Expression)
+		entries[p.URL+"."+p.Method] = accessPolicyEntry{policy: compiled, id: p.ID, compileErr: err}
+	}
+
+	uc.mu.Lock()
+	if uc.gen == gen && uc.ttl > 0 {
+		uc.entries = entries
+		uc.loadedAt = now
+	}
+	uc.mu.Unlock()
+	return entries, nil
+}
+
+func (uc *AccessPolicyUsecase) fail(ctx context.Context, span trace.Span, op, key string, err error) error {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	l := uc.log.WithContext(ctx)
+	switch {
+	case errors.Is(err, ErrAccessPolicyInvalid), errors.Is(err, ErrAccessPolicyNotFound):
+		l.Warnf("%s rejected policy=%s err=%v", op, key, err)
+	default:
+		l.Errorf("%s failed policy=%s err=%v", op, key, err)
+	}
+	return err
+}
+
+func (uc *AccessPolicyUsecase) recordPolicyAudit(ctx context.Context, action string, p *AccessPolicy, detail map[string]any) {
+	if uc.audit == nil || p == nil {
+		return
+	}
+	e := &AuditEvent{
+		Action:     action,
+		ActorKind:  AuditActorAdmin,
+		TargetKind: "access_policy",
+		TargetID:   p.ID,
+		Detail:     detail,
+	}
+	if c, ok := GetClaimsFromContext(ctx); ok && c != nil {
+		e.ActorID = c.UserID
+		e.ActorUsername = c.Username
+	}
+	if e.Detail == nil {
+		e.Detail = map[string]any{}
+	}
+	e.Detail["url"] = p.URL
+	e.Detail["method"] = p.Method
+	if err := uc.audit.RecordAudit(ctx, e); err != nil {
+		uc.log.WithContext(ctx).Warnf("record access policy audit failed action=%s id=%d err=%v", action, p.ID, err)
+	}
+}
@@ -0,0 +1,155 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type memAccessPolicyRepo struct {
+	policies []AccessPolicy
+	lists    int
+}
+
+func (r *memAccessPolicyRepo) ListAccessPolicies(ctx context.Context) ([]AccessPolicy, error) {
+	r.lists++
+	return append([]AccessPolicy(nil), r.policies...), nil
+}
+
+func (r *memAccessPolicyRepo) UpsertAccessPolicy(ctx context.Context, in *AccessPolicy) (*AccessPolicy, error) {
+	for i, p := range r.policies {
+		if p.URL == in.URL && p.Method == in.Method {
+			in.ID = p.ID
+			r.policies[i] = *in
+			return in, nil
+		}
+	}
+	in.ID = len(r.policies) + 1
+	r.policies = append(r.policies, *in)
+	return in, nil
+}
+
+func (r *memAccessPolicyRepo) DeleteAccessPolicy(ctx context.Context, url, method string) (*AccessPolicy, error) {
+	for i, p := range r.policies {
+		if p.URL == url && p.Method == method {
+			r.policies = append(r.policies[:i], r.policies[i+1:]...)
+			return &p, nil
+		}
+	}
+	return nil, ErrAccessPolicyNotFound
+}
+
+func TestPolicyEngine_CompileRejectsInvalidExpressions(t *testing.T) {
+	engine, err := NewPolicyEngine()
+	if err != nil {
+		t.Fatalf("NewPolicyEngine() error = %v", err)
+	}
+	for _, expr := range []string{
+		"",
+		"params.region ==",
+		"params.region",
+		"unknown_var == 1",
+		"1 + 1",
+	} {
+		if _, err := engine.Compile(expr); !errors.Is(err, ErrAccessPolicyInvalid) {
+			t.Fatalf("Compile(%q) error = %v, want ErrAccessPolicyInvalid", expr, err)
+		}
+	}
+}
+
+func TestPolicyEngine_Evaluate(t *testing.T) {
+	engine, err := NewPolicyEngine()
+	if err != nil {
+		t.Fatalf("NewPolicyEngine() error = %v", err)
+	}
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	claims := PolicyClaims(&AuthClaims{UserID: 7, Username: "root", Role: RoleAdmin})
+
+	cases := []struct {
+		expr    string
+		admin   map[string]any
+		params  map[string]any
+		want    bool
+		wantErr bool
+	}{
+		{expr: `params.region == admin.region`, admin: map[string]any{"region": "cn"}, params: map[string]any{"region": "cn"}, want: true},
+		{expr: `params.region == admin.region`, admin: map[string]any{"region": "cn"}, params: map[string]any{"region": "us"}, want: false},
+		// 属性缺失时直接访问报错，调用方按拒绝处理；用 has() 可以显式给默认行为。
+		{expr: `params.region == admin.region`, admin: map[string]any{}, params: map[string]any{"region": "cn"}, wantErr: true},
+		{expr: `!has(admin.region) || params.region == admin.region`, admin: map[string]any{}, params: map[string]any{"region": "cn"}, want: true},
+		// JSON 数字按整数参与比较。
+		{expr: `params.user_id > 100 && claims.uid == 7`, params: map[string]any{"user_id": float64(101)}, want: true},
+		{expr: `claims.role == "admin" && now > timestamp("2024-01-01T00:00:00Z")`, want: true},
+		{expr: `timestamp(params.created_after) >= timestamp("2024-03-01T00:00:00Z")`, params: map[string]any{"created_after": "2024-02-01T00:00:00Z"}, want: false},
+	}
+	for _, tc := range cases {
+		p, err := engine.Compile(tc.expr)
+		if err != nil {
+			t.Fatalf("Compile(%q) error = %v", tc.expr, err)
+		}
+		got, err := p.Evaluate(AccessPolicyInput{Claims: claims, Admin: tc.admin, Params: tc.params, Now: now})
+		if tc.wantErr {
+			if err == nil {
+				t.Fatalf("Evaluate(%q) expected error, got %v", tc.expr, got)
+			}
+			continue
+		}
+		if err != nil || got != tc.want {
+			t.Fatalf("Evaluate(%q) = %v, %v; want %v", tc.expr, got, err, tc.want)
+		}
+	}
+}
+
+func TestAccessPolicyUsecase_CheckAndInvalidate(t *testing.T) {
+	repo := &memAccessPolicyRepo{}
+	uc, err := NewAccessPolicyUsecase(repo, nil, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	if err != nil {
+		t.Fatalf("NewAccessPolicyUsecase() error = %v", err)
+	}
+	ctx := context.Background()
+	in := AccessPolicyInput{Admin: map[string]any{"region": "cn"}, Params: map[string]any{"region": "us"}}
+
+	if err := uc.Check(ctx, "user", "set_disabled", in); err != nil {
+		t.Fatalf("expected no policy to allow, got %v", err)
+	}
+
+	if _, err := uc.Upsert(ctx, AccessPolicy{URL: "user", Method: "set_disabled", Expression: "params.region", Enabled: true}); !errors.Is(err, ErrAccessPolicyInvalid) {
+		t.Fatalf("expected invalid expression to be rejected, got %v", err)
+	}
+	if len(repo.policies) != 0 {
+		t.Fatalf("invalid policy must not be saved: %+v", repo.policies)
+	}
+
+	if _, err := uc.Upsert(ctx, AccessPolicy{URL: "user", Method: "set_disabled", Expression: "params.region == admin.region", Enabled: true}); err != nil {
+		t.Fatalf("Upsert() error = %v", err)
+	}
+	if err := uc.Check(ctx, "user", "set_disabled", in); !errors.Is(err, ErrAccessPolicyDenied) {
+		t.Fatalf("expected region mismatch to be denied, got %v", err)
+	}
+	lists := repo.lists
+	in.Params["region"] = "cn"
+	if err := uc.Check(ctx, "user", "set_disabled", in); err != nil {
+		t.Fatalf("expected region match to pass, got %v", err)
+	}
+	if repo.lists != lists {
+		t.Fatalf("expected compiled policies to be cached, lists=%d", repo.lists)
+	}
+
+	// 存量数据里的坏表达式（如手工改库）一律拒绝。
+	repo.policies[0].Expression = "params.region"
+	uc.Invalidate()
+	if err := uc.Check(ctx, "user", "set_disabled", in); !errors.Is(err, ErrAccessPolicyDenied) {
+		t.Fatalf("expected uncompilable stored policy to deny, got %v", err)
+	}
+
+	repo.policies[0].Enabled = false
+	uc.Invalidate()
+	if err := uc.Check(ctx, "user", "set_disabled", in); err != nil {
+		t.Fatalf("expected disabled policy to be skipped, got %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+// server/internal/biz/admin_access.go
+package biz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// DefaultAdminAccessCacheTTL 管理员权限进程内缓存的默认有效期。
+// 本进程内的角色/权限变更会立即失效缓存；多实例部署时其他实例最多延迟这么久生效。
+const DefaultAdminAccessCacheTTL = 5 * time.Second
+
+// adminAccessKey 组织内角色只在该组织下生效，同一管理员在不同组织下的权限分别缓存。
+type adminAccessKey struct {
+	id    int
+	orgID int
+}
+
+type adminAccessEntry struct {
+	admin     *AdminUser
+	expiresAt time.Time
+}
+
+// AdminAccessResolver 解析管理员的状态、角色与权限，供每次后台 RPC 的权限校验使用。
+// 两级缓存：同一请求内只查一次（需先用 NewContextWithAdminAccessCache 挂载），
+// 跨请求走短 TTL 的进程内缓存。它本身实现 AdminAccountReader，可直接替换底层 repo。
+type AdminAccessResolver struct {
+	repo AdminAccountReader
+	ttl  time.Duration
+	now  func() time.Time
+	log  *log.Helper
+
+	mu      sync.Mutex
+	entries map[adminAccessKey]adminAccessEntry
+	// gen 在每次失效时递增；加载前后 gen 不一致说明期间发生过变更，结果不写回缓存。
+	gen uint64
+}
+
+var _ AdminAccountReader = (*AdminAccessResolver)(nil)
+
+func NewAdminAccessResolver(repo AdminAuthRepo, policy *AuthPolicy, logger log.Logger) *AdminAccessResolver {
+	ttl := DefaultAdminAccessCacheTTL
+	if policy != nil && policy.AdminAccessCacheTTL != 0 {
+		ttl = policy.AdminAccessCacheTTL
+	}
+	return &AdminAccessResolver{
+		repo:    repo,
+		ttl:     ttl,
+		now:     time.Now,
+		log:     log.NewHelper(log.With(logger, "module", "biz.admin_access")),
+		entries: map[adminAccessKey]adminAccessEntry{},
+	}
+}
+
+// GetAdminByID 返回管理员在当前组织下的只读快照；调用方不要修改返回值里的切片。
+func (r *AdminAccessResolver) GetAdminByID(ctx context.Context, id int) (*AdminUser, error) {
+	orgID, _ := TenantFromContext(ctx)
+	key := adminAccessKey{id: id, orgID: orgID}
+	reqCache := adminAccessCacheFromContext(ctx)
+	if reqCache != nil {
+		if a, ok := reqCache.get(key); ok {
+			return a, nil
+		}
+	}
+
+	now := r.now()
+	r.mu.Lock()
+	gen := r.gen
+	e, ok := r.entries[key]
+	r.mu.Unlock()
+	if ok && now.Before(e.expiresAt) {
+		reqCache.put(key, e.admin)
+		return e.admin, nil
+	}
+
+	a, err := r.repo.GetAdminByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		return nil, nil
+	}
+
+	if r.ttl > 0 {
+		r.mu.Lock()
+		if r.gen == gen {
+			r.entries[key] = adminAccessEntry{admin: a, expiresAt: now.Add(r.ttl)}
+		}
+		r.mu.Unlock()
+	}
+	reqCache.put(key, a)
+	return a, nil
+}
+
+// Invalidate 让单个管理员在所有组织下的缓存失效，用于禁用、改角色等只影响该账号的变更。
+func (r *AdminAccessResolver) Invalidate(id int) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	r.gen++
+	for key := range r.entries {
+		if key.id == id {
+			delete(r.entries, key)
+		}
+	}
+	r.mu.Unlock()
+}
+
+// InvalidateAll 清空缓存，用于角色权限变更这类影响面不确定的操作。
+func (r *AdminAccessResolver) InvalidateAll() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	r.gen++
+	r.entries = map[adminAccessKey]adminAccessEntry{}
+	r.mu.Unlock()
+}
+
+type adminAccessRequestCache struct {
+	mu      sync.Mutex
+	entries map[adminAccessKey]*AdminUser
+}
+
+func (c *adminAccessRequestCache) get(key adminAccessKey) (*AdminUser, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	a, ok := c.entries[key]
+	return a, ok
+}
+
+func (c *adminAccessRequestCache) put(key adminAccessKey, a *AdminUser) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	c.entries[key] = a
+	c.mu.Unlock()
+}
+
+type adminAccessCacheKey struct{}
+
+// NewContextWithAdminAccessCache 为一次请求挂载管理员权限缓存；同一请求内多次校验只查一次库。
+func NewContextWithAdminAccessCache(ctx context.Context) context.Context {
+	if adminAccessCacheFromContext(ctx) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, adminAccessCacheKey{}, &adminAccessRequestCache{entries: map[adminAccessKey]*AdminUser{}})
+}
+
+func adminAccessCacheFromContext(ctx context.Context) *adminAccessRequestCache {
+	c, _ := ctx.Value(adminAccessCacheKey{}).(*adminAccessRequestCache)
+	return c
+}
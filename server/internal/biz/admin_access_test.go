@@ -0,0 +1,94 @@
+package biz
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type countingAdminRepo struct {
+	admins map[int]*AdminUser
+	calls  int
+}
+
+func (r *countingAdminRepo) GetAdminByID(ctx context.Context, id int) (*AdminUser, error) {
+	r.calls++
+	a, ok := r.admins[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	cp := *a
+	return &cp, nil
+}
+
+func (r *countingAdminRepo) GetAdminByUsername(ctx context.Context, username string) (*AdminUser, error) {
+	return nil, sql.ErrNoRows
+}
+
+func (r *countingAdminRepo) UpdateAdminLastLogin(ctx context.Context, id int, t time.Time) error {
+	return nil
+}
+
+func newTestAdminAccessResolver(ttl time.Duration) (*AdminAccessResolver, *countingAdminRepo, *time.Time) {
+	repo := &countingAdminRepo{admins: map[int]*AdminUser{7: {ID: 7, Username: "root", Permissions: []string{PermissionAdminAccess}}}}
+	r := NewAdminAccessResolver(repo, &AuthPolicy{AdminAccessCacheTTL: ttl}, log.NewStdLogger(io.Discard))
+	now := time.Unix(1700000000, 0)
+	r.now = func() time.Time { return now }
+	return r, repo, &now
+}
+
+func TestAdminAccessResolver_RequestCache(t *testing.T) {
+	r, repo, _ := newTestAdminAccessResolver(-1)
+	ctx := NewContextWithAdminAccessCache(context.Background())
+
+	for i := 0; i < 3; i++ {
+		if _, err := r.GetAdminByID(ctx, 7); err != nil {
+			t.Fatalf("expected nil err, got %v", err)
+		}
+	}
+	if repo.calls != 1 {
+		t.Fatalf("expected 1 repo call within a request, got %d", repo.calls)
+	}
+
+	// 进程缓存关闭时，新请求必须重新查库。
+	if _, err := r.GetAdminByID(NewContextWithAdminAccessCache(context.Background()), 7); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if repo.calls != 2 {
+		t.Fatalf("expected 2 repo calls, got %d", repo.calls)
+	}
+}
+
+func TestAdminAccessResolver_ProcessCacheTTLAndInvalidate(t *testing.T) {
+	r, repo, now := newTestAdminAccessResolver(5 * time.Second)
+	ctx := context.Background()
+
+	r.GetAdminByID(ctx, 7)
+	r.GetAdminByID(ctx, 7)
+	if repo.calls != 1 {
+		t.Fatalf("expected cached lookup, got %d calls", repo.calls)
+	}
+
+	repo.admins[7].Disabled = true
+	r.Invalidate(7)
+	a, _ := r.GetAdminByID(ctx, 7)
+	if !a.Disabled || repo.calls != 2 {
+		t.Fatalf("expected fresh lookup after Invalidate, disabled=%v calls=%d", a.Disabled, repo.calls)
+	}
+
+	*now = now.Add(6 * time.Second)
+	r.GetAdminByID(ctx, 7)
+	if repo.calls != 3 {
+		t.Fatalf("expected reload after TTL, got %d calls", repo.calls)
+	}
+
+	r.InvalidateAll()
+	r.GetAdminByID(ctx, 7)
+	if repo.calls != 4 {
+		t.Fatalf("expected reload after InvalidateAll, got %d calls", repo.calls)
+	}
+}
@@ -0,0 +1,679 @@
+// server/internal/biz/admin_account.go
+package biz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	PermissionAccountRead  = "admin.account.read"
+	PermissionAccountWrite = "admin.account.write"
+)
+
+var _ = RegisterAdminPermissions(
+	AdminPermission{
+		Key:         PermissionAccountRead,
+		Name:        "查看管理员",
+		Group:       "管理员",
+		Description: "允许查看后台管理员账号及其角色",
+	},
+	AdminPermission{
+		Key:         PermissionAccountWrite,
+		Name:        "管理管理员",
+		Group:       "管理员",
+		Description: "允许创建、启用/禁用管理员，重置其密码并分配角色",
+	},
+)
+
+var (
+	ErrAdminNotFound         = errors.New("admin not found")
+	ErrAdminSelfLockout      = errors.New("admin cannot lock themselves out")
+	ErrAdminPasswordTooShort = errors.New("admin password too short")
+	// ErrAdminPrivilegeEscalation 操作者试图授予自己没有的权限，或者不是超管却要管理超管账号。
+	ErrAdminPrivilegeEscalation = errors.New("admin privilege escalation")
+)
+
+// AdminPasswordMinLen 管理员密码最短长度（按字符计）；普通用户密码规则不受影响。
+const AdminPasswordMinLen = 8
+
+// AdminRoleGrantMaxDuration 单次临时授权的最长时长，超出按参数错误处理；需要长期权限请直接分配角色。
+const AdminRoleGrantMaxDuration = 30 * 24 * time.Hour
+
+// AdminAttributesMaxKeys 单个管理员自定义属性的最大个数。
+const AdminAttributesMaxKeys = 32
+
+// AdminRoleGrant 是一条临时角色授权；StartsAt 为空表示立即生效。
+type AdminRoleGrant struct {
+	AdminID   int
+	RoleKey   string
+	StartsAt  *time.Time
+	ExpiresAt time.Time
+}
+
+const (
+	AuditActionAdminCreate        = "admin.create"
+	AuditActionAdminDisable       = "admin.disable"
+	AuditActionAdminEnable        = "admin.enable"
+	AuditActionAdminResetPassword = "admin.reset_password"
+	AuditActionAdminAssignRoles   = "admin.assign_roles"
+	AuditActionAdminGrantRole     = "admin.grant_role"
+	AuditActionAdminRevokeRole    = "admin.revoke_role"
+	AuditActionAdminGrantExpired  = "admin.role_grant_expired"
+	AuditActionAdminSetAttributes = "admin.set_attributes"
+)
+
+// AdminAccountRepo 管理 admin_users 与 admin_user_roles。
+// 会改变超管数量的写方法（禁用、分配角色）与 RBACRepo 一样在事务内校验，
+// 变更若让启用中的 super_admin 管理员清零则回滚并返回 ErrLastSuperAdmin。
+type AdminAccountRepo interface {
+	ListAdmins(ctx context.Context, limit, offset int, usernameLike string) (list []*AdminUser, total int, err error)
+	// CreateAdmin 用户名与普通用户、管理员共用命名空间，冲突返回 ErrUserExists；角色 key 不存在返回 ErrRoleNotFound。
+	CreateAdmin(ctx context.Context, in *AdminUser, roleKeys []string) (*AdminUser, error)
+	SetAdminDisabled(ctx context.Context, id int, disabled bool) error
+	UpdateAdminPassword(ctx context.Context, id int, passwordHash string) error
+	// SetAdminRoles 整体替换管理员的长期角色；临时授权保留，与长期角色重叠的转为长期。
+	SetAdminRoles(ctx context.Context, id int, roleKeys []string) (*AdminUser, error)
+	// GrantAdminRole 写入或延长临时授权；该角色已是长期角色时保持长期不变。
+	GrantAdminRole(ctx context.Context, grant AdminRoleGrant) (*AdminUser, error)
+	// RevokeAdminRole 删除管理员的某个角色绑定，长期角色与临时授权（含未开始的）都会被删除；
+	// 角色 key 不存在返回 ErrRoleNotFound，本来就没有该绑定时 revoked 为 false。
+	RevokeAdminRole(ctx context.Context, id int, roleKey string) (admin *AdminUser, revoked bool, err error)
+	// DeleteExpiredRoleGrants 删除 expires_at <= now 的临时授权并返回被删除的行，多副本并发执行时每行只会返回一次。
+	DeleteExpiredRoleGrants(ctx context.Context, now time.Time) ([]AdminRoleGrant, error)
+	// SetAdminAttributes 整体替换管理员的自定义属性。
+	SetAdminAttributes(ctx context.Context, id int, attributes map[string]any) (*AdminUser, error)
+}
+
+type AdminAccountUsecase struct {
+	repo   AdminAccountRepo
+	access *AdminAccessResolver
+	rbac   RBACRepo
+	audit  AuditRepo
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewAdminAccountUsecase(
+	repo AdminAccountRepo,
+	access *AdminAccessResolver,
+	rbac RBACRepo,
+	audit AuditRepo,
+	logger log.Logger,
+	tp *tracesdk.TracerProvider,
+) *AdminAccountUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.admin_account"))
+
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.admin_account")
+	} else {
+		tr = otel.Tracer("biz.admin_account")
+	}
+
+	return &AdminAccountUsecase{
+		repo:   repo,
+		access: access,
+		rbac:   rbac,
+		audit:  audit,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+func (uc *AdminAccountUsecase) Tracer() trace.Tracer {
+	if uc.tracer != nil {
+		return uc.tracer
+	}
+	return otel.Tracer("biz.admin_account")
+}
+
+func (uc *AdminAccountUsecase) List(ctx context.Context, limit, offset int, search string) ([]*AdminUser, int, error) {
+	ctx, span := uc.Tracer().Start(ctx, "admin_account.list",
+		trace.WithAttributes(
+			attribute.Int("admin_account.limit", limit),
+			attribute.Int("admin_account.offset", offset),
+		),
+	)
+	defer span.End()
+
+	if limit <= 0 {
+		limit = 30
+	}
+	if limit > 200 {
+		limit = 200
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	list, total, err := uc.repo.ListAdmins(ctx, limit, offset, strings.TrimSpace(search))
+	if err != nil {
+		return nil, 0, uc.fail(ctx, span, "List", 0, err)
+	}
+	span.SetStatus(codes.Ok, "OK")
+	return list, total, nil
+}
+
+// Create 新建管理员并绑定初始角色；用户名规则与注册一致，落库保留规范化前的展示形式。
+func (uc *AdminAccountUsecase) Create(ctx context.Context, username, password string, roleKeys []string) (*AdminUser, error) {
+	ctx, span := uc.Tracer().Start(ctx, "admin_account.create",
+		trace.WithAttributes(attribute.String("admin_account.username", username)),
+	)
+	defer span.End()
+
+	canonical, _, err := ValidateUsername(username)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	hash, err := uc.hashPassword(password)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	roleKeys = normalizeRoleKeys(roleKeys)
+	permissions, err := uc.rolePermissions(ctx, roleKeys)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "Create", 0, err)
+	}
+	if err := uc.checkRoleGrant(ctx, roleKeys, permissions); err != nil {
+		return nil, uc.fail(ctx, span, "Create", 0, err)
+	}
+
+	admin, err := uc.repo.CreateAdmin(ctx, &AdminUser{Username: canonical, PasswordHash: hash}, roleKeys)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "Create", 0, err)
+	}
+
+	uc.recordAdminAudit(ctx, AuditActionAdminCreate, admin.ID, map[string]any{
+		"username": admin.Username,
+		"roles":    admin.Roles,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return admin, nil
+}
+
+// SetDisabled 启用或禁用管理员；不能禁用自己，也不能禁用最后一个启用中的超管。
+func (uc *AdminAccountUsecase) SetDisabled(ctx context.Context, id int, disabled bool) error {
+	ctx, span := uc.Tracer().Start(ctx, "admin_account.set_disabled",
+		trace.WithAttributes(
+			attribute.Int("admin_account.id", id),
+			attribute.Bool("admin_account.disabled", disabled),
+		),
+	)
+	defer span.End()
+
+	if id <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return ErrBadParam
+	}
+	if disabled && uc.isSelf(ctx, id) {
+		span.SetStatus(codes.Error, ErrAdminSelfLockout.Error())
+		return ErrAdminSelfLockout
+	}
+	if err := uc.checkTarget(ctx, id); err != nil {
+		return uc.fail(ctx, span, "SetDisabled", id, err)
+	}
+
+	if err := uc.repo.SetAdminDisabled(ctx, id, disabled); err != nil {
+		return uc.fail(ctx, span, "SetDisabled", id, err)
+	}
+	uc.access.Invalidate(id)
+
+	action := AuditActionAdminEnable
+	if disabled {
+		action = AuditActionAdminDisable
+	}
+	uc.recordAdminAudit(ctx, action, id, nil)
+	span.SetStatus(codes.Ok, "OK")
+	return nil
+}
+
+// ResetPassword 由管理员直接设置另一个管理员的新密码；密码本身不进审计。
+// 重置后就能以对方身份登录，所以超管的密码只能由超管重置。
+func (uc *AdminAccountUsecase) ResetPassword(ctx context.Context, id int, password string) error {
+	ctx, span := uc.Tracer().Start(ctx, "admin_account.reset_password",
+		trace.WithAttributes(attribute.Int("admin_account.id", id)),
+	)
+	defer span.End()
+
+	if id <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return ErrBadParam
+	}
+	hash, err := uc.hashPassword(password)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	if err := uc.checkTarget(ctx, id); err != nil {
+		return uc.fail(ctx, span, "ResetPassword", id, err)
+	}
+
+	if err := uc.repo.UpdateAdminPassword(ctx, id, hash); err != nil {
+		return uc.fail(ctx, span, "ResetPassword", id, err)
+	}
+
+	uc.recordAdminAudit(ctx, AuditActionAdminResetPassword, id, nil)
+	span.SetStatus(codes.Ok, "OK")
+	return nil
+}
+
+// AssignRoles 整体替换管理员的角色。新角色的权限必须都是操作者自己拥有的；
+// 给自己分配角色时，新角色集合必须仍包含 admin.account.write，否则操作者会失去继续管理管理员账号的能力。
+func (uc *AdminAccountUsecase) AssignRoles(ctx context.Context, id int, roleKeys []string) (*AdminUser, error) {
+	ctx, span := uc.Tracer().Start(ctx, "admin_account.assign_roles",
+		trace.WithAttributes(attribute.Int("admin_account.id", id)),
+	)
+	defer span.End()
+
+	if id <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+	roleKeys = normalizeRoleKeys(roleKeys)
+
+	permissions, err := uc.rolePermissions(ctx, roleKeys)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "AssignRoles", id, err)
+	}
+	if uc.isSelf(ctx, id) && !permissions.Allows(PermissionAccountWrite) {
+		span.SetStatus(codes.Error, ErrAdminSelfLockout.Error())
+		return nil, ErrAdminSelfLockout
+	}
+	if err := uc.checkTarget(ctx, id); err != nil {
+		return nil, uc.fail(ctx, span, "AssignRoles", id, err)
+	}
+	if err := uc.checkRoleGrant(ctx, roleKeys, permissions); err != nil {
+		return nil, uc.fail(ctx, span, "AssignRoles", id, err)
+	}
+
+	var beforeRoles []string
+	if uc.access != nil {
+		if before, err := uc.access.GetAdminByID(ctx, id); err == nil && before != nil {
+			beforeRoles = before.Roles
+		}
+	}
+
+	admin, err := uc.repo.SetAdminRoles(ctx, id, roleKeys)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "AssignRoles", id, err)
+	}
+	uc.access.Invalidate(id)
+
+	uc.recordAdminAudit(ctx, AuditActionAdminAssignRoles, id, map[string]any{
+		"before": beforeRoles,
+		"after":  admin.Roles,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return admin, nil
+}
+
+// GrantRole 给管理员临时授予角色，从 startsAt（零值为立即）起生效 duration。
+// 临时授权不计入“最后一个超管”保护，过期后由后台任务删除并写审计。
+func (uc *AdminAccountUsecase) GrantRole(ctx context.Context, id int, roleKey string, startsAt time.Time, duration time.Duration) (*AdminUser, error) {
+	ctx, span := uc.Tracer().Start(ctx, "admin_account.grant_role",
+		trace.WithAttributes(
+			attribute.Int("admin_account.id", id),
+			attribute.String("admin_account.role_key", roleKey),
+			attribute.Int64("admin_account.duration_seconds", int64(duration/time.Second)),
+		),
+	)
+	defer span.End()
+
+	roleKey = strings.TrimSpace(roleKey)
+	if id <= 0 || roleKey == "" || duration <= 0 || duration > AdminRoleGrantMaxDuration {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+	permissions, err := uc.rolePermissions(ctx, []string{roleKey})
+	if err != nil {
+		return nil, uc.fail(ctx, span, "GrantRole", id, err)
+	}
+	if err := uc.checkTarget(ctx, id); err != nil {
+		return nil, uc.fail(ctx, span, "GrantRole", id, err)
+	}
+	if err := uc.checkRoleGrant(ctx, []string{roleKey}, permissions); err != nil {
+		return nil, uc.fail(ctx, span, "GrantRole", id, err)
+	}
+
+	grant := AdminRoleGrant{AdminID: id, RoleKey: roleKey}
+	begin := time.Now()
+	if !startsAt.IsZero() && startsAt.After(begin) {
+		begin = startsAt
+		grant.StartsAt = &begin
+	}
+	grant.ExpiresAt = begin.Add(duration)
+
+	admin, err := uc.repo.GrantAdminRole(ctx, grant)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "GrantRole", id, err)
+	}
+	uc.access.Invalidate(id)
+
+	detail := map[string]any{
+		"role":       roleKey,
+		"expires_at": grant.ExpiresAt.Unix(),
+	}
+	if grant.StartsAt != nil {
+		detail["starts_at"] = grant.StartsAt.Unix()
+	}
+	uc.recordAdminAudit(ctx, AuditActionAdminGrantRole, id, detail)
+	span.SetStatus(codes.Ok, "OK")
+	return admin, nil
+}
+
+// RevokeRole 撤销管理员的一个角色，不区分长期角色与临时授权。
+// 只能撤销自己有能力授予的角色；撤销自己的角色后仍须保留 admin.account.write。
+func (uc *AdminAccountUsecase) RevokeRole(ctx context.Context, id int, roleKey string) (*AdminUser, error) {
+	ctx, span := uc.Tracer().Start(ctx, "admin_account.revoke_role",
+		trace.WithAttributes(
+			attribute.Int("admin_account.id", id),
+			attribute.String("admin_account.role_key", roleKey),
+		),
+	)
+	defer span.End()
+
+	roleKey = strings.TrimSpace(roleKey)
+	if id <= 0 || roleKey == "" {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+	permissions, err := uc.rolePermissions(ctx, []string{roleKey})
+	if err != nil {
+		return nil, uc.fail(ctx, span, "RevokeRole", id, err)
+	}
+	if err := uc.checkTarget(ctx, id); err != nil {
+		return nil, uc.fail(ctx, span, "RevokeRole", id, err)
+	}
+	if err := uc.checkRoleGrant(ctx, []string{roleKey}, permissions); err != nil {
+		return nil, uc.fail(ctx, span, "RevokeRole", id, err)
+	}
+
+	var beforeRoles []string
+	if uc.access != nil {
+		if before, err := uc.access.GetAdminByID(ctx, id); err == nil && before != nil {
+			beforeRoles = before.Roles
+		}
+	}
+	if uc.isSelf(ctx, id) {
+		remaining := slices.DeleteFunc(slices.Clone(beforeRoles), func(k string) bool { return k == roleKey })
+		kept, err := uc.rolePermissions(ctx, remaining)
+		if err != nil {
+			return nil, uc.fail(ctx, span, "RevokeRole", id, err)
+		}
+		if !kept.Allows(PermissionAccountWrite) {
+			span.SetStatus(codes.Error, ErrAdminSelfLockout.Error())
+			return nil, ErrAdminSelfLockout
+		}
+	}
+
+	admin, revoked, err := uc.repo.RevokeAdminRole(ctx, id, roleKey)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "RevokeRole", id, err)
+	}
+	if !revoked {
+		span.SetStatus(codes.Ok, "OK")
+		return admin, nil
+	}
+	uc.access.Invalidate(id)
+
+	uc.recordAdminAudit(ctx, AuditActionAdminRevokeRole, id, map[string]any{
+		"role":   roleKey,
+		"before": beforeRoles,
+		"after":  admin.Roles,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return admin, nil
+}
+
+// SetAttributes 整体替换管理员的自定义属性；属性只被访问策略读取，不影响 RBAC 本身。
+func (uc *AdminAccountUsecase) SetAttributes(ctx context.Context, id int, attributes map[string]any) (*AdminUser, error) {
+	ctx, span := uc.Tracer().Start(ctx, "admin_account.set_attributes",
+		trace.WithAttributes(attribute.Int("admin_account.id", id)),
+	)
+	defer span.End()
+
+	if id <= 0 || len(attributes) > AdminAttributesMaxKeys {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+	for k := range attributes {
+		if strings.TrimSpace(k) == "" {
+			span.SetStatus(codes.Error, ErrBadParam.Error())
+			return nil, ErrBadParam
+		}
+	}
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+	if err := uc.checkTarget(ctx, id); err != nil {
+		return nil, uc.fail(ctx, span, "SetAttributes", id, err)
+	}
+
+	var before map[string]any
+	if uc.access != nil {
+		if a, err := uc.access.GetAdminByID(ctx, id); err == nil && a != nil {
+			before = a.Attributes
+		}
+	}
+
+	admin, err := uc.repo.SetAdminAttributes(ctx, id, attributes)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "SetAttributes", id, err)
+	}
+	uc.access.Invalidate(id)
+
+	uc.recordAdminAudit(ctx, AuditActionAdminSetAttributes, id, map[string]any{
+		"before": before,
+		"after":  admin.Attributes,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return admin, nil
+}
+
+// PurgeExpiredRoleGrants 删除已过期的临时授权，每条写一条系统审计；由 JobServer 周期调用。
+// 鉴权查询本身已忽略过期授权，这里只负责清理与留痕，晚跑一会儿不影响权限判断。
+func (uc *AdminAccountUsecase) PurgeExpiredRoleGrants(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := uc.Tracer().Start(ctx, "admin_account.purge_expired_grants")
+	defer span.End()
+
+	expired, err := uc.repo.DeleteExpiredRoleGrants(ctx, now)
+	if err != nil {
+		return 0, uc.fail(ctx, span, "PurgeExpiredRoleGrants", 0, err)
+	}
+	for _, g := range expired {
+		uc.access.Invalidate(g.AdminID)
+		uc.recordGrantExpired(ctx, g)
+	}
+	span.SetAttributes(attribute.Int("admin_account.expired_grants", len(expired)))
+	span.SetStatus(codes.Ok, "OK")
+	if len(expired) > 0 {
+		uc.log.WithContext(ctx).Infof("purged expired admin role grants count=%d", len(expired))
+	}
+	return len(expired), nil
+}
+
+func (uc *AdminAccountUsecase) recordGrantExpired(ctx context.Context, g AdminRoleGrant) {
+	if uc.audit == nil {
+		return
+	}
+	detail := map[string]any{
+		"role":       g.RoleKey,
+		"expires_at": g.ExpiresAt.Unix(),
+	}
+	if g.StartsAt != nil {
+		detail["starts_at"] = g.StartsAt.Unix()
+	}
+	e := &AuditEvent{
+		Action:     AuditActionAdminGrantExpired,
+		ActorKind:  AuditActorSystem,
+		TargetKind: "admin",
+		TargetID:   g.AdminID,
+		Detail:     detail,
+	}
+	if err := uc.audit.RecordAudit(ctx, e); err != nil {
+		uc.log.WithContext(ctx).Warnf("record grant expired audit failed admin_id=%d role=%s err=%v", g.AdminID, g.RoleKey, err)
+	}
+}
+
+func (uc *AdminAccountUsecase) hashPassword(password string) (string, error) {
+	if utf8.RuneCountInString(password) < AdminPasswordMinLen {
+		return "", ErrAdminPasswordTooShort
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		// bcrypt 只接受 72 字节以内的输入，超长密码按参数错误处理。
+		return "", ErrBadParam
+	}
+	return string(hash), nil
+}
+
+// rolePermissions 校验角色 key 均存在，并返回这些角色合并后的权限集合。
+func (uc *AdminAccountUsecase) rolePermissions(ctx context.Context, roleKeys []string) (PermissionSet, error) {
+	if len(roleKeys) == 0 {
+		return PermissionSet{}, nil
+	}
+	overview, err := uc.rbac.Overview(ctx)
+	if err != nil {
+		return PermissionSet{}, err
+	}
+	return overviewRolePermissions(overview, roleKeys)
+}
+
+// overviewRolePermissions 从 RBAC 概览里取出角色合并后的权限集合（含继承），角色不存在返回 ErrRoleNotFound。
+func overviewRolePermissions(overview *RBACOverview, roleKeys []string) (PermissionSet, error) {
+	byKey := make(map[string]RBACRoleSummary, len(overview.Roles))
+	for _, role := range overview.Roles {
+		byKey[role.Key] = role
+	}
+	var entries []string
+	for _, key := range roleKeys {
+		role, ok := byKey[key]
+		if !ok {
+			return PermissionSet{}, ErrRoleNotFound
+		}
+		entries = append(entries, role.Permissions...)
+		entries = append(entries, role.InheritedPermissions...)
+	}
+	return NewPermissionSet(entries), nil
+}
+
+// checkRoleGrant 要求操作者已经拥有这些角色授予的全部权限，super_admin 只能由超管授予。
+func (uc *AdminAccountUsecase) checkRoleGrant(ctx context.Context, roleKeys []string, permissions PermissionSet) error {
+	op, err := currentAdmin(ctx, uc.access)
+	if err != nil {
+		return err
+	}
+	return checkAdminRoleGrant(op, roleKeys, permissions)
+}
+
+// checkTarget 不是超管的操作者不能改动超管账号：重置密码、改角色、禁用都等于接管或架空对方。
+func (uc *AdminAccountUsecase) checkTarget(ctx context.Context, id int) error {
+	op, err := currentAdmin(ctx, uc.access)
+	if err != nil {
+		return err
+	}
+	if isSuperAdmin(op) {
+		return nil
+	}
+	target, err := uc.access.GetAdminByID(ctx, id)
+	if errors.Is(err, ErrAdminNotFound) || (err == nil && target == nil) {
+		// 不存在的交给后续写操作返回 ErrAdminNotFound。
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if isSuperAdmin(target) {
+		return ErrAdminPrivilegeEscalation
+	}
+	return nil
+}
+
+// currentAdmin 取出发起本次操作的管理员（含当前组织下生效的角色与权限）；取不到时按无权处理。
+func currentAdmin(ctx context.Context, access *AdminAccessResolver) (*AdminUser, error) {
+	c, ok := GetClaimsFromContext(ctx)
+	if !ok || c == nil || c.Role != RoleAdmin || access == nil {
+		return nil, ErrForbidden
+	}
+	op, err := access.GetAdminByID(ctx, c.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if op == nil || op.Disabled {
+		return nil, ErrForbidden
+	}
+	return op, nil
+}
+
+func isSuperAdmin(a *AdminUser) bool {
+	return a != nil && slices.Contains(a.Roles, SuperAdminRoleKey)
+}
+
+// checkAdminRoleGrant 校验 op 能否授予 roleKeys（合并权限为 permissions）。
+func checkAdminRoleGrant(op *AdminUser, roleKeys []string, permissions PermissionSet) error {
+	if isSuperAdmin(op) {
+		return nil
+	}
+	if slices.Contains(roleKeys, SuperAdminRoleKey) || !NewPermissionSet(op.Permissions).Covers(permissions) {
+		return ErrAdminPrivilegeEscalation
+	}
+	return nil
+}
+
+func (uc *AdminAccountUsecase) isSelf(ctx context.Context, id int) bool {
+	c, ok := GetClaimsFromContext(ctx)
+	return ok && c != nil && c.Role == RoleAdmin && c.UserID == id
+}
+
+func (uc *AdminAccountUsecase) fail(ctx context.Context, span trace.Span, op string, adminID int, err error) error {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	l := uc.log.WithContext(ctx)
+	switch {
+	case errors.Is(err, ErrAdminNotFound), errors.Is(err, ErrUserExists), errors.Is(err, ErrRoleNotFound), errors.Is(err, ErrLastSuperAdmin),
+		errors.Is(err, ErrAdminPrivilegeEscalation), errors.Is(err, ErrForbidden):
+		l.Warnf("%s rejected admin_id=%d err=%v", op, adminID, err)
+	default:
+		l.Errorf("%s failed admin_id=%d err=%v", op, adminID, err)
+	}
+	return err
+}
+
+// recordAdminAudit 在变更成功后写审计；此时变更已提交，写入失败只告警。
+func (uc *AdminAccountUsecase) recordAdminAudit(ctx context.Context, action string, adminID int, detail map[string]any) {
+	if uc.audit == nil {
+		return
+	}
+	if detail == nil {
+		detail = map[string]any{}
+	}
+	e := &AuditEvent{
+		Action:     action,
+		ActorKind:  AuditActorAdmin,
+		TargetKind: "admin",
+		TargetID:   adminID,
+		Detail:     detail,
+	}
+	if c, ok := GetClaimsFromContext(ctx); ok && c != nil {
+		e.ActorID = c.UserID
+		e.ActorUsername = c.Username
+	}
+	if err := uc.audit.RecordAudit(ctx, e); err != nil {
+		uc.log.WithContext(ctx).Warnf("record admin audit failed action=%s admin_id=%d err=%v", action, adminID, err)
+	}
+}
@@ -0,0 +1,398 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type memAdminAccountRepo struct {
+	admins map[int]*AdminUser
+	grants []AdminRoleGrant
+	nextID int
+}
+
+func newMemAdminAccountRepo() *memAdminAccountRepo {
+	return &memAdminAccountRepo{
+		admins: map[int]*AdminUser{7: {ID: 7, Username: "root", Roles: []string{SuperAdminRoleKey}, Permissions: AdminPermissionKeys()}},
+		nextID: 8,
+	}
+}
+
+func (r *memAdminAccountRepo) GetAdminByID(ctx context.Context, id int) (*AdminUser, error) {
+	a, ok := r.admins[id]
+	if !ok {
+		return nil, ErrAdminNotFound
+	}
+	cp := *a
+	return &cp, nil
+}
+
+func (r *memAdminAccountRepo) GetAdminByUsername(ctx context.Context, username string) (*AdminUser, error) {
+	for _, a := range r.admins {
+		if NormalizeUsername(a.Username) == NormalizeUsername(username) {
+			cp := *a
+			return &cp, nil
+		}
+	}
+	return nil, ErrAdminNotFound
+}
+
+func (r *memAdminAccountRepo) UpdateAdminLastLogin(ctx context.Context, id int, t time.Time) error {
+	return nil
+}
+
+func (r *memAdminAccountRepo) ListAdmins(ctx context.Context, limit, offset int, usernameLike string) ([]*AdminUser, int, error) {
+	out := make([]*AdminUser, 0, len(r.admins))
+	for _, a := range r.admins {
+		out = append(out, a)
+	}
+	return out, len(out), nil
+}
+
+func (r *memAdminAccountRepo) CreateAdmin(ctx context.Context, in *AdminUser, roleKeys []string) (*AdminUser, error) {
+	for _, a := range r.admins {
+		if NormalizeUsername(a.Username) == NormalizeUsername(in.Username) {
+			return nil, ErrUserExists
+		}
+	}
+	cp := *in
+	cp.ID = r.nextID
+	cp.Roles = roleKeys
+	r.nextID++
+	r.admins[cp.ID] = &cp
+	return &cp, nil
+}
+
+func (r *memAdminAccountRepo) SetAdminDisabled(ctx context.Context, id int, disabled bool) error {
+	a, ok := r.admins[id]
+	if !ok {
+		return ErrAdminNotFound
+	}
+	a.Disabled = disabled
+	return nil
+}
+
+func (r *memAdminAccountRepo) UpdateAdminPassword(ctx context.Context, id int, passwordHash string) error {
+	a, ok := r.admins[id]
+	if !ok {
+		return ErrAdminNotFound
+	}
+	a.PasswordHash = passwordHash
+	return nil
+}
+
+func (r *memAdminAccountRepo) SetAdminRoles(ctx context.Context, id int, roleKeys []string) (*AdminUser, error) {
+	a, ok := r.admins[id]
+	if !ok {
+		return nil, ErrAdminNotFound
+	}
+	a.Roles = roleKeys
+	cp := *a
+	return &cp, nil
+}
+
+func (r *memAdminAccountRepo) GrantAdminRole(ctx context.Context, grant AdminRoleGrant) (*AdminUser, error) {
+	a, ok := r.admins[grant.AdminID]
+	if !ok {
+		return nil, ErrAdminNotFound
+	}
+	r.grants = append(r.grants, grant)
+	a.TemporaryRoles = append(a.TemporaryRoles, grant)
+	cp := *a
+	return &cp, nil
+}
+
+func (r *memAdminAccountRepo) RevokeAdminRole(ctx context.Context, id int, roleKey string) (*AdminUser, bool, error) {
+	a, ok := r.admins[id]
+	if !ok {
+		return nil, false, ErrAdminNotFound
+	}
+	revoked := false
+	roles := a.Roles[:0:0]
+	for _, k := range a.Roles {
+		if k == roleKey {
+			revoked = true
+			continue
+		}
+		roles = append(roles, k)
+	}
+	a.Roles = roles
+	temporary := a.TemporaryRoles[:0:0]
+	for _, g := range a.TemporaryRoles {
+		if g.RoleKey == roleKey {
+			revoked = true
+			continue
+		}
+		temporary = append(temporary, g)
+	}
+	a.TemporaryRoles = temporary
+	cp := *a
+	return &cp, revoked, nil
+}
+
+func (r *memAdminAccountRepo) SetAdminAttributes(ctx context.Context, id int, attributes map[string]any) (*AdminUser, error) {
+	a, ok := r.admins[id]
+	if !ok {
+		return nil, ErrAdminNotFound
+	}
+	a.Attributes = attributes
+	cp := *a
+	return &cp, nil
+}
+
+func (r *memAdminAccountRepo) DeleteExpiredRoleGrants(ctx context.Context, now time.Time) ([]AdminRoleGrant, error) {
+	var expired, kept []AdminRoleGrant
+	for _, g := range r.grants {
+		if g.ExpiresAt.After(now) {
+			kept = append(kept, g)
+		} else {
+			expired = append(expired, g)
+		}
+	}
+	r.grants = kept
+	return expired, nil
+}
+
+func newTestAdminAccountUsecase(audit AuditRepo) (*AdminAccountUsecase, *memAdminAccountRepo, *memRBACRepo) {
+	repo := newMemAdminAccountRepo()
+	rbac := newMemRBACRepo()
+	rbac.roles[2] = &RBACRoleSummary{ID: 2, Key: "auditor", Name: "审计员", Permissions: []string{PermissionAdminAccess, PermissionAccountRead}}
+	access := NewAdminAccessResolver(repo, &AuthPolicy{AdminAccessCacheTTL: -1}, log.NewStdLogger(io.Discard))
+	uc := NewAdminAccountUsecase(repo, access, rbac, audit, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	return uc, repo, rbac
+}
+
+func TestAdminAccountUsecase_SetDisabled_RejectsSelf(t *testing.T) {
+	uc, repo, _ := newTestAdminAccountUsecase(nil)
+
+	if err := uc.SetDisabled(adminCtx(), 7, true); !errors.Is(err, ErrAdminSelfLockout) {
+		t.Fatalf("expected ErrAdminSelfLockout, got %v", err)
+	}
+	if repo.admins[7].Disabled {
+		t.Fatalf("expected self to stay enabled")
+	}
+	// 重新启用自己不构成锁死，不应拦截。
+	if err := uc.SetDisabled(adminCtx(), 7, false); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+}
+
+func TestAdminAccountUsecase_AssignRoles_RejectsDroppingOwnAccountWrite(t *testing.T) {
+	uc, repo, _ := newTestAdminAccountUsecase(nil)
+
+	if _, err := uc.AssignRoles(adminCtx(), 7, []string{"auditor"}); !errors.Is(err, ErrAdminSelfLockout) {
+		t.Fatalf("expected ErrAdminSelfLockout, got %v", err)
+	}
+	if _, err := uc.AssignRoles(adminCtx(), 7, nil); !errors.Is(err, ErrAdminSelfLockout) {
+		t.Fatalf("expected ErrAdminSelfLockout for empty roles, got %v", err)
+	}
+	if got := repo.admins[7].Roles; len(got) != 1 || got[0] != SuperAdminRoleKey {
+		t.Fatalf("expected roles unchanged, got %v", got)
+	}
+
+	if _, err := uc.AssignRoles(adminCtx(), 7, []string{"auditor", SuperAdminRoleKey}); err != nil {
+		t.Fatalf("expected nil err when account.write kept, got %v", err)
+	}
+}
+
+func TestAdminAccountUsecase_CreateAndAssignOthers(t *testing.T) {
+	audit := &memAuditRepo{}
+	uc, _, _ := newTestAdminAccountUsecase(audit)
+	ctx := adminCtx()
+
+	if _, err := uc.Create(ctx, "ops", "short", nil); !errors.Is(err, ErrAdminPasswordTooShort) {
+		t.Fatalf("expected ErrAdminPasswordTooShort, got %v", err)
+	}
+	if _, err := uc.Create(ctx, "ops", "long-enough", []string{"missing"}); !errors.Is(err, ErrRoleNotFound) {
+		t.Fatalf("expected ErrRoleNotFound, got %v", err)
+	}
+	if _, err := uc.Create(ctx, "ROOT", "long-enough", nil); !errors.Is(err, ErrUserExists) {
+		t.Fatalf("expected ErrUserExists, got %v", err)
+	}
+
+	a, err := uc.Create(ctx, " Ops ", "long-enough", []string{"auditor"})
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if a.Username != "Ops" || a.PasswordHash == "long-enough" {
+		t.Fatalf("unexpected admin %+v", a)
+	}
+
+	// 给别人去掉全部角色不受自保护规则限制。
+	if _, err := uc.AssignRoles(ctx, a.ID, nil); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if err := uc.SetDisabled(ctx, a.ID, true); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+
+	want := []string{AuditActionAdminCreate, AuditActionAdminAssignRoles, AuditActionAdminDisable}
+	if len(audit.events) != len(want) {
+		t.Fatalf("expected %d audit events, got %d", len(want), len(audit.events))
+	}
+	for i, action := range want {
+		if audit.events[i].Action != action || audit.events[i].TargetID != a.ID || audit.events[i].ActorID != 7 {
+			t.Fatalf("unexpected audit event %d: %+v", i, audit.events[i])
+		}
+	}
+}
+
+func TestAdminAccountUsecase_GrantRoleAndPurge(t *testing.T) {
+	audit := &memAuditRepo{}
+	uc, repo, _ := newTestAdminAccountUsecase(audit)
+	ctx := adminCtx()
+
+	if _, err := uc.GrantRole(ctx, 7, "auditor", time.Time{}, 0); !errors.Is(err, ErrBadParam) {
+		t.Fatalf("expected ErrBadParam for zero duration, got %v", err)
+	}
+	if _, err := uc.GrantRole(ctx, 7, "auditor", time.Time{}, AdminRoleGrantMaxDuration+time.Second); !errors.Is(err, ErrBadParam) {
+		t.Fatalf("expected ErrBadParam for too long duration, got %v", err)
+	}
+	if _, err := uc.GrantRole(ctx, 7, "missing", time.Time{}, time.Hour); !errors.Is(err, ErrRoleNotFound) {
+		t.Fatalf("expected ErrRoleNotFound, got %v", err)
+	}
+
+	start := time.Now().Add(time.Hour)
+	a, err := uc.GrantRole(ctx, 7, "auditor", start, 2*time.Hour)
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if len(a.TemporaryRoles) != 1 {
+		t.Fatalf("expected one temporary role, got %+v", a.TemporaryRoles)
+	}
+	g := a.TemporaryRoles[0]
+	if g.StartsAt == nil || !g.StartsAt.Equal(start) || !g.ExpiresAt.Equal(start.Add(2*time.Hour)) {
+		t.Fatalf("unexpected grant window %+v", g)
+	}
+
+	if n, err := uc.PurgeExpiredRoleGrants(context.Background(), start.Add(time.Hour)); err != nil || n != 0 {
+		t.Fatalf("expected nothing purged before expiry, got n=%d err=%v", n, err)
+	}
+	if n, err := uc.PurgeExpiredRoleGrants(context.Background(), start.Add(2*time.Hour)); err != nil || n != 1 {
+		t.Fatalf("expected one grant purged, got n=%d err=%v", n, err)
+	}
+	if len(repo.grants) != 0 {
+		t.Fatalf("expected grants cleared, got %+v", repo.grants)
+	}
+
+	last := audit.events[len(audit.events)-1]
+	if last.Action != AuditActionAdminGrantExpired || last.ActorKind != AuditActorSystem || last.TargetID != 7 {
+		t.Fatalf("unexpected expiry audit event %+v", last)
+	}
+}
+
+func TestAdminAccountUsecase_RejectsPrivilegeEscalation(t *testing.T) {
+	uc, repo, rbac := newTestAdminAccountUsecase(nil)
+	rbac.roles[3] = &RBACRoleSummary{ID: 3, Key: "account_manager", Permissions: []string{PermissionAdminAccess, PermissionAccountRead, PermissionAccountWrite}}
+	rbac.roles[4] = &RBACRoleSummary{ID: 4, Key: "user_admin", Permissions: []string{PermissionAdminAccess, "admin.user.*"}}
+	repo.admins[20] = &AdminUser{ID: 20, Username: "ops", Roles: []string{"account_manager"}, Permissions: rbac.roles[3].Permissions}
+	repo.admins[21] = &AdminUser{ID: 21, Username: "helper"}
+	ops := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 20, Username: "ops", Role: RoleAdmin})
+
+	if _, err := uc.Create(ops, "boss", "long-enough", []string{SuperAdminRoleKey}); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+		t.Fatalf("creating a super_admin should be rejected, got %v", err)
+	}
+	if _, err := uc.AssignRoles(ops, 20, []string{"account_manager", SuperAdminRoleKey}); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+		t.Fatalf("self promotion should be rejected, got %v", err)
+	}
+	if _, err := uc.AssignRoles(ops, 21, []string{"user_admin"}); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+		t.Fatalf("granting permissions the operator lacks should be rejected, got %v", err)
+	}
+	if _, err := uc.GrantRole(ops, 20, "user_admin", time.Time{}, time.Hour); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+		t.Fatalf("temporary grant of permissions the operator lacks should be rejected, got %v", err)
+	}
+	if got := repo.admins[21].Roles; len(got) != 0 {
+		t.Fatalf("expected roles unchanged, got %v", got)
+	}
+
+	// 操作者拥有的权限可以正常授予。
+	if _, err := uc.AssignRoles(ops, 21, []string{"auditor"}); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+}
+
+func TestAdminAccountUsecase_SuperAdminTargetsRequireSuperAdmin(t *testing.T) {
+	uc, repo, rbac := newTestAdminAccountUsecase(nil)
+	rbac.roles[3] = &RBACRoleSummary{ID: 3, Key: "account_manager", Permissions: []string{PermissionAdminAccess, PermissionAccountRead, PermissionAccountWrite}}
+	repo.admins[20] = &AdminUser{ID: 20, Username: "ops", Roles: []string{"account_manager"}, Permissions: rbac.roles[3].Permissions}
+	ops := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 20, Username: "ops", Role: RoleAdmin})
+
+	if err := uc.ResetPassword(ops, 7, "taken-over"); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+		t.Fatalf("resetting a super_admin password should be rejected, got %v", err)
+	}
+	if repo.admins[7].PasswordHash != "" {
+		t.Fatal("super_admin password must stay unchanged")
+	}
+	if _, err := uc.AssignRoles(ops, 7, []string{"account_manager"}); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+		t.Fatalf("re-roling a super_admin should be rejected, got %v", err)
+	}
+	if err := uc.SetDisabled(ops, 7, true); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+		t.Fatalf("disabling a super_admin should be rejected, got %v", err)
+	}
+	if err := uc.ResetPassword(ops, 99, "long-enough"); !errors.Is(err, ErrAdminNotFound) {
+		t.Fatalf("expected ErrAdminNotFound for a missing admin, got %v", err)
+	}
+
+	// 超管之间可以互相管理。
+	second, err := uc.Create(adminCtx(), "root2", "long-enough", []string{SuperAdminRoleKey})
+	if err != nil {
+		t.Fatalf("super_admin creating super_admin: %v", err)
+	}
+	if err := uc.ResetPassword(adminCtx(), second.ID, "long-enough-2"); err != nil {
+		t.Fatalf("super_admin resetting super_admin: %v", err)
+	}
+}
+
+func TestAdminAccountUsecase_RevokeRole(t *testing.T) {
+	audit := &memAuditRepo{}
+	uc, repo, rbac := newTestAdminAccountUsecase(audit)
+	rbac.roles[3] = &RBACRoleSummary{ID: 3, Key: "account_manager", Permissions: []string{PermissionAdminAccess, PermissionAccountRead, PermissionAccountWrite}}
+	rbac.roles[4] = &RBACRoleSummary{ID: 4, Key: "user_admin", Permissions: []string{PermissionAdminAccess, "admin.user.*"}}
+	repo.admins[20] = &AdminUser{ID: 20, Username: "ops", Roles: []string{"account_manager"}, Permissions: rbac.roles[3].Permissions}
+	repo.admins[21] = &AdminUser{
+		ID:             21,
+		Username:       "helper",
+		Roles:          []string{"auditor", "user_admin"},
+		TemporaryRoles: []AdminRoleGrant{{AdminID: 21, RoleKey: "auditor", ExpiresAt: time.Now().Add(time.Hour)}},
+	}
+	ops := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 20, Username: "ops", Role: RoleAdmin})
+
+	if _, err := uc.RevokeRole(ops, 21, "missing"); !errors.Is(err, ErrRoleNotFound) {
+		t.Fatalf("expected ErrRoleNotFound, got %v", err)
+	}
+	if _, err := uc.RevokeRole(ops, 21, "user_admin"); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+		t.Fatalf("revoking a role the operator could not grant should be rejected, got %v", err)
+	}
+	if _, err := uc.RevokeRole(ops, 7, SuperAdminRoleKey); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+		t.Fatalf("revoking from a super_admin should be rejected, got %v", err)
+	}
+	if _, err := uc.RevokeRole(ops, 20, "account_manager"); !errors.Is(err, ErrAdminSelfLockout) {
+		t.Fatalf("expected ErrAdminSelfLockout, got %v", err)
+	}
+
+	// 临时授权同样可以提前撤销。
+	a, err := uc.RevokeRole(ops, 21, "auditor")
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if len(a.Roles) != 1 || a.Roles[0] != "user_admin" || len(a.TemporaryRoles) != 0 {
+		t.Fatalf("unexpected admin after revoke %+v", a)
+	}
+	if len(audit.events) != 1 || audit.events[0].Action != AuditActionAdminRevokeRole || audit.events[0].TargetID != 21 {
+		t.Fatalf("unexpected audit events %+v", audit.events)
+	}
+
+	// 没有该绑定时不写审计。
+	if _, err := uc.RevokeRole(ops, 21, "auditor"); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if len(audit.events) != 1 {
+		t.Fatalf("expected no audit for a no-op revoke, got %+v", audit.events)
+	}
+}
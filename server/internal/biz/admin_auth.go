@@ -30,8 +30,17 @@ type AdminUser struct {
 	Username     string
 	PasswordHash string
 	Disabled     bool
-	Roles        []string
-	Permissions  []string
+	// Roles 只含当前生效的角色（长期角色与处于有效期内的临时授权）；Permissions 同理。
+	Roles       []string
+	Permissions []string
+	// TemporaryRoles 是尚未过期的临时授权（含还没开始的），只在管理员管理接口中填充。
+	TemporaryRoles []AdminRoleGrant
+	// Organizations 是管理员所属的组织，升序；为空表示平台级管理员，不受组织限定。
+	Organizations []int
+	// Attributes 是管理员的自定义属性（如 region），供访问策略表达式读取。
+	Attributes  map[string]any
+	LastLoginAt *time.Time
+	CreatedAt   time.Time
 }
 
 type AdminAuthUsecase struct {
@@ -113,7 +122,17 @@ func (uc *AdminAuthUsecase) Login(ctx context.Context, username, password string
 		return "", time.Time{}, nil, err
 	}
 
-	token, expireAt, e = uc.genTok(admin.ID, admin.Username, int8(RoleAdmin))
+	// 默认进入所属的第一个组织，返回的角色与权限换成该组织下生效的结果。
+	orgID := DefaultOrganizationID(admin.Organizations)
+	if orgID > 0 {
+		if scoped, e := uc.repo.GetAdminByID(NewContextWithTenant(ctx, orgID), admin.ID); e == nil && scoped != nil {
+			admin = scoped
+		} else {
+			l.Warnf("Login admin load organization access failed admin_id=%d org_id=%d err=%v", admin.ID, orgID, e)
+		}
+	}
+
+	token, expireAt, e = uc.genTok(admin.ID, admin.Username, int8(RoleAdmin), orgID)
 	if e != nil {
 		err = e
 		span.RecordError(err)
@@ -0,0 +1,37 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	AuditActorAdmin  = "admin"
+	AuditActorUser   = "user"
+	AuditActorSystem = "system"
+)
+
+const (
+	AuditActionImpersonationStart = "user.impersonate"
+	AuditActionImpersonatedCall   = "impersonation.call"
+)
+
+// AuditEvent 是写入审计流水的一条记录；Detail 只放排障需要的上下文，不放密码或 token。
+type AuditEvent struct {
+	ID            int
+	Action        string
+	ActorKind     string
+	ActorID       int
+	ActorUsername string
+	TargetKind    string
+	TargetID      int
+	Detail        map[string]any
+	RequestID     string
+	CreatedAt     time.Time
+}
+
+type AuditRepo interface {
+	RecordAudit(ctx context.Context, e *AuditEvent) error
+	// ListUserAuditEvents 返回普通用户作为操作者或操作对象、id 大于 afterID 的审计记录，按 id 升序，最多 limit 条。
+	ListUserAuditEvents(ctx context.Context, userID, afterID, limit int) ([]*AuditEvent, error)
+}
@@ -4,6 +4,7 @@ package biz
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -20,6 +21,12 @@ var (
 	ErrUserNotFound    = errors.New("user not found")
 	ErrInvalidPassword = errors.New("invalid password")
 	ErrUserDisabled    = errors.New("user disabled")
+
+	// ErrInvalidCredentials 是不区分“用户不存在”和“密码错误”的凭证错误，包裹具体原因供登录流水使用。
+	ErrInvalidCredentials = errors.New("invalid username or password")
+
+	// ErrPasswordChangeRequired 表示密码正确但仍是临时密码，需要先用 auth.change_password 改密。
+	ErrPasswordChangeRequired = errors.New("password change required")
 )
 
 type AuthRepo interface {
@@ -27,6 +34,10 @@ type AuthRepo interface {
 	GetUserByID(ctx context.Context, id int) (*User, error)
 	CreateUser(ctx context.Context, u *User) (*User, error)
 	UpdateUserLastLogin(ctx context.Context, id int, t time.Time) error
+	// UpdateUserPassword 写入新的密码哈希并清除临时密码标记。
+	UpdateUserPassword(ctx context.Context, id int, passwordHash string) error
+	// CreateUserWithInvite 在同一事务内核销邀请码并创建用户，邀请码不可用时返回 ErrInvite*。
+	CreateUserWithInvite(ctx context.Context, u *User, inviteCode string) (*User, error)
 }
 
 type User struct {
@@ -39,10 +50,48 @@ type User struct {
 	LastLoginAt  *time.Time
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+
+	// OrganizationIDs 是用户所属的组织，升序；登录时默认进入第一个。
+	OrganizationIDs []int
+
+	// MustChangePassword 表示当前密码是临时密码，改密前登录返回 ErrPasswordChangeRequired。
+	MustChangePassword bool
+
+	// 可选联系方式；verified_at 为空表示未验证。
+	Email           string
+	EmailVerifiedAt *time.Time
+	Phone           string
+	PhoneVerifiedAt *time.Time
+
+	// 资料字段，完整资料（含自定义属性）见 UserProfile。
+	DisplayName string
+	AvatarURL   string
+	Locale      string
+	Timezone    string
+
+	// DeletionScheduledAt 非空表示用户已自助注销，到这个时间后账号会被抹除。
+	DeletionScheduledAt *time.Time
+}
+
+func (u *User) Contact(channel string) string {
+	switch channel {
+	case VerificationChannelEmail:
+		return u.Email
+	case VerificationChannelPhone:
+		return u.Phone
+	default:
+		return ""
+	}
+}
+
+// Verified 表示至少有一种联系方式已验证。
+func (u *User) Verified() bool {
+	return (u.Email != "" && u.EmailVerifiedAt != nil) || (u.Phone != "" && u.PhoneVerifiedAt != nil)
 }
 
-type TokenGenerator func(userID int, username string, role int8) (token string, expireAt time.Time, err error)
-type AdminTokenGenerator func(userID int, username string, role int8) (token string, expireAt time.Time, err error)
+// organizationID 写入 token 的 org 声明，0 表示不限定组织。
+type TokenGenerator func(userID int, username string, role int8, organizationID int) (token string, expireAt time.Time, err error)
+type AdminTokenGenerator func(userID int, username string, role int8, organizationID int) (token string, expireAt time.Time, err error)
 
 type AuthUsecase struct {
 	// 日志
@@ -56,9 +105,10 @@ type AuthUsecase struct {
 	// repo & token
 	repo   AuthRepo
 	genTok TokenGenerator
+	policy *AuthPolicy
 }
 
-func NewAuthUsecase(repo AuthRepo, genTok TokenGenerator, logger log.Logger, tp *tracesdk.TracerProvider) *AuthUsecase {
+func NewAuthUsecase(repo AuthRepo, genTok TokenGenerator, policy *AuthPolicy, logger log.Logger, tp *tracesdk.TracerProvider) *AuthUsecase {
 	helper := log.NewHelper(log.With(logger, "module", "biz.auth"))
 
 	// tracer 优先用注入的 tp；tp 为空就 fallback 全局 provider
@@ -72,6 +122,7 @@ func NewAuthUsecase(repo AuthRepo, genTok TokenGenerator, logger log.Logger, tp
 	return &AuthUsecase{
 		repo:   repo,
 		genTok: genTok,
+		policy: policy,
 		log:    helper,
 		logger: logger,
 		tp:     tp,
@@ -92,9 +143,24 @@ func (uc *AuthUsecase) Tracer(opts ...trace.TracerOption) trace.Tracer {
 // ======================
 
 func (uc *AuthUsecase) Register(ctx context.Context, username, password string) (token string, expireAt time.Time, u *User, err error) {
+	return uc.RegisterWithInvite(ctx, username, password, "")
+}
+
+// RegistrationMode 返回当前生效的注册模式，供前端决定是否展示邀请码输入框。
+func (uc *AuthUsecase) RegistrationMode() RegistrationMode {
+	return uc.policy.Mode()
+}
+
+// RegisterWithInvite 按注册模式校验后创建用户；开放注册下带邀请码同样会核销，用于预分配角色。
+func (uc *AuthUsecase) RegisterWithInvite(ctx context.Context, username, password, inviteCode string) (token string, expireAt time.Time, u *User, err error) {
+	mode := uc.policy.Mode()
+	inviteCode = NormalizeInviteCode(inviteCode)
+
 	ctx, span := uc.Tracer().Start(ctx, "auth.register",
 		trace.WithAttributes(
 			attribute.String("auth.username", username),
+			attribute.String("auth.registration_mode", string(mode)),
+			attribute.Bool("auth.with_invite", inviteCode != ""),
 		),
 	)
 	defer span.End()
@@ -109,7 +175,30 @@ func (uc *AuthUsecase) Register(ctx context.Context, username, password string)
 		return "", time.Time{}, nil, err
 	}
 
-	l.Infof("Register start username=%s", username)
+	// 用户名统一做 NFKC + 大小写折叠校验，落库保留去空白后的展示形式
+	canonical, _, e := ValidateUsername(username)
+	if e != nil {
+		err = e
+		span.SetStatus(codes.Error, err.Error())
+		l.Infof("Register invalid username username=%q", username)
+		return "", time.Time{}, nil, err
+	}
+	username = canonical
+
+	switch {
+	case mode == RegistrationClosed:
+		err = ErrRegistrationClosed
+		span.SetStatus(codes.Error, err.Error())
+		l.Infof("Register rejected registration closed username=%s", username)
+		return "", time.Time{}, nil, err
+	case mode == RegistrationInviteOnly && inviteCode == "":
+		err = ErrInviteRequired
+		span.SetStatus(codes.Error, err.Error())
+		l.Infof("Register rejected invite required username=%s", username)
+		return "", time.Time{}, nil, err
+	}
+
+	l.Infof("Register start username=%s mode=%s with_invite=%v", username, mode, inviteCode != "")
 
 	// 2) 用户名是否已存在
 	exist, e := uc.repo.GetUserByUsername(ctx, username)
@@ -136,8 +225,13 @@ func (uc *AuthUsecase) Register(ctx context.Context, username, password string)
 		PasswordHash: string(hash),
 	}
 
-	// 4) 创建用户
-	created, e := uc.repo.CreateUser(ctx, newUser)
+	// 4) 创建用户（带邀请码时由 repo 在事务内一并核销）
+	var created *User
+	if inviteCode != "" {
+		created, e = uc.repo.CreateUserWithInvite(ctx, newUser, inviteCode)
+	} else {
+		created, e = uc.repo.CreateUser(ctx, newUser)
+	}
 	if e != nil {
 		err = e
 		span.RecordError(err)
@@ -148,10 +242,17 @@ func (uc *AuthUsecase) Register(ctx context.Context, username, password string)
 
 	span.SetAttributes(attribute.Int("auth.user_id", created.ID))
 
+	// 要求验证时注册只建号不发 token，用户需先完成验证再登录。
+	if uc.policy.RequireVerification() {
+		span.SetStatus(codes.Ok, "OK")
+		l.Infof("Register success pending verification user_id=%d username=%s", created.ID, created.Username)
+		return "", time.Time{}, created, nil
+	}
+
 	// 5) 创建 token
 	// 注册出来的用户默认 Role=0（普通用户）
 	created.Role = 0
-	token, expireAt, e = uc.genTok(created.ID, created.Username, created.Role)
+	token, expireAt, e = uc.genTok(created.ID, created.Username, created.Role, 0)
 	if e != nil {
 		err = e
 		span.RecordError(err)
@@ -224,9 +325,23 @@ func (uc *AuthUsecase) Login(ctx context.Context, username, password string) (to
 		return "", time.Time{}, nil, err
 	}
 
+	if usr.MustChangePassword {
+		err = ErrPasswordChangeRequired
+		span.SetStatus(codes.Error, err.Error())
+		l.Infof("Login password change required user_id=%d username=%s", usr.ID, username)
+		return "", time.Time{}, nil, err
+	}
+
+	if uc.policy.RequireVerification() && !usr.Verified() {
+		err = ErrUserUnverified
+		span.SetStatus(codes.Error, err.Error())
+		l.Infof("Login user unverified user_id=%d username=%s", usr.ID, username)
+		return "", time.Time{}, nil, err
+	}
+
 	uc.log.WithContext(ctx).Infof("Login user=%s id=%d role=%d", usr.Username, usr.ID, usr.Role)
 
-	token, expireAt, e = uc.genTok(usr.ID, usr.Username, usr.Role)
+	token, expireAt, e = uc.genTok(usr.ID, usr.Username, usr.Role, DefaultOrganizationID(usr.OrganizationIDs))
 	if e != nil {
 		err = e
 		span.RecordError(err)
@@ -248,6 +363,35 @@ func (uc *AuthUsecase) Login(ctx context.Context, username, password string) (to
 	return token, expireAt, usr, nil
 }
 
+// CheckCredentials 只校验用户名密码，不签发 token、不检查验证状态；
+// 供未验证用户在登录前发送/提交验证码时证明身份。接口公开，用户不存在和密码错误统一返回 ErrInvalidCredentials，
+// 禁用状态也只在密码正确后才返回，避免被用来探测账号。
+func (uc *AuthUsecase) CheckCredentials(ctx context.Context, username, password string) (*User, error) {
+	l := uc.log.WithContext(ctx)
+	if username == "" || password == "" {
+		return nil, ErrBadParam
+	}
+
+	usr, err := uc.repo.GetUserByUsername(ctx, username)
+	if err != nil || usr == nil {
+		l.Infof("CheckCredentials user not found username=%s err=%v", username, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredentials, ErrUserNotFound)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(usr.PasswordHash), []byte(password)) != nil {
+		l.Infof("CheckCredentials invalid password user_id=%d", usr.ID)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredentials, ErrInvalidPassword)
+	}
+	if usr.Disabled {
+		return nil, ErrUserDisabled
+	}
+	return usr, nil
+}
+
+// RequireVerification 表示登录前是否必须完成邮箱/手机验证。
+func (uc *AuthUsecase) RequireVerification() bool {
+	return uc.policy.RequireVerification()
+}
+
 // GetCurrentUser 获取当前登录用户信息（用于 auth.me 等接口）
 func (uc *AuthUsecase) GetCurrentUser(ctx context.Context, userID int) (*User, error) {
 	ctx, span := uc.Tracer().Start(ctx, "auth.get_current_user",
@@ -277,3 +421,81 @@ func (uc *AuthUsecase) GetCurrentUser(ctx context.Context, userID int) (*User, e
 	span.SetStatus(codes.Ok, "OK")
 	return u, nil
 }
+
+// ChangePassword 修改当前用户密码，必须校验旧密码，成功后清除临时密码标记；模拟登录 token 在 service 层已被拦截。
+func (uc *AuthUsecase) ChangePassword(ctx context.Context, userID int, oldPassword, newPassword string) error {
+	ctx, span := uc.Tracer().Start(ctx, "auth.change_password",
+		trace.WithAttributes(
+			attribute.Int("auth.user_id", userID),
+		),
+	)
+	defer span.End()
+
+	l := uc.log.WithContext(ctx)
+	if userID <= 0 || oldPassword == "" || newPassword == "" {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		l.Warnf("ChangePassword invalid args user_id=%d", userID)
+		return ErrBadParam
+	}
+
+	usr, err := uc.repo.GetUserByID(ctx, userID)
+	if err != nil || usr == nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, ErrUserNotFound.Error())
+		l.Infof("ChangePassword user not found user_id=%d err=%v", userID, err)
+		return ErrUserNotFound
+	}
+	if usr.Disabled {
+		span.SetStatus(codes.Error, ErrUserDisabled.Error())
+		return ErrUserDisabled
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(usr.PasswordHash), []byte(oldPassword)) != nil {
+		span.SetStatus(codes.Error, ErrInvalidPassword.Error())
+		l.Infof("ChangePassword invalid old password user_id=%d", userID)
+		return ErrInvalidPassword
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "hash password failed")
+		l.Errorf("ChangePassword hash password failed user_id=%d err=%v", userID, err)
+		return err
+	}
+
+	if err := uc.repo.UpdateUserPassword(ctx, userID, string(hash)); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "update password failed")
+		l.Errorf("ChangePassword update failed user_id=%d err=%v", userID, err)
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "OK")
+	l.Infof("ChangePassword success user_id=%d", userID)
+	return nil
+}
+
+func (uc *AuthUsecase) UserDataSection() string { return "account" }
+
+// ExportUserData 导出账号本身：用户名、状态、联系方式与所属组织，不含密码哈希。
+func (uc *AuthUsecase) ExportUserData(ctx context.Context, userID int) (any, error) {
+	u, err := uc.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"id":                    u.ID,
+		"username":              u.Username,
+		"disabled":              u.Disabled,
+		"created_at":            u.CreatedAt,
+		"updated_at":            u.UpdatedAt,
+		"last_login_at":         u.LastLoginAt,
+		"organization_ids":      u.OrganizationIDs,
+		"email":                 u.Email,
+		"email_verified_at":     u.EmailVerifiedAt,
+		"phone":                 u.Phone,
+		"phone_verified_at":     u.PhoneVerifiedAt,
+		"deletion_scheduled_at": u.DeletionScheduledAt,
+	}, nil
+}
@@ -14,6 +14,13 @@ type AuthClaims struct {
 	UserID   int
 	Username string
 	Role     Role
+
+	// OrganizationID 来自 token 的 org 声明，0 表示不限定组织。
+	OrganizationID int
+
+	// ActorID/ActorUsername 来自 token 的 act 声明：非 0 表示管理员正在模拟该用户。
+	ActorID       int
+	ActorUsername string
 }
 
 type ctxKeyClaims struct{}
@@ -30,3 +37,7 @@ func GetClaimsFromContext(ctx context.Context) (*AuthClaims, bool) {
 func (c *AuthClaims) IsAdmin() bool {
 	return c != nil && c.Role == RoleAdmin
 }
+
+func (c *AuthClaims) IsImpersonated() bool {
+	return c != nil && c.ActorID > 0
+}
@@ -20,6 +20,8 @@ type memAuthRepo struct {
 	usersByName map[string]*User
 	lastLogin   map[int]time.Time
 	nextUserID  int
+
+	invites map[string]*InviteCode
 }
 
 func newMemAuthRepo() *memAuthRepo {
@@ -27,6 +29,7 @@ func newMemAuthRepo() *memAuthRepo {
 		usersByName: make(map[string]*User),
 		lastLogin:   make(map[int]time.Time),
 		nextUserID:  1,
+		invites:     make(map[string]*InviteCode),
 	}
 }
 
@@ -77,15 +80,51 @@ func (r *memAuthRepo) UpdateUserLastLogin(ctx context.Context, id int, t time.Ti
 	return nil
 }
 
+func (r *memAuthRepo) CreateUserWithInvite(ctx context.Context, u *User, inviteCode string) (*User, error) {
+	r.mu.Lock()
+	invite := r.invites[inviteCode]
+	if invite == nil {
+		r.mu.Unlock()
+		return nil, ErrInviteInvalid
+	}
+	switch invite.Status(time.Now()) {
+	case InviteStatusExpired:
+		r.mu.Unlock()
+		return nil, ErrInviteExpired
+	case InviteStatusExhausted:
+		r.mu.Unlock()
+		return nil, ErrInviteExhausted
+	case InviteStatusRevoked:
+		r.mu.Unlock()
+		return nil, ErrInviteInvalid
+	}
+	invite.UsedCount++
+	r.mu.Unlock()
+
+	return r.CreateUser(ctx, u)
+}
+
+func (r *memAuthRepo) UpdateUserPassword(ctx context.Context, id int, passwordHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.usersByName {
+		if u.ID == id {
+			u.PasswordHash, u.MustChangePassword = passwordHash, false
+			return nil
+		}
+	}
+	return errors.New("not found")
+}
+
 func TestAuthUsecase_Register_Success(t *testing.T) {
 	repo := newMemAuthRepo()
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	genTok := func(userID int, username string, role int8) (string, time.Time, error) {
+	genTok := func(userID int, username string, role int8, organizationID int) (string, time.Time, error) {
 		return "tok-abc", time.Now().Add(7 * 24 * time.Hour), nil
 	}
-	uc := NewAuthUsecase(repo, genTok, logger, tp)
+	uc := NewAuthUsecase(repo, genTok, nil, logger, tp)
 
 	token, exp, u, err := uc.Register(context.Background(), "alice", "p@ss")
 	if err != nil {
@@ -113,9 +152,9 @@ func TestAuthUsecase_Register_UserExists(t *testing.T) {
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	uc := NewAuthUsecase(repo, func(int, string, int8) (string, time.Time, error) {
+	uc := NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
 		return "", time.Time{}, nil
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	_, _, _, err := uc.Register(context.Background(), "alice", "p@ss")
 	if !errors.Is(err, ErrUserExists) {
@@ -128,9 +167,9 @@ func TestAuthUsecase_Register_EmptyArgs(t *testing.T) {
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	uc := NewAuthUsecase(repo, func(int, string, int8) (string, time.Time, error) {
+	uc := NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
 		return "", time.Time{}, nil
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	_, _, _, err := uc.Register(context.Background(), "", "p@ss")
 	if err == nil {
@@ -148,9 +187,9 @@ func TestAuthUsecase_Register_TokenGenFailed(t *testing.T) {
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	uc := NewAuthUsecase(repo, func(int, string, int8) (string, time.Time, error) {
+	uc := NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
 		return "", time.Time{}, errors.New("token gen failed")
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	_, _, _, err := uc.Register(context.Background(), "alice", "p@ss")
 	if err == nil {
@@ -170,9 +209,9 @@ func TestAuthUsecase_Login_Success(t *testing.T) {
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	uc := NewAuthUsecase(repo, func(userID int, username string, role int8) (string, time.Time, error) {
+	uc := NewAuthUsecase(repo, func(userID int, username string, role int8, organizationID int) (string, time.Time, error) {
 		return "tok-login", time.Now().Add(time.Hour), nil
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	token, exp, u, err := uc.Login(context.Background(), "alice", "p@ss")
 	if err != nil {
@@ -194,9 +233,9 @@ func TestAuthUsecase_Login_UserNotFound(t *testing.T) {
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	uc := NewAuthUsecase(repo, func(int, string, int8) (string, time.Time, error) {
+	uc := NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
 		return "", time.Time{}, nil
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	_, _, _, err := uc.Login(context.Background(), "noone", "x")
 	if !errors.Is(err, ErrUserNotFound) {
@@ -216,9 +255,9 @@ func TestAuthUsecase_Login_UserDisabled(t *testing.T) {
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	uc := NewAuthUsecase(repo, func(int, string, int8) (string, time.Time, error) {
+	uc := NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
 		return "", time.Time{}, nil
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	_, _, _, err := uc.Login(context.Background(), "alice", "p@ss")
 	if !errors.Is(err, ErrUserDisabled) {
@@ -238,9 +277,9 @@ func TestAuthUsecase_Login_InvalidPassword(t *testing.T) {
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	uc := NewAuthUsecase(repo, func(int, string, int8) (string, time.Time, error) {
+	uc := NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
 		return "", time.Time{}, nil
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	_, _, _, err := uc.Login(context.Background(), "alice", "wrong")
 	if !errors.Is(err, ErrInvalidPassword) {
@@ -248,14 +287,40 @@ func TestAuthUsecase_Login_InvalidPassword(t *testing.T) {
 	}
 }
 
+func TestAuthUsecase_CheckCredentials_GenericError(t *testing.T) {
+	repo := newMemAuthRepo()
+
+	hash, _ := bcrypt.GenerateFromPassword([]byte("p@ss"), bcrypt.DefaultCost)
+	_, _ = repo.CreateUser(context.Background(), &User{Username: "alice", PasswordHash: string(hash)})
+	_, _ = repo.CreateUser(context.Background(), &User{Username: "bob", PasswordHash: string(hash), Disabled: true})
+
+	uc := NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
+		return "", time.Time{}, nil
+	}, nil, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+
+	// 不存在的用户、密码错误、已禁用但密码错误，对外都是同一个错误。
+	for _, c := range [][2]string{{"nobody", "p@ss"}, {"alice", "wrong"}, {"bob", "wrong"}} {
+		if _, err := uc.CheckCredentials(context.Background(), c[0], c[1]); !errors.Is(err, ErrInvalidCredentials) {
+			t.Fatalf("CheckCredentials(%q) error = %v, want ErrInvalidCredentials", c[0], err)
+		}
+	}
+	if _, err := uc.CheckCredentials(context.Background(), "bob", "p@ss"); !errors.Is(err, ErrUserDisabled) {
+		t.Fatalf("CheckCredentials(disabled, right password) error = %v, want ErrUserDisabled", err)
+	}
+	u, err := uc.CheckCredentials(context.Background(), "alice", "p@ss")
+	if err != nil || u.Username != "alice" {
+		t.Fatalf("CheckCredentials() = %+v, %v", u, err)
+	}
+}
+
 func TestAuthUsecase_Login_EmptyArgs(t *testing.T) {
 	repo := newMemAuthRepo()
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	uc := NewAuthUsecase(repo, func(int, string, int8) (string, time.Time, error) {
+	uc := NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
 		return "", time.Time{}, nil
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	_, _, _, err := uc.Login(context.Background(), "", "p@ss")
 	if err == nil {
@@ -280,12 +345,86 @@ func TestAuthUsecase_Login_TokenGenFailed(t *testing.T) {
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	uc := NewAuthUsecase(repo, func(int, string, int8) (string, time.Time, error) {
+	uc := NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
 		return "", time.Time{}, errors.New("token gen failed")
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	_, _, _, err := uc.Login(context.Background(), "alice", "p@ss")
 	if err == nil {
 		t.Fatalf("expected error when token generation fails")
 	}
 }
+
+func TestAuthUsecase_ChangePassword(t *testing.T) {
+	repo := newMemAuthRepo()
+	hash, _ := bcrypt.GenerateFromPassword([]byte("old"), bcrypt.DefaultCost)
+	created, _ := repo.CreateUser(context.Background(), &User{Username: "alice", PasswordHash: string(hash)})
+
+	uc := NewAuthUsecase(repo, nil, nil, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+
+	if err := uc.ChangePassword(context.Background(), created.ID, "wrong", "new"); !errors.Is(err, ErrInvalidPassword) {
+		t.Fatalf("expected ErrInvalidPassword, got %v", err)
+	}
+	if err := uc.ChangePassword(context.Background(), created.ID, "old", "new"); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+
+	u, _ := repo.GetUserByID(context.Background(), created.ID)
+	if bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte("new")) != nil {
+		t.Fatalf("expected password to be updated")
+	}
+}
+
+func TestAuthUsecase_Login_MustChangePassword(t *testing.T) {
+	repo := newMemAuthRepo()
+	hash, _ := bcrypt.GenerateFromPassword([]byte("temp-pass-1"), bcrypt.DefaultCost)
+	created, _ := repo.CreateUser(context.Background(), &User{Username: "alice", PasswordHash: string(hash), MustChangePassword: true})
+
+	genTok := func(int, string, int8, int) (string, time.Time, error) {
+		return "tok", time.Now().Add(time.Hour), nil
+	}
+	uc := NewAuthUsecase(repo, genTok, nil, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+
+	if _, _, _, err := uc.Login(context.Background(), "alice", "wrong"); !errors.Is(err, ErrInvalidPassword) {
+		t.Fatalf("expected ErrInvalidPassword before the temporary password check, got %v", err)
+	}
+	if _, _, _, err := uc.Login(context.Background(), "alice", "temp-pass-1"); !errors.Is(err, ErrPasswordChangeRequired) {
+		t.Fatalf("expected ErrPasswordChangeRequired, got %v", err)
+	}
+	if err := uc.ChangePassword(context.Background(), created.ID, "temp-pass-1", "new-pass-1"); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if _, _, _, err := uc.Login(context.Background(), "alice", "new-pass-1"); err != nil {
+		t.Fatalf("expected login after password change, got %v", err)
+	}
+}
+
+func TestAuthUsecase_Register_RegistrationModes(t *testing.T) {
+	genTok := func(int, string, int8, int) (string, time.Time, error) {
+		return "tok", time.Now().Add(time.Hour), nil
+	}
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+
+	closed := NewAuthUsecase(newMemAuthRepo(), genTok, &AuthPolicy{RegistrationMode: RegistrationClosed}, logger, tp)
+	if _, _, _, err := closed.Register(context.Background(), "alice", "p@ss"); !errors.Is(err, ErrRegistrationClosed) {
+		t.Fatalf("expected ErrRegistrationClosed, got %v", err)
+	}
+
+	repo := newMemAuthRepo()
+	repo.invites["ABCD2345"] = &InviteCode{ID: 1, Code: "ABCD2345", MaxUses: 1}
+	inviteOnly := NewAuthUsecase(repo, genTok, &AuthPolicy{RegistrationMode: RegistrationInviteOnly}, logger, tp)
+
+	if _, _, _, err := inviteOnly.Register(context.Background(), "alice", "p@ss"); !errors.Is(err, ErrInviteRequired) {
+		t.Fatalf("expected ErrInviteRequired, got %v", err)
+	}
+	if _, _, _, err := inviteOnly.RegisterWithInvite(context.Background(), "alice", "p@ss", "nope"); !errors.Is(err, ErrInviteInvalid) {
+		t.Fatalf("expected ErrInviteInvalid, got %v", err)
+	}
+	if _, _, u, err := inviteOnly.RegisterWithInvite(context.Background(), "alice", "p@ss", " abcd2345 "); err != nil || u == nil {
+		t.Fatalf("expected register with invite ok, got user=%+v err=%v", u, err)
+	}
+	if _, _, _, err := inviteOnly.RegisterWithInvite(context.Background(), "bob", "p@ss", "ABCD2345"); !errors.Is(err, ErrInviteExhausted) {
+		t.Fatalf("expected ErrInviteExhausted, got %v", err)
+	}
+}
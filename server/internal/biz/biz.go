@@ -7,5 +7,19 @@ var ProviderSet = wire.NewSet(
 	NewAuthUsecase,
 	NewAdminAuthUsecase,
 	NewUserAdminUsecase,
+	NewUserImportUsecase,
+	NewUserProfileUsecase,
+	NewUserDeletionUsecase,
+	NewUserDataUsecase,
 	NewRBACUsecase,
+	NewUserRBACUsecase,
+	NewAccessPolicyUsecase,
+	NewOrganizationUsecase,
+	NewImpersonationUsecase,
+	NewInviteUsecase,
+	NewVerificationUsecase,
+	NewLoginHistoryUsecase,
+	NewAdminAccountUsecase,
+	NewAdminAccessResolver,
+	wire.Bind(new(AdminAccountReader), new(*AdminAccessResolver)),
 )
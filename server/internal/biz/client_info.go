@@ -0,0 +1,22 @@
+// server/internal/biz/client_info.go
+package biz
+
+import "context"
+
+// ClientInfo 是请求方的网络信息，由 server 层中间件从请求头/连接里解析后注入 ctx。
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+type ctxKeyClientInfo struct{}
+
+func NewContextWithClientInfo(ctx context.Context, c ClientInfo) context.Context {
+	return context.WithValue(ctx, ctxKeyClientInfo{}, c)
+}
+
+// ClientInfoFromContext 取不到时返回零值，调用方无需判空。
+func ClientInfoFromContext(ctx context.Context) ClientInfo {
+	c, _ := ctx.Value(ctxKeyClientInfo{}).(ClientInfo)
+	return c
+}
@@ -0,0 +1,175 @@
+// server/internal/biz/impersonation.go
+package biz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const PermissionUserImpersonate = "admin.user.impersonate"
+
+var _ = RegisterAdminPermissions(AdminPermission{
+	Key:         PermissionUserImpersonate,
+	Name:        "模拟登录用户",
+	Group:       "账号",
+	Description: "允许以普通用户身份签发短期 token 复现问题，全程写入审计",
+})
+
+var ErrImpersonationNested = errors.New("impersonation cannot be nested")
+
+type ImpersonationTokenGenerator func(userID int, username string, role int8, organizationID int, actorID int, actorUsername string) (token string, expireAt time.Time, err error)
+
+// ImpersonationUsecase 负责管理员“以用户身份登录”：签发带 act 声明的短期 token，并把签发和后续调用写进审计流水。
+type ImpersonationUsecase struct {
+	users  AuthRepo
+	audit  AuditRepo
+	genTok ImpersonationTokenGenerator
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewImpersonationUsecase(users AuthRepo, audit AuditRepo, genTok ImpersonationTokenGenerator, logger log.Logger, tp *tracesdk.TracerProvider) *ImpersonationUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.impersonation"))
+
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.impersonation")
+	} else {
+		tr = otel.Tracer("biz.impersonation")
+	}
+
+	return &ImpersonationUsecase{
+		users:  users,
+		audit:  audit,
+		genTok: genTok,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+func (uc *ImpersonationUsecase) Tracer() trace.Tracer {
+	if uc.tracer != nil {
+		return uc.tracer
+	}
+	return otel.Tracer("biz.impersonation")
+}
+
+// Impersonate 由已通过权限校验的管理员调用；审计写入失败时不签发 token，避免出现无记录的模拟登录。
+func (uc *ImpersonationUsecase) Impersonate(ctx context.Context, userID int, reason string) (token string, expireAt time.Time, u *User, err error) {
+	ctx, span := uc.Tracer().Start(ctx, "impersonation.start",
+		trace.WithAttributes(attribute.Int("user.id", userID)),
+	)
+	defer span.End()
+
+	l := uc.log.WithContext(ctx)
+
+	admin, ok := GetClaimsFromContext(ctx)
+	if !ok || admin == nil || admin.Role != RoleAdmin {
+		span.SetStatus(codes.Error, ErrForbidden.Error())
+		l.Warn("Impersonate forbidden")
+		return "", time.Time{}, nil, ErrForbidden
+	}
+	if admin.IsImpersonated() {
+		span.SetStatus(codes.Error, ErrImpersonationNested.Error())
+		l.Warnf("Impersonate nested actor_admin_id=%d", admin.ActorID)
+		return "", time.Time{}, nil, ErrImpersonationNested
+	}
+	span.SetAttributes(attribute.Int("auth.admin_uid", admin.UserID))
+
+	if userID <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return "", time.Time{}, nil, ErrBadParam
+	}
+
+	target, e := uc.users.GetUserByID(ctx, userID)
+	if e != nil || target == nil {
+		span.RecordError(e)
+		span.SetStatus(codes.Error, ErrUserNotFound.Error())
+		l.Infof("Impersonate user not found admin_id=%d user_id=%d err=%v", admin.UserID, userID, e)
+		return "", time.Time{}, nil, ErrUserNotFound
+	}
+	if target.Disabled {
+		span.SetStatus(codes.Error, ErrUserDisabled.Error())
+		l.Infof("Impersonate user disabled admin_id=%d user_id=%d", admin.UserID, userID)
+		return "", time.Time{}, nil, ErrUserDisabled
+	}
+
+	reason = strings.TrimSpace(reason)
+	if e := uc.audit.RecordAudit(ctx, &AuditEvent{
+		Action:        AuditActionImpersonationStart,
+		ActorKind:     AuditActorAdmin,
+		ActorID:       admin.UserID,
+		ActorUsername: admin.Username,
+		TargetKind:    AuditActorUser,
+		TargetID:      target.ID,
+		Detail: map[string]any{
+			"target_username": target.Username,
+			"reason":          reason,
+		},
+	}); e != nil {
+		err = e
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "record audit failed")
+		l.Errorf("Impersonate record audit failed admin_id=%d user_id=%d err=%v", admin.UserID, target.ID, err)
+		return "", time.Time{}, nil, err
+	}
+
+	// 管理员处在某个组织内时沿用该组织（能查到目标用户说明其属于该组织），否则取用户的默认组织。
+	orgID, scoped := TenantFromContext(ctx)
+	if !scoped {
+		orgID = DefaultOrganizationID(target.OrganizationIDs)
+	}
+	token, expireAt, e = uc.genTok(target.ID, target.Username, int8(RoleUser), orgID, admin.UserID, admin.Username)
+	if e != nil {
+		err = e
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "generate token failed")
+		l.Errorf("Impersonate generate token failed admin_id=%d user_id=%d err=%v", admin.UserID, target.ID, err)
+		return "", time.Time{}, nil, err
+	}
+
+	span.SetStatus(codes.Ok, "OK")
+	l.Infof("Impersonate success admin_id=%d admin_username=%s user_id=%d username=%s expires_at=%d",
+		admin.UserID, admin.Username, target.ID, target.Username, expireAt.Unix(),
+	)
+	return token, expireAt, target, nil
+}
+
+// RecordImpersonatedCall 记录模拟登录期间的每一次调用；写入失败只告警，不阻断用户侧排障。
+func (uc *ImpersonationUsecase) RecordImpersonatedCall(ctx context.Context, url, method string) {
+	c, ok := GetClaimsFromContext(ctx)
+	if !ok || !c.IsImpersonated() {
+		return
+	}
+
+	l := uc.log.WithContext(ctx)
+	l.Infof("[impersonation] call actor_admin_id=%d actor_admin_username=%s user_id=%d username=%s url=%s method=%s",
+		c.ActorID, c.ActorUsername, c.UserID, c.Username, url, method,
+	)
+
+	if err := uc.audit.RecordAudit(ctx, &AuditEvent{
+		Action:        AuditActionImpersonatedCall,
+		ActorKind:     AuditActorAdmin,
+		ActorID:       c.ActorID,
+		ActorUsername: c.ActorUsername,
+		TargetKind:    AuditActorUser,
+		TargetID:      c.UserID,
+		Detail: map[string]any{
+			"target_username": c.Username,
+			"rpc":             url + "." + method,
+		},
+	}); err != nil {
+		l.Warnf("[impersonation] record call audit failed actor_admin_id=%d user_id=%d rpc=%s.%s err=%v",
+			c.ActorID, c.UserID, url, method, err,
+		)
+	}
+}
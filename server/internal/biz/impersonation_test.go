@@ -0,0 +1,128 @@
+// server/internal/biz/impersonation_test.go
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type memAuditRepo struct {
+	mu     sync.Mutex
+	events []AuditEvent
+	err    error
+}
+
+func (r *memAuditRepo) RecordAudit(ctx context.Context, e *AuditEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.events = append(r.events, *e)
+	return nil
+}
+
+// ListUserAuditEvents 以写入顺序（从 1 开始）作为 id。
+func (r *memAuditRepo) ListUserAuditEvents(ctx context.Context, userID, afterID, limit int) ([]*AuditEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*AuditEvent
+	for i := afterID; i < len(r.events) && len(out) < limit; i++ {
+		e := r.events[i]
+		if (e.ActorKind == AuditActorUser && e.ActorID == userID) || (e.TargetKind == "user" && e.TargetID == userID) {
+			e.ID = i + 1
+			out = append(out, &e)
+		}
+	}
+	return out, nil
+}
+
+func newTestImpersonationUsecase(t *testing.T, audit *memAuditRepo) (*ImpersonationUsecase, *memAuthRepo) {
+	t.Helper()
+
+	repo := newMemAuthRepo()
+	_, _ = repo.CreateUser(context.Background(), &User{Username: "alice", PasswordHash: "x"})
+	_, _ = repo.CreateUser(context.Background(), &User{Username: "bob", PasswordHash: "x", Disabled: true})
+
+	genTok := func(userID int, username string, role int8, organizationID int, actorID int, actorUsername string) (string, time.Time, error) {
+		return "imp-tok", time.Now().Add(15 * time.Minute), nil
+	}
+	uc := NewImpersonationUsecase(repo, audit, genTok, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	return uc, repo
+}
+
+func adminCtx() context.Context {
+	return NewContextWithClaims(context.Background(), &AuthClaims{UserID: 7, Username: "root", Role: RoleAdmin})
+}
+
+func TestImpersonationUsecase_Impersonate_RecordsAudit(t *testing.T) {
+	audit := &memAuditRepo{}
+	uc, _ := newTestImpersonationUsecase(t, audit)
+
+	token, _, u, err := uc.Impersonate(adminCtx(), 1, " ticket-42 ")
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if token != "imp-tok" || u == nil || u.Username != "alice" {
+		t.Fatalf("unexpected result token=%q user=%+v", token, u)
+	}
+	if len(audit.events) != 1 {
+		t.Fatalf("expected 1 audit event, got %d", len(audit.events))
+	}
+	e := audit.events[0]
+	if e.Action != AuditActionImpersonationStart || e.ActorID != 7 || e.TargetID != 1 || e.Detail["reason"] != "ticket-42" {
+		t.Fatalf("unexpected audit event: %+v", e)
+	}
+}
+
+func TestImpersonationUsecase_Impersonate_Rejects(t *testing.T) {
+	audit := &memAuditRepo{}
+	uc, _ := newTestImpersonationUsecase(t, audit)
+
+	nested := NewContextWithClaims(context.Background(), &AuthClaims{
+		UserID: 1, Username: "alice", Role: RoleAdmin, ActorID: 7, ActorUsername: "root",
+	})
+	userCtx := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 1, Username: "alice", Role: RoleUser})
+
+	cases := []struct {
+		name string
+		ctx  context.Context
+		uid  int
+		want error
+	}{
+		{name: "no claims", ctx: context.Background(), uid: 1, want: ErrForbidden},
+		{name: "user role", ctx: userCtx, uid: 1, want: ErrForbidden},
+		{name: "nested", ctx: nested, uid: 1, want: ErrImpersonationNested},
+		{name: "bad id", ctx: adminCtx(), uid: 0, want: ErrBadParam},
+		{name: "not found", ctx: adminCtx(), uid: 99, want: ErrUserNotFound},
+		{name: "disabled", ctx: adminCtx(), uid: 2, want: ErrUserDisabled},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, _, err := uc.Impersonate(tc.ctx, tc.uid, "")
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, err)
+			}
+		})
+	}
+	if len(audit.events) != 0 {
+		t.Fatalf("expected no audit events, got %d", len(audit.events))
+	}
+}
+
+func TestImpersonationUsecase_Impersonate_AuditFailureBlocksToken(t *testing.T) {
+	audit := &memAuditRepo{err: errors.New("db down")}
+	uc, _ := newTestImpersonationUsecase(t, audit)
+
+	token, _, _, err := uc.Impersonate(adminCtx(), 1, "")
+	if err == nil || token != "" {
+		t.Fatalf("expected audit failure to block token, got token=%q err=%v", token, err)
+	}
+}
@@ -0,0 +1,363 @@
+// server/internal/biz/invite.go
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	PermissionInviteRead  = "admin.invite.read"
+	PermissionInviteWrite = "admin.invite.write"
+)
+
+var _ = RegisterAdminPermissions(
+	AdminPermission{
+		Key:         PermissionInviteRead,
+		Name:        "查看邀请码",
+		Group:       "注册",
+		Description: "允许查看注册邀请码及其使用情况",
+	},
+	AdminPermission{
+		Key:         PermissionInviteWrite,
+		Name:        "管理邀请码",
+		Group:       "注册",
+		Description: "允许创建和撤销注册邀请码",
+	},
+)
+
+var (
+	ErrRegistrationClosed = errors.New("registration closed")
+	ErrInviteRequired     = errors.New("invite code required")
+	ErrInviteInvalid      = errors.New("invite code invalid")
+	ErrInviteExpired      = errors.New("invite code expired")
+	ErrInviteExhausted    = errors.New("invite code exhausted")
+)
+
+type RegistrationMode string
+
+const (
+	RegistrationOpen       RegistrationMode = "open"
+	RegistrationInviteOnly RegistrationMode = "invite_only"
+	RegistrationClosed     RegistrationMode = "closed"
+)
+
+// AuthPolicy 汇总来自配置的注册/登录策略；nil 等价于默认开放注册。
+type AuthPolicy struct {
+	RegistrationMode RegistrationMode
+	Verification     VerificationPolicy
+	// LoginHistoryRetention 为 0 时用默认 90 天，小于 0 表示不清理。
+	LoginHistoryRetention time.Duration
+	// AdminAccessCacheTTL 为 0 时用默认 5 秒，小于 0 表示不做进程内缓存。
+	AdminAccessCacheTTL time.Duration
+	// DeletedUserRetention 为 0 时用默认 30 天，小于 0 表示不自动匿名化。
+	DeletedUserRetention time.Duration
+	// DeletedUsername 为空时按 DeletedUsernameTombstone 处理。
+	DeletedUsername DeletedUsernamePolicy
+	// AccountDeletionGrace 为 0 时用默认 7 天，小于 0 表示不设宽限期。
+	AccountDeletionGrace time.Duration
+	// LoginMaxFailures 为 0 时用默认 10 次，小于 0 表示不限制；LoginFailureWindow 为 0 时用默认 15 分钟。
+	LoginMaxFailures   int
+	LoginFailureWindow time.Duration
+}
+
+func (p *AuthPolicy) Mode() RegistrationMode {
+	if p == nil || p.RegistrationMode == "" {
+		return RegistrationOpen
+	}
+	return p.RegistrationMode
+}
+
+const (
+	AuditActionInviteCreate = "invite.create"
+	AuditActionInviteRevoke = "invite.revoke"
+)
+
+const (
+	InviteStatusActive    = "active"
+	InviteStatusExpired   = "expired"
+	InviteStatusExhausted = "exhausted"
+	InviteStatusRevoked   = "revoked"
+)
+
+type InviteCode struct {
+	ID        int
+	Code      string
+	MaxUses   int
+	UsedCount int
+	ExpiresAt *time.Time
+	// RoleKey 是注册后预分配给用户的角色，空表示不预分配。
+	RoleKey string
+	Note    string
+	// OrganizationID 是邀请码所属组织，0 表示平台级；组织内的管理员只能看到和撤销本组织的邀请码。
+	OrganizationID int
+	CreatedBy      int
+	RevokedAt      *time.Time
+	CreatedAt      time.Time
+}
+
+func (c *InviteCode) Status(now time.Time) string {
+	switch {
+	case c.RevokedAt != nil:
+		return InviteStatusRevoked
+	case c.ExpiresAt != nil && !c.ExpiresAt.After(now):
+		return InviteStatusExpired
+	case c.UsedCount >= c.MaxUses:
+		return InviteStatusExhausted
+	default:
+		return InviteStatusActive
+	}
+}
+
+// InviteRedemption 是用户注册时使用的一个邀请码。
+type InviteRedemption struct {
+	InviteCodeID int
+	Code         string
+	RoleKey      string
+	CreatedAt    time.Time
+}
+
+type InviteRepo interface {
+	CreateInvite(ctx context.Context, in *InviteCode) (*InviteCode, error)
+	// ListInvites 限定组织时只返回该组织的邀请码。
+	ListInvites(ctx context.Context, limit, offset int, activeOnly bool) (list []*InviteCode, total int, err error)
+	// RevokeInvite 限定组织时只能撤销该组织的邀请码，其他组织的邀请码按不存在处理（ErrInviteInvalid）。
+	RevokeInvite(ctx context.Context, id int, at time.Time) (*InviteCode, error)
+	ListUserInviteRedemptions(ctx context.Context, userID int) ([]InviteRedemption, error)
+}
+
+const (
+	inviteCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	inviteCodeLength   = 10
+	inviteMaxUsesLimit = 10000
+)
+
+// NewInviteCode 生成去掉易混字符的随机邀请码。
+func NewInviteCode() (string, error) {
+	buf := make([]byte, inviteCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	for i, b := range buf {
+		buf[i] = inviteCodeAlphabet[int(b)%len(inviteCodeAlphabet)]
+	}
+	return string(buf), nil
+}
+
+// NormalizeInviteCode 统一邀请码输入格式，用户手抄时大小写和空格不敏感。
+func NormalizeInviteCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+type InviteUsecase struct {
+	repo   InviteRepo
+	audit  AuditRepo
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewInviteUsecase(repo InviteRepo, audit AuditRepo, logger log.Logger, tp *tracesdk.TracerProvider) *InviteUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.invite"))
+
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.invite")
+	} else {
+		tr = otel.Tracer("biz.invite")
+	}
+
+	return &InviteUsecase{
+		repo:   repo,
+		audit:  audit,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+func (uc *InviteUsecase) UserDataSection() string { return "invite_redemptions" }
+
+// ExportUserData 导出用户注册时使用的邀请码；邀请码的备注与创建者属于管理侧信息，不导出。
+func (uc *InviteUsecase) ExportUserData(ctx context.Context, userID int) (any, error) {
+	list, err := uc.repo.ListUserInviteRedemptions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]map[string]any, 0, len(list))
+	for _, r := range list {
+		out = append(out, map[string]any{
+			"code":        r.Code,
+			"role":        r.RoleKey,
+			"redeemed_at": r.CreatedAt,
+		})
+	}
+	return out, nil
+}
+
+func (uc *InviteUsecase) Tracer() trace.Tracer {
+	if uc.tracer != nil {
+		return uc.tracer
+	}
+	return otel.Tracer("biz.invite")
+}
+
+// Create 生成邀请码；roleKey 非空时必须是已存在的用户侧角色，否则返回 ErrRoleKeyInvalid / ErrRoleNotFound，
+// 避免发出注册时才发现角色不存在的邀请码。
+func (uc *InviteUsecase) Create(ctx context.Context, maxUses int, expiresAt *time.Time, roleKey, note string) (*InviteCode, error) {
+	ctx, span := uc.Tracer().Start(ctx, "invite.create",
+		trace.WithAttributes(attribute.Int("invite.max_uses", maxUses)),
+	)
+	defer span.End()
+
+	l := uc.log.WithContext(ctx)
+
+	admin, ok := GetClaimsFromContext(ctx)
+	if !ok || !admin.IsAdmin() {
+		span.SetStatus(codes.Error, ErrForbidden.Error())
+		return nil, ErrForbidden
+	}
+	if maxUses <= 0 || maxUses > inviteMaxUsesLimit {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		l.Warnf("Create invite bad max_uses=%d", maxUses)
+		return nil, ErrBadParam
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		l.Warnf("Create invite expires_at in the past expires_at=%d", expiresAt.Unix())
+		return nil, ErrBadParam
+	}
+	roleKey = strings.TrimSpace(roleKey)
+	if roleKey != "" && !roleKeyPattern.MatchString(roleKey) {
+		span.SetStatus(codes.Error, ErrRoleKeyInvalid.Error())
+		l.Warnf("Create invite bad role_key=%q", roleKey)
+		return nil, ErrRoleKeyInvalid
+	}
+
+	code, err := NewInviteCode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "generate code failed")
+		return nil, err
+	}
+
+	orgID, _ := TenantFromContext(ctx)
+	created, err := uc.repo.CreateInvite(ctx, &InviteCode{
+		Code:           code,
+		MaxUses:        maxUses,
+		ExpiresAt:      expiresAt,
+		RoleKey:        roleKey,
+		Note:           strings.TrimSpace(note),
+		OrganizationID: orgID,
+		CreatedBy:      admin.UserID,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "repo.CreateInvite failed")
+		if errors.Is(err, ErrRoleNotFound) {
+			l.Warnf("Create invite unknown role_key=%q admin_id=%d", roleKey, admin.UserID)
+			return nil, err
+		}
+		l.Errorf("Create invite failed admin_id=%d err=%v", admin.UserID, err)
+		return nil, err
+	}
+
+	uc.recordAudit(ctx, admin, AuditActionInviteCreate, created)
+
+	span.SetStatus(codes.Ok, "OK")
+	l.Infof("Create invite success admin_id=%d invite_id=%d max_uses=%d role_key=%q", admin.UserID, created.ID, created.MaxUses, created.RoleKey)
+	return created, nil
+}
+
+func (uc *InviteUsecase) List(ctx context.Context, limit, offset int, activeOnly bool) ([]*InviteCode, int, error) {
+	ctx, span := uc.Tracer().Start(ctx, "invite.list")
+	defer span.End()
+
+	if c, ok := GetClaimsFromContext(ctx); !ok || !c.IsAdmin() {
+		span.SetStatus(codes.Error, ErrForbidden.Error())
+		return nil, 0, ErrForbidden
+	}
+
+	if limit <= 0 {
+		limit = 30
+	}
+	if limit > 200 {
+		limit = 200
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	list, total, err := uc.repo.ListInvites(ctx, limit, offset, activeOnly)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "repo.ListInvites failed")
+		uc.log.WithContext(ctx).Errorf("List invites failed err=%v", err)
+		return nil, 0, err
+	}
+
+	span.SetStatus(codes.Ok, "OK")
+	return list, total, nil
+}
+
+func (uc *InviteUsecase) Revoke(ctx context.Context, id int) (*InviteCode, error) {
+	ctx, span := uc.Tracer().Start(ctx, "invite.revoke",
+		trace.WithAttributes(attribute.Int("invite.id", id)),
+	)
+	defer span.End()
+
+	l := uc.log.WithContext(ctx)
+
+	admin, ok := GetClaimsFromContext(ctx)
+	if !ok || !admin.IsAdmin() {
+		span.SetStatus(codes.Error, ErrForbidden.Error())
+		return nil, ErrForbidden
+	}
+	if id <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+
+	revoked, err := uc.repo.RevokeInvite(ctx, id, time.Now())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "repo.RevokeInvite failed")
+		l.Warnf("Revoke invite failed admin_id=%d invite_id=%d err=%v", admin.UserID, id, err)
+		return nil, err
+	}
+
+	uc.recordAudit(ctx, admin, AuditActionInviteRevoke, revoked)
+
+	span.SetStatus(codes.Ok, "OK")
+	l.Infof("Revoke invite success admin_id=%d invite_id=%d", admin.UserID, id)
+	return revoked, nil
+}
+
+// recordAudit 邀请码变更的审计失败只告警：邀请码本身已落库，重试只会造成重复记录。
+func (uc *InviteUsecase) recordAudit(ctx context.Context, admin *AuthClaims, action string, invite *InviteCode) {
+	if uc.audit == nil || invite == nil {
+		return
+	}
+	if err := uc.audit.RecordAudit(ctx, &AuditEvent{
+		Action:        action,
+		ActorKind:     AuditActorAdmin,
+		ActorID:       admin.UserID,
+		ActorUsername: admin.Username,
+		TargetKind:    "invite",
+		TargetID:      invite.ID,
+		Detail: map[string]any{
+			"max_uses": invite.MaxUses,
+			"role_key": invite.RoleKey,
+		},
+	}); err != nil {
+		uc.log.WithContext(ctx).Warnf("record invite audit failed action=%s invite_id=%d err=%v", action, invite.ID, err)
+	}
+}
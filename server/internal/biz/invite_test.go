@@ -0,0 +1,146 @@
+// server/internal/biz/invite_test.go
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type memInviteRepo struct {
+	invites     []*InviteCode
+	redemptions map[int][]InviteRedemption
+	// roles 非 nil 时模拟数据层对 role_key 的存在性校验。
+	roles map[string]bool
+}
+
+func (r *memInviteRepo) CreateInvite(ctx context.Context, in *InviteCode) (*InviteCode, error) {
+	if r.roles != nil && in.RoleKey != "" && !r.roles[in.RoleKey] {
+		return nil, ErrRoleNotFound
+	}
+	cp := *in
+	cp.ID = len(r.invites) + 1
+	cp.CreatedAt = time.Now()
+	r.invites = append(r.invites, &cp)
+	return &cp, nil
+}
+
+func (r *memInviteRepo) ListInvites(ctx context.Context, limit, offset int, activeOnly bool) ([]*InviteCode, int, error) {
+	orgID, scoped := TenantFromContext(ctx)
+	var out []*InviteCode
+	for _, invite := range r.invites {
+		if !scoped || invite.OrganizationID == orgID {
+			out = append(out, invite)
+		}
+	}
+	return out, len(out), nil
+}
+
+func (r *memInviteRepo) RevokeInvite(ctx context.Context, id int, at time.Time) (*InviteCode, error) {
+	orgID, scoped := TenantFromContext(ctx)
+	for _, invite := range r.invites {
+		if invite.ID == id && (!scoped || invite.OrganizationID == orgID) {
+			invite.RevokedAt = &at
+			return invite, nil
+		}
+	}
+	return nil, ErrInviteInvalid
+}
+
+func (r *memInviteRepo) ListUserInviteRedemptions(ctx context.Context, userID int) ([]InviteRedemption, error) {
+	return r.redemptions[userID], nil
+}
+
+func TestInviteUsecase_CreateAndRevoke(t *testing.T) {
+	repo := &memInviteRepo{}
+	audit := &memAuditRepo{}
+	uc := NewInviteUsecase(repo, audit, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+
+	if _, err := uc.Create(context.Background(), 1, nil, "", ""); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden without admin claims, got %v", err)
+	}
+	if _, err := uc.Create(adminCtx(), 0, nil, "", ""); !errors.Is(err, ErrBadParam) {
+		t.Fatalf("expected ErrBadParam for max_uses=0, got %v", err)
+	}
+	past := time.Now().Add(-time.Hour)
+	if _, err := uc.Create(adminCtx(), 1, &past, "", ""); !errors.Is(err, ErrBadParam) {
+		t.Fatalf("expected ErrBadParam for past expires_at, got %v", err)
+	}
+
+	invite, err := uc.Create(adminCtx(), 3, nil, " vip ", "beta")
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if len(invite.Code) != inviteCodeLength || invite.RoleKey != "vip" || invite.CreatedBy != 7 {
+		t.Fatalf("unexpected invite: %+v", invite)
+	}
+	if invite.Status(time.Now()) != InviteStatusActive {
+		t.Fatalf("expected active invite, got %s", invite.Status(time.Now()))
+	}
+
+	revoked, err := uc.Revoke(adminCtx(), invite.ID)
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if revoked.Status(time.Now()) != InviteStatusRevoked {
+		t.Fatalf("expected revoked invite, got %s", revoked.Status(time.Now()))
+	}
+	if len(audit.events) != 2 || audit.events[0].Action != AuditActionInviteCreate || audit.events[1].Action != AuditActionInviteRevoke {
+		t.Fatalf("unexpected audit events: %+v", audit.events)
+	}
+}
+
+func TestInviteUsecase_TenantScoped(t *testing.T) {
+	repo := &memInviteRepo{}
+	uc := NewInviteUsecase(repo, &memAuditRepo{}, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+
+	platform, err := uc.Create(adminCtx(), 1, nil, "", "")
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	orgCtx := NewContextWithTenant(adminCtx(), 5)
+	scoped, err := uc.Create(orgCtx, 1, nil, "", "")
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if platform.OrganizationID != 0 || scoped.OrganizationID != 5 {
+		t.Fatalf("unexpected organization ids: platform=%d scoped=%d", platform.OrganizationID, scoped.OrganizationID)
+	}
+
+	list, total, err := uc.List(orgCtx, 10, 0, false)
+	if err != nil || total != 1 || list[0].ID != scoped.ID {
+		t.Fatalf("expected only the organization invite, got total=%d err=%v", total, err)
+	}
+	if _, err := uc.Revoke(NewContextWithTenant(adminCtx(), 6), scoped.ID); !errors.Is(err, ErrInviteInvalid) {
+		t.Fatalf("expected ErrInviteInvalid from another organization, got %v", err)
+	}
+	if _, err := uc.Revoke(orgCtx, platform.ID); !errors.Is(err, ErrInviteInvalid) {
+		t.Fatalf("expected ErrInviteInvalid for a platform invite, got %v", err)
+	}
+	if _, err := uc.Revoke(orgCtx, scoped.ID); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+}
+
+func TestInviteUsecase_CreateValidatesRoleKey(t *testing.T) {
+	repo := &memInviteRepo{roles: map[string]bool{"vip": true}}
+	uc := NewInviteUsecase(repo, &memAuditRepo{}, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+
+	if _, err := uc.Create(adminCtx(), 1, nil, "VIP!", ""); !errors.Is(err, ErrRoleKeyInvalid) {
+		t.Fatalf("expected ErrRoleKeyInvalid, got %v", err)
+	}
+	if _, err := uc.Create(adminCtx(), 1, nil, "gold", ""); !errors.Is(err, ErrRoleNotFound) {
+		t.Fatalf("expected ErrRoleNotFound, got %v", err)
+	}
+	if len(repo.invites) != 0 {
+		t.Fatalf("invalid role_key must not create invites, got %+v", repo.invites)
+	}
+	if _, err := uc.Create(adminCtx(), 1, nil, "vip", ""); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+}
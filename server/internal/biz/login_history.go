@@ -0,0 +1,364 @@
+// server/internal/biz/login_history.go
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	LoginAccountUser  = "user"
+	LoginAccountAdmin = "admin"
+)
+
+// 登录失败原因码：只给管理员和账号本人看，不回传给登录接口，避免暴露账号是否存在。
+const (
+	LoginReasonOK             = "ok"
+	LoginReasonInvalidArgs    = "invalid_args"
+	LoginReasonUnknownAccount = "unknown_account"
+	LoginReasonBadPassword    = "bad_password"
+	LoginReasonDisabled       = "disabled"
+	LoginReasonUnverified     = "unverified"
+	LoginReasonThrottled      = "throttled"
+	LoginReasonError          = "error"
+
+	LoginReasonPasswordChangeRequired = "password_change_required"
+)
+
+// ErrLoginThrottled 表示账号在窗口内密码错误次数过多，暂时拒绝登录。
+var ErrLoginThrottled = errors.New("too many failed login attempts")
+
+const (
+	defaultLoginHistoryRetention = 90 * 24 * time.Hour
+	defaultLoginMaxFailures      = 10
+	defaultLoginFailureWindow    = 15 * time.Minute
+	loginEventUsernameMaxLen     = 64
+	loginEventUserAgentMaxLen    = 512
+)
+
+type LoginEvent struct {
+	ID          int
+	AccountKind string
+	AccountID   int
+	Username    string
+	Success     bool
+	Reason      string
+	IP          string
+	UserAgent   string
+	RequestID   string
+	CreatedAt   time.Time
+}
+
+// LoginEventFilter 的零值字段表示不过滤；Success 为 nil 表示成功失败都要。
+type LoginEventFilter struct {
+	AccountKind string
+	AccountID   int
+	Username    string
+	IP          string
+	Success     *bool
+	Since       *time.Time
+	Until       *time.Time
+	Limit       int
+	Offset      int
+}
+
+type LoginEventRepo interface {
+	RecordLoginEvent(ctx context.Context, e *LoginEvent) error
+	ListLoginEvents(ctx context.Context, f LoginEventFilter) ([]*LoginEvent, int, error)
+	PurgeLoginEventsBefore(ctx context.Context, before time.Time) (int, error)
+	// CountLoginFailures 统计账号在 since 之后密码错误（bad_password）的次数，不受组织范围限制。
+	CountLoginFailures(ctx context.Context, accountKind string, accountID int, since time.Time) (int, error)
+}
+
+// LoginReason 把登录结果映射成落库的原因码。
+func LoginReason(err error) string {
+	switch {
+	case err == nil:
+		return LoginReasonOK
+	case errors.Is(err, ErrUserNotFound):
+		return LoginReasonUnknownAccount
+	case errors.Is(err, ErrInvalidPassword):
+		return LoginReasonBadPassword
+	case errors.Is(err, ErrUserDisabled):
+		return LoginReasonDisabled
+	case errors.Is(err, ErrUserUnverified):
+		return LoginReasonUnverified
+	case errors.Is(err, ErrBadParam):
+		return LoginReasonInvalidArgs
+	case errors.Is(err, ErrLoginThrottled):
+		return LoginReasonThrottled
+	case errors.Is(err, ErrPasswordChangeRequired):
+		return LoginReasonPasswordChangeRequired
+	default:
+		return LoginReasonError
+	}
+}
+
+// LoginHistoryUsecase 记录登录流水并提供查询与按保留期清理。
+type LoginHistoryUsecase struct {
+	repo   LoginEventRepo
+	users  AuthRepo
+	admins AdminAuthRepo
+	policy *AuthPolicy
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewLoginHistoryUsecase(repo LoginEventRepo, users AuthRepo, admins AdminAuthRepo, policy *AuthPolicy, logger log.Logger, tp *tracesdk.TracerProvider) *LoginHistoryUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.login_history"))
+
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.login_history")
+	} else {
+		tr = otel.Tracer("biz.login_history")
+	}
+
+	return &LoginHistoryUsecase{
+		repo:   repo,
+		users:  users,
+		admins: admins,
+		policy: policy,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+func (uc *LoginHistoryUsecase) Tracer() trace.Tracer {
+	if uc.tracer != nil {
+		return uc.tracer
+	}
+	return otel.Tracer("biz.login_history")
+}
+
+// RecordLogin 在登录接口返回前调用；accountID 未知（失败场景）时按用户名补查。
+// 写入失败只告警，登录流水不能反过来阻断登录。
+func (uc *LoginHistoryUsecase) RecordLogin(ctx context.Context, accountKind string, accountID int, username string, loginErr error) {
+	l := uc.log.WithContext(ctx)
+
+	username = CanonicalUsername(username)
+	if utf8.RuneCountInString(username) > loginEventUsernameMaxLen {
+		username = string([]rune(username)[:loginEventUsernameMaxLen])
+	}
+	reason := LoginReason(loginErr)
+
+	if accountID == 0 && username != "" && reason != LoginReasonUnknownAccount {
+		accountID = uc.lookupAccountID(ctx, accountKind, username)
+	}
+
+	client := ClientInfoFromContext(ctx)
+	ua := client.UserAgent
+	if len(ua) > loginEventUserAgentMaxLen {
+		ua = ua[:loginEventUserAgentMaxLen]
+		for !utf8.ValidString(ua) {
+			ua = ua[:len(ua)-1]
+		}
+	}
+
+	e := &LoginEvent{
+		AccountKind: accountKind,
+		AccountID:   accountID,
+		Username:    username,
+		Success:     loginErr == nil,
+		Reason:      reason,
+		IP:          client.IP,
+		UserAgent:   ua,
+	}
+	if err := uc.repo.RecordLoginEvent(ctx, e); err != nil {
+		l.Warnf("RecordLogin failed kind=%s account_id=%d reason=%s err=%v", accountKind, accountID, reason, err)
+	}
+}
+
+// CheckThrottle 在校验密码前调用：账号在窗口内密码错误达到上限时返回 ErrLoginThrottled。
+// 只按账号计数，用户名不存在时直接放行（后续统一返回凭证错误）；被拒绝的尝试记为 throttled，不再计入次数，
+// 窗口过去后自动解除。查询失败只告警放行，登录流水不能反过来阻断登录。
+func (uc *LoginHistoryUsecase) CheckThrottle(ctx context.Context, accountKind, username string) error {
+	maxFailures, window := uc.throttle()
+	if maxFailures <= 0 {
+		return nil
+	}
+	accountID := uc.lookupAccountID(ctx, accountKind, CanonicalUsername(username))
+	if accountID == 0 {
+		return nil
+	}
+	n, err := uc.repo.CountLoginFailures(ctx, accountKind, accountID, time.Now().Add(-window))
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("CheckThrottle count failed kind=%s account_id=%d err=%v", accountKind, accountID, err)
+		return nil
+	}
+	if n >= maxFailures {
+		uc.log.WithContext(ctx).Warnf("CheckThrottle rejected kind=%s account_id=%d failures=%d", accountKind, accountID, n)
+		return ErrLoginThrottled
+	}
+	return nil
+}
+
+func (uc *LoginHistoryUsecase) throttle() (int, time.Duration) {
+	maxFailures, window := defaultLoginMaxFailures, defaultLoginFailureWindow
+	if uc.policy != nil {
+		if uc.policy.LoginMaxFailures != 0 {
+			maxFailures = uc.policy.LoginMaxFailures
+		}
+		if uc.policy.LoginFailureWindow > 0 {
+			window = uc.policy.LoginFailureWindow
+		}
+	}
+	return maxFailures, window
+}
+
+func (uc *LoginHistoryUsecase) lookupAccountID(ctx context.Context, accountKind, username string) int {
+	switch accountKind {
+	case LoginAccountUser:
+		if uc.users == nil {
+			return 0
+		}
+		if u, err := uc.users.GetUserByUsername(ctx, username); err == nil && u != nil {
+			return u.ID
+		}
+	case LoginAccountAdmin:
+		if uc.admins == nil {
+			return 0
+		}
+		if a, err := uc.admins.GetAdminByUsername(ctx, username); err == nil && a != nil {
+			return a.ID
+		}
+	}
+	return 0
+}
+
+// ListMine 返回当前登录账号（用户或管理员）自己的登录流水。
+func (uc *LoginHistoryUsecase) ListMine(ctx context.Context, limit, offset int) ([]*LoginEvent, int, error) {
+	c, ok := GetClaimsFromContext(ctx)
+	if !ok || c == nil || c.UserID <= 0 {
+		return nil, 0, ErrForbidden
+	}
+
+	kind := LoginAccountUser
+	if c.IsAdmin() {
+		kind = LoginAccountAdmin
+	}
+	// 只查自己的记录，不需要组织范围；管理员的登录流水不属于任何组织。
+	return uc.List(NewContextWithTenant(ctx, 0), LoginEventFilter{
+		AccountKind: kind,
+		AccountID:   c.UserID,
+		Limit:       limit,
+		Offset:      offset,
+	})
+}
+
+// List 供管理员按条件检索；调用方负责权限校验。限定组织时只返回该组织用户的记录。
+func (uc *LoginHistoryUsecase) List(ctx context.Context, f LoginEventFilter) ([]*LoginEvent, int, error) {
+	ctx, span := uc.Tracer().Start(ctx, "login_history.list",
+		trace.WithAttributes(
+			attribute.String("login_history.account_kind", f.AccountKind),
+			attribute.Int("login_history.account_id", f.AccountID),
+		),
+	)
+	defer span.End()
+
+	switch f.AccountKind {
+	case "", LoginAccountUser, LoginAccountAdmin:
+	default:
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, 0, ErrBadParam
+	}
+	if f.Limit <= 0 {
+		f.Limit = 30
+	}
+	if f.Limit > 200 {
+		f.Limit = 200
+	}
+	if f.Offset < 0 {
+		f.Offset = 0
+	}
+
+	list, total, err := uc.repo.ListLoginEvents(ctx, f)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "list login events failed")
+		uc.log.WithContext(ctx).Errorf("ListLoginEvents failed err=%v", err)
+		return nil, 0, err
+	}
+	span.SetStatus(codes.Ok, "OK")
+	return list, total, nil
+}
+
+// loginEventExportBatch 是导出登录流水时每次查询的条数。
+const loginEventExportBatch = 500
+
+func (uc *LoginHistoryUsecase) UserDataSection() string { return "login_history" }
+
+// ExportUserData 导出保留期内的全部登录流水，包括登录时的 IP 与 User-Agent。
+func (uc *LoginHistoryUsecase) ExportUserData(ctx context.Context, userID int) (any, error) {
+	out := []map[string]any{}
+	for offset := 0; ; offset += loginEventExportBatch {
+		list, _, err := uc.repo.ListLoginEvents(ctx, LoginEventFilter{
+			AccountKind: LoginAccountUser,
+			AccountID:   userID,
+			Limit:       loginEventExportBatch,
+			Offset:      offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range list {
+			out = append(out, map[string]any{
+				"created_at": e.CreatedAt,
+				"success":    e.Success,
+				"reason":     e.Reason,
+				"ip":         e.IP,
+				"user_agent": e.UserAgent,
+				"request_id": e.RequestID,
+			})
+		}
+		if len(list) < loginEventExportBatch {
+			return out, nil
+		}
+	}
+}
+
+// Retention 返回登录流水保留时长；0 表示不清理。
+func (uc *LoginHistoryUsecase) Retention() time.Duration {
+	if uc.policy == nil || uc.policy.LoginHistoryRetention == 0 {
+		return defaultLoginHistoryRetention
+	}
+	if uc.policy.LoginHistoryRetention < 0 {
+		return 0
+	}
+	return uc.policy.LoginHistoryRetention
+}
+
+// PurgeExpired 删除超过保留期的登录流水，由后台任务周期调用。
+func (uc *LoginHistoryUsecase) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	retention := uc.Retention()
+	if retention <= 0 {
+		return 0, nil
+	}
+
+	ctx, span := uc.Tracer().Start(ctx, "login_history.purge")
+	defer span.End()
+
+	before := now.Add(-retention)
+	n, err := uc.repo.PurgeLoginEventsBefore(ctx, before)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "purge login events failed")
+		uc.log.WithContext(ctx).Errorf("PurgeLoginEventsBefore failed before=%s err=%v", before.Format(time.RFC3339), err)
+		return 0, err
+	}
+	span.SetAttributes(attribute.Int("login_history.purged", n))
+	span.SetStatus(codes.Ok, "OK")
+	if n > 0 {
+		uc.log.WithContext(ctx).Infof("purged login events count=%d before=%s", n, before.Format(time.RFC3339))
+	}
+	return n, nil
+}
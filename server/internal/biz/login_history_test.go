@@ -0,0 +1,180 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type memLoginEventRepo struct {
+	mu          sync.Mutex
+	events      []LoginEvent
+	lastFilter  LoginEventFilter
+	purgeBefore time.Time
+}
+
+func (r *memLoginEventRepo) RecordLoginEvent(ctx context.Context, e *LoginEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e.CreatedAt = time.Now()
+	r.events = append(r.events, *e)
+	return nil
+}
+
+func (r *memLoginEventRepo) ListLoginEvents(ctx context.Context, f LoginEventFilter) ([]*LoginEvent, int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastFilter = f
+	return nil, 0, nil
+}
+
+func (r *memLoginEventRepo) PurgeLoginEventsBefore(ctx context.Context, before time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.purgeBefore = before
+	return 3, nil
+}
+
+func (r *memLoginEventRepo) CountLoginFailures(ctx context.Context, accountKind string, accountID int, since time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, e := range r.events {
+		if e.AccountKind == accountKind && e.AccountID == accountID && e.Reason == LoginReasonBadPassword && !e.CreatedAt.Before(since) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func newTestLoginHistoryUsecase(policy *AuthPolicy) (*LoginHistoryUsecase, *memLoginEventRepo, *memAuthRepo) {
+	events := &memLoginEventRepo{}
+	users := newMemAuthRepo()
+	_, _ = users.CreateUser(context.Background(), &User{Username: "alice", PasswordHash: "x"})
+
+	uc := NewLoginHistoryUsecase(events, users, nil, policy, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	return uc, events, users
+}
+
+func TestLoginReason(t *testing.T) {
+	cases := map[error]string{
+		nil:                            LoginReasonOK,
+		ErrUserNotFound:                LoginReasonUnknownAccount,
+		ErrInvalidPassword:             LoginReasonBadPassword,
+		ErrUserDisabled:                LoginReasonDisabled,
+		ErrUserUnverified:              LoginReasonUnverified,
+		ErrBadParam:                    LoginReasonInvalidArgs,
+		ErrLoginThrottled:              LoginReasonThrottled,
+		ErrPasswordChangeRequired:      LoginReasonPasswordChangeRequired,
+		errors.New("connection reset"): LoginReasonError,
+		// CheckCredentials 对外只返回 ErrInvalidCredentials，登录流水仍按包裹的具体原因记录。
+		fmt.Errorf("%w: %w", ErrInvalidCredentials, ErrUserNotFound):    LoginReasonUnknownAccount,
+		fmt.Errorf("%w: %w", ErrInvalidCredentials, ErrInvalidPassword): LoginReasonBadPassword,
+	}
+	for err, want := range cases {
+		if got := LoginReason(err); got != want {
+			t.Fatalf("LoginReason(%v) = %q, want %q", err, got, want)
+		}
+	}
+}
+
+func TestLoginHistoryUsecase_CheckThrottle(t *testing.T) {
+	uc, _, _ := newTestLoginHistoryUsecase(&AuthPolicy{LoginMaxFailures: 3})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if err := uc.CheckThrottle(ctx, LoginAccountUser, "Alice"); err != nil {
+			t.Fatalf("attempt %d: CheckThrottle() error = %v", i, err)
+		}
+		uc.RecordLogin(ctx, LoginAccountUser, 0, "alice", ErrInvalidPassword)
+	}
+	if err := uc.CheckThrottle(ctx, LoginAccountUser, "alice"); !errors.Is(err, ErrLoginThrottled) {
+		t.Fatalf("CheckThrottle() after 3 failures error = %v, want ErrLoginThrottled", err)
+	}
+	// 被拒绝的尝试不计入次数；管理员账号与不存在的用户名各自独立。
+	uc.RecordLogin(ctx, LoginAccountUser, 0, "alice", ErrLoginThrottled)
+	if err := uc.CheckThrottle(ctx, LoginAccountAdmin, "alice"); err != nil {
+		t.Fatalf("CheckThrottle(admin) error = %v", err)
+	}
+	if err := uc.CheckThrottle(ctx, LoginAccountUser, "nobody"); err != nil {
+		t.Fatalf("CheckThrottle(unknown) error = %v", err)
+	}
+
+	disabled, _, _ := newTestLoginHistoryUsecase(&AuthPolicy{LoginMaxFailures: -1})
+	for i := 0; i < 20; i++ {
+		disabled.RecordLogin(ctx, LoginAccountUser, 0, "alice", ErrInvalidPassword)
+	}
+	if err := disabled.CheckThrottle(ctx, LoginAccountUser, "alice"); err != nil {
+		t.Fatalf("CheckThrottle(disabled) error = %v", err)
+	}
+}
+
+func TestLoginHistoryUsecase_RecordLoginFillsAccountAndClient(t *testing.T) {
+	uc, events, _ := newTestLoginHistoryUsecase(nil)
+
+	ctx := NewContextWithClientInfo(context.Background(), ClientInfo{
+		IP:        "203.0.113.7",
+		UserAgent: strings.Repeat("a", 600),
+	})
+	uc.RecordLogin(ctx, LoginAccountUser, 0, " alice ", ErrInvalidPassword)
+	uc.RecordLogin(ctx, LoginAccountUser, 0, "ghost", ErrUserNotFound)
+
+	if len(events.events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events.events))
+	}
+	got := events.events[0]
+	if got.AccountID != 1 || got.Username != "alice" || got.Success || got.Reason != LoginReasonBadPassword {
+		t.Fatalf("unexpected event %+v", got)
+	}
+	if got.IP != "203.0.113.7" || len(got.UserAgent) != loginEventUserAgentMaxLen {
+		t.Fatalf("unexpected client info ip=%q ua_len=%d", got.IP, len(got.UserAgent))
+	}
+	if unknown := events.events[1]; unknown.AccountID != 0 || unknown.Reason != LoginReasonUnknownAccount {
+		t.Fatalf("unexpected unknown-account event %+v", unknown)
+	}
+}
+
+func TestLoginHistoryUsecase_ListMineScopesToCurrentAccount(t *testing.T) {
+	uc, events, _ := newTestLoginHistoryUsecase(nil)
+
+	if _, _, err := uc.ListMine(context.Background(), 10, 0); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden without claims, got %v", err)
+	}
+
+	ctx := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 7, Username: "root", Role: RoleAdmin})
+	if _, _, err := uc.ListMine(ctx, 1000, -1); err != nil {
+		t.Fatalf("ListMine() error = %v", err)
+	}
+	f := events.lastFilter
+	if f.AccountKind != LoginAccountAdmin || f.AccountID != 7 || f.Limit != 200 || f.Offset != 0 {
+		t.Fatalf("unexpected filter %+v", f)
+	}
+}
+
+func TestLoginHistoryUsecase_Retention(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	uc, events, _ := newTestLoginHistoryUsecase(nil)
+	if n, err := uc.PurgeExpired(context.Background(), now); err != nil || n != 3 {
+		t.Fatalf("PurgeExpired() = %d, %v", n, err)
+	}
+	if want := now.Add(-90 * 24 * time.Hour); !events.purgeBefore.Equal(want) {
+		t.Fatalf("purge before = %v, want %v", events.purgeBefore, want)
+	}
+
+	uc, events, _ = newTestLoginHistoryUsecase(&AuthPolicy{LoginHistoryRetention: -1})
+	if n, err := uc.PurgeExpired(context.Background(), now); err != nil || n != 0 {
+		t.Fatalf("PurgeExpired() with retention disabled = %d, %v", n, err)
+	}
+	if !events.purgeBefore.IsZero() {
+		t.Fatalf("expected no purge when retention disabled")
+	}
+}
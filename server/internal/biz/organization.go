@@ -0,0 +1,540 @@
+// server/internal/biz/organization.go
+package biz
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrOrganizationNotFound    = errors.New("organization not found")
+	ErrOrganizationExists      = errors.New("organization already exists")
+	ErrOrganizationSlugInvalid = errors.New("organization slug invalid")
+	ErrOrganizationForbidden   = errors.New("organization access forbidden")
+	// ErrOrganizationLastMembership 表示要移除的是管理员的最后一个组织；没有组织的管理员即平台管理员。
+	ErrOrganizationLastMembership = errors.New("cannot remove admin's last organization")
+	// ErrOrganizationRoleScope 表示试图在组织内绑定只能平台级授予的角色（如 super_admin）。
+	ErrOrganizationRoleScope = errors.New("role cannot be bound within organization")
+)
+
+const (
+	PermissionOrganizationRead        = "admin.organization.read"
+	PermissionOrganizationWrite       = "admin.organization.write"
+	PermissionOrganizationCrossTenant = "admin.organization.cross_tenant"
+)
+
+var _ = RegisterAdminPermissions(
+	AdminPermission{
+		Key:         PermissionOrganizationRead,
+		Name:        "查看组织",
+		Group:       "组织",
+		Description: "允许查看组织及其成员",
+	},
+	AdminPermission{
+		Key:         PermissionOrganizationWrite,
+		Name:        "管理组织",
+		Group:       "组织",
+		Description: "允许创建组织、调整成员并分配组织内角色",
+	},
+	AdminPermission{
+		Key:         PermissionOrganizationCrossTenant,
+		Name:        "跨组织访问",
+		Group:       "组织",
+		Description: "允许进入任意组织，或不限定组织查看全部数据；只能通过平台级角色授予",
+	},
+)
+
+const (
+	AuditActionOrganizationCreate        = "organization.create"
+	AuditActionOrganizationAddMember     = "organization.add_member"
+	AuditActionOrganizationRemoveMember  = "organization.remove_member"
+	AuditActionOrganizationSetAdminRoles = "organization.set_admin_roles"
+)
+
+// 组织成员的账号类型，与 organization_members.member_kind 一致。
+const (
+	OrganizationMemberUser  = "user"
+	OrganizationMemberAdmin = "admin"
+)
+
+type Organization struct {
+	ID        int
+	Slug      string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type OrganizationMember struct {
+	OrganizationID int
+	Kind           string
+	MemberID       int
+	Username       string
+	// Roles 只对管理员成员填充，是其在该组织内绑定的角色。
+	Roles     []string
+	CreatedAt time.Time
+}
+
+// OrganizationMembership 是某个账号所在的一个组织及加入时间。
+type OrganizationMembership struct {
+	Organization Organization
+	JoinedAt     time.Time
+}
+
+// OrganizationRepo 管理 organizations、organization_members 与 organization_admin_roles。
+type OrganizationRepo interface {
+	ListOrganizations(ctx context.Context) ([]Organization, error)
+	// GetOrganization 不存在时返回 ErrOrganizationNotFound。
+	GetOrganization(ctx context.Context, id int) (*Organization, error)
+	// CreateOrganization slug 冲突返回 ErrOrganizationExists。
+	CreateOrganization(ctx context.Context, in *Organization) (*Organization, error)
+	ListOrganizationMembers(ctx context.Context, orgID int) ([]OrganizationMember, error)
+	// ListMemberOrganizations 返回账号所在的全部组织，按组织 id 升序。
+	ListMemberOrganizations(ctx context.Context, kind string, memberID int) ([]OrganizationMembership, error)
+	// AddOrganizationMember 重复添加视为成功；账号不存在返回 ErrUserNotFound / ErrAdminNotFound。
+	// ctx 限定组织时账号已属于其他组织返回 ErrOrganizationForbidden。
+	AddOrganizationMember(ctx context.Context, orgID int, kind string, memberID int) error
+	// RemoveOrganizationMember 不是成员时视为成功；移除管理员时一并删除其在该组织内的角色绑定，
+	// 是其最后一个组织时返回 ErrOrganizationLastMembership。
+	RemoveOrganizationMember(ctx context.Context, orgID int, kind string, memberID int) error
+	// SetOrganizationAdminRoles 整体替换管理员在该组织内的角色；管理员不是组织成员时返回 ErrAdminNotFound。
+	SetOrganizationAdminRoles(ctx context.Context, orgID, adminID int, roleKeys []string) ([]string, error)
+}
+
+type ctxKeyTenant struct{}
+
+// NewContextWithTenant 标记本次请求所在的组织；数据层据此自动给用户查询加上组织范围。
+func NewContextWithTenant(ctx context.Context, orgID int) context.Context {
+	return context.WithValue(ctx, ctxKeyTenant{}, orgID)
+}
+
+// TenantFromContext 返回当前组织；没有限定组织时 ok=false，表示平台级（不按组织过滤）。
+func TenantFromContext(ctx context.Context) (int, bool) {
+	id, ok := ctx.Value(ctxKeyTenant{}).(int)
+	return id, ok && id > 0
+}
+
+// DefaultOrganizationID 登录时默认进入的组织：取 id 最小的一个，没有组织时为 0。
+func DefaultOrganizationID(ids []int) int {
+	out := 0
+	for _, id := range ids {
+		if id > 0 && (out == 0 || id < out) {
+			out = id
+		}
+	}
+	return out
+}
+
+// CheckAdminTenant 判断管理员能否在 orgID 下操作（0 表示不限定组织）。不属于该组织时要求跨组织权限，
+// 且只看平台级角色：组织内绑定的角色不能让管理员越出组织边界。admin 是任意组织下加载的快照即可。
+func CheckAdminTenant(ctx context.Context, reader AdminAccountReader, admin *AdminUser, orgID int) error {
+	if admin == nil {
+		return ErrOrganizationForbidden
+	}
+	if adminInTenant(admin, orgID) {
+		return nil
+	}
+	platform, err := reader.GetAdminByID(NewContextWithTenant(ctx, 0), admin.ID)
+	if err != nil {
+		return err
+	}
+	if platform != nil && NewPermissionSet(platform.Permissions).Allows(PermissionOrganizationCrossTenant) {
+		return nil
+	}
+	return ErrOrganizationForbidden
+}
+
+// adminInTenant 判断管理员不依赖跨组织权限就能在 orgID 下操作（0 表示不限定组织）：
+// 不属于任何组织的平台管理员只能不限定组织，其余管理员只能进入自己所属的组织。
+func adminInTenant(admin *AdminUser, orgID int) bool {
+	if orgID <= 0 {
+		return len(admin.Organizations) == 0
+	}
+	return containsInt(admin.Organizations, orgID)
+}
+
+func containsInt(list []int, v int) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+var organizationSlugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,63}$`)
+
+type OrganizationUsecase struct {
+	repo        OrganizationRepo
+	users       AuthRepo
+	access      *AdminAccessResolver
+	rbac        RBACRepo
+	audit       AuditRepo
+	genTok      TokenGenerator
+	genAdminTok AdminTokenGenerator
+	log         *log.Helper
+	tracer      trace.Tracer
+}
+
+func NewOrganizationUsecase(
+	repo OrganizationRepo,
+	users AuthRepo,
+	access *AdminAccessResolver,
+	rbac RBACRepo,
+	audit AuditRepo,
+	genTok TokenGenerator,
+	genAdminTok AdminTokenGenerator,
+	logger log.Logger,
+	tp *tracesdk.TracerProvider,
+) *OrganizationUsecase {
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.organization")
+	} else {
+		tr = otel.Tracer("biz.organization")
+	}
+	return &OrganizationUsecase{
+		repo:        repo,
+		users:       users,
+		access:      access,
+		rbac:        rbac,
+		audit:       audit,
+		genTok:      genTok,
+		genAdminTok: genAdminTok,
+		log:         log.NewHelper(log.With(logger, "module", "biz.organization")),
+		tracer:      tr,
+	}
+}
+
+func (uc *OrganizationUsecase) UserDataSection() string { return "organizations" }
+
+// ExportUserData 导出用户所在的组织及加入时间。
+func (uc *OrganizationUsecase) ExportUserData(ctx context.Context, userID int) (any, error) {
+	list, err := uc.repo.ListMemberOrganizations(ctx, OrganizationMemberUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]map[string]any, 0, len(list))
+	for _, m := range list {
+		out = append(out, map[string]any{
+			"id":        m.Organization.ID,
+			"slug":      m.Organization.Slug,
+			"name":      m.Organization.Name,
+			"joined_at": m.JoinedAt,
+		})
+	}
+	return out, nil
+}
+
+func (uc *OrganizationUsecase) Tracer() trace.Tracer {
+	if uc.tracer != nil {
+		return uc.tracer
+	}
+	return otel.Tracer("biz.organization")
+}
+
+// List 限定了组织时只返回当前组织。
+func (uc *OrganizationUsecase) List(ctx context.Context) ([]Organization, error) {
+	ctx, span := uc.Tracer().Start(ctx, "organization.list")
+	defer span.End()
+
+	list, err := uc.repo.ListOrganizations(ctx)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "List", 0, err)
+	}
+	if orgID, ok := TenantFromContext(ctx); ok {
+		scoped := make([]Organization, 0, 1)
+		for _, o := range list {
+			if o.ID == orgID {
+				scoped = append(scoped, o)
+			}
+		}
+		list = scoped
+	}
+	span.SetStatus(codes.Ok, "OK")
+	return list, nil
+}
+
+// Create 新建组织只允许平台级操作（不限定组织时）。
+func (uc *OrganizationUsecase) Create(ctx context.Context, slug, name string) (*Organization, error) {
+	slug = strings.TrimSpace(slug)
+	name = strings.TrimSpace(name)
+	ctx, span := uc.Tracer().Start(ctx, "organization.create",
+		trace.WithAttributes(attribute.String("organization.slug", slug)),
+	)
+	defer span.End()
+
+	if _, ok := TenantFromContext(ctx); ok {
+		span.SetStatus(codes.Error, ErrOrganizationForbidden.Error())
+		return nil, ErrOrganizationForbidden
+	}
+	if !organizationSlugPattern.MatchString(slug) {
+		span.SetStatus(codes.Error, ErrOrganizationSlugInvalid.Error())
+		return nil, ErrOrganizationSlugInvalid
+	}
+	if name == "" {
+		name = slug
+	}
+
+	org, err := uc.repo.CreateOrganization(ctx, &Organization{Slug: slug, Name: name})
+	if err != nil {
+		return nil, uc.fail(ctx, span, "Create", 0, err)
+	}
+	uc.recordOrganizationAudit(ctx, AuditActionOrganizationCreate, org.ID, map[string]any{
+		"slug": org.Slug,
+		"name": org.Name,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return org, nil
+}
+
+func (uc *OrganizationUsecase) Members(ctx context.Context, orgID int) ([]OrganizationMember, error) {
+	ctx, span := uc.Tracer().Start(ctx, "organization.members",
+		trace.WithAttributes(attribute.Int("organization.id", orgID)),
+	)
+	defer span.End()
+
+	if err := uc.checkScope(ctx, orgID); err != nil {
+		return nil, uc.fail(ctx, span, "Members", orgID, err)
+	}
+	list, err := uc.repo.ListOrganizationMembers(ctx, orgID)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "Members", orgID, err)
+	}
+	span.SetStatus(codes.Ok, "OK")
+	return list, nil
+}
+
+func (uc *OrganizationUsecase) AddMember(ctx context.Context, orgID int, kind string, memberID int) error {
+	ctx, span := uc.Tracer().Start(ctx, "organization.add_member",
+		trace.WithAttributes(attribute.Int("organization.id", orgID), attribute.String("organization.member_kind", kind)),
+	)
+	defer span.End()
+
+	if !validMemberKind(kind) || memberID <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return ErrBadParam
+	}
+	if err := uc.checkScope(ctx, orgID); err != nil {
+		return uc.fail(ctx, span, "AddMember", orgID, err)
+	}
+	// 没有组织的管理员是平台管理员，拉进组织会改变其权限范围，只能在平台级操作。
+	if _, scoped := TenantFromContext(ctx); scoped && kind == OrganizationMemberAdmin {
+		return uc.fail(ctx, span, "AddMember", orgID, ErrOrganizationForbidden)
+	}
+	if err := uc.repo.AddOrganizationMember(ctx, orgID, kind, memberID); err != nil {
+		return uc.fail(ctx, span, "AddMember", orgID, err)
+	}
+	if kind == OrganizationMemberAdmin {
+		uc.access.Invalidate(memberID)
+	}
+	uc.recordOrganizationAudit(ctx, AuditActionOrganizationAddMember, orgID, map[string]any{
+		"member_kind": kind,
+		"member_id":   memberID,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return nil
+}
+
+// RemoveMember 不能把自己移出当前所在的组织，避免操作者立刻失去访问权限。
+func (uc *OrganizationUsecase) RemoveMember(ctx context.Context, orgID int, kind string, memberID int) error {
+	ctx, span := uc.Tracer().Start(ctx, "organization.remove_member",
+		trace.WithAttributes(attribute.Int("organization.id", orgID), attribute.String("organization.member_kind", kind)),
+	)
+	defer span.End()
+
+	if !validMemberKind(kind) || memberID <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return ErrBadParam
+	}
+	if err := uc.checkScope(ctx, orgID); err != nil {
+		return uc.fail(ctx, span, "RemoveMember", orgID, err)
+	}
+	if kind == OrganizationMemberAdmin {
+		if c, ok := GetClaimsFromContext(ctx); ok && c != nil && c.Role == RoleAdmin && c.UserID == memberID {
+			span.SetStatus(codes.Error, ErrAdminSelfLockout.Error())
+			return ErrAdminSelfLockout
+		}
+	}
+	if err := uc.repo.RemoveOrganizationMember(ctx, orgID, kind, memberID); err != nil {
+		return uc.fail(ctx, span, "RemoveMember", orgID, err)
+	}
+	if kind == OrganizationMemberAdmin {
+		uc.access.Invalidate(memberID)
+	}
+	uc.recordOrganizationAudit(ctx, AuditActionOrganizationRemoveMember, orgID, map[string]any{
+		"member_kind": kind,
+		"member_id":   memberID,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return nil
+}
+
+// SetAdminRoles 整体替换管理员在组织内的角色。组织内角色只在该组织下生效，
+// 跨组织权限只认平台级角色，super_admin 也只能平台级授予。
+func (uc *OrganizationUsecase) SetAdminRoles(ctx context.Context, orgID, adminID int, roleKeys []string) ([]string, error) {
+	ctx, span := uc.Tracer().Start(ctx, "organization.set_admin_roles",
+		trace.WithAttributes(attribute.Int("organization.id", orgID), attribute.Int("organization.admin_id", adminID)),
+	)
+	defer span.End()
+
+	if adminID <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+	if err := uc.checkScope(ctx, orgID); err != nil {
+		return nil, uc.fail(ctx, span, "SetAdminRoles", orgID, err)
+	}
+	roleKeys = normalizeRoleKeys(roleKeys)
+	if err := uc.checkTenantRoles(ctx, roleKeys); err != nil {
+		return nil, uc.fail(ctx, span, "SetAdminRoles", orgID, err)
+	}
+
+	roles, err := uc.repo.SetOrganizationAdminRoles(ctx, orgID, adminID, roleKeys)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "SetAdminRoles", orgID, err)
+	}
+	uc.access.Invalidate(adminID)
+
+	uc.recordOrganizationAudit(ctx, AuditActionOrganizationSetAdminRoles, orgID, map[string]any{
+		"admin_id": adminID,
+		"roles":    roles,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return roles, nil
+}
+
+// Switch 为当前登录账号签发进入 orgID 的新 token；orgID=0 表示离开组织（不限定组织）。
+// 普通用户只能进入自己所属的组织；管理员按 CheckAdminTenant 判断。
+func (uc *OrganizationUsecase) Switch(ctx context.Context, orgID int) (token string, expireAt time.Time, err error) {
+	ctx, span := uc.Tracer().Start(ctx, "organization.switch",
+		trace.WithAttributes(attribute.Int("organization.id", orgID)),
+	)
+	defer span.End()
+
+	c, ok := GetClaimsFromContext(ctx)
+	if !ok || c == nil {
+		span.SetStatus(codes.Error, ErrForbidden.Error())
+		return "", time.Time{}, ErrForbidden
+	}
+	if orgID < 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return "", time.Time{}, ErrBadParam
+	}
+	if orgID > 0 {
+		if _, err := uc.repo.GetOrganization(ctx, orgID); err != nil {
+			return "", time.Time{}, uc.fail(ctx, span, "Switch", orgID, err)
+		}
+	}
+
+	if c.Role == RoleAdmin {
+		admin, err := uc.access.GetAdminByID(ctx, c.UserID)
+		if err != nil || admin == nil {
+			return "", time.Time{}, uc.fail(ctx, span, "Switch", orgID, ErrAdminNotFound)
+		}
+		if err := CheckAdminTenant(ctx, uc.access, admin, orgID); err != nil {
+			return "", time.Time{}, uc.fail(ctx, span, "Switch", orgID, err)
+		}
+		token, expireAt, err = uc.genAdminTok(admin.ID, admin.Username, int8(RoleAdmin), orgID)
+	} else {
+		u, err := uc.users.GetUserByID(ctx, c.UserID)
+		if err != nil || u == nil {
+			return "", time.Time{}, uc.fail(ctx, span, "Switch", orgID, ErrUserNotFound)
+		}
+		if orgID > 0 && !containsInt(u.OrganizationIDs, orgID) {
+			return "", time.Time{}, uc.fail(ctx, span, "Switch", orgID, ErrOrganizationForbidden)
+		}
+		token, expireAt, err = uc.genTok(u.ID, u.Username, u.Role, orgID)
+	}
+	if err != nil {
+		return "", time.Time{}, uc.fail(ctx, span, "Switch", orgID, err)
+	}
+	span.SetStatus(codes.Ok, "OK")
+	return token, expireAt, nil
+}
+
+// checkScope 限定了组织的管理员只能管理当前组织。
+func (uc *OrganizationUsecase) checkScope(ctx context.Context, orgID int) error {
+	if orgID <= 0 {
+		return ErrBadParam
+	}
+	if current, ok := TenantFromContext(ctx); ok && current != orgID {
+		return ErrOrganizationForbidden
+	}
+	_, err := uc.repo.GetOrganization(ctx, orgID)
+	return err
+}
+
+// checkTenantRoles 校验角色均存在且不含 super_admin，并且操作者已经拥有这些角色的全部权限。
+func (uc *OrganizationUsecase) checkTenantRoles(ctx context.Context, roleKeys []string) error {
+	if len(roleKeys) == 0 {
+		return nil
+	}
+	if slices.Contains(roleKeys, SuperAdminRoleKey) {
+		return ErrOrganizationRoleScope
+	}
+	overview, err := uc.rbac.Overview(ctx)
+	if err != nil {
+		return err
+	}
+	permissions, err := overviewRolePermissions(overview, roleKeys)
+	if err != nil {
+		return err
+	}
+	op, err := currentAdmin(ctx, uc.access)
+	if err != nil {
+		return err
+	}
+	return checkAdminRoleGrant(op, roleKeys, permissions)
+}
+
+func validMemberKind(kind string) bool {
+	return kind == OrganizationMemberUser || kind == OrganizationMemberAdmin
+}
+
+func (uc *OrganizationUsecase) fail(ctx context.Context, span trace.Span, op string, orgID int, err error) error {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	l := uc.log.WithContext(ctx)
+	switch {
+	case errors.Is(err, ErrOrganizationNotFound), errors.Is(err, ErrOrganizationExists),
+		errors.Is(err, ErrOrganizationForbidden), errors.Is(err, ErrOrganizationRoleScope),
+		errors.Is(err, ErrOrganizationLastMembership), errors.Is(err, ErrAdminPrivilegeEscalation),
+		errors.Is(err, ErrUserNotFound), errors.Is(err, ErrAdminNotFound), errors.Is(err, ErrRoleNotFound):
+		l.Warnf("%s rejected org_id=%d err=%v", op, orgID, err)
+	default:
+		l.Errorf("%s failed org_id=%d err=%v", op, orgID, err)
+	}
+	return err
+}
+
+func (uc *OrganizationUsecase) recordOrganizationAudit(ctx context.Context, action string, orgID int, detail map[string]any) {
+	if uc.audit == nil {
+		return
+	}
+	e := &AuditEvent{
+		Action:     action,
+		ActorKind:  AuditActorAdmin,
+		TargetKind: "organization",
+		TargetID:   orgID,
+		Detail:     detail,
+	}
+	if c, ok := GetClaimsFromContext(ctx); ok && c != nil {
+		e.ActorID = c.UserID
+		e.ActorUsername = c.Username
+	}
+	if err := uc.audit.RecordAudit(ctx, e); err != nil {
+		uc.log.WithContext(ctx).Warnf("record organization audit failed action=%s org_id=%d err=%v", action, orgID, err)
+	}
+}
@@ -0,0 +1,97 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// tenantAdminReader 按组织返回不同的权限快照，模拟组织内角色只在该组织下生效。
+type tenantAdminReader struct {
+	platform []string
+	tenant   map[int][]string
+	orgs     []int
+}
+
+func (r *tenantAdminReader) GetAdminByID(ctx context.Context, id int) (*AdminUser, error) {
+	perms := r.platform
+	if orgID, ok := TenantFromContext(ctx); ok {
+		perms = append(append([]string{}, perms...), r.tenant[orgID]...)
+	}
+	return &AdminUser{ID: id, Username: "ops", Permissions: perms, Organizations: r.orgs}, nil
+}
+
+func TestDefaultOrganizationID(t *testing.T) {
+	cases := []struct {
+		in   []int
+		want int
+	}{
+		{nil, 0},
+		{[]int{5, 3, 9}, 3},
+		{[]int{0, 4}, 4},
+	}
+	for _, c := range cases {
+		if got := DefaultOrganizationID(c.in); got != c.want {
+			t.Fatalf("DefaultOrganizationID(%v)=%d want %d", c.in, got, c.want)
+		}
+	}
+}
+
+func TestTenantFromContext(t *testing.T) {
+	if _, ok := TenantFromContext(context.Background()); ok {
+		t.Fatalf("expected no tenant on empty ctx")
+	}
+	if _, ok := TenantFromContext(NewContextWithTenant(context.Background(), 0)); ok {
+		t.Fatalf("expected org 0 to mean platform scope")
+	}
+	if id, ok := TenantFromContext(NewContextWithTenant(context.Background(), 3)); !ok || id != 3 {
+		t.Fatalf("expected tenant 3, got %d ok=%v", id, ok)
+	}
+}
+
+func TestCheckAdminTenant(t *testing.T) {
+	ctx := context.Background()
+
+	member := &tenantAdminReader{orgs: []int{1}}
+	admin, _ := member.GetAdminByID(ctx, 7)
+	if err := CheckAdminTenant(ctx, member, admin, 1); err != nil {
+		t.Fatalf("member should enter own org, got %v", err)
+	}
+	if err := CheckAdminTenant(ctx, member, admin, 2); !errors.Is(err, ErrOrganizationForbidden) {
+		t.Fatalf("expected ErrOrganizationForbidden for foreign org, got %v", err)
+	}
+	if err := CheckAdminTenant(ctx, member, admin, 0); !errors.Is(err, ErrOrganizationForbidden) {
+		t.Fatalf("expected tenant admin to be denied platform scope, got %v", err)
+	}
+
+	// 组织内授予的跨组织权限不能让管理员越出组织。
+	scoped := &tenantAdminReader{orgs: []int{1}, tenant: map[int][]string{1: {PermissionOrganizationCrossTenant}}}
+	admin, _ = scoped.GetAdminByID(NewContextWithTenant(ctx, 1), 7)
+	if err := CheckAdminTenant(ctx, scoped, admin, 2); !errors.Is(err, ErrOrganizationForbidden) {
+		t.Fatalf("expected tenant-scoped cross_tenant to be ignored, got %v", err)
+	}
+
+	platform := &tenantAdminReader{orgs: []int{1}, platform: []string{PermissionOrganizationCrossTenant}}
+	admin, _ = platform.GetAdminByID(ctx, 7)
+	if err := CheckAdminTenant(ctx, platform, admin, 2); err != nil {
+		t.Fatalf("platform cross_tenant should allow foreign org, got %v", err)
+	}
+
+	root := &tenantAdminReader{}
+	admin, _ = root.GetAdminByID(ctx, 7)
+	if err := CheckAdminTenant(ctx, root, admin, 0); err != nil {
+		t.Fatalf("platform admin should stay unscoped, got %v", err)
+	}
+}
+
+func TestAdminAccessResolver_CachesPerTenant(t *testing.T) {
+	r, repo, _ := newTestAdminAccessResolver(-1)
+	ctx := NewContextWithAdminAccessCache(context.Background())
+
+	r.GetAdminByID(ctx, 7)
+	r.GetAdminByID(NewContextWithTenant(ctx, 1), 7)
+	r.GetAdminByID(NewContextWithTenant(ctx, 1), 7)
+	if repo.calls != 2 {
+		t.Fatalf("expected one lookup per tenant, got %d", repo.calls)
+	}
+}
@@ -0,0 +1,72 @@
+// server/internal/biz/pagination.go
+package biz
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrPageInvalid   = errors.New("page request invalid")
+	ErrCursorInvalid = errors.New("page cursor invalid")
+)
+
+// TotalMode 控制列表是否以及如何计算总数。
+type TotalMode string
+
+const (
+	// TotalExact 精确计数（COUNT(*)），offset 分页的默认值。
+	TotalExact TotalMode = "exact"
+	// TotalEstimate 允许用数据库统计信息估算，结果可能与实际条数有出入。
+	TotalEstimate TotalMode = "estimate"
+	// TotalNone 不计算总数，游标分页的默认值。
+	TotalNone TotalMode = "none"
+)
+
+// PageRequest 列表方法通用的分页参数：UseCursor=false 时按 Limit/Offset 分页，
+// 否则按 Cursor 继续上一页（首页 Cursor 为空）。游标内容由数据层编码，对上层不透明。
+type PageRequest struct {
+	Limit     int
+	Offset    int
+	UseCursor bool
+	Cursor    string
+	Total     TotalMode
+}
+
+// PageInfo 列表方法通用的分页结果；NextCursor 为空表示没有下一页（仅游标分页返回）。
+type PageInfo struct {
+	Total          int
+	TotalEstimated bool
+	NextCursor     string
+}
+
+// Normalize 补全分页默认值并校验参数组合；limit 超出 maxLimit 时截断。
+func (p *PageRequest) Normalize(defLimit, maxLimit int) error {
+	if p.Limit <= 0 {
+		p.Limit = defLimit
+	}
+	if p.Limit > maxLimit {
+		p.Limit = maxLimit
+	}
+	if p.Offset < 0 {
+		p.Offset = 0
+	}
+	if p.Cursor != "" {
+		p.UseCursor = true
+	}
+	if p.UseCursor && p.Offset > 0 {
+		return fmt.Errorf("%w: 游标分页不能同时使用 offset", ErrPageInvalid)
+	}
+
+	switch p.Total {
+	case "":
+		p.Total = TotalExact
+		if p.UseCursor {
+			p.Total = TotalNone
+		}
+	case TotalExact, TotalEstimate, TotalNone:
+	default:
+		return fmt.Errorf("%w: total 只能是 exact、estimate 或 none", ErrPageInvalid)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package biz
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPageRequest_Normalize(t *testing.T) {
+	p := PageRequest{}
+	if err := p.Normalize(30, 200); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if p.Limit != 30 || p.UseCursor || p.Total != TotalExact {
+		t.Fatalf("unexpected offset defaults: %+v", p)
+	}
+
+	p = PageRequest{Limit: 1000, Cursor: "abc"}
+	if err := p.Normalize(30, 200); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if p.Limit != 200 || !p.UseCursor || p.Total != TotalNone {
+		t.Fatalf("unexpected cursor defaults: %+v", p)
+	}
+
+	for i, bad := range []PageRequest{
+		{UseCursor: true, Offset: 10},
+		{Total: "approx"},
+	} {
+		if err := bad.Normalize(30, 200); !errors.Is(err, ErrPageInvalid) {
+			t.Fatalf("case %d: expected ErrPageInvalid, got %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// server/internal/biz/permission_match.go
+package biz
+
+import (
+	"sort"
+	"strings"
+)
+
+// 角色上的权限条目有三种写法：
+//   - 精确权限码：admin.user.read
+//   - 层级通配：admin.user.* 匹配 admin.user. 下任意层级（admin.user.read、admin.user.x.y）
+//   - 显式拒绝：在前两种前加 "!"，如 !admin.user.write；拒绝优先于任何授予，与来自哪个角色无关
+const (
+	PermissionDenyPrefix = "!"
+	permissionWildcard   = "*"
+)
+
+// MatchPermission 判断单个条目（不含 "!"）是否覆盖某个具体权限码。
+func MatchPermission(pattern, permission string) bool {
+	if pattern == permissionWildcard {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(pattern, "."+permissionWildcard); ok {
+		return strings.HasPrefix(permission, prefix+".")
+	}
+	return pattern == permission
+}
+
+// ParsePermissionEntry 拆出条目的权限码与是否为拒绝。
+func ParsePermissionEntry(entry string) (key string, deny bool) {
+	entry = strings.TrimSpace(entry)
+	if k, ok := strings.CutPrefix(entry, PermissionDenyPrefix); ok {
+		return strings.TrimSpace(k), true
+	}
+	return entry, false
+}
+
+// PermissionSet 是管理员所有角色权限条目的合集，dispatcher 鉴权、auth.me 展开和自我保护校验都用它判断。
+type PermissionSet struct {
+	grants []string
+	denies []string
+}
+
+func NewPermissionSet(entries []string) PermissionSet {
+	var s PermissionSet
+	for _, e := range entries {
+		key, deny := ParsePermissionEntry(e)
+		if key == "" {
+			continue
+		}
+		if deny {
+			s.denies = append(s.denies, key)
+		} else {
+			s.grants = append(s.grants, key)
+		}
+	}
+	return s
+}
+
+func (s PermissionSet) Allows(permission string) bool {
+	if permission == "" {
+		return false
+	}
+	for _, d := range s.denies {
+		if MatchPermission(d, permission) {
+			return false
+		}
+	}
+	for _, g := range s.grants {
+		if MatchPermission(g, permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// Covers 判断 other 授予的权限是否都已被 s 允许，用于“只能授予自己拥有的权限”。
+// 除了逐个比较已注册的权限码，other 里的通配条目本身也要被 s 允许，否则以后新增的权限会越过操作者。
+func (s PermissionSet) Covers(other PermissionSet) bool {
+	for _, key := range AdminPermissionKeys() {
+		if other.Allows(key) && !s.Allows(key) {
+			return false
+		}
+	}
+	for _, g := range other.grants {
+		if strings.HasSuffix(g, permissionWildcard) && !s.Allows(g) {
+			return false
+		}
+	}
+	return true
+}
+
+// Expand 展开成已注册的具体权限码列表，供前端按 includes 判断菜单与按钮。
+func (s PermissionSet) Expand() []string {
+	out := make([]string, 0)
+	for _, key := range AdminPermissionKeys() {
+		if s.Allows(key) {
+			out = append(out, key)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// AdminPermissionWildcards 由已注册权限码推导出各层级的通配权限（admin.*、admin.user.* 等），
+// 启动同步时一并写入 admin_permissions，角色才能像普通权限码一样绑定它们。
+func AdminPermissionWildcards() []AdminPermission {
+	seen := map[string]bool{}
+	var out []AdminPermission
+	for _, key := range AdminPermissionKeys() {
+		parts := strings.Split(key, ".")
+		for i := 1; i < len(parts); i++ {
+			prefix := strings.Join(parts[:i], ".")
+			if seen[prefix] {
+				continue
+			}
+			seen[prefix] = true
+			out = append(out, AdminPermission{
+				Key:         prefix + "." + permissionWildcard,
+				Name:        prefix + " 全部",
+				Group:       "通配",
+				Description: "匹配 " + prefix + ". 下的全部权限，包括以后新增的",
+			})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+// AdminPermissionCatalog 是需要同步到数据库的全部权限码：已注册的具体权限加推导出的通配权限。
+func AdminPermissionCatalog() []AdminPermission {
+	return append(AdminPermissions(), AdminPermissionWildcards()...)
+}
@@ -0,0 +1,79 @@
+package biz
+
+import "testing"
+
+func TestMatchPermission(t *testing.T) {
+	cases := []struct {
+		pattern, perm string
+		want          bool
+	}{
+		{"admin.user.read", "admin.user.read", true},
+		{"admin.user.read", "admin.user.write", false},
+		{"admin.user.*", "admin.user.read", true},
+		{"admin.user.*", "admin.user.x.y", true},
+		{"admin.user.*", "admin.user", false},
+		{"admin.user.*", "admin.userx.read", false},
+		{"admin.*", "admin.rbac.write", true},
+		{"*", "admin.rbac.write", true},
+	}
+	for _, c := range cases {
+		if got := MatchPermission(c.pattern, c.perm); got != c.want {
+			t.Fatalf("MatchPermission(%q, %q) = %v, want %v", c.pattern, c.perm, got, c.want)
+		}
+	}
+}
+
+func TestPermissionSet_DenyWins(t *testing.T) {
+	s := NewPermissionSet([]string{"admin.*", "!admin.user.write", " ! admin.rbac.* "})
+
+	if !s.Allows(PermissionUserRead) || !s.Allows(PermissionAdminAccess) {
+		t.Fatalf("expected wildcard grant to allow user.read and admin.access")
+	}
+	if s.Allows(PermissionUserWrite) || s.Allows(PermissionRBACWrite) || s.Allows(PermissionRBACRead) {
+		t.Fatalf("expected deny entries to override grants")
+	}
+	if s.Allows("") {
+		t.Fatalf("expected empty permission to be rejected")
+	}
+
+	for _, k := range s.Expand() {
+		if k == PermissionUserWrite || k == PermissionRBACRead || k == PermissionRBACWrite {
+			t.Fatalf("expanded set contains denied %s", k)
+		}
+		if !IsAdminPermissionRegistered(k) {
+			t.Fatalf("expanded set contains unregistered %s", k)
+		}
+	}
+}
+
+func TestPermissionSet_Covers(t *testing.T) {
+	op := NewPermissionSet([]string{"admin.*", "!admin.rbac.*"})
+
+	if !op.Covers(NewPermissionSet([]string{PermissionUserRead, "admin.user.*"})) {
+		t.Fatal("expected subset to be covered")
+	}
+	if op.Covers(NewPermissionSet([]string{PermissionRBACWrite})) {
+		t.Fatal("denied permission must not be covered")
+	}
+	if op.Covers(NewPermissionSet([]string{"*"})) {
+		t.Fatal("a wider wildcard must not be covered")
+	}
+	if !op.Covers(NewPermissionSet([]string{"admin.*", "!admin.rbac.*"})) {
+		t.Fatal("expected identical set to be covered")
+	}
+	if !op.Covers(PermissionSet{}) {
+		t.Fatal("empty set is always covered")
+	}
+}
+
+func TestAdminPermissionWildcards(t *testing.T) {
+	got := map[string]bool{}
+	for _, p := range AdminPermissionWildcards() {
+		got[p.Key] = true
+	}
+	for _, want := range []string{"admin.*", "admin.user.*", "admin.rbac.*"} {
+		if !got[want] {
+			t.Fatalf("expected derived wildcard %s, got %v", want, got)
+		}
+	}
+}
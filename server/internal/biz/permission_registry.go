@@ -0,0 +1,107 @@
+// server/internal/biz/permission_registry.go
+package biz
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type AdminPermission struct {
+	Key         string
+	Name        string
+	Group       string
+	Description string
+}
+
+// 权限码由各业务模块在代码里声明（包级 var 初始化时注册），启动时由 data 层同步到 admin_permissions。
+// 数据库里多出来的权限码视为“孤儿”，只告警不删除，避免误删仍绑定在自定义角色上的权限。
+var adminPermissionRegistry = struct {
+	mu    sync.RWMutex
+	byKey map[string]AdminPermission
+}{byKey: map[string]AdminPermission{}}
+
+// RegisterAdminPermissions 注册权限码；key 为空或重复注册属于编码错误，直接 panic。
+// 返回值只为方便在包级 var 中调用。
+func RegisterAdminPermissions(perms ...AdminPermission) []AdminPermission {
+	adminPermissionRegistry.mu.Lock()
+	defer adminPermissionRegistry.mu.Unlock()
+	for _, p := range perms {
+		if strings.TrimSpace(p.Key) == "" || strings.TrimSpace(p.Name) == "" {
+			panic(fmt.Sprintf("RegisterAdminPermissions: key and name are required, got %+v", p))
+		}
+		if _, ok := adminPermissionRegistry.byKey[p.Key]; ok {
+			panic("RegisterAdminPermissions: duplicate permission " + p.Key)
+		}
+		adminPermissionRegistry.byKey[p.Key] = p
+	}
+	return perms
+}
+
+// AdminPermissions 返回已注册的全部权限码，按 key 排序。
+func AdminPermissions() []AdminPermission {
+	adminPermissionRegistry.mu.RLock()
+	defer adminPermissionRegistry.mu.RUnlock()
+	out := make([]AdminPermission, 0, len(adminPermissionRegistry.byKey))
+	for _, p := range adminPermissionRegistry.byKey {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+func AdminPermissionKeys() []string {
+	perms := AdminPermissions()
+	out := make([]string, 0, len(perms))
+	for _, p := range perms {
+		out = append(out, p.Key)
+	}
+	return out
+}
+
+func IsAdminPermissionRegistered(key string) bool {
+	adminPermissionRegistry.mu.RLock()
+	defer adminPermissionRegistry.mu.RUnlock()
+	_, ok := adminPermissionRegistry.byKey[key]
+	return ok
+}
+
+// PermissionDiff 描述代码注册表与数据库 admin_permissions 的差异。
+type PermissionDiff struct {
+	// Missing 代码里已注册、数据库里还没有（启动同步前或同步失败）。
+	Missing []AdminPermission
+	// Changed 两边都有，但名称/分组/描述不一致，以代码为准。
+	Changed []AdminPermission
+	// Orphaned 数据库里有、代码里已不再注册。
+	Orphaned []RBACPermissionSummary
+}
+
+func (d PermissionDiff) Empty() bool {
+	return len(d.Missing) == 0 && len(d.Changed) == 0 && len(d.Orphaned) == 0
+}
+
+// DiffAdminPermissions 对比注册表（含推导出的通配权限）与数据库中的权限码。
+func DiffAdminPermissions(inDB []RBACPermissionSummary) PermissionDiff {
+	var diff PermissionDiff
+	dbByKey := make(map[string]RBACPermissionSummary, len(inDB))
+	for _, p := range inDB {
+		dbByKey[p.Key] = p
+	}
+
+	for _, p := range AdminPermissionCatalog() {
+		got, ok := dbByKey[p.Key]
+		switch {
+		case !ok:
+			diff.Missing = append(diff.Missing, p)
+		case got.Name != p.Name || got.Group != p.Group || got.Description != p.Description:
+			diff.Changed = append(diff.Changed, p)
+		}
+		delete(dbByKey, p.Key)
+	}
+	for _, p := range dbByKey {
+		diff.Orphaned = append(diff.Orphaned, p)
+	}
+	sort.Slice(diff.Orphaned, func(i, j int) bool { return diff.Orphaned[i].Key < diff.Orphaned[j].Key })
+	return diff
+}
@@ -0,0 +1,37 @@
+package biz
+
+import "testing"
+
+func TestDiffAdminPermissions(t *testing.T) {
+	var inDB []RBACPermissionSummary
+	for _, p := range AdminPermissionCatalog() {
+		inDB = append(inDB, RBACPermissionSummary{Key: p.Key, Name: p.Name, Group: p.Group, Description: p.Description})
+	}
+	if diff := DiffAdminPermissions(inDB); !diff.Empty() {
+		t.Fatalf("expected in-sync diff, got %+v", diff)
+	}
+
+	inDB[0].Name = "renamed"
+	inDB = append(inDB[:1], inDB[2:]...)
+	inDB = append(inDB, RBACPermissionSummary{Key: "legacy.points.write", Name: "积分"})
+
+	diff := DiffAdminPermissions(inDB)
+	if len(diff.Changed) != 1 || diff.Changed[0].Key != AdminPermissionCatalog()[0].Key {
+		t.Fatalf("unexpected changed: %+v", diff.Changed)
+	}
+	if len(diff.Missing) != 1 || diff.Missing[0].Key != AdminPermissionCatalog()[1].Key {
+		t.Fatalf("unexpected missing: %+v", diff.Missing)
+	}
+	if len(diff.Orphaned) != 1 || diff.Orphaned[0].Key != "legacy.points.write" {
+		t.Fatalf("unexpected orphaned: %+v", diff.Orphaned)
+	}
+}
+
+func TestRegisterAdminPermissionsRejectsDuplicate(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic on duplicate registration")
+		}
+	}()
+	RegisterAdminPermissions(AdminPermission{Key: PermissionAdminAccess, Name: "dup"})
+}
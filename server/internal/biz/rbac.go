@@ -1,57 +1,61 @@
 package biz
 
-import "context"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
 
-type AdminPermission struct {
-	Key         string
-	Name        string
-	Group       string
-	Description string
-}
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrRoleNotFound          = errors.New("role not found")
+	ErrRoleExists            = errors.New("role already exists")
+	ErrRoleKeyInvalid        = errors.New("role key invalid")
+	ErrRoleBuiltin           = errors.New("builtin role cannot be deleted")
+	ErrPermissionUnknown     = errors.New("permission unknown")
+	ErrSuperAdminPermissions = errors.New("super_admin must keep all permissions")
+	ErrLastSuperAdmin        = errors.New("change would leave no active super admin")
+	ErrRoleCycle             = errors.New("role inheritance would form a cycle")
+)
 
 const (
 	PermissionAdminAccess = "admin.access"
-	PermissionUserRead    = "admin.user.read"
-	PermissionUserWrite   = "admin.user.write"
 	PermissionRBACRead    = "admin.rbac.read"
+	PermissionRBACWrite   = "admin.rbac.write"
 )
 
 const SuperAdminRoleKey = "super_admin"
 
-var DefaultAdminPermissions = []AdminPermission{
-	{
+var _ = RegisterAdminPermissions(
+	AdminPermission{
 		Key:         PermissionAdminAccess,
 		Name:        "后台访问",
 		Group:       "系统",
 		Description: "允许进入管理员后台基础入口",
 	},
-	{
-		Key:         PermissionUserRead,
-		Name:        "查看账号",
-		Group:       "账号",
-		Description: "允许查看普通用户账号目录",
-	},
-	{
-		Key:         PermissionUserWrite,
-		Name:        "管理账号状态",
-		Group:       "账号",
-		Description: "允许启用或禁用普通用户账号",
-	},
-	{
+	AdminPermission{
 		Key:         PermissionRBACRead,
 		Name:        "查看角色权限",
 		Group:       "权限",
 		Description: "允许查看后台角色与权限基线",
 	},
-}
-
-func DefaultAdminPermissionKeys() []string {
-	out := make([]string, 0, len(DefaultAdminPermissions))
-	for _, p := range DefaultAdminPermissions {
-		out = append(out, p.Key)
-	}
-	return out
-}
+	AdminPermission{
+		Key:         PermissionRBACWrite,
+		Name:        "管理角色权限",
+		Group:       "权限",
+		Description: "允许创建、修改、删除后台角色并调整角色权限",
+	},
+)
 
 type RBACRoleSummary struct {
 	ID          int
@@ -60,6 +64,10 @@ type RBACRoleSummary struct {
 	Description string
 	Builtin     bool
 	AdminCount  int
+	Permissions []string
+	// Parents 是直接父角色的 key；InheritedPermissions 是从全部祖先角色继承来、自身未直接声明的权限条目。
+	Parents              []string
+	InheritedPermissions []string
 }
 
 type RBACPermissionSummary struct {
@@ -75,18 +83,388 @@ type RBACOverview struct {
 	Permissions []RBACPermissionSummary
 }
 
+const (
+	AuditActionRoleCreate         = "rbac.role.create"
+	AuditActionRoleUpdate         = "rbac.role.update"
+	AuditActionRoleDelete         = "rbac.role.delete"
+	AuditActionRoleSetPermissions = "rbac.role.set_permissions"
+	AuditActionRoleSetParents     = "rbac.role.set_parents"
+)
+
+// RBACRepo 的写方法都在事务内执行，并在提交前校验变更没有让启用中的 super_admin 管理员清零，
+// 否则回滚并返回 ErrLastSuperAdmin。
 type RBACRepo interface {
 	Overview(ctx context.Context) (*RBACOverview, error)
+	GetRole(ctx context.Context, id int) (*RBACRoleSummary, error)
+	CreateRole(ctx context.Context, in *RBACRoleSummary) (*RBACRoleSummary, error)
+	UpdateRole(ctx context.Context, id int, name, description string) (*RBACRoleSummary, error)
+	DeleteRole(ctx context.Context, id int) error
+	SetRolePermissions(ctx context.Context, id int, permissionKeys []string) (*RBACRoleSummary, error)
+	// SetRoleParents 整体替换父角色；父角色不存在返回 ErrRoleNotFound，成环返回 ErrRoleCycle。
+	SetRoleParents(ctx context.Context, id int, parentKeys []string) (*RBACRoleSummary, error)
+	// ListPolicyBindings 返回全部管理员（含无角色的）及其长期角色，供策略导入导出使用。
+	ListPolicyBindings(ctx context.Context) ([]RBACPolicyBinding, error)
+	// ListSuperAdminRoleKeys 返回当前生效的超管（含临时授权）持有的全部角色 key。
+	ListSuperAdminRoleKeys(ctx context.Context) ([]string, error)
+	// ApplyPolicy 在一个事务里读取当前角色与绑定，交给 plan 计算变更后应用，同样受超管数量保护；
+	// plan 返回错误时不做任何修改。返回实际应用的计划。
+	ApplyPolicy(ctx context.Context, plan func(overview *RBACOverview, bindings []RBACPolicyBinding) (*RBACPolicyPlan, error)) (*RBACPolicyPlan, error)
 }
 
 type RBACUsecase struct {
-	repo RBACRepo
+	repo   RBACRepo
+	audit  AuditRepo
+	access *AdminAccessResolver
+	log    *log.Helper
+	tracer trace.Tracer
 }
 
-func NewRBACUsecase(repo RBACRepo) *RBACUsecase {
-	return &RBACUsecase{repo: repo}
+func NewRBACUsecase(repo RBACRepo, audit AuditRepo, access *AdminAccessResolver, logger log.Logger, tp *tracesdk.TracerProvider) *RBACUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.rbac"))
+
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.rbac")
+	} else {
+		tr = otel.Tracer("biz.rbac")
+	}
+
+	return &RBACUsecase{
+		repo:   repo,
+		audit:  audit,
+		access: access,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+func (uc *RBACUsecase) Tracer() trace.Tracer {
+	if uc.tracer != nil {
+		return uc.tracer
+	}
+	return otel.Tracer("biz.rbac")
 }
 
 func (uc *RBACUsecase) Overview(ctx context.Context) (*RBACOverview, error) {
 	return uc.repo.Overview(ctx)
 }
+
+// PermissionsDiff 对比代码注册表与数据库中的权限码，用于排查启动同步是否生效、是否有遗留权限码。
+func (uc *RBACUsecase) PermissionsDiff(ctx context.Context) (PermissionDiff, error) {
+	overview, err := uc.repo.Overview(ctx)
+	if err != nil {
+		return PermissionDiff{}, err
+	}
+	return DiffAdminPermissions(overview.Permissions), nil
+}
+
+var roleKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,63}$`)
+
+// CreateRole 新建自定义角色（builtin=false），可同时指定初始权限。
+func (uc *RBACUsecase) CreateRole(ctx context.Context, key, name, description string, permissionKeys []string) (*RBACRoleSummary, error) {
+	ctx, span := uc.Tracer().Start(ctx, "rbac.create_role", trace.WithAttributes(attribute.String("rbac.role_key", key)))
+	defer span.End()
+
+	key = strings.TrimSpace(key)
+	name = strings.TrimSpace(name)
+	if !roleKeyPattern.MatchString(key) {
+		span.SetStatus(codes.Error, ErrRoleKeyInvalid.Error())
+		return nil, ErrRoleKeyInvalid
+	}
+	if name == "" {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+
+	permissionKeys = normalizePermissionKeys(permissionKeys)
+	overview, err := uc.repo.Overview(ctx)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "CreateRole", key, err)
+	}
+	next := append(slices.Clone(overview.Roles), RBACRoleSummary{Key: key, Permissions: permissionKeys})
+	if err := uc.checkRoleEdit(ctx, overview, next, key); err != nil {
+		return nil, uc.fail(ctx, span, "CreateRole", key, err)
+	}
+
+	role, err := uc.repo.CreateRole(ctx, &RBACRoleSummary{
+		Key:         key,
+		Name:        name,
+		Description: strings.TrimSpace(description),
+		Permissions: permissionKeys,
+	})
+	if err != nil {
+		return nil, uc.fail(ctx, span, "CreateRole", key, err)
+	}
+
+	uc.recordRoleAudit(ctx, AuditActionRoleCreate, role, map[string]any{"permissions": role.Permissions})
+	span.SetStatus(codes.Ok, "OK")
+	return role, nil
+}
+
+// UpdateRole 只允许改名称和描述；key 是权限绑定与配置引用的稳定标识，不可修改。
+func (uc *RBACUsecase) UpdateRole(ctx context.Context, id int, name, description string) (*RBACRoleSummary, error) {
+	ctx, span := uc.Tracer().Start(ctx, "rbac.update_role", trace.WithAttributes(attribute.Int("rbac.role_id", id)))
+	defer span.End()
+
+	name = strings.TrimSpace(name)
+	if id <= 0 || name == "" {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+
+	role, err := uc.repo.UpdateRole(ctx, id, name, strings.TrimSpace(description))
+	if err != nil {
+		return nil, uc.fail(ctx, span, "UpdateRole", "", err)
+	}
+
+	uc.recordRoleAudit(ctx, AuditActionRoleUpdate, role, map[string]any{"name": role.Name, "description": role.Description})
+	span.SetStatus(codes.Ok, "OK")
+	return role, nil
+}
+
+// DeleteRole 删除自定义角色及其权限、管理员绑定和继承关系；内置角色不可删除。
+func (uc *RBACUsecase) DeleteRole(ctx context.Context, id int) error {
+	ctx, span := uc.Tracer().Start(ctx, "rbac.delete_role", trace.WithAttributes(attribute.Int("rbac.role_id", id)))
+	defer span.End()
+
+	if id <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return ErrBadParam
+	}
+
+	role, err := uc.repo.GetRole(ctx, id)
+	if err != nil {
+		return uc.fail(ctx, span, "DeleteRole", "", err)
+	}
+	if role.Builtin {
+		span.SetStatus(codes.Error, ErrRoleBuiltin.Error())
+		return ErrRoleBuiltin
+	}
+
+	if err := uc.repo.DeleteRole(ctx, id); err != nil {
+		return uc.fail(ctx, span, "DeleteRole", role.Key, err)
+	}
+	uc.access.InvalidateAll()
+
+	uc.recordRoleAudit(ctx, AuditActionRoleDelete, role, map[string]any{
+		"permissions": role.Permissions,
+		"admin_count": role.AdminCount,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return nil
+}
+
+// SetRolePermissions 整体替换角色的权限集合；super_admin 必须始终拥有全部权限。
+func (uc *RBACUsecase) SetRolePermissions(ctx context.Context, id int, permissionKeys []string) (*RBACRoleSummary, error) {
+	ctx, span := uc.Tracer().Start(ctx, "rbac.set_role_permissions", trace.WithAttributes(attribute.Int("rbac.role_id", id)))
+	defer span.End()
+
+	if id <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+	permissionKeys = normalizePermissionKeys(permissionKeys)
+
+	before, err := uc.repo.GetRole(ctx, id)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "SetRolePermissions", "", err)
+	}
+	overview, err := uc.repo.Overview(ctx)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "SetRolePermissions", before.Key, err)
+	}
+	if err := uc.checkRoleEdit(ctx, overview, withRoleDefinition(overview.Roles, before.Key, permissionKeys, before.Parents), before.Key); err != nil {
+		return nil, uc.fail(ctx, span, "SetRolePermissions", before.Key, err)
+	}
+	if before.Key == SuperAdminRoleKey {
+		// 超管必须逐条持有全部权限码，也不允许挂拒绝条目，否则会被锁在某些功能之外。
+		want := map[string]bool{}
+		for _, entry := range permissionKeys {
+			k, deny := ParsePermissionEntry(entry)
+			if deny {
+				span.SetStatus(codes.Error, ErrSuperAdminPermissions.Error())
+				return nil, ErrSuperAdminPermissions
+			}
+			want[k] = true
+		}
+		for _, p := range overview.Permissions {
+			if !want[p.Key] {
+				span.SetStatus(codes.Error, ErrSuperAdminPermissions.Error())
+				return nil, ErrSuperAdminPermissions
+			}
+		}
+	}
+
+	role, err := uc.repo.SetRolePermissions(ctx, id, permissionKeys)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "SetRolePermissions", before.Key, err)
+	}
+	uc.access.InvalidateAll()
+
+	uc.recordRoleAudit(ctx, AuditActionRoleSetPermissions, role, map[string]any{
+		"before": before.Permissions,
+		"after":  role.Permissions,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return role, nil
+}
+
+// SetRoleParents 整体替换角色的父角色；继承关系必须保持无环。
+// super_admin 不能有父角色：父角色里的 ! 条目会被继承下来，削掉超管的权限。
+func (uc *RBACUsecase) SetRoleParents(ctx context.Context, id int, parentKeys []string) (*RBACRoleSummary, error) {
+	ctx, span := uc.Tracer().Start(ctx, "rbac.set_role_parents", trace.WithAttributes(attribute.Int("rbac.role_id", id)))
+	defer span.End()
+
+	if id <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+	parentKeys = normalizeRoleKeys(parentKeys)
+
+	before, err := uc.repo.GetRole(ctx, id)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "SetRoleParents", "", err)
+	}
+	if before.Key == SuperAdminRoleKey && len(parentKeys) > 0 {
+		return nil, uc.fail(ctx, span, "SetRoleParents", before.Key, ErrSuperAdminPermissions)
+	}
+	overview, err := uc.repo.Overview(ctx)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "SetRoleParents", before.Key, err)
+	}
+	known := make(map[string]bool, len(overview.Roles))
+	for _, role := range overview.Roles {
+		known[role.Key] = true
+	}
+	for _, k := range parentKeys {
+		if !known[k] {
+			return nil, uc.fail(ctx, span, "SetRoleParents", before.Key, ErrRoleNotFound)
+		}
+	}
+	if roleInheritanceHasCycle(overview.Roles, before.Key, parentKeys) {
+		return nil, uc.fail(ctx, span, "SetRoleParents", before.Key, ErrRoleCycle)
+	}
+	if err := uc.checkRoleEdit(ctx, overview, withRoleDefinition(overview.Roles, before.Key, before.Permissions, parentKeys), before.Key); err != nil {
+		return nil, uc.fail(ctx, span, "SetRoleParents", before.Key, err)
+	}
+
+	role, err := uc.repo.SetRoleParents(ctx, id, parentKeys)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "SetRoleParents", before.Key, err)
+	}
+	uc.access.InvalidateAll()
+
+	uc.recordRoleAudit(ctx, AuditActionRoleSetParents, role, map[string]any{
+		"before": before.Parents,
+		"after":  role.Parents,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return role, nil
+}
+
+// checkRoleEdit 校验非超管对角色定义的修改：next 是修改后的全部角色（继承尚未展开），
+// keys 对应角色的有效权限（自身+继承）必须在操作者权限范围内，否则改自己持有的角色就能提权。
+// 超管持有的角色及其祖先只能由超管修改，否则可以借 ! 条目削掉超管的权限。
+func (uc *RBACUsecase) checkRoleEdit(ctx context.Context, overview *RBACOverview, next []RBACRoleSummary, keys ...string) error {
+	op, err := currentAdmin(ctx, uc.access)
+	if err != nil {
+		return err
+	}
+	if isSuperAdmin(op) {
+		return nil
+	}
+	superKeys, err := uc.repo.ListSuperAdminRoleKeys(ctx)
+	if err != nil {
+		return err
+	}
+	guarded := roleAncestors(overview.Roles, append(superKeys, SuperAdminRoleKey))
+	for _, key := range keys {
+		if guarded[key] {
+			return fmt.Errorf("%w: role %q is held by a super admin", ErrAdminPrivilegeEscalation, key)
+		}
+	}
+	ApplyRoleInheritance(next)
+	held := NewPermissionSet(op.Permissions)
+	for _, key := range keys {
+		permissions, err := overviewRolePermissions(&RBACOverview{Roles: next}, []string{key})
+		if err != nil {
+			return err
+		}
+		if !held.Covers(permissions) {
+			return fmt.Errorf("%w: role %q", ErrAdminPrivilegeEscalation, key)
+		}
+	}
+	return nil
+}
+
+// withRoleDefinition 返回 roles 的副本，其中 key 对应角色的权限与父角色替换为给定值。
+func withRoleDefinition(roles []RBACRoleSummary, key string, permissions, parents []string) []RBACRoleSummary {
+	out := slices.Clone(roles)
+	for i := range out {
+		if out[i].Key == key {
+			out[i].Permissions, out[i].Parents, out[i].InheritedPermissions = permissions, parents, nil
+		}
+	}
+	return out
+}
+
+func (uc *RBACUsecase) fail(ctx context.Context, span trace.Span, op, roleKey string, err error) error {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	l := uc.log.WithContext(ctx)
+	switch {
+	case errors.Is(err, ErrRoleNotFound), errors.Is(err, ErrRoleExists), errors.Is(err, ErrPermissionUnknown), errors.Is(err, ErrLastSuperAdmin), errors.Is(err, ErrRoleCycle), errors.Is(err, ErrPolicyInvalid),
+		errors.Is(err, ErrAdminPrivilegeEscalation), errors.Is(err, ErrForbidden):
+		l.Warnf("%s rejected role_key=%s err=%v", op, roleKey, err)
+	default:
+		l.Errorf("%s failed role_key=%s err=%v", op, roleKey, err)
+	}
+	return err
+}
+
+// recordRoleAudit 在变更成功后写审计；此时变更已提交，写入失败只告警。
+func (uc *RBACUsecase) recordRoleAudit(ctx context.Context, action string, role *RBACRoleSummary, detail map[string]any) {
+	if uc.audit == nil || role == nil {
+		return
+	}
+	e := &AuditEvent{
+		Action:     action,
+		ActorKind:  AuditActorAdmin,
+		TargetKind: "role",
+		TargetID:   role.ID,
+		Detail:     detail,
+	}
+	if c, ok := GetClaimsFromContext(ctx); ok && c != nil {
+		e.ActorID = c.UserID
+		e.ActorUsername = c.Username
+	}
+	if e.Detail == nil {
+		e.Detail = map[string]any{}
+	}
+	e.Detail["role_key"] = role.Key
+	if err := uc.audit.RecordAudit(ctx, e); err != nil {
+		uc.log.WithContext(ctx).Warnf("record rbac audit failed action=%s role_id=%d err=%v", action, role.ID, err)
+	}
+}
+
+func normalizePermissionKeys(keys []string) []string {
+	seen := make(map[string]bool, len(keys))
+	out := make([]string, 0, len(keys))
+	for _, k := range keys {
+		key, deny := ParsePermissionEntry(k)
+		if key == "" {
+			continue
+		}
+		k = key
+		if deny {
+			k = PermissionDenyPrefix + key
+		}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,459 @@
+// server/internal/biz/rbac_policy.go
+package biz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gopkg.in/yaml.v3"
+)
+
+// RBAC 策略文件把角色、角色权限、角色继承与管理员的长期角色绑定导出成 YAML，
+// 方便多环境共用一份配置并在 Git 里评审。权限码本身由代码注册，不在文件里定义；
+// 管理员按用户名绑定（各环境 id 不同），临时授权不参与导入导出。
+
+const RBACPolicyVersion = 1
+
+var ErrPolicyInvalid = errors.New("rbac policy invalid")
+
+const AuditActionPolicyImport = "rbac.policy.import"
+
+type RBACPolicy struct {
+	Version  int                 `yaml:"version"`
+	Roles    []RBACPolicyRole    `yaml:"roles"`
+	Bindings []RBACPolicyBinding `yaml:"bindings,omitempty"`
+}
+
+type RBACPolicyRole struct {
+	Key         string   `yaml:"key"`
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description,omitempty"`
+	Parents     []string `yaml:"parents,omitempty"`
+	Permissions []string `yaml:"permissions"`
+}
+
+type RBACPolicyBinding struct {
+	Admin string   `yaml:"admin"`
+	Roles []string `yaml:"roles"`
+
+	// AdminID 只在服务端内部使用，导入时由用户名解析得到。
+	AdminID int `yaml:"-"`
+}
+
+// RBACPolicyRoleChange 是一个已存在角色的变更；Before/After 都是完整状态，repo 按 After 覆盖。
+type RBACPolicyRoleChange struct {
+	Before RBACPolicyRole
+	After  RBACPolicyRole
+}
+
+type RBACPolicyBindingChange struct {
+	AdminID int
+	Admin   string
+	Before  []string
+	After   []string
+}
+
+// RBACPolicyPlan 是导入前计算出的变更集合；dry-run 只返回它，apply 时 repo 在一个事务里执行。
+type RBACPolicyPlan struct {
+	CreateRoles []RBACPolicyRole
+	UpdateRoles []RBACPolicyRoleChange
+	// DeleteRoles 只在 prune 时填充，内置角色永远不会出现在这里。
+	DeleteRoles []string
+	Bindings    []RBACPolicyBindingChange
+	// UnknownAdmins 是文件里出现但当前环境不存在的管理员用户名，导入时跳过。
+	UnknownAdmins []string
+}
+
+func (p *RBACPolicyPlan) Empty() bool {
+	return len(p.CreateRoles) == 0 && len(p.UpdateRoles) == 0 && len(p.DeleteRoles) == 0 && len(p.Bindings) == 0
+}
+
+type RBACPolicyImportOptions struct {
+	DryRun bool
+	// Prune 删除文件里没有的自定义角色（连同其权限、继承关系与管理员绑定）。
+	Prune bool
+}
+
+// MarshalRBACPolicy 输出稳定排序的 YAML，便于 diff。
+func MarshalRBACPolicy(p *RBACPolicy) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(p); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ParseRBACPolicy 解析 YAML；未知字段直接报错，避免拼写错误被静默忽略。
+func ParseRBACPolicy(raw []byte) (*RBACPolicy, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(raw))
+	dec.KnownFields(true)
+	var p RBACPolicy
+	if err := dec.Decode(&p); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPolicyInvalid, err)
+	}
+	if p.Version != RBACPolicyVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrPolicyInvalid, p.Version)
+	}
+	return &p, nil
+}
+
+// ExportPolicy 导出当前角色、权限条目、继承关系与长期角色绑定。
+func (uc *RBACUsecase) ExportPolicy(ctx context.Context) (*RBACPolicy, error) {
+	ctx, span := uc.Tracer().Start(ctx, "rbac.export_policy")
+	defer span.End()
+
+	overview, err := uc.repo.Overview(ctx)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "ExportPolicy", "", err)
+	}
+	bindings, err := uc.repo.ListPolicyBindings(ctx)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "ExportPolicy", "", err)
+	}
+
+	p := &RBACPolicy{Version: RBACPolicyVersion}
+	for _, role := range overview.Roles {
+		p.Roles = append(p.Roles, policyRoleFromSummary(role))
+	}
+	sort.Slice(p.Roles, func(i, j int) bool { return p.Roles[i].Key < p.Roles[j].Key })
+	for _, b := range bindings {
+		if len(b.Roles) == 0 {
+			continue
+		}
+		p.Bindings = append(p.Bindings, RBACPolicyBinding{Admin: b.Admin, Roles: normalizeRoleKeys(b.Roles)})
+	}
+	sort.Slice(p.Bindings, func(i, j int) bool { return p.Bindings[i].Admin < p.Bindings[j].Admin })
+
+	span.SetStatus(codes.Ok, "OK")
+	return p, nil
+}
+
+// ImportPolicy 校验策略文件并计算变更；DryRun 时只返回计划，否则在一个事务里重新读取现状、重新计算并整体应用，
+// 避免计划与应用之间的并发修改被覆盖。文件里没有的管理员绑定保持不变；没有的角色只有 Prune 时才删除，内置角色永不删除。
+// 绑定变更与 admin.assign_roles 规则相同：只能授予操作者自己拥有的权限，涉及 super_admin 的绑定只有超管能改。
+func (uc *RBACUsecase) ImportPolicy(ctx context.Context, desired *RBACPolicy, opts RBACPolicyImportOptions) (*RBACPolicyPlan, error) {
+	ctx, span := uc.Tracer().Start(ctx, "rbac.import_policy")
+	defer span.End()
+	span.SetAttributes(attribute.Bool("rbac.dry_run", opts.DryRun), attribute.Bool("rbac.prune", opts.Prune))
+
+	if desired == nil {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+	planFn := func(overview *RBACOverview, bindings []RBACPolicyBinding) (*RBACPolicyPlan, error) {
+		plan, err := planRBACPolicy(overview, bindings, desired, opts.Prune)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.checkPolicyChanges(ctx, overview, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	}
+
+	if opts.DryRun {
+		overview, err := uc.repo.Overview(ctx)
+		if err != nil {
+			return nil, uc.fail(ctx, span, "ImportPolicy", "", err)
+		}
+		bindings, err := uc.repo.ListPolicyBindings(ctx)
+		if err != nil {
+			return nil, uc.fail(ctx, span, "ImportPolicy", "", err)
+		}
+		plan, err := planFn(overview, bindings)
+		if err != nil {
+			return nil, uc.fail(ctx, span, "ImportPolicy", "", err)
+		}
+		span.SetStatus(codes.Ok, "OK")
+		return plan, nil
+	}
+
+	plan, err := uc.repo.ApplyPolicy(ctx, planFn)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "ImportPolicy", "", err)
+	}
+	if plan.Empty() {
+		span.SetStatus(codes.Ok, "OK")
+		return plan, nil
+	}
+	uc.access.InvalidateAll()
+
+	uc.recordPolicyAudit(ctx, plan, opts)
+	span.SetStatus(codes.Ok, "OK")
+	return plan, nil
+}
+
+// checkPolicyChanges 对计划里新建或改动权限、父角色的角色做与 rbac.set_role_permissions 相同的越权校验，
+// 对每个绑定变更做与 admin.assign_roles 相同的校验；角色权限都按导入后的定义计算。
+func (uc *RBACUsecase) checkPolicyChanges(ctx context.Context, overview *RBACOverview, plan *RBACPolicyPlan) error {
+	var edited []string
+	for _, c := range plan.UpdateRoles {
+		if !slices.Equal(c.Before.Permissions, c.After.Permissions) || !slices.Equal(c.Before.Parents, c.After.Parents) {
+			edited = append(edited, c.After.Key)
+		}
+	}
+	edited = append(edited, policyRoleKeys(plan.CreateRoles)...)
+	if len(edited) > 0 {
+		if err := uc.checkRoleEdit(ctx, overview, policyFinalRoles(overview, plan), edited...); err != nil {
+			return err
+		}
+	}
+	if len(plan.Bindings) == 0 {
+		return nil
+	}
+	op, err := currentAdmin(ctx, uc.access)
+	if err != nil {
+		return err
+	}
+	final := &RBACOverview{Roles: policyFinalRoles(overview, plan)}
+	for _, b := range plan.Bindings {
+		// 改动超管的绑定等于接管或架空对方，和授予 super_admin 一样只能由超管操作。
+		if !isSuperAdmin(op) && slices.Contains(b.Before, SuperAdminRoleKey) {
+			return fmt.Errorf("%w: binding %q", ErrAdminPrivilegeEscalation, b.Admin)
+		}
+		permissions, err := overviewRolePermissions(final, b.After)
+		if err != nil {
+			return err
+		}
+		if err := checkAdminRoleGrant(op, b.After, permissions); err != nil {
+			return fmt.Errorf("%w: binding %q", err, b.Admin)
+		}
+	}
+	return nil
+}
+
+// policyFinalRoles 返回应用计划后的角色定义（含继承），只用于校验。
+func policyFinalRoles(overview *RBACOverview, plan *RBACPolicyPlan) []RBACRoleSummary {
+	changed := make(map[string]RBACPolicyRole, len(plan.UpdateRoles)+len(plan.CreateRoles))
+	for _, c := range plan.UpdateRoles {
+		changed[c.After.Key] = c.After
+	}
+	for _, r := range plan.CreateRoles {
+		changed[r.Key] = r
+	}
+	roles := make([]RBACRoleSummary, 0, len(overview.Roles)+len(plan.CreateRoles))
+	for _, role := range overview.Roles {
+		if slices.Contains(plan.DeleteRoles, role.Key) {
+			continue
+		}
+		if r, ok := changed[role.Key]; ok {
+			role.Permissions, role.Parents = r.Permissions, r.Parents
+		}
+		roles = append(roles, role)
+	}
+	for _, r := range plan.CreateRoles {
+		roles = append(roles, RBACRoleSummary{Key: r.Key, Name: r.Name, Permissions: r.Permissions, Parents: r.Parents})
+	}
+	ApplyRoleInheritance(roles)
+	return roles
+}
+
+// recordPolicyAudit 一次导入只写一条汇总审计，细节以变更前后的角色 key 与绑定为准。
+func (uc *RBACUsecase) recordPolicyAudit(ctx context.Context, plan *RBACPolicyPlan, opts RBACPolicyImportOptions) {
+	if uc.audit == nil {
+		return
+	}
+	bindings := make([]any, 0, len(plan.Bindings))
+	for _, b := range plan.Bindings {
+		bindings = append(bindings, map[string]any{"admin": b.Admin, "before": b.Before, "after": b.After})
+	}
+	e := &AuditEvent{
+		Action:     AuditActionPolicyImport,
+		ActorKind:  AuditActorAdmin,
+		TargetKind: "rbac_policy",
+		Detail: map[string]any{
+			"created":  policyRoleKeys(plan.CreateRoles),
+			"updated":  policyChangeKeys(plan.UpdateRoles),
+			"deleted":  plan.DeleteRoles,
+			"bindings": bindings,
+			"prune":    opts.Prune,
+		},
+	}
+	if c, ok := GetClaimsFromContext(ctx); ok && c != nil {
+		e.ActorID = c.UserID
+		e.ActorUsername = c.Username
+	}
+	if err := uc.audit.RecordAudit(ctx, e); err != nil {
+		uc.log.WithContext(ctx).Warnf("record rbac policy audit failed err=%v", err)
+	}
+}
+
+func planRBACPolicy(overview *RBACOverview, bindings []RBACPolicyBinding, desired *RBACPolicy, prune bool) (*RBACPolicyPlan, error) {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s", ErrPolicyInvalid, fmt.Sprintf(format, args...))
+	}
+
+	permissionKnown := make(map[string]bool, len(overview.Permissions))
+	for _, p := range overview.Permissions {
+		permissionKnown[p.Key] = true
+	}
+	current := make(map[string]RBACRoleSummary, len(overview.Roles))
+	for _, role := range overview.Roles {
+		current[role.Key] = role
+	}
+
+	plan := &RBACPolicyPlan{}
+	inFile := make(map[string]RBACPolicyRole, len(desired.Roles))
+	for _, r := range desired.Roles {
+		r.Key = strings.TrimSpace(r.Key)
+		r.Name = strings.TrimSpace(r.Name)
+		r.Description = strings.TrimSpace(r.Description)
+		r.Parents = normalizeRoleKeys(r.Parents)
+		r.Permissions = normalizePermissionKeys(r.Permissions)
+
+		if !roleKeyPattern.MatchString(r.Key) {
+			return nil, invalid("role %q: invalid key", r.Key)
+		}
+		if _, dup := inFile[r.Key]; dup {
+			return nil, invalid("role %q: duplicated", r.Key)
+		}
+		if r.Name == "" {
+			return nil, invalid("role %q: name is required", r.Key)
+		}
+		for _, entry := range r.Permissions {
+			key, deny := ParsePermissionEntry(entry)
+			if !permissionKnown[key] {
+				return nil, invalid("role %q: unknown permission %q", r.Key, key)
+			}
+			if deny && r.Key == SuperAdminRoleKey {
+				return nil, invalid("role %q: deny entries are not allowed", r.Key)
+			}
+		}
+		if r.Key == SuperAdminRoleKey {
+			for key := range permissionKnown {
+				if !slices.Contains(r.Permissions, key) {
+					return nil, invalid("role %q: must keep all permissions, missing %q", r.Key, key)
+				}
+			}
+		}
+		inFile[r.Key] = r
+
+		before, ok := current[r.Key]
+		if !ok {
+			plan.CreateRoles = append(plan.CreateRoles, r)
+			continue
+		}
+		old := policyRoleFromSummary(before)
+		if !policyRoleEqual(old, r) {
+			plan.UpdateRoles = append(plan.UpdateRoles, RBACPolicyRoleChange{Before: old, After: r})
+		}
+	}
+
+	// 计算导入后的最终角色集合，用来校验父角色引用、继承无环与绑定引用。
+	final := make([]RBACRoleSummary, 0, len(current)+len(plan.CreateRoles))
+	finalKeys := map[string]bool{}
+	for key, role := range current {
+		if r, ok := inFile[key]; ok {
+			final = append(final, RBACRoleSummary{Key: key, Parents: r.Parents})
+		} else if prune && !role.Builtin {
+			plan.DeleteRoles = append(plan.DeleteRoles, key)
+			continue
+		} else {
+			final = append(final, RBACRoleSummary{Key: key, Parents: role.Parents})
+		}
+		finalKeys[key] = true
+	}
+	for _, r := range plan.CreateRoles {
+		final = append(final, RBACRoleSummary{Key: r.Key, Parents: r.Parents})
+		finalKeys[r.Key] = true
+	}
+	sort.Strings(plan.DeleteRoles)
+
+	for _, role := range final {
+		if role.Key == SuperAdminRoleKey && len(role.Parents) > 0 {
+			return nil, invalid("role %q: parents are not allowed", role.Key)
+		}
+		for _, parent := range role.Parents {
+			if !finalKeys[parent] {
+				return nil, invalid("role %q: unknown parent %q", role.Key, parent)
+			}
+		}
+		if roleInheritanceHasCycle(final, role.Key, role.Parents) {
+			return nil, invalid("role %q: inheritance cycle", role.Key)
+		}
+	}
+
+	byAdmin := make(map[string]RBACPolicyBinding, len(bindings))
+	for _, b := range bindings {
+		byAdmin[NormalizeUsername(b.Admin)] = b
+	}
+	seenAdmins := map[string]bool{}
+	for _, b := range desired.Bindings {
+		name := NormalizeUsername(b.Admin)
+		if name == "" {
+			return nil, invalid("binding: admin is required")
+		}
+		if seenAdmins[name] {
+			return nil, invalid("binding %q: duplicated", b.Admin)
+		}
+		seenAdmins[name] = true
+
+		roles := normalizeRoleKeys(b.Roles)
+		for _, key := range roles {
+			if !finalKeys[key] {
+				return nil, invalid("binding %q: unknown role %q", b.Admin, key)
+			}
+		}
+		cur, ok := byAdmin[name]
+		if !ok {
+			plan.UnknownAdmins = append(plan.UnknownAdmins, b.Admin)
+			continue
+		}
+		before := normalizeRoleKeys(cur.Roles)
+		if !slices.Equal(before, roles) {
+			plan.Bindings = append(plan.Bindings, RBACPolicyBindingChange{
+				AdminID: cur.AdminID,
+				Admin:   cur.Admin,
+				Before:  before,
+				After:   roles,
+			})
+		}
+	}
+	sort.Slice(plan.Bindings, func(i, j int) bool { return plan.Bindings[i].Admin < plan.Bindings[j].Admin })
+	return plan, nil
+}
+
+func policyRoleFromSummary(role RBACRoleSummary) RBACPolicyRole {
+	return RBACPolicyRole{
+		Key:         role.Key,
+		Name:        role.Name,
+		Description: role.Description,
+		Parents:     normalizeRoleKeys(role.Parents),
+		Permissions: normalizePermissionKeys(role.Permissions),
+	}
+}
+
+func policyRoleEqual(a, b RBACPolicyRole) bool {
+	return a.Name == b.Name &&
+		a.Description == b.Description &&
+		slices.Equal(a.Parents, b.Parents) &&
+		slices.Equal(a.Permissions, b.Permissions)
+}
+
+func policyRoleKeys(roles []RBACPolicyRole) []string {
+	out := make([]string, 0, len(roles))
+	for _, r := range roles {
+		out = append(out, r.Key)
+	}
+	return out
+}
+
+func policyChangeKeys(changes []RBACPolicyRoleChange) []string {
+	out := make([]string, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, c.After.Key)
+	}
+	return out
+}
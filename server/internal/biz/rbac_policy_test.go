@@ -0,0 +1,235 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRBACPolicy_ExportRoundTripIsInSync(t *testing.T) {
+	uc, repo, _ := newTestRBACUsecase()
+	repo.roles[2] = &RBACRoleSummary{ID: 2, Key: "ops", Name: "运营", Permissions: []string{PermissionUserRead}, Parents: []string{}}
+	repo.bindings = []RBACPolicyBinding{{AdminID: 7, Admin: "root", Roles: []string{SuperAdminRoleKey}}}
+
+	exported, err := uc.ExportPolicy(adminCtx())
+	if err != nil {
+		t.Fatalf("ExportPolicy() error = %v", err)
+	}
+	raw, err := MarshalRBACPolicy(exported)
+	if err != nil {
+		t.Fatalf("MarshalRBACPolicy() error = %v", err)
+	}
+	parsed, err := ParseRBACPolicy(raw)
+	if err != nil {
+		t.Fatalf("ParseRBACPolicy() error = %v\n%s", err, raw)
+	}
+
+	plan, err := uc.ImportPolicy(adminCtx(), parsed, RBACPolicyImportOptions{})
+	if err != nil {
+		t.Fatalf("ImportPolicy() error = %v", err)
+	}
+	if !plan.Empty() || len(repo.applied) != 0 {
+		t.Fatalf("expected exported policy to be in sync, got %+v", plan)
+	}
+}
+
+func TestRBACPolicy_ImportPlansAndPrunes(t *testing.T) {
+	uc, repo, audit := newTestRBACUsecase()
+	repo.roles[2] = &RBACRoleSummary{ID: 2, Key: "ops", Name: "运营", Permissions: []string{PermissionUserRead}}
+	repo.roles[3] = &RBACRoleSummary{ID: 3, Key: "legacy", Name: "旧角色"}
+	repo.bindings = []RBACPolicyBinding{
+		{AdminID: 7, Admin: "root", Roles: []string{SuperAdminRoleKey}},
+		{AdminID: 8, Admin: "Alice", Roles: []string{"legacy"}},
+	}
+
+	policy, err := ParseRBACPolicy([]byte(`
+version: 1
+roles:
+  - key: ops
+    name: 运营
+    permissions: [admin.user.read, admin.user.write]
+  - key: ops_lead
+    name: 运营主管
+    parents: [ops]
+    permissions: ["!admin.user.impersonate"]
+bindings:
+  - admin: alice
+    roles: [ops_lead]
+  - admin: bob
+    roles: [ops]
+`))
+	if err != nil {
+		t.Fatalf("ParseRBACPolicy() error = %v", err)
+	}
+
+	plan, err := uc.ImportPolicy(adminCtx(), policy, RBACPolicyImportOptions{DryRun: true, Prune: true})
+	if err != nil {
+		t.Fatalf("ImportPolicy(dry run) error = %v", err)
+	}
+	if len(repo.applied) != 0 || len(audit.events) != 0 {
+		t.Fatalf("dry run must not apply or audit")
+	}
+	if len(plan.CreateRoles) != 1 || plan.CreateRoles[0].Key != "ops_lead" {
+		t.Fatalf("unexpected create roles %+v", plan.CreateRoles)
+	}
+	if len(plan.UpdateRoles) != 1 || plan.UpdateRoles[0].After.Key != "ops" {
+		t.Fatalf("unexpected update roles %+v", plan.UpdateRoles)
+	}
+	// super_admin 不在文件里但属于内置角色，prune 也不会删除。
+	if len(plan.DeleteRoles) != 1 || plan.DeleteRoles[0] != "legacy" {
+		t.Fatalf("unexpected delete roles %+v", plan.DeleteRoles)
+	}
+	if len(plan.Bindings) != 1 || plan.Bindings[0].AdminID != 8 || plan.Bindings[0].After[0] != "ops_lead" {
+		t.Fatalf("unexpected bindings %+v", plan.Bindings)
+	}
+	if len(plan.UnknownAdmins) != 1 || plan.UnknownAdmins[0] != "bob" {
+		t.Fatalf("unexpected unknown admins %+v", plan.UnknownAdmins)
+	}
+
+	if _, err := uc.ImportPolicy(adminCtx(), policy, RBACPolicyImportOptions{Prune: true}); err != nil {
+		t.Fatalf("ImportPolicy(apply) error = %v", err)
+	}
+	if len(repo.applied) != 1 || len(audit.events) != 1 || audit.events[0].Action != AuditActionPolicyImport {
+		t.Fatalf("expected one applied plan and one audit event, got %d/%+v", len(repo.applied), audit.events)
+	}
+}
+
+func TestRBACPolicy_ImportRejectsInvalid(t *testing.T) {
+	uc, repo, _ := newTestRBACUsecase()
+	repo.roles[2] = &RBACRoleSummary{ID: 2, Key: "ops", Name: "运营", Parents: []string{"ops_lead"}}
+	repo.roles[3] = &RBACRoleSummary{ID: 3, Key: "ops_lead", Name: "运营主管"}
+
+	cases := map[string]string{
+		"unknown permission": "version: 1\nroles:\n  - key: ops\n    name: 运营\n    permissions: [admin.nope]\n",
+		"unknown parent":     "version: 1\nroles:\n  - key: ops\n    name: 运营\n    parents: [ghost]\n    permissions: []\n",
+		"cycle":              "version: 1\nroles:\n  - key: ops_lead\n    name: 运营主管\n    parents: [ops]\n    permissions: []\n",
+		"super admin deny":   "version: 1\nroles:\n  - key: super_admin\n    name: 超管\n    permissions: [\"!admin.access\"]\n",
+		"super admin parent": "version: 1\nroles:\n  - key: super_admin\n    name: 超管\n    parents: [ops_lead]\n    permissions: [" + strings.Join(AdminPermissionKeys(), ", ") + "]\n",
+	}
+	for name, raw := range cases {
+		policy, err := ParseRBACPolicy([]byte(raw))
+		if err != nil {
+			t.Fatalf("%s: ParseRBACPolicy() error = %v", name, err)
+		}
+		if _, err := uc.ImportPolicy(adminCtx(), policy, RBACPolicyImportOptions{DryRun: true}); !errors.Is(err, ErrPolicyInvalid) {
+			t.Fatalf("%s: error = %v, want ErrPolicyInvalid", name, err)
+		}
+	}
+
+	if _, err := ParseRBACPolicy([]byte("version: 1\nroles: []\nextra: true\n")); err == nil || !strings.Contains(err.Error(), "extra") {
+		t.Fatalf("expected unknown field error, got %v", err)
+	}
+}
+
+func TestRBACPolicy_ImportRejectsBindingEscalation(t *testing.T) {
+	uc, repo, admins, _ := newTestRBACUsecaseWithAdmins()
+	repo.roles[2] = &RBACRoleSummary{ID: 2, Key: "ops", Name: "运营", Permissions: []string{PermissionUserRead}}
+	repo.bindings = []RBACPolicyBinding{
+		{AdminID: 7, Admin: "root", Roles: []string{SuperAdminRoleKey}},
+		{AdminID: 8, Admin: "alice"},
+	}
+	admins.admins[9] = &AdminUser{ID: 9, Username: "lead", Roles: []string{"rbac_lead"},
+		Permissions: []string{PermissionAdminAccess, PermissionRBACWrite, PermissionAccountWrite, PermissionUserRead}}
+	ctx := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 9, Username: "lead", Role: RoleAdmin})
+
+	roles := "roles:\n  - key: ops\n    name: 运营\n    permissions: [admin.user.read]\n"
+	cases := map[string]string{
+		"grant super_admin":  "version: 1\n" + roles + "bindings:\n  - admin: alice\n    roles: [super_admin]\n",
+		"demote super_admin": "version: 1\n" + roles + "bindings:\n  - admin: root\n    roles: [ops]\n",
+		// 角色在同一份文件里被改成操作者没有的权限，按导入后的定义校验。
+		"widened role": "version: 1\nroles:\n  - key: ops\n    name: 运营\n    permissions: [admin.user.read, admin.user.write]\nbindings:\n  - admin: alice\n    roles: [ops]\n",
+	}
+	for name, raw := range cases {
+		policy, err := ParseRBACPolicy([]byte(raw))
+		if err != nil {
+			t.Fatalf("%s: ParseRBACPolicy() error = %v", name, err)
+		}
+		for _, dryRun := range []bool{true, false} {
+			if _, err := uc.ImportPolicy(ctx, policy, RBACPolicyImportOptions{DryRun: dryRun}); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+				t.Fatalf("%s (dry_run=%v): error = %v, want ErrAdminPrivilegeEscalation", name, dryRun, err)
+			}
+		}
+	}
+	if len(repo.applied) != 0 {
+		t.Fatalf("rejected imports must not be applied, got %+v", repo.applied)
+	}
+
+	policy, err := ParseRBACPolicy([]byte("version: 1\n" + roles + "bindings:\n  - admin: alice\n    roles: [ops]\n"))
+	if err != nil {
+		t.Fatalf("ParseRBACPolicy() error = %v", err)
+	}
+	if _, err := uc.ImportPolicy(ctx, policy, RBACPolicyImportOptions{}); err != nil {
+		t.Fatalf("ImportPolicy(held permissions) error = %v", err)
+	}
+}
+
+func TestRBACPolicy_ImportReplansAtApply(t *testing.T) {
+	uc, repo, _ := newTestRBACUsecase()
+	repo.roles[2] = &RBACRoleSummary{ID: 2, Key: "ops", Name: "运营", Permissions: []string{PermissionUserRead}}
+	repo.bindings = []RBACPolicyBinding{{AdminID: 8, Admin: "alice"}}
+
+	policy, err := ParseRBACPolicy([]byte("version: 1\nroles:\n  - key: ops\n    name: 运营\n    permissions: [admin.user.read]\nbindings:\n  - admin: alice\n    roles: [ops]\n"))
+	if err != nil {
+		t.Fatalf("ParseRBACPolicy() error = %v", err)
+	}
+	plan, err := uc.ImportPolicy(adminCtx(), policy, RBACPolicyImportOptions{DryRun: true})
+	if err != nil || len(plan.Bindings) != 1 {
+		t.Fatalf("ImportPolicy(dry run) = %+v, %v", plan, err)
+	}
+
+	// dry-run 之后有人已经把 alice 绑到了 ops，应用时按最新状态重新计算，不再有变更。
+	repo.bindings[0].Roles = []string{"ops"}
+	plan, err = uc.ImportPolicy(adminCtx(), policy, RBACPolicyImportOptions{})
+	if err != nil {
+		t.Fatalf("ImportPolicy() error = %v", err)
+	}
+	if !plan.Empty() || len(repo.applied) != 0 {
+		t.Fatalf("expected re-planned import to be empty, got %+v", plan)
+	}
+}
+
+func TestRBACPolicy_ImportRejectsRoleEscalation(t *testing.T) {
+	uc, repo, admins, _ := newTestRBACUsecaseWithAdmins()
+	lead := []string{PermissionAdminAccess, PermissionRBACWrite, PermissionUserRead}
+	repo.roles[2] = &RBACRoleSummary{ID: 2, Key: "rbac_lead", Name: "权限管理", Permissions: lead}
+	repo.roles[3] = &RBACRoleSummary{ID: 3, Key: "accounts", Name: "账号管理", Permissions: []string{PermissionAccountWrite}}
+	repo.roles[4] = &RBACRoleSummary{ID: 4, Key: "root_extra", Name: "超管附加"}
+	repo.bindings = []RBACPolicyBinding{
+		{AdminID: 7, Admin: "root", Roles: []string{SuperAdminRoleKey, "root_extra"}},
+		{AdminID: 9, Admin: "lead", Roles: []string{"rbac_lead"}},
+	}
+	admins.admins[9] = &AdminUser{ID: 9, Username: "lead", Roles: []string{"rbac_lead"}, Permissions: lead}
+	ctx := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 9, Username: "lead", Role: RoleAdmin})
+
+	accounts := "  - key: accounts\n    name: 账号管理\n    permissions: [admin.account.write]\n"
+	cases := map[string]string{
+		// 导入者给自己持有的角色加权限或挂上高权限的父角色，等于给自己提权。
+		"widen own role":       "version: 1\nroles:\n  - key: rbac_lead\n    name: 权限管理\n    permissions: [admin.access, admin.rbac.write, admin.user.read, admin.account.write]\n" + accounts,
+		"privileged parent":    "version: 1\nroles:\n  - key: rbac_lead\n    name: 权限管理\n    permissions: [admin.access, admin.rbac.write, admin.user.read]\n    parents: [accounts]\n" + accounts,
+		"create wide role":     "version: 1\nroles:\n  - key: wide\n    name: 越权\n    permissions: [admin.account.write]\n",
+		"restrict super admin": "version: 1\nroles:\n  - key: root_extra\n    name: 超管附加\n    permissions: [\"!admin.account.write\"]\n",
+	}
+	for name, raw := range cases {
+		policy, err := ParseRBACPolicy([]byte(raw))
+		if err != nil {
+			t.Fatalf("%s: ParseRBACPolicy() error = %v", name, err)
+		}
+		for _, dryRun := range []bool{true, false} {
+			if _, err := uc.ImportPolicy(ctx, policy, RBACPolicyImportOptions{DryRun: dryRun}); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+				t.Fatalf("%s (dry_run=%v): error = %v, want ErrAdminPrivilegeEscalation", name, dryRun, err)
+			}
+		}
+	}
+	if len(repo.applied) != 0 {
+		t.Fatalf("rejected imports must not be applied, got %+v", repo.applied)
+	}
+
+	policy, err := ParseRBACPolicy([]byte("version: 1\nroles:\n  - key: readers\n    name: 只读\n    permissions: [admin.user.read]\n"))
+	if err != nil {
+		t.Fatalf("ParseRBACPolicy() error = %v", err)
+	}
+	if _, err := uc.ImportPolicy(ctx, policy, RBACPolicyImportOptions{}); err != nil {
+		t.Fatalf("ImportPolicy(held permissions) error = %v", err)
+	}
+}
@@ -0,0 +1,295 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type memRBACRepo struct {
+	roles       map[int]*RBACRoleSummary
+	permissions []RBACPermissionSummary
+	nextID      int
+	setCalls    int
+	bindings    []RBACPolicyBinding
+	applied     []*RBACPolicyPlan
+}
+
+func newMemRBACRepo() *memRBACRepo {
+	r := &memRBACRepo{roles: map[int]*RBACRoleSummary{}, nextID: 2}
+	for _, p := range AdminPermissions() {
+		r.permissions = append(r.permissions, RBACPermissionSummary{Key: p.Key, Name: p.Name, Builtin: true})
+	}
+	r.roles[1] = &RBACRoleSummary{ID: 1, Key: SuperAdminRoleKey, Name: "超级管理员", Builtin: true, AdminCount: 1, Permissions: AdminPermissionKeys()}
+	return r
+}
+
+func (r *memRBACRepo) Overview(ctx context.Context) (*RBACOverview, error) {
+	out := &RBACOverview{Permissions: r.permissions}
+	for _, role := range r.roles {
+		out.Roles = append(out.Roles, *role)
+	}
+	ApplyRoleInheritance(out.Roles)
+	return out, nil
+}
+
+func (r *memRBACRepo) GetRole(ctx context.Context, id int) (*RBACRoleSummary, error) {
+	role, ok := r.roles[id]
+	if !ok {
+		return nil, ErrRoleNotFound
+	}
+	cp := *role
+	return &cp, nil
+}
+
+func (r *memRBACRepo) CreateRole(ctx context.Context, in *RBACRoleSummary) (*RBACRoleSummary, error) {
+	for _, role := range r.roles {
+		if role.Key == in.Key {
+			return nil, ErrRoleExists
+		}
+	}
+	cp := *in
+	cp.ID = r.nextID
+	r.nextID++
+	r.roles[cp.ID] = &cp
+	return r.GetRole(ctx, cp.ID)
+}
+
+func (r *memRBACRepo) UpdateRole(ctx context.Context, id int, name, description string) (*RBACRoleSummary, error) {
+	role, ok := r.roles[id]
+	if !ok {
+		return nil, ErrRoleNotFound
+	}
+	role.Name, role.Description = name, description
+	return r.GetRole(ctx, id)
+}
+
+func (r *memRBACRepo) DeleteRole(ctx context.Context, id int) error {
+	delete(r.roles, id)
+	return nil
+}
+
+func (r *memRBACRepo) SetRolePermissions(ctx context.Context, id int, permissionKeys []string) (*RBACRoleSummary, error) {
+	r.setCalls++
+	role, ok := r.roles[id]
+	if !ok {
+		return nil, ErrRoleNotFound
+	}
+	role.Permissions = permissionKeys
+	return r.GetRole(ctx, id)
+}
+
+func (r *memRBACRepo) SetRoleParents(ctx context.Context, id int, parentKeys []string) (*RBACRoleSummary, error) {
+	role, ok := r.roles[id]
+	if !ok {
+		return nil, ErrRoleNotFound
+	}
+	role.Parents = parentKeys
+	return r.GetRole(ctx, id)
+}
+
+func (r *memRBACRepo) ListPolicyBindings(ctx context.Context) ([]RBACPolicyBinding, error) {
+	return r.bindings, nil
+}
+
+func (r *memRBACRepo) ListSuperAdminRoleKeys(ctx context.Context) ([]string, error) {
+	var out []string
+	for _, b := range r.bindings {
+		if slices.Contains(b.Roles, SuperAdminRoleKey) {
+			out = append(out, b.Roles...)
+		}
+	}
+	return out, nil
+}
+
+func (r *memRBACRepo) ApplyPolicy(ctx context.Context, planFn func(*RBACOverview, []RBACPolicyBinding) (*RBACPolicyPlan, error)) (*RBACPolicyPlan, error) {
+	overview, err := r.Overview(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := planFn(overview, r.bindings)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.Empty() {
+		r.applied = append(r.applied, plan)
+	}
+	return plan, nil
+}
+
+func newTestRBACUsecase() (*RBACUsecase, *memRBACRepo, *memAuditRepo) {
+	uc, repo, _, audit := newTestRBACUsecaseWithAdmins()
+	return uc, repo, audit
+}
+
+// newTestRBACUsecaseWithAdmins 的操作者是 adminCtx() 里的 root（id=7，super_admin）。
+func newTestRBACUsecaseWithAdmins() (*RBACUsecase, *memRBACRepo, *memAdminAccountRepo, *memAuditRepo) {
+	repo := newMemRBACRepo()
+	admins := newMemAdminAccountRepo()
+	audit := &memAuditRepo{}
+	access := NewAdminAccessResolver(admins, &AuthPolicy{AdminAccessCacheTTL: -1}, log.NewStdLogger(io.Discard))
+	return NewRBACUsecase(repo, audit, access, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider()), repo, admins, audit
+}
+
+func TestRBACUsecase_CreateRoleValidatesKey(t *testing.T) {
+	uc, _, audit := newTestRBACUsecase()
+	ctx := adminCtx()
+
+	for _, key := range []string{"", "Ops", "1ops", "ops-team", "o"} {
+		if _, err := uc.CreateRole(ctx, key, "运维", "", nil); !errors.Is(err, ErrRoleKeyInvalid) {
+			t.Fatalf("CreateRole(%q) error = %v, want ErrRoleKeyInvalid", key, err)
+		}
+	}
+
+	role, err := uc.CreateRole(ctx, "ops", "运维", "", []string{PermissionUserRead, " ", PermissionUserRead})
+	if err != nil {
+		t.Fatalf("CreateRole() error = %v", err)
+	}
+	if role.Builtin || len(role.Permissions) != 1 || role.Permissions[0] != PermissionUserRead {
+		t.Fatalf("unexpected role %+v", role)
+	}
+	if len(audit.events) != 1 || audit.events[0].Action != AuditActionRoleCreate || audit.events[0].ActorID != 7 {
+		t.Fatalf("unexpected audit events %+v", audit.events)
+	}
+}
+
+func TestRBACUsecase_DeleteRoleRejectsBuiltin(t *testing.T) {
+	uc, repo, _ := newTestRBACUsecase()
+
+	if err := uc.DeleteRole(adminCtx(), 1); !errors.Is(err, ErrRoleBuiltin) {
+		t.Fatalf("DeleteRole(super_admin) error = %v, want ErrRoleBuiltin", err)
+	}
+	if _, ok := repo.roles[1]; !ok {
+		t.Fatalf("builtin role should not be deleted")
+	}
+	if err := uc.DeleteRole(adminCtx(), 99); !errors.Is(err, ErrRoleNotFound) {
+		t.Fatalf("DeleteRole(missing) error = %v, want ErrRoleNotFound", err)
+	}
+}
+
+func TestRBACUsecase_SuperAdminKeepsAllPermissions(t *testing.T) {
+	uc, repo, _ := newTestRBACUsecase()
+
+	keys := AdminPermissionKeys()
+	if _, err := uc.SetRolePermissions(adminCtx(), 1, keys[1:]); !errors.Is(err, ErrSuperAdminPermissions) {
+		t.Fatalf("SetRolePermissions() error = %v, want ErrSuperAdminPermissions", err)
+	}
+	if repo.setCalls != 0 {
+		t.Fatalf("repo should not be called when super_admin loses permissions")
+	}
+
+	if _, err := uc.SetRolePermissions(adminCtx(), 1, keys); err != nil {
+		t.Fatalf("SetRolePermissions(all) error = %v", err)
+	}
+}
+
+func TestApplyRoleInheritance(t *testing.T) {
+	roles := []RBACRoleSummary{
+		{Key: "viewer", Permissions: []string{PermissionUserRead}},
+		{Key: "ops", Permissions: []string{PermissionUserWrite, "!" + PermissionInviteWrite}, Parents: []string{"viewer"}},
+		{Key: "ops_lead", Permissions: []string{PermissionUserRead}, Parents: []string{"ops"}},
+	}
+	ApplyRoleInheritance(roles)
+
+	got := roles[2].InheritedPermissions
+	want := []string{"!" + PermissionInviteWrite, PermissionUserWrite}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("ops_lead inherited = %v, want %v", got, want)
+	}
+	if len(roles[0].InheritedPermissions) != 0 {
+		t.Fatalf("viewer inherited = %v, want none", roles[0].InheritedPermissions)
+	}
+}
+
+func TestRBACUsecase_SetRoleParentsRejectsCycle(t *testing.T) {
+	uc, repo, audit := newTestRBACUsecase()
+	repo.roles[2] = &RBACRoleSummary{ID: 2, Key: "ops"}
+	repo.roles[3] = &RBACRoleSummary{ID: 3, Key: "ops_lead", Parents: []string{"ops"}}
+
+	if _, err := uc.SetRoleParents(adminCtx(), 2, []string{"ops_lead"}); !errors.Is(err, ErrRoleCycle) {
+		t.Fatalf("SetRoleParents(cycle) error = %v, want ErrRoleCycle", err)
+	}
+	if _, err := uc.SetRoleParents(adminCtx(), 2, []string{"ops"}); !errors.Is(err, ErrRoleCycle) {
+		t.Fatalf("SetRoleParents(self) error = %v, want ErrRoleCycle", err)
+	}
+	if _, err := uc.SetRoleParents(adminCtx(), 2, []string{"missing"}); !errors.Is(err, ErrRoleNotFound) {
+		t.Fatalf("SetRoleParents(missing) error = %v, want ErrRoleNotFound", err)
+	}
+
+	role, err := uc.SetRoleParents(adminCtx(), 3, []string{" ops ", SuperAdminRoleKey, "ops"})
+	if err != nil {
+		t.Fatalf("SetRoleParents() error = %v", err)
+	}
+	if len(role.Parents) != 2 || role.Parents[0] != "ops" || role.Parents[1] != SuperAdminRoleKey {
+		t.Fatalf("parents = %v, want normalized [ops super_admin]", role.Parents)
+	}
+	if n := len(audit.events); n != 1 || audit.events[0].Action != AuditActionRoleSetParents {
+		t.Fatalf("unexpected audit events %+v", audit.events)
+	}
+}
+
+func TestRBACUsecase_SuperAdminCannotHaveParents(t *testing.T) {
+	uc, repo, _ := newTestRBACUsecase()
+	repo.roles[2] = &RBACRoleSummary{ID: 2, Key: "restricted", Permissions: []string{"!" + PermissionAccountWrite}}
+
+	if _, err := uc.SetRoleParents(adminCtx(), 1, []string{"restricted"}); !errors.Is(err, ErrSuperAdminPermissions) {
+		t.Fatalf("SetRoleParents(super_admin) error = %v, want ErrSuperAdminPermissions", err)
+	}
+	if len(repo.roles[1].Parents) != 0 {
+		t.Fatalf("super_admin parents = %v, want none", repo.roles[1].Parents)
+	}
+	if _, err := uc.SetRoleParents(adminCtx(), 1, nil); err != nil {
+		t.Fatalf("SetRoleParents(super_admin, none) error = %v", err)
+	}
+}
+
+func TestRBACUsecase_RoleEditRejectsEscalation(t *testing.T) {
+	uc, repo, admins, _ := newTestRBACUsecaseWithAdmins()
+	lead := []string{PermissionAdminAccess, PermissionRBACWrite, PermissionUserRead}
+	repo.roles[2] = &RBACRoleSummary{ID: 2, Key: "rbac_lead", Name: "权限管理", Permissions: lead}
+	repo.roles[3] = &RBACRoleSummary{ID: 3, Key: "accounts", Name: "账号管理", Permissions: []string{PermissionAccountWrite}}
+	repo.roles[4] = &RBACRoleSummary{ID: 4, Key: "ops", Name: "运营", Permissions: []string{PermissionUserRead}}
+	repo.roles[5] = &RBACRoleSummary{ID: 5, Key: "base", Name: "基础"}
+	repo.roles[6] = &RBACRoleSummary{ID: 6, Key: "root_extra", Name: "超管附加", Parents: []string{"base"}}
+	repo.nextID = 7
+	repo.bindings = []RBACPolicyBinding{
+		{AdminID: 7, Admin: "root", Roles: []string{SuperAdminRoleKey, "root_extra"}},
+		{AdminID: 9, Admin: "lead", Roles: []string{"rbac_lead"}},
+	}
+	admins.admins[9] = &AdminUser{ID: 9, Username: "lead", Roles: []string{"rbac_lead"}, Permissions: lead}
+	ctx := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 9, Username: "lead", Role: RoleAdmin})
+
+	if _, err := uc.SetRolePermissions(ctx, 2, append(slices.Clone(lead), PermissionAccountWrite)); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+		t.Fatalf("SetRolePermissions(own role widened) error = %v, want ErrAdminPrivilegeEscalation", err)
+	}
+	if _, err := uc.SetRoleParents(ctx, 2, []string{"accounts"}); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+		t.Fatalf("SetRoleParents(privileged parent) error = %v, want ErrAdminPrivilegeEscalation", err)
+	}
+	if _, err := uc.CreateRole(ctx, "wide", "越权", "", []string{"admin.*"}); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+		t.Fatalf("CreateRole(admin.*) error = %v, want ErrAdminPrivilegeEscalation", err)
+	}
+	// 超管持有的角色及其祖先可以借拒绝条目削掉超管权限，只能由超管修改。
+	for _, id := range []int{1, 5, 6} {
+		if _, err := uc.SetRolePermissions(ctx, id, []string{"!" + PermissionAccountWrite}); !errors.Is(err, ErrAdminPrivilegeEscalation) {
+			t.Fatalf("SetRolePermissions(role %d held by super admin) error = %v, want ErrAdminPrivilegeEscalation", id, err)
+		}
+	}
+	if repo.setCalls != 0 || len(repo.roles[2].Parents) != 0 {
+		t.Fatalf("rejected edits must not be saved, set_calls=%d parents=%v", repo.setCalls, repo.roles[2].Parents)
+	}
+
+	if _, err := uc.SetRoleParents(ctx, 4, []string{"rbac_lead"}); err != nil {
+		t.Fatalf("SetRoleParents(held permissions) error = %v", err)
+	}
+	if _, err := uc.CreateRole(ctx, "readers", "只读", "", []string{PermissionUserRead}); err != nil {
+		t.Fatalf("CreateRole(held permissions) error = %v", err)
+	}
+	if _, err := uc.SetRolePermissions(adminCtx(), 6, []string{PermissionAccountWrite}); err != nil {
+		t.Fatalf("SetRolePermissions(super admin operator) error = %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+// server/internal/biz/role_inheritance.go
+package biz
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// 角色可以声明若干父角色，继承父角色（及其祖先）的全部权限条目，拒绝条目同样继承。
+// 继承关系必须是无环图：写入前由 RBACUsecase 校验，写入事务内再由 repo 兜底。
+
+// ApplyRoleInheritance 按 Parents 传递计算每个角色继承来的权限条目，写入 InheritedPermissions。
+// 已在角色自身 Permissions 里的条目不重复列出；遇到历史脏数据里的环也能正常结束。
+func ApplyRoleInheritance(roles []RBACRoleSummary) {
+	byKey := make(map[string]int, len(roles))
+	for i, role := range roles {
+		byKey[role.Key] = i
+	}
+
+	for i := range roles {
+		direct := make(map[string]bool, len(roles[i].Permissions))
+		for _, p := range roles[i].Permissions {
+			direct[p] = true
+		}
+
+		inherited := map[string]bool{}
+		visited := map[string]bool{roles[i].Key: true}
+		stack := append([]string(nil), roles[i].Parents...)
+		for len(stack) > 0 {
+			key := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+			j, ok := byKey[key]
+			if !ok {
+				continue
+			}
+			for _, p := range roles[j].Permissions {
+				if !direct[p] {
+					inherited[p] = true
+				}
+			}
+			stack = append(stack, roles[j].Parents...)
+		}
+
+		out := make([]string, 0, len(inherited))
+		for p := range inherited {
+			out = append(out, p)
+		}
+		sort.Strings(out)
+		roles[i].InheritedPermissions = out
+	}
+}
+
+// roleInheritanceHasCycle 判断把 roleKey 的父角色换成 parents 之后是否成环（含把自己设为父角色）。
+func roleInheritanceHasCycle(roles []RBACRoleSummary, roleKey string, parents []string) bool {
+	parentsOf := make(map[string][]string, len(roles))
+	for _, role := range roles {
+		parentsOf[role.Key] = role.Parents
+	}
+	parentsOf[roleKey] = parents
+
+	visited := map[string]bool{}
+	stack := append([]string(nil), parents...)
+	for len(stack) > 0 {
+		key := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if key == roleKey {
+			return true
+		}
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+		stack = append(stack, parentsOf[key]...)
+	}
+	return false
+}
+
+// normalizeRoleKeys 去空白、去重并排序，角色 key 列表（管理员角色、父角色）统一按此处理。
+func normalizeRoleKeys(keys []string) []string {
+	seen := make(map[string]bool, len(keys))
+	out := make([]string, 0, len(keys))
+	for _, k := range keys {
+		k = strings.TrimSpace(k)
+		if k == "" || seen[k] {
+			continue
+		}
+		seen[k] = true
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// roleAncestors 返回 keys 及其全部祖先角色。
+func roleAncestors(roles []RBACRoleSummary, keys []string) map[string]bool {
+	parents := make(map[string][]string, len(roles))
+	for _, role := range roles {
+		parents[role.Key] = role.Parents
+	}
+	out := map[string]bool{}
+	stack := slices.Clone(keys)
+	for len(stack) > 0 {
+		k := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if out[k] {
+			continue
+		}
+		out[k] = true
+		stack = append(stack, parents[k]...)
+	}
+	return out
+}
@@ -0,0 +1,209 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type memUserAdminRepo struct {
+	disabled map[int]bool
+	filtered []int
+	users    []*User
+}
+
+// ListUsers 忽略筛选与排序，游标即下一页起始下标。
+func (r *memUserAdminRepo) ListUsers(ctx context.Context, q UserListQuery) (*UserListPage, error) {
+	start, _ := strconv.Atoi(q.Cursor)
+	end := min(start+q.Limit, len(r.users))
+	page := &UserListPage{Users: r.users[start:end]}
+	if end < len(r.users) {
+		page.NextCursor = strconv.Itoa(end)
+	}
+	return page, nil
+}
+
+func (r *memUserAdminRepo) SetUserDisabled(ctx context.Context, userID int, disabled bool) error {
+	r.disabled[userID] = disabled
+	return nil
+}
+
+func (r *memUserAdminRepo) ListUserIDs(ctx context.Context, f UserListFilter, limit int) ([]int, error) {
+	if len(r.filtered) > limit {
+		return r.filtered[:limit], nil
+	}
+	return r.filtered, nil
+}
+
+func (r *memUserAdminRepo) BulkSetUserDisabled(ctx context.Context, ids []int, disabled, dryRun bool) ([]UserBulkOutcome, error) {
+	out := make([]UserBulkOutcome, 0, len(ids))
+	for _, id := range ids {
+		cur, ok := r.disabled[id]
+		switch {
+		case !ok:
+			out = append(out, UserBulkOutcome{UserID: id, Status: UserBulkNotFound})
+		case cur == disabled:
+			out = append(out, UserBulkOutcome{UserID: id, Status: UserBulkUnchanged})
+		default:
+			out = append(out, UserBulkOutcome{UserID: id, Status: UserBulkChanged})
+			if !dryRun {
+				r.disabled[id] = disabled
+			}
+		}
+	}
+	return out, nil
+}
+
+func TestUserListQuery_Validate(t *testing.T) {
+	q := UserListQuery{PageRequest: PageRequest{Limit: 500, Offset: -3}, Filter: UserListFilter{Search: "  bob "}}
+	if err := q.Validate(); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if q.Limit != 200 || q.Offset != 0 || q.Filter.Search != "bob" {
+		t.Fatalf("unexpected defaults: %+v", q)
+	}
+	if q.Sort.Field != UserSortID || !q.Sort.Desc || q.Filter.SearchMode != UserSearchContains {
+		t.Fatalf("expected default sort id desc, got %+v", q.Sort)
+	}
+
+	fuzzy := UserListQuery{Filter: UserListFilter{Search: "bob", SearchMode: UserSearchFuzzy}}
+	if err := fuzzy.Validate(); err != nil || fuzzy.Sort.Field != UserSortRelevance || !fuzzy.Sort.Desc {
+		t.Fatalf("fuzzy search should default to relevance desc, got %+v err=%v", fuzzy.Sort, err)
+	}
+	fuzzy = UserListQuery{PageRequest: PageRequest{UseCursor: true}, Filter: UserListFilter{Search: "bob", SearchMode: UserSearchFuzzy}}
+	if err := fuzzy.Validate(); err != nil || fuzzy.Sort.Field != UserSortID {
+		t.Fatalf("fuzzy search with cursor should default to id, got %+v err=%v", fuzzy.Sort, err)
+	}
+
+	from := time.Unix(1700000000, 0)
+	to := from.Add(-time.Hour)
+	many := make([]int, UserListMaxIDs+1)
+	for i := range many {
+		many[i] = i + 1
+	}
+	bad := []UserListQuery{
+		{Sort: UserListSort{Field: "password_hash"}},
+		{Filter: UserListFilter{IDs: []int{1, 0}}},
+		{Filter: UserListFilter{IDs: many}},
+		{Filter: UserListFilter{CreatedFrom: &from, CreatedTo: &to}},
+		{Filter: UserListFilter{LastLoginFrom: &from, LastLoginTo: &from}},
+		{Filter: UserListFilter{NeverLoggedIn: true, LastLoginTo: &from}},
+		{Filter: UserListFilter{Search: "bob", SearchMode: "regex"}},
+		{Filter: UserListFilter{Search: "bob"}, Sort: UserListSort{Field: UserSortRelevance, Desc: true}},
+		{Filter: UserListFilter{Search: "bob", SearchMode: UserSearchFuzzy}, Sort: UserListSort{Field: UserSortRelevance}},
+		{PageRequest: PageRequest{UseCursor: true}, Filter: UserListFilter{Search: "bob", SearchMode: UserSearchFuzzy}, Sort: UserListSort{Field: UserSortRelevance, Desc: true}},
+	}
+	for i, q := range bad {
+		if err := q.Validate(); !errors.Is(err, ErrUserListInvalid) {
+			t.Fatalf("case %d: expected ErrUserListInvalid, got %v", i, err)
+		}
+	}
+}
+
+func TestUserAdminUsecase_BulkSetDisabled(t *testing.T) {
+	repo := &memUserAdminRepo{disabled: map[int]bool{1: false, 2: true, 3: false}}
+	audit := &memAuditRepo{}
+	uc := NewUserAdminUsecase(repo, audit, log.NewStdLogger(io.Discard), nil)
+	ctx := adminCtx()
+
+	res, err := uc.BulkSetDisabled(ctx, UserBulkSetDisabledRequest{IDs: []int{1, 2, 9, 1}, Disabled: true, DryRun: true})
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if res.Matched != 2 || res.Changed != 1 || len(res.Outcomes) != 3 || repo.disabled[1] {
+		t.Fatalf("unexpected dry run result: %+v disabled=%v", res, repo.disabled)
+	}
+	if len(audit.events) != 0 {
+		t.Fatalf("dry run must not write audit, got %d events", len(audit.events))
+	}
+
+	res, err = uc.BulkSetDisabled(ctx, UserBulkSetDisabledRequest{IDs: []int{1, 2, 9}, Disabled: true})
+	if err != nil || res.Changed != 1 || !repo.disabled[1] {
+		t.Fatalf("unexpected result: %+v err=%v", res, err)
+	}
+	if res.Outcomes[2].Status != UserBulkNotFound {
+		t.Fatalf("expected missing id to be not_found, got %+v", res.Outcomes[2])
+	}
+	if len(audit.events) != 1 || audit.events[0].Action != AuditActionUserBulkSetDisabled {
+		t.Fatalf("expected one bulk audit event, got %+v", audit.events)
+	}
+}
+
+func TestUserAdminUsecase_BulkSetDisabledRejects(t *testing.T) {
+	many := make([]int, UserBulkMaxTargets+1)
+	for i := range many {
+		many[i] = i + 1
+	}
+	repo := &memUserAdminRepo{disabled: map[int]bool{}, filtered: many}
+	uc := NewUserAdminUsecase(repo, nil, log.NewStdLogger(io.Discard), nil)
+	ctx := adminCtx()
+	yes := true
+
+	cases := []struct {
+		req  UserBulkSetDisabledRequest
+		want error
+	}{
+		{UserBulkSetDisabledRequest{}, ErrUserListInvalid},
+		{UserBulkSetDisabledRequest{IDs: []int{1}, Filter: &UserListFilter{Disabled: &yes}}, ErrUserListInvalid},
+		{UserBulkSetDisabledRequest{Filter: &UserListFilter{}}, ErrUserListInvalid},
+		{UserBulkSetDisabledRequest{IDs: []int{0}}, ErrUserListInvalid},
+		{UserBulkSetDisabledRequest{IDs: many}, ErrUserBulkTooMany},
+		{UserBulkSetDisabledRequest{Filter: &UserListFilter{Disabled: &yes}}, ErrUserBulkTooMany},
+	}
+	for i, c := range cases {
+		if _, err := uc.BulkSetDisabled(ctx, c.req); !errors.Is(err, c.want) {
+			t.Fatalf("case %d: expected %v, got %v", i, c.want, err)
+		}
+	}
+
+	if _, err := uc.BulkSetDisabled(context.Background(), UserBulkSetDisabledRequest{IDs: []int{1}}); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden without admin claims, got %v", err)
+	}
+}
+
+func TestUserAdminUsecase_ExportUsers(t *testing.T) {
+	repo := &memUserAdminRepo{}
+	for i := 1; i <= userExportBatchSize*2+5; i++ {
+		repo.users = append(repo.users, &User{ID: i})
+	}
+	audit := &memAuditRepo{}
+	uc := NewUserAdminUsecase(repo, audit, log.NewStdLogger(io.Discard), nil)
+	ctx := adminCtx()
+
+	var got []int
+	n, err := uc.ExportUsers(ctx, UserListQuery{}, "csv", func(u *User) error {
+		got = append(got, u.ID)
+		return nil
+	})
+	if err != nil || n != len(repo.users) || len(got) != n || got[n-1] != n {
+		t.Fatalf("unexpected export n=%d len=%d err=%v", n, len(got), err)
+	}
+	if len(audit.events) != 1 || audit.events[0].Action != AuditActionUserExport ||
+		audit.events[0].Detail["rows"] != n || audit.events[0].Detail["completed"] != true {
+		t.Fatalf("unexpected audit events: %+v", audit.events)
+	}
+
+	// 写出失败（例如客户端断开）时停止读取，审计记录实际行数。
+	stop := errors.New("client gone")
+	n, err = uc.ExportUsers(ctx, UserListQuery{}, "xlsx", func(u *User) error {
+		if u.ID == 3 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || n != 2 {
+		t.Fatalf("expected stop after 2 rows, got n=%d err=%v", n, err)
+	}
+	if e := audit.events[1]; e.Detail["rows"] != 2 || e.Detail["completed"] != false || e.Detail["format"] != "xlsx" {
+		t.Fatalf("unexpected audit detail: %+v", e.Detail)
+	}
+
+	if _, err := uc.ExportUsers(context.Background(), UserListQuery{}, "csv", func(*User) error { return nil }); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden without admin claims, got %v", err)
+	}
+}
@@ -0,0 +1,210 @@
+// server/internal/biz/user_data_export.go
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const AuditActionUserExportData = "user.export_data"
+
+// UserDataExporter 是保存了用户数据、需要出现在“导出我的数据”里的模块。
+type UserDataExporter interface {
+	// UserDataSection 是该模块在导出包里的 key，全局唯一。
+	UserDataSection() string
+	// ExportUserData 返回该模块保存的关于 userID 的全部数据，需能被 encoding/json 序列化；没有数据时返回 nil。
+	ExportUserData(ctx context.Context, userID int) (any, error)
+}
+
+// UserDataExport 是一次导出的结果，Sections 的 key 为 UserDataSection。
+type UserDataExport struct {
+	UserID      int
+	GeneratedAt time.Time
+	Sections    map[string]any
+}
+
+// UserDataUsecase 汇总各模块登记的 UserDataExporter，生成账号数据导出包。
+type UserDataUsecase struct {
+	mu        sync.RWMutex
+	exporters map[string]UserDataExporter
+
+	audit  AuditRepo
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+// NewUserDataUsecase 登记内置模块；新增保存用户数据的模块时实现 UserDataExporter 并在这里加上。
+func NewUserDataUsecase(
+	auth *AuthUsecase,
+	profile *UserProfileUsecase,
+	logins *LoginHistoryUsecase,
+	roles *UserRBACUsecase,
+	organizations *OrganizationUsecase,
+	invites *InviteUsecase,
+	verification *VerificationUsecase,
+	audit AuditRepo,
+	logger log.Logger,
+	tp *tracesdk.TracerProvider,
+) *UserDataUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.userdata"))
+
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.userdata")
+	} else {
+		tr = otel.Tracer("biz.userdata")
+	}
+
+	uc := &UserDataUsecase{
+		exporters: map[string]UserDataExporter{},
+		audit:     audit,
+		log:       helper,
+		tracer:    tr,
+	}
+	uc.Register(auth, profile, logins, roles, organizations, invites, verification)
+	if audit != nil {
+		uc.Register(auditUserDataExporter{repo: audit})
+	}
+	return uc
+}
+
+const auditExportBatch = 500
+
+// auditUserDataExporter 导出用户作为操作者或操作对象的审计记录。
+// 操作者是管理员或系统时只导出其类型，不导出管理员的 id 与用户名。
+type auditUserDataExporter struct {
+	repo AuditRepo
+}
+
+func (auditUserDataExporter) UserDataSection() string { return "audit_logs" }
+
+func (e auditUserDataExporter) ExportUserData(ctx context.Context, userID int) (any, error) {
+	out := []map[string]any{}
+	for afterID := 0; ; {
+		list, err := e.repo.ListUserAuditEvents(ctx, userID, afterID, auditExportBatch)
+		if err != nil {
+			return nil, err
+		}
+		for _, ev := range list {
+			row := map[string]any{
+				"action":      ev.Action,
+				"actor_kind":  ev.ActorKind,
+				"target_kind": ev.TargetKind,
+				"target_id":   ev.TargetID,
+				"detail":      ev.Detail,
+				"request_id":  ev.RequestID,
+				"created_at":  ev.CreatedAt,
+			}
+			if ev.ActorKind == AuditActorUser && ev.ActorID == userID {
+				row["actor_id"] = ev.ActorID
+				row["actor_username"] = ev.ActorUsername
+			}
+			out = append(out, row)
+			afterID = ev.ID
+		}
+		if len(list) < auditExportBatch {
+			return out, nil
+		}
+	}
+}
+
+func (uc *UserDataUsecase) Tracer() trace.Tracer {
+	if uc.tracer != nil {
+		return uc.tracer
+	}
+	return otel.Tracer("biz.userdata")
+}
+
+// Register 登记参与导出的模块；section 为空或重复时 panic，属于编码错误。
+func (uc *UserDataUsecase) Register(exporters ...UserDataExporter) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	for _, e := range exporters {
+		section := e.UserDataSection()
+		if section == "" {
+			panic("UserDataUsecase.Register: empty section")
+		}
+		if _, dup := uc.exporters[section]; dup {
+			panic("UserDataUsecase.Register: duplicate section " + section)
+		}
+		uc.exporters[section] = e
+	}
+}
+
+// Sections 返回已登记的 section，按字母序。
+func (uc *UserDataUsecase) Sections() []string {
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+
+	out := make([]string, 0, len(uc.exporters))
+	for section := range uc.exporters {
+		out = append(out, section)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// ExportMine 导出当前用户的全部数据；任何一个模块失败都整体失败，避免给出不完整的导出包。
+func (uc *UserDataUsecase) ExportMine(ctx context.Context) (*UserDataExport, error) {
+	ctx, span := uc.Tracer().Start(ctx, "userdata.export_mine")
+	defer span.End()
+
+	l := uc.log.WithContext(ctx)
+
+	c, ok := GetClaimsFromContext(ctx)
+	if !ok || c == nil || c.UserID <= 0 || c.Role != RoleUser || c.IsImpersonated() {
+		span.SetStatus(codes.Error, ErrForbidden.Error())
+		return nil, ErrForbidden
+	}
+	span.SetAttributes(attribute.Int("user.id", c.UserID))
+
+	sections := uc.Sections()
+	out := &UserDataExport{
+		UserID:      c.UserID,
+		GeneratedAt: time.Now(),
+		Sections:    make(map[string]any, len(sections)),
+	}
+	for _, section := range sections {
+		uc.mu.RLock()
+		e := uc.exporters[section]
+		uc.mu.RUnlock()
+
+		data, err := e.ExportUserData(ctx, c.UserID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "export section failed")
+			l.Errorf("ExportMine section failed user_id=%d section=%s err=%v", c.UserID, section, err)
+			return nil, fmt.Errorf("export %s: %w", section, err)
+		}
+		out.Sections[section] = data
+	}
+
+	if uc.audit != nil {
+		if err := uc.audit.RecordAudit(context.WithoutCancel(ctx), &AuditEvent{
+			Action:        AuditActionUserExportData,
+			ActorKind:     AuditActorUser,
+			ActorID:       c.UserID,
+			ActorUsername: c.Username,
+			TargetKind:    "user",
+			TargetID:      c.UserID,
+			Detail:        map[string]any{"sections": sections},
+		}); err != nil {
+			l.Warnf("record export_data audit failed user_id=%d err=%v", c.UserID, err)
+		}
+	}
+
+	span.SetStatus(codes.Ok, "OK")
+	l.Infof("ExportMine success user_id=%d sections=%d", c.UserID, len(sections))
+	return out, nil
+}
@@ -0,0 +1,178 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type staticUserDataExporter struct {
+	section string
+	data    any
+	err     error
+}
+
+func (e staticUserDataExporter) UserDataSection() string { return e.section }
+
+func (e staticUserDataExporter) ExportUserData(ctx context.Context, userID int) (any, error) {
+	return e.data, e.err
+}
+
+type userAccessRepo struct {
+	UserRBACRepo
+}
+
+func (userAccessRepo) GetUserAccess(ctx context.Context, userID int) ([]string, []string, error) {
+	return []string{"pro"}, []string{UserPermissionPremium}, nil
+}
+
+type memberOrganizationRepo struct {
+	OrganizationRepo
+}
+
+func (memberOrganizationRepo) ListMemberOrganizations(ctx context.Context, kind string, memberID int) ([]OrganizationMembership, error) {
+	if kind != OrganizationMemberUser || memberID != 1 {
+		return nil, nil
+	}
+	return []OrganizationMembership{{Organization: Organization{ID: 3, Slug: "acme", Name: "Acme"}, JoinedAt: time.Now()}}, nil
+}
+
+func newTestUserDataUsecase(audit AuditRepo) *UserDataUsecase {
+	logger := log.NewStdLogger(io.Discard)
+	users := newMemAuthRepo()
+	_, _ = users.CreateUser(context.Background(), &User{Username: "alice", PasswordHash: "secret-hash", Email: "alice@example.com"})
+	profile, _ := newTestUserProfileUsecase(nil)
+	logins, _, _ := newTestLoginHistoryUsecase(nil)
+	invites := &memInviteRepo{redemptions: map[int][]InviteRedemption{
+		1: {{InviteCodeID: 5, Code: "ABCDEFGH23", RoleKey: "vip", CreatedAt: time.Now()}},
+	}}
+	codes := &memVerificationRepo{users: users, codes: []*VerificationCode{
+		{ID: 1, UserID: 1, Channel: VerificationChannelPhone, Target: "+8613800000000", CodeHash: "code-hash", ExpiresAt: time.Now().Add(time.Minute)},
+	}}
+
+	return NewUserDataUsecase(
+		NewAuthUsecase(users, nil, nil, logger, nil),
+		profile,
+		logins,
+		NewUserRBACUsecase(userAccessRepo{}, nil, logger, nil),
+		NewOrganizationUsecase(memberOrganizationRepo{}, users, nil, nil, nil, nil, nil, logger, nil),
+		NewInviteUsecase(invites, nil, logger, nil),
+		NewVerificationUsecase(codes, users, nil, nil, nil, logger, nil),
+		audit,
+		logger,
+		nil,
+	)
+}
+
+func TestUserDataUsecase_ExportMine(t *testing.T) {
+	audit := &memAuditRepo{}
+	uc := newTestUserDataUsecase(audit)
+	uc.Register(staticUserDataExporter{section: "app_settings", data: map[string]any{"theme": "dark"}})
+
+	if got := strings.Join(uc.Sections(), ","); got != "account,app_settings,audit_logs,invite_redemptions,login_history,organizations,profile,roles,verification_codes" {
+		t.Fatalf("Sections() = %s", got)
+	}
+
+	if _, err := uc.ExportMine(adminCtx()); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("admin token should be forbidden, got %v", err)
+	}
+
+	alice := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 1, Username: "alice", Role: RoleUser})
+	export, err := uc.ExportMine(alice)
+	if err != nil {
+		t.Fatalf("ExportMine() error = %v", err)
+	}
+	if export.UserID != 1 || time.Since(export.GeneratedAt) > time.Minute || len(export.Sections) != 9 {
+		t.Fatalf("unexpected export %+v", export)
+	}
+	account, _ := export.Sections["account"].(map[string]any)
+	if account["username"] != "alice" || account["email"] != "alice@example.com" {
+		t.Fatalf("unexpected account section %v", account)
+	}
+	if _, ok := account["password_hash"]; ok {
+		t.Fatal("account section must not contain the password hash")
+	}
+	profile, _ := export.Sections["profile"].(map[string]any)
+	if attrs, _ := profile["attributes"].(map[string]any); attrs["removed_key"] != "kept" {
+		t.Fatalf("profile should export stored attributes as-is, got %v", profile)
+	}
+
+	if len(audit.events) != 1 || audit.events[0].Action != AuditActionUserExportData || audit.events[0].ActorKind != AuditActorUser {
+		t.Fatalf("unexpected audit events %+v", audit.events)
+	}
+}
+
+func TestUserDataUsecase_ExportBuiltinSections(t *testing.T) {
+	audit := &memAuditRepo{events: []AuditEvent{
+		{Action: "user.update_profile", ActorKind: AuditActorAdmin, ActorID: 9, ActorUsername: "root", TargetKind: "user", TargetID: 1},
+		{Action: "user.update_profile", ActorKind: AuditActorAdmin, ActorID: 9, ActorUsername: "root", TargetKind: "user", TargetID: 2},
+		{Action: AuditActionUserExportData, ActorKind: AuditActorUser, ActorID: 1, ActorUsername: "alice", TargetKind: "user", TargetID: 1},
+	}}
+	uc := newTestUserDataUsecase(audit)
+
+	alice := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 1, Username: "alice", Role: RoleUser})
+	export, err := uc.ExportMine(alice)
+	if err != nil {
+		t.Fatalf("ExportMine() error = %v", err)
+	}
+
+	orgs, _ := export.Sections["organizations"].([]map[string]any)
+	if len(orgs) != 1 || orgs[0]["slug"] != "acme" {
+		t.Fatalf("unexpected organizations section %v", export.Sections["organizations"])
+	}
+	invites, _ := export.Sections["invite_redemptions"].([]map[string]any)
+	if len(invites) != 1 || invites[0]["code"] != "ABCDEFGH23" || invites[0]["role"] != "vip" {
+		t.Fatalf("unexpected invite_redemptions section %v", export.Sections["invite_redemptions"])
+	}
+	codes, _ := export.Sections["verification_codes"].([]map[string]any)
+	if len(codes) != 1 || codes[0]["target"] != "+8613800000000" {
+		t.Fatalf("unexpected verification_codes section %v", export.Sections["verification_codes"])
+	}
+	if _, ok := codes[0]["code_hash"]; ok {
+		t.Fatal("verification section must not contain the code hash")
+	}
+
+	logs, _ := export.Sections["audit_logs"].([]map[string]any)
+	if len(logs) != 2 {
+		t.Fatalf("expected the two audit rows about alice, got %v", logs)
+	}
+	if _, ok := logs[0]["actor_username"]; ok {
+		t.Fatalf("admin identity must not be exported, got %v", logs[0])
+	}
+	if logs[1]["actor_username"] != "alice" {
+		t.Fatalf("the user's own actions should keep the actor, got %v", logs[1])
+	}
+}
+
+func TestUserDataUsecase_ExportFailsOnSectionError(t *testing.T) {
+	uc := newTestUserDataUsecase(nil)
+	boom := errors.New("boom")
+	uc.Register(staticUserDataExporter{section: "broken", err: boom})
+
+	alice := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 1, Role: RoleUser})
+	if _, err := uc.ExportMine(alice); !errors.Is(err, boom) {
+		t.Fatalf("expected section error, got %v", err)
+	}
+}
+
+func TestUserDataUsecase_RegisterPanics(t *testing.T) {
+	uc := newTestUserDataUsecase(nil)
+	for _, e := range []UserDataExporter{
+		staticUserDataExporter{section: ""},
+		staticUserDataExporter{section: "profile"},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("expected panic for section %q", e.UserDataSection())
+				}
+			}()
+			uc.Register(e)
+		}()
+	}
+}
@@ -0,0 +1,451 @@
+// server/internal/biz/user_deletion.go
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const PermissionUserDelete = "admin.user.delete"
+
+var _ = RegisterAdminPermissions(AdminPermission{
+	Key:         PermissionUserDelete,
+	Name:        "删除账号",
+	Group:       "账号",
+	Description: "允许软删除普通用户账号，以及在保留期内恢复",
+})
+
+// ErrUserRestoreConflict 恢复时原用户名已被其他账号占用（仅释放用户名的策略下会出现）。
+var ErrUserRestoreConflict = errors.New("username taken, user cannot be restored")
+
+const (
+	AuditActionUserDelete  = "user.delete"
+	AuditActionUserRestore = "user.restore"
+	AuditActionUserPurge   = "user.purge"
+
+	// 用户自助注销：申请、撤销，以及宽限期满后的抹除。
+	AuditActionUserRequestDeletion = "user.request_deletion"
+	AuditActionUserCancelDeletion  = "user.cancel_deletion"
+	AuditActionUserErase           = "user.erase"
+)
+
+// DeletedUsernamePolicy 决定软删除后用户名是否立即释放。
+type DeletedUsernamePolicy string
+
+const (
+	// DeletedUsernameTombstone 用户名保留到匿名化为止，期间不能被重新注册，恢复总能成功。
+	DeletedUsernameTombstone DeletedUsernamePolicy = "tombstone"
+	// DeletedUsernameRelease 删除时立即释放用户名；恢复时如果已被占用则失败。
+	DeletedUsernameRelease DeletedUsernamePolicy = "release"
+)
+
+const (
+	defaultDeletedUserRetention = 30 * 24 * time.Hour
+	defaultAccountDeletionGrace = 7 * 24 * time.Hour
+	// UserDeleteReasonMaxLen 删除原因的最大字符数，原因只写入审计。
+	UserDeleteReasonMaxLen = 256
+	// 清理任务每批匿名化的用户数。
+	userPurgeBatch = 100
+)
+
+// DeletedUserTombstone 是释放或匿名化后占位的用户名。含 "~"，通不过 ValidateUsername，不会与真实用户名冲突。
+func DeletedUserTombstone(id int) string {
+	return fmt.Sprintf("~deleted-%d", id)
+}
+
+// DeletedUser 是回收站中的一个用户；Username 为删除前的用户名。
+type DeletedUser struct {
+	ID               int
+	Username         string
+	UsernameReleased bool
+	Disabled         bool
+	DeletedAt        time.Time
+	CreatedAt        time.Time
+}
+
+type UserDeletionRepo interface {
+	// SoftDeleteUser 标记删除，releaseUsername 时把用户名换成 DeletedUserTombstone 并保留原名；
+	// 用户不存在、不在当前组织或已删除时返回 ErrUserNotFound。
+	SoftDeleteUser(ctx context.Context, userID int, at time.Time, releaseUsername bool) (*DeletedUser, error)
+	// RestoreUser 撤销删除并写回原用户名；不在回收站（未删除或已匿名化）时返回 ErrUserNotFound，
+	// 原用户名已被占用时返回 ErrUserRestoreConflict。
+	RestoreUser(ctx context.Context, userID int) (*User, error)
+	// ListDeletedUsers 按删除时间倒序列出尚未匿名化的已删除用户。
+	ListDeletedUsers(ctx context.Context, limit, offset int) ([]*DeletedUser, int, error)
+	// PurgeDeletedUsers 匿名化删除时间早于 before 的用户，最多 limit 个，返回被匿名化的用户 id。不受组织范围限制。
+	PurgeDeletedUsers(ctx context.Context, before time.Time, limit int) ([]int, error)
+
+	// ScheduleUserDeletion 记录自助注销的抹除时间并返回生效的时间；已申请过时保持原时间不变。
+	ScheduleUserDeletion(ctx context.Context, userID int, at time.Time) (time.Time, error)
+	// CancelUserDeletion 撤销自助注销，没有待执行的申请时返回 false。
+	CancelUserDeletion(ctx context.Context, userID int) (bool, error)
+	// EraseScheduledUsers 把抹除时间不晚于 now 的用户标记删除并立即匿名化，最多 limit 个；
+	// 已被管理员软删除的账号同样处理。不受组织范围限制。
+	EraseScheduledUsers(ctx context.Context, now time.Time, limit int) ([]int, error)
+}
+
+type UserDeletionUsecase struct {
+	repo   UserDeletionRepo
+	users  AuthRepo
+	audit  AuditRepo
+	policy *AuthPolicy
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewUserDeletionUsecase(repo UserDeletionRepo, users AuthRepo, audit AuditRepo, policy *AuthPolicy, logger log.Logger, tp *tracesdk.TracerProvider) *UserDeletionUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.userdeletion"))
+
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.userdeletion")
+	} else {
+		tr = otel.Tracer("biz.userdeletion")
+	}
+
+	return &UserDeletionUsecase{
+		repo:   repo,
+		users:  users,
+		audit:  audit,
+		policy: policy,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+func (uc *UserDeletionUsecase) Tracer() trace.Tracer {
+	if uc.tracer != nil {
+		return uc.tracer
+	}
+	return otel.Tracer("biz.userdeletion")
+}
+
+func (uc *UserDeletionUsecase) requireAdmin(ctx context.Context) (*AuthClaims, error) {
+	c, ok := GetClaimsFromContext(ctx)
+	if !ok || c == nil || c.Role != RoleAdmin {
+		return nil, ErrForbidden
+	}
+	return c, nil
+}
+
+// Retention 返回软删除用户的保留时长；0 表示不自动匿名化。
+func (uc *UserDeletionUsecase) Retention() time.Duration {
+	if uc.policy == nil || uc.policy.DeletedUserRetention == 0 {
+		return defaultDeletedUserRetention
+	}
+	if uc.policy.DeletedUserRetention < 0 {
+		return 0
+	}
+	return uc.policy.DeletedUserRetention
+}
+
+// DeletionGrace 返回自助注销的宽限期；0 表示下一次清理任务即抹除。
+func (uc *UserDeletionUsecase) DeletionGrace() time.Duration {
+	if uc.policy == nil || uc.policy.AccountDeletionGrace == 0 {
+		return defaultAccountDeletionGrace
+	}
+	if uc.policy.AccountDeletionGrace < 0 {
+		return 0
+	}
+	return uc.policy.AccountDeletionGrace
+}
+
+func (uc *UserDeletionUsecase) releaseUsername() bool {
+	return uc.policy != nil && uc.policy.DeletedUsername == DeletedUsernameRelease
+}
+
+// Delete 软删除用户：账号立即不可登录、不出现在任何查询里，保留期内可以恢复。
+func (uc *UserDeletionUsecase) Delete(ctx context.Context, userID int, reason string) (*DeletedUser, error) {
+	ctx, span := uc.Tracer().Start(ctx, "userdeletion.delete",
+		trace.WithAttributes(attribute.Int("user.id", userID)),
+	)
+	defer span.End()
+
+	l := uc.log.WithContext(ctx)
+
+	c, err := uc.requireAdmin(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		l.Warn("DeleteUser forbidden")
+		return nil, err
+	}
+	reason = strings.TrimSpace(reason)
+	if userID <= 0 || utf8.RuneCountInString(reason) > UserDeleteReasonMaxLen {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+
+	release := uc.releaseUsername()
+	d, err := uc.repo.SoftDeleteUser(ctx, userID, time.Now(), release)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		l.Warnf("DeleteUser failed operator_uid=%d user_id=%d err=%v", c.UserID, userID, err)
+		return nil, err
+	}
+
+	uc.record(ctx, c, AuditActionUserDelete, userID, map[string]any{
+		"username":          d.Username,
+		"username_released": d.UsernameReleased,
+		"reason":            reason,
+	})
+
+	span.SetStatus(codes.Ok, "OK")
+	l.Infof("DeleteUser success operator_uid=%d user_id=%d released=%v", c.UserID, userID, d.UsernameReleased)
+	return d, nil
+}
+
+// Restore 恢复保留期内的已删除用户；禁用状态、组织成员关系与角色保持删除前的样子。
+func (uc *UserDeletionUsecase) Restore(ctx context.Context, userID int) (*User, error) {
+	ctx, span := uc.Tracer().Start(ctx, "userdeletion.restore",
+		trace.WithAttributes(attribute.Int("user.id", userID)),
+	)
+	defer span.End()
+
+	l := uc.log.WithContext(ctx)
+
+	c, err := uc.requireAdmin(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		l.Warn("RestoreUser forbidden")
+		return nil, err
+	}
+	if userID <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+
+	u, err := uc.repo.RestoreUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		l.Warnf("RestoreUser failed operator_uid=%d user_id=%d err=%v", c.UserID, userID, err)
+		return nil, err
+	}
+
+	uc.record(ctx, c, AuditActionUserRestore, userID, map[string]any{"username": u.Username})
+
+	span.SetStatus(codes.Ok, "OK")
+	l.Infof("RestoreUser success operator_uid=%d user_id=%d", c.UserID, userID)
+	return u, nil
+}
+
+// ListDeleted 列出回收站中的用户。
+func (uc *UserDeletionUsecase) ListDeleted(ctx context.Context, limit, offset int) ([]*DeletedUser, int, error) {
+	ctx, span := uc.Tracer().Start(ctx, "userdeletion.list_deleted")
+	defer span.End()
+
+	if _, err := uc.requireAdmin(ctx); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, err
+	}
+	if limit <= 0 {
+		limit = 30
+	}
+	if limit > 200 {
+		limit = 200
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	list, total, err := uc.repo.ListDeletedUsers(ctx, limit, offset)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		uc.log.WithContext(ctx).Errorf("ListDeletedUsers failed err=%v", err)
+		return nil, 0, err
+	}
+	span.SetStatus(codes.Ok, "OK")
+	return list, total, nil
+}
+
+// PurgeExpired 匿名化超过保留期的已删除用户，每个用户写一条系统审计；由 JobServer 周期调用。
+func (uc *UserDeletionUsecase) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	retention := uc.Retention()
+	if retention <= 0 {
+		return 0, nil
+	}
+
+	ctx, span := uc.Tracer().Start(ctx, "userdeletion.purge")
+	defer span.End()
+
+	before := now.Add(-retention)
+	total := 0
+	for ctx.Err() == nil {
+		ids, err := uc.repo.PurgeDeletedUsers(ctx, before, userPurgeBatch)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "purge deleted users failed")
+			uc.log.WithContext(ctx).Errorf("PurgeDeletedUsers failed before=%s purged=%d err=%v", before.Format(time.RFC3339), total, err)
+			return total, err
+		}
+		for _, id := range ids {
+			uc.record(ctx, nil, AuditActionUserPurge, id, map[string]any{"deleted_before": before.Unix()})
+		}
+		total += len(ids)
+		if len(ids) < userPurgeBatch {
+			break
+		}
+	}
+
+	span.SetAttributes(attribute.Int("userdeletion.purged", total))
+	span.SetStatus(codes.Ok, "OK")
+	if total > 0 {
+		uc.log.WithContext(ctx).Infof("purged deleted users count=%d before=%s", total, before.Format(time.RFC3339))
+	}
+	return total, nil
+}
+
+// requireSelf 只允许普通用户本人操作；模拟登录 token 不能替用户注销。
+func (uc *UserDeletionUsecase) requireSelf(ctx context.Context) (*AuthClaims, error) {
+	c, ok := GetClaimsFromContext(ctx)
+	if !ok || c == nil || c.UserID <= 0 || c.Role != RoleUser || c.IsImpersonated() {
+		return nil, ErrForbidden
+	}
+	return c, nil
+}
+
+// RequestSelfDeletion 在校验密码后为当前用户预定抹除时间。宽限期内账号照常可用，
+// 可以用 CancelSelfDeletion 撤销；重复申请不会推迟原定时间。
+func (uc *UserDeletionUsecase) RequestSelfDeletion(ctx context.Context, password, reason string) (time.Time, error) {
+	ctx, span := uc.Tracer().Start(ctx, "userdeletion.request_self")
+	defer span.End()
+
+	l := uc.log.WithContext(ctx)
+
+	c, err := uc.requireSelf(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return time.Time{}, err
+	}
+	span.SetAttributes(attribute.Int("user.id", c.UserID))
+
+	reason = strings.TrimSpace(reason)
+	if password == "" || utf8.RuneCountInString(reason) > UserDeleteReasonMaxLen {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return time.Time{}, ErrBadParam
+	}
+
+	u, err := uc.users.GetUserByID(ctx, c.UserID)
+	if err != nil || u == nil {
+		span.SetStatus(codes.Error, ErrUserNotFound.Error())
+		l.Infof("RequestSelfDeletion user not found user_id=%d err=%v", c.UserID, err)
+		return time.Time{}, ErrUserNotFound
+	}
+	if bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) != nil {
+		span.SetStatus(codes.Error, ErrInvalidPassword.Error())
+		l.Infof("RequestSelfDeletion invalid password user_id=%d", c.UserID)
+		return time.Time{}, ErrInvalidPassword
+	}
+
+	at, err := uc.repo.ScheduleUserDeletion(ctx, c.UserID, time.Now().Add(uc.DeletionGrace()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		l.Errorf("RequestSelfDeletion failed user_id=%d err=%v", c.UserID, err)
+		return time.Time{}, err
+	}
+
+	uc.record(ctx, c, AuditActionUserRequestDeletion, c.UserID, map[string]any{
+		"scheduled_at": at.Unix(),
+		"reason":       reason,
+	})
+
+	span.SetStatus(codes.Ok, "OK")
+	l.Infof("RequestSelfDeletion success user_id=%d scheduled_at=%s", c.UserID, at.Format(time.RFC3339))
+	return at, nil
+}
+
+// CancelSelfDeletion 撤销当前用户的注销申请；返回 false 表示本来就没有申请。
+func (uc *UserDeletionUsecase) CancelSelfDeletion(ctx context.Context) (bool, error) {
+	ctx, span := uc.Tracer().Start(ctx, "userdeletion.cancel_self")
+	defer span.End()
+
+	c, err := uc.requireSelf(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return false, err
+	}
+
+	cancelled, err := uc.repo.CancelUserDeletion(ctx, c.UserID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		uc.log.WithContext(ctx).Errorf("CancelSelfDeletion failed user_id=%d err=%v", c.UserID, err)
+		return false, err
+	}
+	if cancelled {
+		uc.record(ctx, c, AuditActionUserCancelDeletion, c.UserID, nil)
+		uc.log.WithContext(ctx).Infof("CancelSelfDeletion success user_id=%d", c.UserID)
+	}
+
+	span.SetStatus(codes.Ok, "OK")
+	return cancelled, nil
+}
+
+// EraseScheduled 抹除宽限期已满的自助注销账号，每个用户写一条系统审计；由 JobServer 周期调用。
+func (uc *UserDeletionUsecase) EraseScheduled(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := uc.Tracer().Start(ctx, "userdeletion.erase")
+	defer span.End()
+
+	total := 0
+	for ctx.Err() == nil {
+		ids, err := uc.repo.EraseScheduledUsers(ctx, now, userPurgeBatch)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "erase scheduled users failed")
+			uc.log.WithContext(ctx).Errorf("EraseScheduledUsers failed erased=%d err=%v", total, err)
+			return total, err
+		}
+		for _, id := range ids {
+			uc.record(ctx, nil, AuditActionUserErase, id, nil)
+		}
+		total += len(ids)
+		if len(ids) < userPurgeBatch {
+			break
+		}
+	}
+
+	span.SetAttributes(attribute.Int("userdeletion.erased", total))
+	span.SetStatus(codes.Ok, "OK")
+	if total > 0 {
+		uc.log.WithContext(ctx).Infof("erased self-deleted users count=%d", total)
+	}
+	return total, nil
+}
+
+// record 写审计；c 为空表示系统任务。
+func (uc *UserDeletionUsecase) record(ctx context.Context, c *AuthClaims, action string, userID int, detail map[string]any) {
+	if uc.audit == nil {
+		return
+	}
+	e := &AuditEvent{
+		Action:     action,
+		ActorKind:  AuditActorSystem,
+		TargetKind: "user",
+		TargetID:   userID,
+		Detail:     detail,
+	}
+	if c != nil {
+		e.ActorKind, e.ActorID, e.ActorUsername = AuditActorAdmin, c.UserID, c.Username
+		if !c.IsAdmin() {
+			e.ActorKind = AuditActorUser
+		}
+	}
+	if err := uc.audit.RecordAudit(context.WithoutCancel(ctx), e); err != nil {
+		uc.log.WithContext(ctx).Warnf("record %s audit failed user_id=%d err=%v", action, userID, err)
+	}
+}
@@ -0,0 +1,336 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type memDeletedUserRow struct {
+	username    string
+	released    string
+	deletedAt   *time.Time
+	scheduledAt *time.Time
+	purged      bool
+}
+
+type memUserDeletionRepo struct {
+	users map[int]*memDeletedUserRow
+}
+
+func (r *memUserDeletionRepo) SoftDeleteUser(ctx context.Context, userID int, at time.Time, release bool) (*DeletedUser, error) {
+	u, ok := r.users[userID]
+	if !ok || u.deletedAt != nil {
+		return nil, ErrUserNotFound
+	}
+	d := &DeletedUser{ID: userID, Username: u.username, UsernameReleased: release, DeletedAt: at}
+	u.deletedAt = &at
+	if release {
+		u.released, u.username = u.username, DeletedUserTombstone(userID)
+	}
+	return d, nil
+}
+
+func (r *memUserDeletionRepo) RestoreUser(ctx context.Context, userID int) (*User, error) {
+	u, ok := r.users[userID]
+	if !ok || u.deletedAt == nil || u.purged {
+		return nil, ErrUserNotFound
+	}
+	if u.released != "" {
+		for id, other := range r.users {
+			if id != userID && other.username == u.released {
+				return nil, ErrUserRestoreConflict
+			}
+		}
+		u.username, u.released = u.released, ""
+	}
+	u.deletedAt = nil
+	return &User{ID: userID, Username: u.username}, nil
+}
+
+func (r *memUserDeletionRepo) ListDeletedUsers(ctx context.Context, limit, offset int) ([]*DeletedUser, int, error) {
+	var out []*DeletedUser
+	for id, u := range r.users {
+		if u.deletedAt != nil && !u.purged {
+			out = append(out, &DeletedUser{ID: id, Username: u.username, DeletedAt: *u.deletedAt})
+		}
+	}
+	return out, len(out), nil
+}
+
+func (r *memUserDeletionRepo) PurgeDeletedUsers(ctx context.Context, before time.Time, limit int) ([]int, error) {
+	var ids []int
+	for id, u := range r.users {
+		if u.deletedAt != nil && !u.purged && u.deletedAt.Before(before) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	for _, id := range ids {
+		r.users[id].purged = true
+	}
+	return ids, nil
+}
+
+func (r *memUserDeletionRepo) ScheduleUserDeletion(ctx context.Context, userID int, at time.Time) (time.Time, error) {
+	u, ok := r.users[userID]
+	if !ok || u.deletedAt != nil {
+		return time.Time{}, ErrUserNotFound
+	}
+	if u.scheduledAt == nil {
+		u.scheduledAt = &at
+	}
+	return *u.scheduledAt, nil
+}
+
+func (r *memUserDeletionRepo) CancelUserDeletion(ctx context.Context, userID int) (bool, error) {
+	u, ok := r.users[userID]
+	if !ok || u.scheduledAt == nil {
+		return false, nil
+	}
+	u.scheduledAt = nil
+	return true, nil
+}
+
+func (r *memUserDeletionRepo) EraseScheduledUsers(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	var ids []int
+	for id, u := range r.users {
+		if u.scheduledAt != nil && !u.purged && !u.scheduledAt.After(now) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	for _, id := range ids {
+		r.users[id].deletedAt, r.users[id].purged = &now, true
+	}
+	return ids, nil
+}
+
+func newTestUserDeletionUsecase(audit AuditRepo, policy *AuthPolicy) (*UserDeletionUsecase, *memUserDeletionRepo) {
+	repo := &memUserDeletionRepo{users: map[int]*memDeletedUserRow{
+		1: {username: "alice"},
+		2: {username: "bob"},
+	}}
+	users := newMemAuthRepo()
+	hash, _ := bcrypt.GenerateFromPassword([]byte("alicepw"), bcrypt.MinCost)
+	_, _ = users.CreateUser(context.Background(), &User{Username: "alice", PasswordHash: string(hash)})
+	return NewUserDeletionUsecase(repo, users, audit, policy, log.NewStdLogger(io.Discard), nil), repo
+}
+
+func TestUserDeletionUsecase_DeleteRestore(t *testing.T) {
+	audit := &memAuditRepo{}
+	uc, repo := newTestUserDeletionUsecase(audit, nil)
+
+	if _, err := uc.Delete(NewContextWithClaims(context.Background(), &AuthClaims{UserID: 1, Role: RoleUser}), 2, ""); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("user token should be forbidden, got %v", err)
+	}
+	if _, err := uc.Delete(adminCtx(), 1, strings.Repeat("字", UserDeleteReasonMaxLen+1)); !errors.Is(err, ErrBadParam) {
+		t.Fatalf("too long reason should be rejected, got %v", err)
+	}
+
+	d, err := uc.Delete(adminCtx(), 1, " 用户申请注销 ")
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if d.Username != "alice" || d.UsernameReleased || repo.users[1].username != "alice" {
+		t.Fatalf("tombstone policy should keep the username, got %+v", d)
+	}
+	if _, err := uc.Delete(adminCtx(), 1, ""); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("deleting twice should return ErrUserNotFound, got %v", err)
+	}
+
+	u, err := uc.Restore(adminCtx(), 1)
+	if err != nil || u.Username != "alice" {
+		t.Fatalf("Restore() = %+v, %v", u, err)
+	}
+	if _, err := uc.Restore(adminCtx(), 2); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("restoring an active user should return ErrUserNotFound, got %v", err)
+	}
+
+	if len(audit.events) != 2 {
+		t.Fatalf("expected 2 audit events, got %d", len(audit.events))
+	}
+	e := audit.events[0]
+	if e.Action != AuditActionUserDelete || e.TargetID != 1 || e.ActorKind != AuditActorAdmin || e.Detail["reason"] != "用户申请注销" {
+		t.Fatalf("unexpected delete audit %+v", e)
+	}
+	if audit.events[1].Action != AuditActionUserRestore {
+		t.Fatalf("unexpected restore audit %+v", audit.events[1])
+	}
+}
+
+func TestUserDeletionUsecase_ReleaseUsername(t *testing.T) {
+	uc, repo := newTestUserDeletionUsecase(nil, &AuthPolicy{DeletedUsername: DeletedUsernameRelease})
+
+	d, err := uc.Delete(adminCtx(), 1, "")
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if !d.UsernameReleased || repo.users[1].username != DeletedUserTombstone(1) {
+		t.Fatalf("release policy should free the username, got %+v", d)
+	}
+	if _, _, err := ValidateUsername(DeletedUserTombstone(1)); err == nil {
+		t.Fatal("tombstone username must not be a valid username")
+	}
+
+	// 用户名被他人注册后无法恢复。
+	repo.users[3] = &memDeletedUserRow{username: "alice"}
+	if _, err := uc.Restore(adminCtx(), 1); !errors.Is(err, ErrUserRestoreConflict) {
+		t.Fatalf("expected ErrUserRestoreConflict, got %v", err)
+	}
+	delete(repo.users, 3)
+	if u, err := uc.Restore(adminCtx(), 1); err != nil || u.Username != "alice" {
+		t.Fatalf("Restore() = %+v, %v", u, err)
+	}
+}
+
+func TestUserDeletionUsecase_Retention(t *testing.T) {
+	cases := []struct {
+		policy *AuthPolicy
+		want   time.Duration
+	}{
+		{nil, defaultDeletedUserRetention},
+		{&AuthPolicy{}, defaultDeletedUserRetention},
+		{&AuthPolicy{DeletedUserRetention: 48 * time.Hour}, 48 * time.Hour},
+		{&AuthPolicy{DeletedUserRetention: -1}, 0},
+	}
+	for _, tc := range cases {
+		uc, _ := newTestUserDeletionUsecase(nil, tc.policy)
+		if got := uc.Retention(); got != tc.want {
+			t.Fatalf("Retention() = %s, want %s (policy %+v)", got, tc.want, tc.policy)
+		}
+	}
+}
+
+func TestUserDeletionUsecase_PurgeExpired(t *testing.T) {
+	audit := &memAuditRepo{}
+	uc, repo := newTestUserDeletionUsecase(audit, &AuthPolicy{DeletedUserRetention: 24 * time.Hour})
+
+	now := time.Now()
+	old := now.Add(-48 * time.Hour)
+	recent := now.Add(-time.Hour)
+	repo.users = map[int]*memDeletedUserRow{}
+	for id := 1; id <= userPurgeBatch+5; id++ {
+		repo.users[id] = &memDeletedUserRow{username: "u", deletedAt: &old}
+	}
+	repo.users[1000] = &memDeletedUserRow{username: "recent", deletedAt: &recent}
+	repo.users[1001] = &memDeletedUserRow{username: "active"}
+
+	n, err := uc.PurgeExpired(context.Background(), now)
+	if err != nil {
+		t.Fatalf("PurgeExpired() error = %v", err)
+	}
+	if n != userPurgeBatch+5 {
+		t.Fatalf("purged %d users, want %d", n, userPurgeBatch+5)
+	}
+	if repo.users[1000].purged || repo.users[1001].purged {
+		t.Fatal("users within retention must not be purged")
+	}
+	if len(audit.events) != n {
+		t.Fatalf("expected %d audit events, got %d", n, len(audit.events))
+	}
+	if e := audit.events[0]; e.Action != AuditActionUserPurge || e.ActorKind != AuditActorSystem {
+		t.Fatalf("unexpected purge audit %+v", e)
+	}
+
+	disabled, repo := newTestUserDeletionUsecase(nil, &AuthPolicy{DeletedUserRetention: -1})
+	repo.users[1].deletedAt = &old
+	if n, err := disabled.PurgeExpired(context.Background(), now); err != nil || n != 0 || repo.users[1].purged {
+		t.Fatalf("negative retention should never purge, got n=%d err=%v", n, err)
+	}
+}
+
+func TestUserDeletionUsecase_SelfDeletion(t *testing.T) {
+	audit := &memAuditRepo{}
+	uc, repo := newTestUserDeletionUsecase(audit, &AuthPolicy{AccountDeletionGrace: 48 * time.Hour})
+	alice := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 1, Username: "alice", Role: RoleUser})
+
+	if _, err := uc.RequestSelfDeletion(alice, "wrong", ""); !errors.Is(err, ErrInvalidPassword) {
+		t.Fatalf("wrong password should be rejected, got %v", err)
+	}
+	if _, err := uc.RequestSelfDeletion(adminCtx(), "alicepw", ""); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("admin token should be forbidden, got %v", err)
+	}
+	impersonated := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 1, Role: RoleUser, ActorID: 7})
+	if _, err := uc.RequestSelfDeletion(impersonated, "alicepw", ""); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("impersonated token should be forbidden, got %v", err)
+	}
+
+	before := time.Now()
+	at, err := uc.RequestSelfDeletion(alice, "alicepw", "不再使用")
+	if err != nil {
+		t.Fatalf("RequestSelfDeletion() error = %v", err)
+	}
+	if at.Before(before.Add(48*time.Hour)) || at.After(time.Now().Add(48*time.Hour)) {
+		t.Fatalf("scheduled at %s, want now + 48h", at)
+	}
+	again, err := uc.RequestSelfDeletion(alice, "alicepw", "")
+	if err != nil || !again.Equal(at) {
+		t.Fatalf("repeated request should keep the original time, got %s err=%v", again, err)
+	}
+
+	if n, err := uc.EraseScheduled(context.Background(), time.Now()); err != nil || n != 0 {
+		t.Fatalf("nothing should be erased within the grace period, got n=%d err=%v", n, err)
+	}
+
+	cancelled, err := uc.CancelSelfDeletion(alice)
+	if err != nil || !cancelled || repo.users[1].scheduledAt != nil {
+		t.Fatalf("CancelSelfDeletion() = %v, %v", cancelled, err)
+	}
+	if cancelled, _ := uc.CancelSelfDeletion(alice); cancelled {
+		t.Fatal("cancelling twice should report false")
+	}
+
+	if _, err := uc.RequestSelfDeletion(alice, "alicepw", ""); err != nil {
+		t.Fatalf("RequestSelfDeletion() error = %v", err)
+	}
+	n, err := uc.EraseScheduled(context.Background(), time.Now().Add(49*time.Hour))
+	if err != nil || n != 1 || !repo.users[1].purged {
+		t.Fatalf("EraseScheduled() = %d, %v", n, err)
+	}
+
+	var actions []string
+	for _, e := range audit.events {
+		actions = append(actions, e.Action)
+	}
+	want := []string{AuditActionUserRequestDeletion, AuditActionUserRequestDeletion, AuditActionUserCancelDeletion, AuditActionUserRequestDeletion, AuditActionUserErase}
+	if strings.Join(actions, ",") != strings.Join(want, ",") {
+		t.Fatalf("audit actions = %v, want %v", actions, want)
+	}
+	if e := audit.events[0]; e.ActorKind != AuditActorUser || e.ActorID != 1 || e.Detail["reason"] != "不再使用" {
+		t.Fatalf("unexpected request audit %+v", e)
+	}
+	if e := audit.events[len(audit.events)-1]; e.ActorKind != AuditActorSystem || e.TargetID != 1 {
+		t.Fatalf("unexpected erase audit %+v", e)
+	}
+}
+
+func TestUserDeletionUsecase_DeletionGrace(t *testing.T) {
+	cases := []struct {
+		policy *AuthPolicy
+		want   time.Duration
+	}{
+		{nil, defaultAccountDeletionGrace},
+		{&AuthPolicy{AccountDeletionGrace: time.Hour}, time.Hour},
+		{&AuthPolicy{AccountDeletionGrace: -1}, 0},
+	}
+	for _, tc := range cases {
+		uc, _ := newTestUserDeletionUsecase(nil, tc.policy)
+		if got := uc.DeletionGrace(); got != tc.want {
+			t.Fatalf("DeletionGrace() = %s, want %s (policy %+v)", got, tc.want, tc.policy)
+		}
+	}
+}
@@ -0,0 +1,552 @@
+// server/internal/biz/user_import.go
+package biz
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const PermissionUserImport = "admin.user.import"
+
+var _ = RegisterAdminPermissions(AdminPermission{
+	Key:         PermissionUserImport,
+	Name:        "导入账号",
+	Group:       "账号",
+	Description: "允许通过 CSV 批量创建普通用户账号并查看导入任务进度",
+})
+
+var (
+	ErrUserImportInvalid     = errors.New("user import file invalid")
+	ErrUserImportJobNotFound = errors.New("user import job not found")
+)
+
+const AuditActionUserImport = "user.import"
+
+const (
+	// UserImportMaxRows 单个文件最多的数据行数（不含表头）。
+	UserImportMaxRows = 10000
+	// UserImportMaxBytes 单个文件的大小上限。
+	UserImportMaxBytes = 4 << 20
+	// UserImportMaxRowErrors 任务里保留的行级错误条数上限，总数以 FailedCount 为准。
+	UserImportMaxRowErrors = 1000
+	// UserImportInitialPasswordMinLen 初始密码最短长度（按字符计）。
+	UserImportInitialPasswordMinLen = 8
+
+	// 每处理这么多行写一次进度。
+	userImportProgressEvery = 100
+	// 运行中的任务超过这么久没有更新进度，视为进程已退出、任务中断。
+	userImportStaleAfter = 10 * time.Minute
+	// 查重时每次查询的用户名个数。
+	userImportLookupBatch = 500
+)
+
+// 导入任务状态。
+const (
+	UserImportPending   = "pending"
+	UserImportRunning   = "running"
+	UserImportSucceeded = "succeeded"
+	UserImportFailed    = "failed"
+)
+
+// UserImportRowError 一条行级错误；Row 为 CSV 中的行号（表头为第 1 行）。
+type UserImportRowError struct {
+	Row      int
+	Username string
+	Message  string
+}
+
+// UserImportJob 一次导入任务的进度。试运行时 CreatedCount 表示“校验通过、会被创建”的行数。
+type UserImportJob struct {
+	ID             int
+	Status         string
+	DryRun         bool
+	OrganizationID int
+	CreatedBy      int
+	TotalRows      int
+	ProcessedRows  int
+	CreatedCount   int
+	FailedCount    int
+	RowErrors      []UserImportRowError
+	// Error 是导致任务整体失败的原因；行级错误不会让任务失败。
+	Error      string
+	FinishedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Finished 任务已结束（成功或失败）时返回 true。
+func (j *UserImportJob) Finished() bool {
+	return j.Status == UserImportSucceeded || j.Status == UserImportFailed
+}
+
+// UserImportRow 是 CSV 中的一行原始数据，校验在任务执行时进行，以便按行报告错误。
+type UserImportRow struct {
+	Line         int
+	Username     string
+	PasswordHash string
+	// InitialPassword 按原样保留，不去掉首尾空白。
+	InitialPassword string
+	Disabled        string
+	// 列数与表头不一致时记录原因，执行时直接作为行级错误。
+	parseErr string
+}
+
+type UserImportRepo interface {
+	CreateImportJob(ctx context.Context, job *UserImportJob) (*UserImportJob, error)
+	// UpdateImportJob 覆盖写入进度、计数、行级错误与状态。
+	UpdateImportJob(ctx context.Context, job *UserImportJob) error
+	// GetImportJob 不存在时返回 ErrUserImportJobNotFound。
+	GetImportJob(ctx context.Context, id int) (*UserImportJob, error)
+	// TakenUsernames 返回已被用户或管理员占用的规范化用户名，不受组织范围限制。
+	TakenUsernames(ctx context.Context, normalized []string) (map[string]bool, error)
+}
+
+// userImportColumns 允许的表头；username 必填，password_hash 与 initial_password 每行二选一。
+// initial_password 是管理员下发的临时密码，用户首次登录前必须先修改。
+var userImportColumns = map[string]bool{
+	"username":         true,
+	"password_hash":    true,
+	"initial_password": true,
+	"disabled":         true,
+}
+
+// userImportColumnAliases 是兼容的旧列名，解析时换成正式列名。
+var userImportColumnAliases = map[string]string{
+	"password": "initial_password",
+}
+
+// ParseUserImportCSV 解析导入文件：首行为表头（列名不区分大小写、顺序任意），空行忽略。
+// 文件结构错误（缺少 username 列、未知列、引号不闭合、超出行数限制）时返回包装了 ErrUserImportInvalid 的错误。
+func ParseUserImportCSV(data string) ([]UserImportRow, error) {
+	if len(data) > UserImportMaxBytes {
+		return nil, fmt.Errorf("%w: 文件不能超过 %d MB", ErrUserImportInvalid, UserImportMaxBytes>>20)
+	}
+	if !utf8.ValidString(data) {
+		return nil, fmt.Errorf("%w: 文件必须是 UTF-8 编码", ErrUserImportInvalid)
+	}
+	data = strings.TrimPrefix(data, "\ufeff")
+
+	r := csv.NewReader(strings.NewReader(data))
+	// 不开 TrimLeadingSpace：它会吃掉未加引号的密码开头的空格，其余列由 cell 统一去空白。
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: 文件为空", ErrUserImportInvalid)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUserImportInvalid, err)
+	}
+	index := map[string]int{}
+	for i, h := range header {
+		name := strings.ToLower(strings.TrimSpace(h))
+		if alias, ok := userImportColumnAliases[name]; ok {
+			name = alias
+		}
+		if !userImportColumns[name] {
+			return nil, fmt.Errorf("%w: 不支持的列 %q", ErrUserImportInvalid, h)
+		}
+		if _, dup := index[name]; dup {
+			return nil, fmt.Errorf("%w: 列 %q 重复", ErrUserImportInvalid, name)
+		}
+		index[name] = i
+	}
+	if _, ok := index["username"]; !ok {
+		return nil, fmt.Errorf("%w: 缺少 username 列", ErrUserImportInvalid)
+	}
+	raw := func(rec []string, name string) string {
+		if i, ok := index[name]; ok && i < len(rec) {
+			return rec[i]
+		}
+		return ""
+	}
+	cell := func(rec []string, name string) string {
+		return strings.TrimSpace(raw(rec, name))
+	}
+
+	var rows []UserImportRow
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUserImportInvalid, err)
+		}
+		if len(rows) >= UserImportMaxRows {
+			return nil, fmt.Errorf("%w: 最多 %d 行数据", ErrUserImportInvalid, UserImportMaxRows)
+		}
+		line, _ := r.FieldPos(0)
+		row := UserImportRow{
+			Line:            line,
+			Username:        cell(rec, "username"),
+			PasswordHash:    cell(rec, "password_hash"),
+			InitialPassword: raw(rec, "initial_password"),
+			Disabled:        cell(rec, "disabled"),
+		}
+		if len(rec) != len(header) {
+			row.parseErr = fmt.Sprintf("列数 %d 与表头 %d 不一致", len(rec), len(header))
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: 没有数据行", ErrUserImportInvalid)
+	}
+	return rows, nil
+}
+
+// parseImportDisabled 解析 disabled 列，空值视为 false。
+func parseImportDisabled(s string) (bool, bool) {
+	switch strings.ToLower(s) {
+	case "", "0", "false", "no", "n":
+		return false, true
+	case "1", "true", "yes", "y":
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+// userImportCandidate 是通过格式校验、准备创建的一行。
+type userImportCandidate struct {
+	username   string
+	normalized string
+	hash       string
+	password   string
+	disabled   bool
+}
+
+// validateImportRow 做不依赖数据库的校验，返回可创建的候选或错误原因。
+func validateImportRow(row UserImportRow) (userImportCandidate, string) {
+	if row.parseErr != "" {
+		return userImportCandidate{}, row.parseErr
+	}
+	canonical, normalized, err := ValidateUsername(row.Username)
+	if err != nil {
+		return userImportCandidate{}, fmt.Sprintf("用户名需为 %d-%d 位字母、数字或 _ . -，且以字母或数字开头", UsernameMinLen, UsernameMaxLen)
+	}
+	c := userImportCandidate{username: canonical, normalized: normalized}
+
+	switch {
+	case row.PasswordHash != "" && row.InitialPassword != "":
+		return c, "password_hash 与 initial_password 只能填一个"
+	case row.PasswordHash != "":
+		if _, err := bcrypt.Cost([]byte(row.PasswordHash)); err != nil {
+			return c, "password_hash 不是有效的 bcrypt 哈希"
+		}
+		c.hash = row.PasswordHash
+	case row.InitialPassword != "":
+		if strings.TrimSpace(row.InitialPassword) == "" {
+			return c, "初始密码不能只包含空白字符"
+		}
+		if utf8.RuneCountInString(row.InitialPassword) < UserImportInitialPasswordMinLen {
+			return c, fmt.Sprintf("初始密码至少 %d 位", UserImportInitialPasswordMinLen)
+		}
+		if len(row.InitialPassword) > 72 {
+			return c, "初始密码不能超过 72 字节"
+		}
+		c.password = row.InitialPassword
+	default:
+		return c, "password_hash 与 initial_password 必须填一个"
+	}
+
+	disabled, ok := parseImportDisabled(row.Disabled)
+	if !ok {
+		return c, "disabled 只能是 true/false、1/0 或 yes/no"
+	}
+	c.disabled = disabled
+	return c, ""
+}
+
+type UserImportUsecase struct {
+	repo   UserImportRepo
+	users  AuthRepo
+	audit  AuditRepo
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewUserImportUsecase(repo UserImportRepo, users AuthRepo, audit AuditRepo, logger log.Logger, tp *tracesdk.TracerProvider) *UserImportUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.userimport"))
+
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.userimport")
+	} else {
+		tr = otel.Tracer("biz.userimport")
+	}
+
+	return &UserImportUsecase{
+		repo:   repo,
+		users:  users,
+		audit:  audit,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+func (uc *UserImportUsecase) Tracer() trace.Tracer {
+	if uc.tracer != nil {
+		return uc.tracer
+	}
+	return otel.Tracer("biz.userimport")
+}
+
+func (uc *UserImportUsecase) requireAdmin(ctx context.Context) (*AuthClaims, error) {
+	c, ok := GetClaimsFromContext(ctx)
+	if !ok || c == nil || c.Role != RoleAdmin {
+		return nil, ErrForbidden
+	}
+	return c, nil
+}
+
+// Start 解析文件并登记任务，返回任务与真正执行导入的 run。
+// run 由调用方放到后台执行（见 taskgroup），它会一直运行到结束，中途进度通过 Job 查询。
+// 限定组织时，导入的用户加入当前组织。
+func (uc *UserImportUsecase) Start(ctx context.Context, data string, dryRun bool) (*UserImportJob, func(ctx context.Context), error) {
+	ctx, span := uc.Tracer().Start(ctx, "userimport.start",
+		trace.WithAttributes(
+			attribute.Int("userimport.bytes", len(data)),
+			attribute.Bool("userimport.dry_run", dryRun),
+		),
+	)
+	defer span.End()
+
+	l := uc.log.WithContext(ctx)
+
+	c, err := uc.requireAdmin(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		l.Warn("StartImport forbidden")
+		return nil, nil, err
+	}
+
+	rows, err := ParseUserImportCSV(data)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		l.Infof("StartImport invalid file operator_uid=%d err=%v", c.UserID, err)
+		return nil, nil, err
+	}
+
+	orgID, _ := TenantFromContext(ctx)
+	job, err := uc.repo.CreateImportJob(ctx, &UserImportJob{
+		Status:         UserImportPending,
+		DryRun:         dryRun,
+		OrganizationID: orgID,
+		CreatedBy:      c.UserID,
+		TotalRows:      len(rows),
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "repo.CreateImportJob failed")
+		l.Errorf("StartImport create job failed operator_uid=%d err=%v", c.UserID, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(attribute.Int("userimport.job_id", job.ID), attribute.Int("userimport.rows", len(rows)))
+	span.SetStatus(codes.Ok, "OK")
+	l.Infof("StartImport accepted job_id=%d operator_uid=%d rows=%d dry_run=%v org=%d", job.ID, c.UserID, len(rows), dryRun, orgID)
+
+	snapshot := *job
+	return &snapshot, func(ctx context.Context) { uc.run(ctx, job, rows) }, nil
+}
+
+// Job 查询任务进度；限定组织时只能看到本组织发起的任务。
+func (uc *UserImportUsecase) Job(ctx context.Context, id int) (*UserImportJob, error) {
+	if _, err := uc.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if id <= 0 {
+		return nil, ErrUserImportJobNotFound
+	}
+	job, err := uc.repo.GetImportJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if orgID, scoped := TenantFromContext(ctx); scoped && job.OrganizationID != orgID {
+		return nil, ErrUserImportJobNotFound
+	}
+	if !job.Finished() && time.Since(job.UpdatedAt) > userImportStaleAfter {
+		// 进度长时间没有推进：执行它的进程已经退出，任务不会再继续。
+		job.Status = UserImportFailed
+		job.Error = "任务已中断，请重新导入（已创建的用户会被识别为已存在）"
+	}
+	return job, nil
+}
+
+// run 执行导入：先做格式校验、文件内查重与数据库查重，再按行创建。
+// 单行失败记为行级错误继续处理；数据库异常或任务被取消时整体失败，已创建的用户保留，重新导入会把它们记为已存在。
+func (uc *UserImportUsecase) run(ctx context.Context, job *UserImportJob, rows []UserImportRow) {
+	ctx, span := uc.Tracer().Start(ctx, "userimport.run",
+		trace.WithAttributes(
+			attribute.Int("userimport.job_id", job.ID),
+			attribute.Int("userimport.rows", len(rows)),
+			attribute.Bool("userimport.dry_run", job.DryRun),
+		),
+	)
+	defer span.End()
+
+	l := uc.log.WithContext(ctx)
+	// 进度写库不能被任务取消打断，否则中断原因记不下来。
+	save := func() {
+		if err := uc.repo.UpdateImportJob(context.WithoutCancel(ctx), job); err != nil {
+			l.Warnf("import job save progress failed job_id=%d processed=%d err=%v", job.ID, job.ProcessedRows, err)
+		}
+	}
+	var createdIDs []int
+	fail := func(err error) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		l.Errorf("import job failed job_id=%d processed=%d created=%d err=%v", job.ID, job.ProcessedRows, job.CreatedCount, err)
+		job.Status = UserImportFailed
+		job.Error = err.Error()
+		uc.finish(ctx, job, createdIDs)
+		save()
+	}
+
+	job.Status = UserImportRunning
+	save()
+	l.Infof("import job running job_id=%d rows=%d dry_run=%v", job.ID, len(rows), job.DryRun)
+
+	candidates := make([]userImportCandidate, len(rows))
+	problems := make([]string, len(rows))
+	seen := map[string]int{}
+	var lookup []string
+	for i, row := range rows {
+		c, msg := validateImportRow(row)
+		candidates[i] = c
+		if msg == "" && c.normalized != "" {
+			if first, dup := seen[c.normalized]; dup {
+				msg = fmt.Sprintf("与第 %d 行用户名重复", rows[first].Line)
+			} else {
+				seen[c.normalized] = i
+				lookup = append(lookup, c.normalized)
+			}
+		}
+		problems[i] = msg
+	}
+
+	taken := map[string]bool{}
+	for start := 0; start < len(lookup); start += userImportLookupBatch {
+		end := min(start+userImportLookupBatch, len(lookup))
+		batch, err := uc.repo.TakenUsernames(ctx, lookup[start:end])
+		if err != nil {
+			fail(fmt.Errorf("检查用户名是否已存在失败: %w", err))
+			return
+		}
+		for k := range batch {
+			taken[k] = true
+		}
+	}
+
+	orgID, scoped := TenantFromContext(ctx)
+	for i, row := range rows {
+		if err := ctx.Err(); err != nil {
+			fail(errors.New("任务被取消（服务正在退出）"))
+			return
+		}
+
+		c := candidates[i]
+		msg := problems[i]
+		if msg == "" && taken[c.normalized] {
+			msg = "用户名已存在"
+		}
+		if msg == "" && !job.DryRun {
+			id, err := uc.createImportedUser(ctx, c, orgID, scoped)
+			switch {
+			case errors.Is(err, ErrUserExists):
+				msg = "用户名已存在"
+			case err != nil:
+				fail(fmt.Errorf("第 %d 行创建用户失败: %w", row.Line, err))
+				return
+			default:
+				createdIDs = append(createdIDs, id)
+			}
+		}
+
+		if msg != "" {
+			job.FailedCount++
+			if len(job.RowErrors) < UserImportMaxRowErrors {
+				job.RowErrors = append(job.RowErrors, UserImportRowError{Row: row.Line, Username: row.Username, Message: msg})
+			}
+		} else {
+			job.CreatedCount++
+		}
+		job.ProcessedRows++
+		if job.ProcessedRows%userImportProgressEvery == 0 && job.ProcessedRows < len(rows) {
+			save()
+		}
+	}
+
+	job.Status = UserImportSucceeded
+	uc.finish(ctx, job, createdIDs)
+	save()
+
+	span.SetAttributes(attribute.Int("userimport.created", job.CreatedCount), attribute.Int("userimport.failed", job.FailedCount))
+	span.SetStatus(codes.Ok, "OK")
+	l.Infof("import job succeeded job_id=%d created=%d failed=%d dry_run=%v", job.ID, job.CreatedCount, job.FailedCount, job.DryRun)
+}
+
+func (uc *UserImportUsecase) createImportedUser(ctx context.Context, c userImportCandidate, orgID int, scoped bool) (int, error) {
+	hash := c.hash
+	if c.password != "" {
+		h, err := bcrypt.GenerateFromPassword([]byte(c.password), bcrypt.DefaultCost)
+		if err != nil {
+			return 0, err
+		}
+		hash = string(h)
+	}
+	// 用明文初始密码建的号必须在首次登录前改密；password_hash 是从其他系统迁来的既有密码，不要求修改。
+	u := &User{Username: c.username, PasswordHash: hash, Disabled: c.disabled, MustChangePassword: c.password != ""}
+	if scoped {
+		u.OrganizationIDs = []int{orgID}
+	}
+	created, err := uc.users.CreateUser(ctx, u)
+	if err != nil {
+		return 0, err
+	}
+	return created.ID, nil
+}
+
+// finish 标记结束时间，并在实际导入（非试运行）时写入审计。
+func (uc *UserImportUsecase) finish(ctx context.Context, job *UserImportJob, createdIDs []int) {
+	now := time.Now()
+	job.FinishedAt = &now
+	if job.DryRun || uc.audit == nil {
+		return
+	}
+	e := &AuditEvent{
+		Action:     AuditActionUserImport,
+		ActorKind:  AuditActorAdmin,
+		TargetKind: "user",
+		Detail: map[string]any{
+			"job_id":   job.ID,
+			"status":   job.Status,
+			"rows":     job.TotalRows,
+			"created":  job.CreatedCount,
+			"failed":   job.FailedCount,
+			"user_ids": createdIDs,
+		},
+	}
+	if c, ok := GetClaimsFromContext(ctx); ok && c != nil {
+		e.ActorID = c.UserID
+		e.ActorUsername = c.Username
+	}
+	if err := uc.audit.RecordAudit(context.WithoutCancel(ctx), e); err != nil {
+		uc.log.WithContext(ctx).Warnf("record user import audit failed job_id=%d err=%v", job.ID, err)
+	}
+}
@@ -0,0 +1,193 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type memUserImportRepo struct {
+	mu    sync.Mutex
+	jobs  map[int]*UserImportJob
+	taken map[string]bool
+	saves int
+}
+
+func (r *memUserImportRepo) CreateImportJob(ctx context.Context, job *UserImportJob) (*UserImportJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cp := *job
+	cp.ID = len(r.jobs) + 1
+	r.jobs[cp.ID] = &cp
+	return &cp, nil
+}
+
+func (r *memUserImportRepo) UpdateImportJob(ctx context.Context, job *UserImportJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cp := *job
+	cp.RowErrors = append([]UserImportRowError(nil), job.RowErrors...)
+	r.jobs[job.ID] = &cp
+	r.saves++
+	return nil
+}
+
+func (r *memUserImportRepo) GetImportJob(ctx context.Context, id int) (*UserImportJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil, ErrUserImportJobNotFound
+	}
+	cp := *job
+	return &cp, nil
+}
+
+func (r *memUserImportRepo) TakenUsernames(ctx context.Context, normalized []string) (map[string]bool, error) {
+	out := map[string]bool{}
+	for _, n := range normalized {
+		if r.taken[n] {
+			out[n] = true
+		}
+	}
+	return out, nil
+}
+
+func TestParseUserImportCSV(t *testing.T) {
+	rows, err := ParseUserImportCSV("\ufeffUsername, Disabled,initial_password\nalice,true, secret-123 \n\n\"bob\nby\",,x\ncarol\n")
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %+v", rows)
+	}
+	if rows[0].Line != 2 || rows[0].Username != "alice" || rows[0].Disabled != "true" || rows[0].InitialPassword != " secret-123 " {
+		t.Fatalf("unexpected first row: %+v", rows[0])
+	}
+	// 带换行的引号字段占两行，行号取它开始的那一行。
+	if rows[1].Line != 4 || rows[2].Line != 6 || rows[2].parseErr == "" {
+		t.Fatalf("unexpected line numbers or parse error: %+v", rows[1:])
+	}
+
+	// password 是 initial_password 的旧列名。
+	rows, err = ParseUserImportCSV("username,Password\nalice, temp-pass-1\n")
+	if err != nil || len(rows) != 1 || rows[0].InitialPassword != " temp-pass-1" {
+		t.Fatalf("expected password alias to fill initial_password, got rows=%+v err=%v", rows, err)
+	}
+
+	for _, bad := range []string{
+		"",
+		"username\n",
+		"initial_password\nsecret-123\n",
+		"username,password,initial_password\nalice,a,b\n",
+		"username,email\nalice,a@example.com\n",
+		"username,username\nalice,alice\n",
+		"username\n\"alice\n",
+		"username\n" + strings.Repeat("u\n", UserImportMaxRows+1),
+	} {
+		if _, err := ParseUserImportCSV(bad); !errors.Is(err, ErrUserImportInvalid) {
+			t.Fatalf("expected ErrUserImportInvalid for %.40q, got %v", bad, err)
+		}
+	}
+}
+
+func TestUserImportUsecase_Run(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("legacy-pass"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := strings.Join([]string{
+		"username,password_hash,initial_password,disabled",
+		"alice," + string(hash) + ",,",
+		"Bob,,temp-pass-1,yes",
+		"ALICE," + string(hash) + ",,",
+		"x,,temp-pass-1,",
+		"carol,,short,",
+		"dave,,,",
+		"erin,not-a-hash,,",
+		"frank," + string(hash) + ",,maybe",
+		"admin," + string(hash) + ",,",
+	}, "\n")
+
+	for _, dryRun := range []bool{true, false} {
+		repo := &memUserImportRepo{jobs: map[int]*UserImportJob{}, taken: map[string]bool{"admin": true}}
+		users := newMemAuthRepo()
+		audit := &memAuditRepo{}
+		uc := NewUserImportUsecase(repo, users, audit, log.NewStdLogger(io.Discard), nil)
+		ctx := NewContextWithTenant(adminCtx(), 5)
+
+		job, run, err := uc.Start(ctx, data, dryRun)
+		if err != nil {
+			t.Fatalf("dry_run=%v: expected nil err, got %v", dryRun, err)
+		}
+		if job.Status != UserImportPending || job.TotalRows != 9 || job.OrganizationID != 5 || job.CreatedBy != 7 {
+			t.Fatalf("dry_run=%v: unexpected job: %+v", dryRun, job)
+		}
+		run(ctx)
+
+		got, err := uc.Job(ctx, job.ID)
+		if err != nil {
+			t.Fatalf("dry_run=%v: Job err=%v", dryRun, err)
+		}
+		if got.Status != UserImportSucceeded || got.ProcessedRows != 9 || got.CreatedCount != 2 || got.FailedCount != 7 || got.FinishedAt == nil {
+			t.Fatalf("dry_run=%v: unexpected result: %+v", dryRun, got)
+		}
+		wantRows := []int{4, 5, 6, 7, 8, 9, 10}
+		for i, e := range got.RowErrors {
+			if e.Row != wantRows[i] {
+				t.Fatalf("dry_run=%v: unexpected row errors: %+v", dryRun, got.RowErrors)
+			}
+		}
+		if got.RowErrors[0].Message != "与第 2 行用户名重复" || got.RowErrors[6].Message != "用户名已存在" {
+			t.Fatalf("dry_run=%v: unexpected messages: %+v", dryRun, got.RowErrors)
+		}
+
+		if dryRun {
+			if len(users.usersByName) != 0 || len(audit.events) != 0 {
+				t.Fatalf("dry run must not create users or audit, got users=%d audit=%d", len(users.usersByName), len(audit.events))
+			}
+			continue
+		}
+		bob := users.usersByName["Bob"]
+		if bob == nil || !bob.Disabled || len(bob.OrganizationIDs) != 1 || bob.OrganizationIDs[0] != 5 {
+			t.Fatalf("unexpected imported user: %+v", bob)
+		}
+		if bcrypt.CompareHashAndPassword([]byte(bob.PasswordHash), []byte("temp-pass-1")) != nil {
+			t.Fatalf("initial password not hashed correctly")
+		}
+		if users.usersByName["alice"].PasswordHash != string(hash) {
+			t.Fatalf("password_hash must be stored as is")
+		}
+		if !bob.MustChangePassword || users.usersByName["alice"].MustChangePassword {
+			t.Fatalf("only users created with initial_password must change password")
+		}
+		if len(audit.events) != 1 || audit.events[0].Action != AuditActionUserImport || audit.events[0].Detail["created"] != 2 {
+			t.Fatalf("unexpected audit events: %+v", audit.events)
+		}
+	}
+}
+
+func TestUserImportUsecase_JobScope(t *testing.T) {
+	repo := &memUserImportRepo{jobs: map[int]*UserImportJob{}}
+	uc := NewUserImportUsecase(repo, newMemAuthRepo(), nil, log.NewStdLogger(io.Discard), nil)
+
+	job, _, err := uc.Start(NewContextWithTenant(adminCtx(), 5), "username,initial_password\nalice,temp-pass-1\n", true)
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if _, err := uc.Job(NewContextWithTenant(adminCtx(), 6), job.ID); !errors.Is(err, ErrUserImportJobNotFound) {
+		t.Fatalf("expected other tenant to get ErrUserImportJobNotFound, got %v", err)
+	}
+	if _, err := uc.Job(adminCtx(), job.ID); err != nil {
+		t.Fatalf("expected platform admin to see job, got %v", err)
+	}
+	if _, _, err := uc.Start(context.Background(), "username\nalice\n", false); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden without admin claims, got %v", err)
+	}
+}
@@ -0,0 +1,589 @@
+// server/internal/biz/user_profile.go
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	// 时区校验依赖 IANA 数据库，内嵌一份，避免容器镜像里没有 zoneinfo 时所有时区都被判为非法。
+	_ "time/tzdata"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/text/language"
+)
+
+var ErrUserProfileInvalid = errors.New("user profile invalid")
+
+const AuditActionUserUpdateProfile = "user.update_profile"
+
+const (
+	UserDisplayNameMaxLen = 64
+	UserAvatarURLMaxLen   = 512
+	// UserAttributeDefaultMaxLen 字符串属性未指定 MaxLen 时的长度上限（按字符计）。
+	UserAttributeDefaultMaxLen = 256
+)
+
+// UserProfile 是用户的资料；Attributes 只包含已注册的 key。
+type UserProfile struct {
+	UserID        int
+	Username      string
+	DisplayName   string
+	Email         string
+	EmailVerified bool
+	AvatarURL     string
+	Locale        string
+	Timezone      string
+	Attributes    map[string]any
+	UpdatedAt     time.Time
+}
+
+// UserProfilePatch 是一次局部修改：nil 表示不修改，空字符串表示清空。
+// Attributes 按 key 合并，值为 nil 表示删除该 key，未出现的 key 保持不变。
+type UserProfilePatch struct {
+	DisplayName *string
+	Email       *string
+	AvatarURL   *string
+	Locale      *string
+	Timezone    *string
+	Attributes  map[string]any
+}
+
+func (p *UserProfilePatch) Empty() bool {
+	return p == nil || (p.DisplayName == nil && p.Email == nil && p.AvatarURL == nil &&
+		p.Locale == nil && p.Timezone == nil && len(p.Attributes) == 0)
+}
+
+// MergeAttributes 把 patch 中的属性合并到 cur 上，返回新的 map，不修改 cur。
+func (p *UserProfilePatch) MergeAttributes(cur map[string]any) map[string]any {
+	out := make(map[string]any, len(cur)+len(p.Attributes))
+	for k, v := range cur {
+		out[k] = v
+	}
+	for k, v := range p.Attributes {
+		if v == nil {
+			delete(out, k)
+			continue
+		}
+		out[k] = v
+	}
+	return out
+}
+
+type UserProfileRepo interface {
+	// GetUserProfile 返回的 Attributes 是数据库中的原样内容；用户不存在（或不在当前组织）时返回 ErrUserNotFound。
+	GetUserProfile(ctx context.Context, userID int) (*UserProfile, error)
+	// UpdateUserProfile 应用已校验过的 patch，属性按 key 合并且不会覆盖并发写入的其他 key。
+	// 邮箱变化时清除验证状态；邮箱已被其他账号使用时返回 ErrVerificationContactConflict。
+	UpdateUserProfile(ctx context.Context, userID int, patch *UserProfilePatch) (*UserProfile, error)
+}
+
+// UserAttributeType 是自定义属性的值类型。
+type UserAttributeType string
+
+const (
+	UserAttributeString UserAttributeType = "string"
+	UserAttributeInt    UserAttributeType = "int"
+	UserAttributeNumber UserAttributeType = "number"
+	UserAttributeBool   UserAttributeType = "bool"
+)
+
+// UserAttributeField 声明一个项目自定义的资料字段，值存放在 users.attributes 中。
+type UserAttributeField struct {
+	Key         string
+	Type        UserAttributeType
+	Label       string
+	Description string
+	// MaxLen 字符串的最大字符数，0 表示 UserAttributeDefaultMaxLen。
+	MaxLen int
+	// Options 非空时字符串只能取其中之一。
+	Options []string
+	// SelfEditable 为 true 时用户可以通过 auth.update_profile 修改，否则只有管理员能改。
+	SelfEditable bool
+}
+
+var userAttributeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// 自定义属性由项目代码在包级 var 初始化时注册，与权限码一样不走数据库配置。
+// 数据库里已不再注册的 key 会保留，但不会返回给前端，也不能再写入。
+var userAttributeRegistry = struct {
+	mu    sync.RWMutex
+	byKey map[string]UserAttributeField
+}{byKey: map[string]UserAttributeField{}}
+
+// RegisterUserAttributes 注册自定义资料字段；key 非法、类型未知或重复注册属于编码错误，直接 panic。
+// 返回值只为方便在包级 var 中调用。
+func RegisterUserAttributes(fields ...UserAttributeField) []UserAttributeField {
+	userAttributeRegistry.mu.Lock()
+	defer userAttributeRegistry.mu.Unlock()
+	for _, f := range fields {
+		if !userAttributeKeyPattern.MatchString(f.Key) || strings.TrimSpace(f.Label) == "" {
+			panic(fmt.Sprintf("RegisterUserAttributes: invalid key or empty label, got %+v", f))
+		}
+		switch f.Type {
+		case UserAttributeString, UserAttributeInt, UserAttributeNumber, UserAttributeBool:
+		default:
+			panic(fmt.Sprintf("RegisterUserAttributes: unknown type %q for %s", f.Type, f.Key))
+		}
+		if len(f.Options) > 0 && f.Type != UserAttributeString {
+			panic("RegisterUserAttributes: options only apply to string attributes, key=" + f.Key)
+		}
+		if _, ok := userAttributeRegistry.byKey[f.Key]; ok {
+			panic("RegisterUserAttributes: duplicate attribute " + f.Key)
+		}
+		userAttributeRegistry.byKey[f.Key] = f
+	}
+	return fields
+}
+
+// UserAttributes 返回已注册的全部自定义资料字段，按 key 排序。
+func UserAttributes() []UserAttributeField {
+	userAttributeRegistry.mu.RLock()
+	defer userAttributeRegistry.mu.RUnlock()
+	out := make([]UserAttributeField, 0, len(userAttributeRegistry.byKey))
+	for _, f := range userAttributeRegistry.byKey {
+		out = append(out, f)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+func lookupUserAttribute(key string) (UserAttributeField, bool) {
+	userAttributeRegistry.mu.RLock()
+	defer userAttributeRegistry.mu.RUnlock()
+	f, ok := userAttributeRegistry.byKey[key]
+	return f, ok
+}
+
+// normalize 校验属性值并转换成统一的 Go 类型；JSON 数字统一解析为 float64，int 类型要求是整数。
+func (f UserAttributeField) normalize(v any) (any, error) {
+	switch f.Type {
+	case UserAttributeString:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s 必须是字符串", ErrUserProfileInvalid, f.Key)
+		}
+		maxLen := f.MaxLen
+		if maxLen <= 0 {
+			maxLen = UserAttributeDefaultMaxLen
+		}
+		if utf8.RuneCountInString(s) > maxLen || hasControlRune(s) {
+			return nil, fmt.Errorf("%w: %s 不能超过 %d 个字符且不能包含控制字符", ErrUserProfileInvalid, f.Key, maxLen)
+		}
+		if len(f.Options) > 0 && !containsString(f.Options, s) {
+			return nil, fmt.Errorf("%w: %s 只能是 %s 之一", ErrUserProfileInvalid, f.Key, strings.Join(f.Options, "/"))
+		}
+		return s, nil
+	case UserAttributeInt, UserAttributeNumber:
+		var n float64
+		switch x := v.(type) {
+		case float64:
+			n = x
+		case int:
+			n = float64(x)
+		case int64:
+			n = float64(x)
+		default:
+			return nil, fmt.Errorf("%w: %s 必须是数字", ErrUserProfileInvalid, f.Key)
+		}
+		if math.IsNaN(n) || math.IsInf(n, 0) {
+			return nil, fmt.Errorf("%w: %s 必须是数字", ErrUserProfileInvalid, f.Key)
+		}
+		if f.Type == UserAttributeInt {
+			if n != math.Trunc(n) || math.Abs(n) > 1<<53 {
+				return nil, fmt.Errorf("%w: %s 必须是整数", ErrUserProfileInvalid, f.Key)
+			}
+			return int64(n), nil
+		}
+		return n, nil
+	case UserAttributeBool:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s 必须是布尔值", ErrUserProfileInvalid, f.Key)
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("%w: %s 类型未知", ErrUserProfileInvalid, f.Key)
+	}
+}
+
+func hasControlRune(s string) bool {
+	for _, r := range s {
+		if unicode.IsControl(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeUserProfilePatch 校验并规范化 patch，返回新的 patch。
+// self 为 true 时只允许修改 SelfEditable 的自定义属性。
+func normalizeUserProfilePatch(p *UserProfilePatch, self bool) (*UserProfilePatch, error) {
+	out := &UserProfilePatch{}
+
+	if p.DisplayName != nil {
+		s := strings.TrimSpace(*p.DisplayName)
+		if utf8.RuneCountInString(s) > UserDisplayNameMaxLen || hasControlRune(s) {
+			return nil, fmt.Errorf("%w: 显示名称不能超过 %d 个字符且不能包含控制字符", ErrUserProfileInvalid, UserDisplayNameMaxLen)
+		}
+		out.DisplayName = &s
+	}
+
+	if p.Email != nil {
+		s := strings.TrimSpace(*p.Email)
+		if s != "" {
+			email, err := NormalizeVerificationTarget(VerificationChannelEmail, s)
+			if err != nil {
+				return nil, fmt.Errorf("%w: 邮箱格式不正确", ErrUserProfileInvalid)
+			}
+			s = email
+		}
+		out.Email = &s
+	}
+
+	if p.AvatarURL != nil {
+		s := strings.TrimSpace(*p.AvatarURL)
+		if s != "" {
+			u, err := url.Parse(s)
+			if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || len(s) > UserAvatarURLMaxLen {
+				return nil, fmt.Errorf("%w: 头像地址必须是不超过 %d 字节的 http(s) 链接", ErrUserProfileInvalid, UserAvatarURLMaxLen)
+			}
+		}
+		out.AvatarURL = &s
+	}
+
+	if p.Locale != nil {
+		s := strings.TrimSpace(*p.Locale)
+		if s != "" {
+			tag, err := language.Parse(s)
+			if err != nil || len(tag.String()) > 35 {
+				return nil, fmt.Errorf("%w: 语言需要是 BCP 47 标签，如 zh-CN", ErrUserProfileInvalid)
+			}
+			s = tag.String()
+		}
+		out.Locale = &s
+	}
+
+	if p.Timezone != nil {
+		s := strings.TrimSpace(*p.Timezone)
+		if s != "" {
+			// "Local" 取决于服务器配置，不是一个确定的时区。
+			if _, err := time.LoadLocation(s); err != nil || s == "Local" {
+				return nil, fmt.Errorf("%w: 时区需要是 IANA 名称，如 Asia/Shanghai", ErrUserProfileInvalid)
+			}
+		}
+		out.Timezone = &s
+	}
+
+	if len(p.Attributes) > 0 {
+		out.Attributes = make(map[string]any, len(p.Attributes))
+		for k, v := range p.Attributes {
+			f, ok := lookupUserAttribute(k)
+			if !ok {
+				return nil, fmt.Errorf("%w: 未知的自定义字段 %s", ErrUserProfileInvalid, k)
+			}
+			if self && !f.SelfEditable {
+				return nil, fmt.Errorf("%w: 自定义字段 %s 只能由管理员修改", ErrUserProfileInvalid, k)
+			}
+			if v == nil {
+				out.Attributes[k] = nil
+				continue
+			}
+			nv, err := f.normalize(v)
+			if err != nil {
+				return nil, err
+			}
+			out.Attributes[k] = nv
+		}
+	}
+
+	if out.Empty() {
+		return nil, fmt.Errorf("%w: 没有需要修改的字段", ErrUserProfileInvalid)
+	}
+	return out, nil
+}
+
+// visibleUserAttributes 只保留仍在注册表中的属性。
+func visibleUserAttributes(attrs map[string]any) map[string]any {
+	out := make(map[string]any, len(attrs))
+	for k, v := range attrs {
+		if _, ok := lookupUserAttribute(k); ok {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+type UserProfileUsecase struct {
+	repo   UserProfileRepo
+	audit  AuditRepo
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewUserProfileUsecase(repo UserProfileRepo, audit AuditRepo, logger log.Logger, tp *tracesdk.TracerProvider) *UserProfileUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.userprofile"))
+
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.userprofile")
+	} else {
+		tr = otel.Tracer("biz.userprofile")
+	}
+
+	return &UserProfileUsecase{
+		repo:   repo,
+		audit:  audit,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+func (uc *UserProfileUsecase) Tracer() trace.Tracer {
+	if uc.tracer != nil {
+		return uc.tracer
+	}
+	return otel.Tracer("biz.userprofile")
+}
+
+func (uc *UserProfileUsecase) requireAdmin(ctx context.Context) (*AuthClaims, error) {
+	c, ok := GetClaimsFromContext(ctx)
+	if !ok || c == nil || c.Role != RoleAdmin {
+		return nil, ErrForbidden
+	}
+	return c, nil
+}
+
+func (uc *UserProfileUsecase) requireUser(ctx context.Context) (*AuthClaims, error) {
+	c, ok := GetClaimsFromContext(ctx)
+	if !ok || c == nil || c.Role != RoleUser || c.UserID <= 0 {
+		return nil, ErrForbidden
+	}
+	return c, nil
+}
+
+// Mine 返回当前登录用户的资料。
+func (uc *UserProfileUsecase) Mine(ctx context.Context) (*UserProfile, error) {
+	ctx, span := uc.Tracer().Start(ctx, "userprofile.mine")
+	defer span.End()
+
+	c, err := uc.requireUser(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return uc.get(ctx, span, c.UserID)
+}
+
+// UpdateMine 修改当前登录用户的资料；只能修改 SelfEditable 的自定义属性。
+func (uc *UserProfileUsecase) UpdateMine(ctx context.Context, patch *UserProfilePatch) (*UserProfile, error) {
+	ctx, span := uc.Tracer().Start(ctx, "userprofile.update_mine")
+	defer span.End()
+
+	l := uc.log.WithContext(ctx)
+
+	c, err := uc.requireUser(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("userprofile.user_id", c.UserID))
+
+	p, err := normalizeUserProfilePatch(patch, true)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		l.Infof("UpdateMine invalid uid=%d err=%v", c.UserID, err)
+		return nil, err
+	}
+
+	profile, err := uc.repo.UpdateUserProfile(ctx, c.UserID, p)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		l.Warnf("UpdateMine failed uid=%d err=%v", c.UserID, err)
+		return nil, err
+	}
+	profile.Attributes = visibleUserAttributes(profile.Attributes)
+
+	l.Infof("UpdateMine success uid=%d fields=%v", c.UserID, p.fieldNames())
+	return profile, nil
+}
+
+// Get 管理员查看用户资料。
+func (uc *UserProfileUsecase) Get(ctx context.Context, userID int) (*UserProfile, error) {
+	ctx, span := uc.Tracer().Start(ctx, "userprofile.get",
+		trace.WithAttributes(attribute.Int("userprofile.user_id", userID)),
+	)
+	defer span.End()
+
+	if _, err := uc.requireAdmin(ctx); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	if userID <= 0 {
+		span.SetStatus(codes.Error, "invalid user id")
+		return nil, ErrBadParam
+	}
+	return uc.get(ctx, span, userID)
+}
+
+func (uc *UserProfileUsecase) UserDataSection() string { return "profile" }
+
+// ExportUserData 导出资料字段；自定义属性按库里保存的原样导出，包括已取消注册的 key。
+func (uc *UserProfileUsecase) ExportUserData(ctx context.Context, userID int) (any, error) {
+	p, err := uc.repo.GetUserProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"display_name": p.DisplayName,
+		"avatar_url":   p.AvatarURL,
+		"locale":       p.Locale,
+		"timezone":     p.Timezone,
+		"attributes":   p.Attributes,
+		"updated_at":   p.UpdatedAt,
+	}, nil
+}
+
+// Update 管理员修改用户资料，可以修改全部自定义属性；变更内容写入审计。
+func (uc *UserProfileUsecase) Update(ctx context.Context, userID int, patch *UserProfilePatch) (*UserProfile, error) {
+	ctx, span := uc.Tracer().Start(ctx, "userprofile.update",
+		trace.WithAttributes(attribute.Int("userprofile.user_id", userID)),
+	)
+	defer span.End()
+
+	l := uc.log.WithContext(ctx)
+
+	c, err := uc.requireAdmin(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		l.Warn("UpdateProfile forbidden")
+		return nil, err
+	}
+	if userID <= 0 {
+		span.SetStatus(codes.Error, "invalid user id")
+		return nil, ErrBadParam
+	}
+
+	p, err := normalizeUserProfilePatch(patch, false)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		l.Infof("UpdateProfile invalid operator_uid=%d user_id=%d err=%v", c.UserID, userID, err)
+		return nil, err
+	}
+
+	before, err := uc.repo.GetUserProfile(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	after, err := uc.repo.UpdateUserProfile(ctx, userID, p)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		l.Warnf("UpdateProfile failed operator_uid=%d user_id=%d err=%v", c.UserID, userID, err)
+		return nil, err
+	}
+
+	if uc.audit != nil {
+		e := &AuditEvent{
+			Action:        AuditActionUserUpdateProfile,
+			ActorKind:     AuditActorAdmin,
+			ActorID:       c.UserID,
+			ActorUsername: c.Username,
+			TargetKind:    "user",
+			TargetID:      userID,
+			Detail:        profileChanges(before, after, p),
+		}
+		if err := uc.audit.RecordAudit(context.WithoutCancel(ctx), e); err != nil {
+			l.Warnf("record update profile audit failed user_id=%d err=%v", userID, err)
+		}
+	}
+
+	after.Attributes = visibleUserAttributes(after.Attributes)
+	l.Infof("UpdateProfile success operator_uid=%d user_id=%d fields=%v", c.UserID, userID, p.fieldNames())
+	return after, nil
+}
+
+func (uc *UserProfileUsecase) get(ctx context.Context, span trace.Span, userID int) (*UserProfile, error) {
+	profile, err := uc.repo.GetUserProfile(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		uc.log.WithContext(ctx).Infof("GetProfile failed user_id=%d err=%v", userID, err)
+		return nil, err
+	}
+	profile.Attributes = visibleUserAttributes(profile.Attributes)
+	return profile, nil
+}
+
+// fieldNames 返回 patch 涉及的字段名，自定义属性记为 attributes.<key>，用于日志与审计。
+func (p *UserProfilePatch) fieldNames() []string {
+	var out []string
+	for _, f := range []struct {
+		name string
+		v    *string
+	}{
+		{"display_name", p.DisplayName},
+		{"email", p.Email},
+		{"avatar_url", p.AvatarURL},
+		{"locale", p.Locale},
+		{"timezone", p.Timezone},
+	} {
+		if f.v != nil {
+			out = append(out, f.name)
+		}
+	}
+	keys := make([]string, 0, len(p.Attributes))
+	for k := range p.Attributes {
+		keys = append(keys, "attributes."+k)
+	}
+	sort.Strings(keys)
+	return append(out, keys...)
+}
+
+// profileChanges 生成审计明细：只记录实际发生变化的字段及其前后值。
+func profileChanges(before, after *UserProfile, p *UserProfilePatch) map[string]any {
+	changes := map[string]any{}
+	add := func(name string, b, a any) {
+		if b != a {
+			changes[name] = map[string]any{"before": b, "after": a}
+		}
+	}
+	add("display_name", before.DisplayName, after.DisplayName)
+	add("email", before.Email, after.Email)
+	add("avatar_url", before.AvatarURL, after.AvatarURL)
+	add("locale", before.Locale, after.Locale)
+	add("timezone", before.Timezone, after.Timezone)
+	for k := range p.Attributes {
+		b, a := before.Attributes[k], after.Attributes[k]
+		if fmt.Sprint(b) != fmt.Sprint(a) {
+			changes["attributes."+k] = map[string]any{"before": b, "after": a}
+		}
+	}
+	return map[string]any{"changes": changes}
+}
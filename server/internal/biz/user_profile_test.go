@@ -0,0 +1,236 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var _ = RegisterUserAttributes(
+	UserAttributeField{Key: "test_nickname", Type: UserAttributeString, Label: "昵称", MaxLen: 8, SelfEditable: true},
+	UserAttributeField{Key: "test_tier", Type: UserAttributeString, Label: "等级", Options: []string{"free", "pro"}},
+	UserAttributeField{Key: "test_score", Type: UserAttributeInt, Label: "积分"},
+	UserAttributeField{Key: "test_vip", Type: UserAttributeBool, Label: "VIP", SelfEditable: true},
+)
+
+type memUserProfileRepo struct {
+	profiles map[int]*UserProfile
+}
+
+func (r *memUserProfileRepo) GetUserProfile(ctx context.Context, userID int) (*UserProfile, error) {
+	p, ok := r.profiles[userID]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	cp := *p
+	cp.Attributes = (&UserProfilePatch{}).MergeAttributes(p.Attributes)
+	return &cp, nil
+}
+
+func (r *memUserProfileRepo) UpdateUserProfile(ctx context.Context, userID int, patch *UserProfilePatch) (*UserProfile, error) {
+	p, ok := r.profiles[userID]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	for _, f := range []struct {
+		dst *string
+		v   *string
+	}{
+		{&p.DisplayName, patch.DisplayName},
+		{&p.AvatarURL, patch.AvatarURL},
+		{&p.Locale, patch.Locale},
+		{&p.Timezone, patch.Timezone},
+	} {
+		if f.v != nil {
+			*f.dst = *f.v
+		}
+	}
+	if patch.Email != nil && *patch.Email != p.Email {
+		p.Email = *patch.Email
+		p.EmailVerified = false
+	}
+	p.Attributes = patch.MergeAttributes(p.Attributes)
+	p.UpdatedAt = time.Now()
+	return r.GetUserProfile(ctx, userID)
+}
+
+func newTestUserProfileUsecase(audit AuditRepo) (*UserProfileUsecase, *memUserProfileRepo) {
+	repo := &memUserProfileRepo{profiles: map[int]*UserProfile{
+		1: {
+			UserID:        1,
+			Username:      "alice",
+			Email:         "alice@example.com",
+			EmailVerified: true,
+			Attributes:    map[string]any{"test_tier": "free", "removed_key": "kept"},
+		},
+	}}
+	return NewUserProfileUsecase(repo, audit, log.NewStdLogger(io.Discard), nil), repo
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestNormalizeUserProfilePatch(t *testing.T) {
+	cases := []struct {
+		name  string
+		patch *UserProfilePatch
+		self  bool
+		ok    bool
+	}{
+		{"display name trimmed", &UserProfilePatch{DisplayName: strPtr("  Alice  ")}, true, true},
+		{"display name too long", &UserProfilePatch{DisplayName: strPtr(strings.Repeat("字", 65))}, true, false},
+		{"display name control char", &UserProfilePatch{DisplayName: strPtr("a\nb")}, true, false},
+		{"email invalid", &UserProfilePatch{Email: strPtr("not-an-email")}, true, false},
+		{"email cleared", &UserProfilePatch{Email: strPtr("")}, true, true},
+		{"avatar https", &UserProfilePatch{AvatarURL: strPtr("https://cdn.example.com/a.png")}, true, true},
+		{"avatar javascript", &UserProfilePatch{AvatarURL: strPtr("javascript:alert(1)")}, true, false},
+		{"locale", &UserProfilePatch{Locale: strPtr("zh-cn")}, true, true},
+		{"locale invalid", &UserProfilePatch{Locale: strPtr("not a locale")}, true, false},
+		{"timezone", &UserProfilePatch{Timezone: strPtr("Asia/Shanghai")}, true, true},
+		{"timezone invalid", &UserProfilePatch{Timezone: strPtr("Mars/Olympus")}, true, false},
+		{"timezone local", &UserProfilePatch{Timezone: strPtr("Local")}, true, false},
+		{"attribute unknown", &UserProfilePatch{Attributes: map[string]any{"nope": "x"}}, false, false},
+		{"attribute self editable", &UserProfilePatch{Attributes: map[string]any{"test_nickname": "al"}}, true, true},
+		{"attribute admin only", &UserProfilePatch{Attributes: map[string]any{"test_tier": "pro"}}, true, false},
+		{"attribute admin only by admin", &UserProfilePatch{Attributes: map[string]any{"test_tier": "pro"}}, false, true},
+		{"attribute option", &UserProfilePatch{Attributes: map[string]any{"test_tier": "gold"}}, false, false},
+		{"attribute too long", &UserProfilePatch{Attributes: map[string]any{"test_nickname": "123456789"}}, true, false},
+		{"attribute int", &UserProfilePatch{Attributes: map[string]any{"test_score": float64(3)}}, false, true},
+		{"attribute int fraction", &UserProfilePatch{Attributes: map[string]any{"test_score": 1.5}}, false, false},
+		{"attribute bool type", &UserProfilePatch{Attributes: map[string]any{"test_vip": "yes"}}, true, false},
+		{"attribute delete", &UserProfilePatch{Attributes: map[string]any{"test_vip": nil}}, true, true},
+		{"empty", &UserProfilePatch{}, true, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := normalizeUserProfilePatch(tc.patch, tc.self)
+			if tc.ok && err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if !tc.ok && !errors.Is(err, ErrUserProfileInvalid) {
+				t.Fatalf("expected ErrUserProfileInvalid, got %v", err)
+			}
+		})
+	}
+
+	p, err := normalizeUserProfilePatch(&UserProfilePatch{
+		DisplayName: strPtr("  Alice "),
+		Email:       strPtr("Alice@Example.com"),
+		Locale:      strPtr("zh-cn"),
+		Attributes:  map[string]any{"test_score": float64(3)},
+	}, false)
+	if err != nil {
+		t.Fatalf("normalize error %v", err)
+	}
+	if *p.DisplayName != "Alice" || *p.Email != "alice@example.com" || *p.Locale != "zh-CN" {
+		t.Fatalf("unexpected normalized patch %+v", p)
+	}
+	if v, ok := p.Attributes["test_score"].(int64); !ok || v != 3 {
+		t.Fatalf("int attribute should normalize to int64, got %#v", p.Attributes["test_score"])
+	}
+}
+
+func TestRegisterUserAttributes_Panics(t *testing.T) {
+	cases := []UserAttributeField{
+		{Key: "Bad-Key", Type: UserAttributeString, Label: "x"},
+		{Key: "test_no_label", Type: UserAttributeString},
+		{Key: "test_bad_type", Type: "date", Label: "x"},
+		{Key: "test_int_options", Type: UserAttributeInt, Label: "x", Options: []string{"1"}},
+		{Key: "test_nickname", Type: UserAttributeString, Label: "重复"},
+	}
+	for _, f := range cases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("expected panic for %+v", f)
+				}
+			}()
+			RegisterUserAttributes(f)
+		}()
+	}
+}
+
+func TestUserProfilePatch_MergeAttributes(t *testing.T) {
+	cur := map[string]any{"a": 1, "b": 2}
+	p := &UserProfilePatch{Attributes: map[string]any{"a": nil, "c": 3}}
+	got := p.MergeAttributes(cur)
+	if _, ok := got["a"]; ok || got["b"] != 2 || got["c"] != 3 {
+		t.Fatalf("unexpected merge result %v", got)
+	}
+	if cur["a"] != 1 {
+		t.Fatal("merge must not modify the current attributes")
+	}
+}
+
+func TestUserProfileUsecase_Self(t *testing.T) {
+	uc, repo := newTestUserProfileUsecase(nil)
+	ctx := NewContextWithClaims(context.Background(), &AuthClaims{UserID: 1, Username: "alice", Role: RoleUser})
+
+	got, err := uc.Mine(ctx)
+	if err != nil {
+		t.Fatalf("Mine() error = %v", err)
+	}
+	if _, ok := got.Attributes["removed_key"]; ok {
+		t.Fatalf("unregistered attributes should be hidden, got %v", got.Attributes)
+	}
+
+	got, err = uc.UpdateMine(ctx, &UserProfilePatch{
+		Email:      strPtr("new@example.com"),
+		Attributes: map[string]any{"test_vip": true},
+	})
+	if err != nil {
+		t.Fatalf("UpdateMine() error = %v", err)
+	}
+	if got.Email != "new@example.com" || got.EmailVerified || got.Attributes["test_vip"] != true || got.Attributes["test_tier"] != "free" {
+		t.Fatalf("unexpected profile %+v", got)
+	}
+	if repo.profiles[1].Attributes["removed_key"] != "kept" {
+		t.Fatal("unregistered attributes should be kept in storage")
+	}
+
+	if _, err := uc.UpdateMine(ctx, &UserProfilePatch{Attributes: map[string]any{"test_tier": "pro"}}); !errors.Is(err, ErrUserProfileInvalid) {
+		t.Fatalf("self update of admin-only attribute should fail, got %v", err)
+	}
+	if _, err := uc.Mine(adminCtx()); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("admin token should not use self profile, got %v", err)
+	}
+}
+
+func TestUserProfileUsecase_Update_RecordsAudit(t *testing.T) {
+	audit := &memAuditRepo{}
+	uc, _ := newTestUserProfileUsecase(audit)
+
+	if _, err := uc.Update(NewContextWithClaims(context.Background(), &AuthClaims{UserID: 1, Role: RoleUser}), 1,
+		&UserProfilePatch{DisplayName: strPtr("x")}); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("user token should be forbidden, got %v", err)
+	}
+
+	got, err := uc.Update(adminCtx(), 1, &UserProfilePatch{
+		DisplayName: strPtr("Alice"),
+		Attributes:  map[string]any{"test_tier": "pro", "test_score": float64(10)},
+	})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if got.DisplayName != "Alice" || got.Attributes["test_tier"] != "pro" {
+		t.Fatalf("unexpected profile %+v", got)
+	}
+
+	if len(audit.events) != 1 {
+		t.Fatalf("expected 1 audit event, got %d", len(audit.events))
+	}
+	e := audit.events[0]
+	changes, _ := e.Detail["changes"].(map[string]any)
+	if e.Action != AuditActionUserUpdateProfile || e.TargetID != 1 || e.ActorID != 7 ||
+		changes["display_name"] == nil || changes["attributes.test_tier"] == nil || changes["email"] != nil {
+		t.Fatalf("unexpected audit event %+v", e)
+	}
+
+	if _, err := uc.Update(adminCtx(), 99, &UserProfilePatch{DisplayName: strPtr("x")}); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("missing user should return ErrUserNotFound, got %v", err)
+	}
+}
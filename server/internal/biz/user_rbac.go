@@ -0,0 +1,405 @@
+// server/internal/biz/user_rbac.go
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	PermissionUserRoleRead  = "admin.user_role.read"
+	PermissionUserRoleWrite = "admin.user_role.write"
+)
+
+var _ = RegisterAdminPermissions(
+	AdminPermission{
+		Key:         PermissionUserRoleRead,
+		Name:        "查看用户角色",
+		Group:       "账号",
+		Description: "允许查看普通用户的角色、用户侧权限码与用户的角色绑定",
+	},
+	AdminPermission{
+		Key:         PermissionUserRoleWrite,
+		Name:        "管理用户角色",
+		Group:       "账号",
+		Description: "允许维护普通用户角色及其权限，并给用户分配角色",
+	},
+)
+
+// 用户侧权限码统一以 app. 开头，与后台 admin.* 分属两个命名空间，互不授予。
+const UserPermissionPrefix = "app."
+
+const (
+	UserPermissionPremium  = "app.premium"
+	UserPermissionModerate = "app.moderate"
+)
+
+type UserPermission struct {
+	Key         string
+	Name        string
+	Group       string
+	Description string
+}
+
+var _ = RegisterUserPermissions(
+	UserPermission{
+		Key:         UserPermissionPremium,
+		Name:        "付费功能",
+		Group:       "会员",
+		Description: "允许使用付费档位才开放的功能",
+	},
+	UserPermission{
+		Key:         UserPermissionModerate,
+		Name:        "内容审核",
+		Group:       "社区",
+		Description: "允许处理其他用户提交的内容",
+	},
+)
+
+// 用户侧权限码与后台权限码一样在代码里声明；角色上直接保存 key，不落单独的权限表。
+var userPermissionRegistry = struct {
+	mu    sync.RWMutex
+	byKey map[string]UserPermission
+}{byKey: map[string]UserPermission{}}
+
+// RegisterUserPermissions 注册用户侧权限码；key 不在 app. 命名空间、为空或重复属于编码错误，直接 panic。
+func RegisterUserPermissions(perms ...UserPermission) []UserPermission {
+	userPermissionRegistry.mu.Lock()
+	defer userPermissionRegistry.mu.Unlock()
+	for _, p := range perms {
+		if !strings.HasPrefix(p.Key, UserPermissionPrefix) || len(p.Key) == len(UserPermissionPrefix) || strings.TrimSpace(p.Name) == "" {
+			panic(fmt.Sprintf("RegisterUserPermissions: key must start with %q and name is required, got %+v", UserPermissionPrefix, p))
+		}
+		if _, ok := userPermissionRegistry.byKey[p.Key]; ok {
+			panic("RegisterUserPermissions: duplicate permission " + p.Key)
+		}
+		userPermissionRegistry.byKey[p.Key] = p
+	}
+	return perms
+}
+
+// UserPermissions 返回已注册的全部用户侧权限码，按 key 排序。
+func UserPermissions() []UserPermission {
+	userPermissionRegistry.mu.RLock()
+	defer userPermissionRegistry.mu.RUnlock()
+	out := make([]UserPermission, 0, len(userPermissionRegistry.byKey))
+	for _, p := range userPermissionRegistry.byKey {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+func IsUserPermissionRegistered(key string) bool {
+	userPermissionRegistry.mu.RLock()
+	defer userPermissionRegistry.mu.RUnlock()
+	_, ok := userPermissionRegistry.byKey[key]
+	return ok
+}
+
+type UserRoleSummary struct {
+	ID          int
+	Key         string
+	Name        string
+	Description string
+	UserCount   int
+	Permissions []string
+}
+
+type UserRBACOverview struct {
+	Roles       []UserRoleSummary
+	Permissions []UserPermission
+}
+
+const (
+	AuditActionUserRoleCreate         = "user_rbac.role.create"
+	AuditActionUserRoleUpdate         = "user_rbac.role.update"
+	AuditActionUserRoleDelete         = "user_rbac.role.delete"
+	AuditActionUserRoleSetPermissions = "user_rbac.role.set_permissions"
+	AuditActionUserAssignRoles        = "user_rbac.user.assign_roles"
+)
+
+type UserRBACRepo interface {
+	ListUserRoles(ctx context.Context) ([]UserRoleSummary, error)
+	GetUserRole(ctx context.Context, id int) (*UserRoleSummary, error)
+	CreateUserRole(ctx context.Context, in *UserRoleSummary) (*UserRoleSummary, error)
+	UpdateUserRole(ctx context.Context, id int, name, description string) (*UserRoleSummary, error)
+	// DeleteUserRole 同时删除角色的权限与用户绑定。
+	DeleteUserRole(ctx context.Context, id int) error
+	SetUserRolePermissions(ctx context.Context, id int, permissionKeys []string) (*UserRoleSummary, error)
+	// SetUserRoles 整体替换用户的角色并返回替换前的角色；用户不存在（限定组织时含不属于该组织）返回 ErrUserNotFound，
+	// 角色不存在返回 ErrRoleNotFound。
+	SetUserRoles(ctx context.Context, userID int, roleKeys []string) (before []string, err error)
+	// GetUserAccess 返回用户的角色 key 与全部角色权限码的并集，均已排序去重；限定组织时用户不属于该组织返回 ErrUserNotFound。
+	GetUserAccess(ctx context.Context, userID int) (roles, permissions []string, err error)
+}
+
+type UserRBACUsecase struct {
+	repo   UserRBACRepo
+	audit  AuditRepo
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewUserRBACUsecase(repo UserRBACRepo, audit AuditRepo, logger log.Logger, tp *tracesdk.TracerProvider) *UserRBACUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.user_rbac"))
+
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.user_rbac")
+	} else {
+		tr = otel.Tracer("biz.user_rbac")
+	}
+
+	return &UserRBACUsecase{
+		repo:   repo,
+		audit:  audit,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+func (uc *UserRBACUsecase) Tracer() trace.Tracer {
+	if uc.tracer != nil {
+		return uc.tracer
+	}
+	return otel.Tracer("biz.user_rbac")
+}
+
+func (uc *UserRBACUsecase) Overview(ctx context.Context) (*UserRBACOverview, error) {
+	roles, err := uc.repo.ListUserRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UserRBACOverview{Roles: roles, Permissions: UserPermissions()}, nil
+}
+
+// Access 返回用户当前的角色与权限码，供 auth.me 和 dispatcher 的用户侧鉴权使用。
+func (uc *UserRBACUsecase) Access(ctx context.Context, userID int) (roles, permissions []string, err error) {
+	return uc.repo.GetUserAccess(ctx, userID)
+}
+
+func (uc *UserRBACUsecase) UserDataSection() string { return "roles" }
+
+// ExportUserData 导出用户绑定的角色与由此获得的权限码。
+func (uc *UserRBACUsecase) ExportUserData(ctx context.Context, userID int) (any, error) {
+	roles, permissions, err := uc.repo.GetUserAccess(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"roles": roles, "permissions": permissions}, nil
+}
+
+func (uc *UserRBACUsecase) CreateRole(ctx context.Context, key, name, description string, permissionKeys []string) (*UserRoleSummary, error) {
+	ctx, span := uc.Tracer().Start(ctx, "user_rbac.create_role", trace.WithAttributes(attribute.String("user_rbac.role_key", key)))
+	defer span.End()
+
+	key = strings.TrimSpace(key)
+	name = strings.TrimSpace(name)
+	if !roleKeyPattern.MatchString(key) {
+		span.SetStatus(codes.Error, ErrRoleKeyInvalid.Error())
+		return nil, ErrRoleKeyInvalid
+	}
+	if name == "" {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+	permissionKeys, err := normalizeUserPermissionKeys(permissionKeys)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "CreateRole", key, err)
+	}
+
+	role, err := uc.repo.CreateUserRole(ctx, &UserRoleSummary{
+		Key:         key,
+		Name:        name,
+		Description: strings.TrimSpace(description),
+		Permissions: permissionKeys,
+	})
+	if err != nil {
+		return nil, uc.fail(ctx, span, "CreateRole", key, err)
+	}
+
+	uc.recordRoleAudit(ctx, AuditActionUserRoleCreate, role, map[string]any{"permissions": role.Permissions})
+	span.SetStatus(codes.Ok, "OK")
+	return role, nil
+}
+
+// UpdateRole 只允许改名称和描述；key 被邀请码等配置引用，不可修改。
+func (uc *UserRBACUsecase) UpdateRole(ctx context.Context, id int, name, description string) (*UserRoleSummary, error) {
+	ctx, span := uc.Tracer().Start(ctx, "user_rbac.update_role", trace.WithAttributes(attribute.Int("user_rbac.role_id", id)))
+	defer span.End()
+
+	name = strings.TrimSpace(name)
+	if id <= 0 || name == "" {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+
+	role, err := uc.repo.UpdateUserRole(ctx, id, name, strings.TrimSpace(description))
+	if err != nil {
+		return nil, uc.fail(ctx, span, "UpdateRole", "", err)
+	}
+
+	uc.recordRoleAudit(ctx, AuditActionUserRoleUpdate, role, map[string]any{"name": role.Name, "description": role.Description})
+	span.SetStatus(codes.Ok, "OK")
+	return role, nil
+}
+
+func (uc *UserRBACUsecase) DeleteRole(ctx context.Context, id int) error {
+	ctx, span := uc.Tracer().Start(ctx, "user_rbac.delete_role", trace.WithAttributes(attribute.Int("user_rbac.role_id", id)))
+	defer span.End()
+
+	if id <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return ErrBadParam
+	}
+
+	role, err := uc.repo.GetUserRole(ctx, id)
+	if err != nil {
+		return uc.fail(ctx, span, "DeleteRole", "", err)
+	}
+	if err := uc.repo.DeleteUserRole(ctx, id); err != nil {
+		return uc.fail(ctx, span, "DeleteRole", role.Key, err)
+	}
+
+	uc.recordRoleAudit(ctx, AuditActionUserRoleDelete, role, map[string]any{
+		"permissions": role.Permissions,
+		"user_count":  role.UserCount,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return nil
+}
+
+// SetRolePermissions 整体替换角色的权限码；只接受已注册的 app.* 权限码。
+func (uc *UserRBACUsecase) SetRolePermissions(ctx context.Context, id int, permissionKeys []string) (*UserRoleSummary, error) {
+	ctx, span := uc.Tracer().Start(ctx, "user_rbac.set_role_permissions", trace.WithAttributes(attribute.Int("user_rbac.role_id", id)))
+	defer span.End()
+
+	if id <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+	permissionKeys, err := normalizeUserPermissionKeys(permissionKeys)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "SetRolePermissions", "", err)
+	}
+
+	before, err := uc.repo.GetUserRole(ctx, id)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "SetRolePermissions", "", err)
+	}
+	role, err := uc.repo.SetUserRolePermissions(ctx, id, permissionKeys)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "SetRolePermissions", before.Key, err)
+	}
+
+	uc.recordRoleAudit(ctx, AuditActionUserRoleSetPermissions, role, map[string]any{
+		"before": before.Permissions,
+		"after":  role.Permissions,
+	})
+	span.SetStatus(codes.Ok, "OK")
+	return role, nil
+}
+
+// AssignRoles 整体替换用户的角色，传空数组表示清空。
+func (uc *UserRBACUsecase) AssignRoles(ctx context.Context, userID int, roleKeys []string) ([]string, error) {
+	ctx, span := uc.Tracer().Start(ctx, "user_rbac.assign_roles", trace.WithAttributes(attribute.Int("user_rbac.user_id", userID)))
+	defer span.End()
+
+	if userID <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return nil, ErrBadParam
+	}
+	roleKeys = normalizeRoleKeys(roleKeys)
+
+	before, err := uc.repo.SetUserRoles(ctx, userID, roleKeys)
+	if err != nil {
+		return nil, uc.fail(ctx, span, "AssignRoles", strings.Join(roleKeys, ","), err)
+	}
+
+	if uc.audit != nil {
+		e := &AuditEvent{
+			Action:     AuditActionUserAssignRoles,
+			ActorKind:  AuditActorAdmin,
+			TargetKind: "user",
+			TargetID:   userID,
+			Detail:     map[string]any{"before": before, "after": roleKeys},
+		}
+		if c, ok := GetClaimsFromContext(ctx); ok && c != nil {
+			e.ActorID = c.UserID
+			e.ActorUsername = c.Username
+		}
+		if err := uc.audit.RecordAudit(ctx, e); err != nil {
+			uc.log.WithContext(ctx).Warnf("record user role audit failed user_id=%d err=%v", userID, err)
+		}
+	}
+	span.SetStatus(codes.Ok, "OK")
+	return roleKeys, nil
+}
+
+func (uc *UserRBACUsecase) fail(ctx context.Context, span trace.Span, op, roleKey string, err error) error {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	l := uc.log.WithContext(ctx)
+	switch {
+	case errors.Is(err, ErrRoleNotFound), errors.Is(err, ErrRoleExists), errors.Is(err, ErrPermissionUnknown), errors.Is(err, ErrUserNotFound):
+		l.Warnf("%s rejected role_key=%s err=%v", op, roleKey, err)
+	default:
+		l.Errorf("%s failed role_key=%s err=%v", op, roleKey, err)
+	}
+	return err
+}
+
+func (uc *UserRBACUsecase) recordRoleAudit(ctx context.Context, action string, role *UserRoleSummary, detail map[string]any) {
+	if uc.audit == nil || role == nil {
+		return
+	}
+	e := &AuditEvent{
+		Action:     action,
+		ActorKind:  AuditActorAdmin,
+		TargetKind: "user_role",
+		TargetID:   role.ID,
+		Detail:     detail,
+	}
+	if c, ok := GetClaimsFromContext(ctx); ok && c != nil {
+		e.ActorID = c.UserID
+		e.ActorUsername = c.Username
+	}
+	if e.Detail == nil {
+		e.Detail = map[string]any{}
+	}
+	e.Detail["role_key"] = role.Key
+	if err := uc.audit.RecordAudit(ctx, e); err != nil {
+		uc.log.WithContext(ctx).Warnf("record user rbac audit failed action=%s role_id=%d err=%v", action, role.ID, err)
+	}
+}
+
+// normalizeUserPermissionKeys 去重排序；用户侧角色不支持通配与拒绝条目，只接受已注册的权限码。
+func normalizeUserPermissionKeys(keys []string) ([]string, error) {
+	seen := make(map[string]bool, len(keys))
+	out := make([]string, 0, len(keys))
+	for _, k := range keys {
+		k = strings.TrimSpace(k)
+		if k == "" || seen[k] {
+			continue
+		}
+		if !IsUserPermissionRegistered(k) {
+			return nil, fmt.Errorf("%w: %s", ErrPermissionUnknown, k)
+		}
+		seen[k] = true
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out, nil
+}
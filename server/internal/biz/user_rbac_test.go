@@ -0,0 +1,73 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type memUserRBACRepo struct {
+	UserRBACRepo
+	created  []*UserRoleSummary
+	bindings map[int][]string
+}
+
+func (r *memUserRBACRepo) CreateUserRole(ctx context.Context, in *UserRoleSummary) (*UserRoleSummary, error) {
+	out := *in
+	out.ID = len(r.created) + 1
+	r.created = append(r.created, &out)
+	return &out, nil
+}
+
+func (r *memUserRBACRepo) SetUserRoles(ctx context.Context, userID int, roleKeys []string) ([]string, error) {
+	before := r.bindings[userID]
+	r.bindings[userID] = roleKeys
+	return before, nil
+}
+
+func TestUserRBACUsecase_CreateRoleOnlyAcceptsUserPermissions(t *testing.T) {
+	repo := &memUserRBACRepo{bindings: map[int][]string{}}
+	audit := &memAuditRepo{}
+	uc := NewUserRBACUsecase(repo, audit, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+
+	for _, perms := range [][]string{{PermissionUserRead}, {"app.*"}, {"!" + UserPermissionPremium}} {
+		if _, err := uc.CreateRole(adminCtx(), "pro", "专业版", "", perms); !errors.Is(err, ErrPermissionUnknown) {
+			t.Fatalf("CreateRole(%v) error = %v, want ErrPermissionUnknown", perms, err)
+		}
+	}
+
+	role, err := uc.CreateRole(adminCtx(), "pro", "专业版", "", []string{UserPermissionPremium, UserPermissionPremium})
+	if err != nil {
+		t.Fatalf("CreateRole() error = %v", err)
+	}
+	if len(role.Permissions) != 1 || role.Permissions[0] != UserPermissionPremium {
+		t.Fatalf("unexpected permissions %v", role.Permissions)
+	}
+	if len(audit.events) != 1 || audit.events[0].Action != AuditActionUserRoleCreate {
+		t.Fatalf("expected create audit, got %+v", audit.events)
+	}
+}
+
+func TestUserRBACUsecase_AssignRolesRecordsBeforeAndAfter(t *testing.T) {
+	repo := &memUserRBACRepo{bindings: map[int][]string{7: {"free"}}}
+	audit := &memAuditRepo{}
+	uc := NewUserRBACUsecase(repo, audit, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+
+	after, err := uc.AssignRoles(adminCtx(), 7, []string{" pro ", "moderator", "pro"})
+	if err != nil {
+		t.Fatalf("AssignRoles() error = %v", err)
+	}
+	if len(after) != 2 || after[0] != "moderator" || after[1] != "pro" {
+		t.Fatalf("unexpected roles %v", after)
+	}
+	if len(audit.events) != 1 || audit.events[0].TargetID != 7 || audit.events[0].Action != AuditActionUserAssignRoles {
+		t.Fatalf("unexpected audit %+v", audit.events)
+	}
+	if _, err := uc.AssignRoles(adminCtx(), 0, nil); !errors.Is(err, ErrBadParam) {
+		t.Fatalf("AssignRoles(0) error = %v, want ErrBadParam", err)
+	}
+}
@@ -0,0 +1,50 @@
+// server/internal/biz/username.go
+package biz
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+var ErrUsernameInvalid = errors.New("username invalid")
+
+const (
+	UsernameMinLen = 3
+	UsernameMaxLen = 32
+)
+
+// CanonicalUsername 是落库展示用的形式：去首尾空白并做 NFKC，
+// 全角字母、兼容字符会被折叠成常规写法，但保留大小写。
+func CanonicalUsername(raw string) string {
+	return norm.NFKC.String(strings.TrimSpace(raw))
+}
+
+// NormalizeUsername 是唯一性与登录查找用的 key：CanonicalUsername 之后再做大小写折叠，
+// 所以 "Alice"、"alice"、"ａｌｉｃｅ" 指向同一个账号。
+func NormalizeUsername(raw string) string {
+	return norm.NFKC.String(cases.Fold().String(CanonicalUsername(raw)))
+}
+
+// ValidateUsername 校验新建账号的用户名：只允许 ASCII 字母、数字和 _ . -，
+// 以字母或数字开头，长度 3~32。限制在 ASCII 内是为了从根上挡住 Unicode 形近字冒充。
+// 已有账号不受这里约束，登录只做 NormalizeUsername。
+func ValidateUsername(raw string) (canonical, normalized string, err error) {
+	canonical = CanonicalUsername(raw)
+	n := utf8.RuneCountInString(canonical)
+	if n < UsernameMinLen || n > UsernameMaxLen {
+		return "", "", ErrUsernameInvalid
+	}
+	for i, r := range canonical {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case (r == '_' || r == '.' || r == '-') && i > 0:
+		default:
+			return "", "", ErrUsernameInvalid
+		}
+	}
+	return canonical, NormalizeUsername(canonical), nil
+}
@@ -0,0 +1,65 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestNormalizeUsernameFoldsCaseAndWidth(t *testing.T) {
+	cases := map[string]string{
+		"Alice":      "alice",
+		"  alice  ":  "alice",
+		"ＡＬＩＣＥ":      "alice",
+		"Bob_01.dev": "bob_01.dev",
+		"straße":     "strasse",
+	}
+	for in, want := range cases {
+		if got := NormalizeUsername(in); got != want {
+			t.Fatalf("NormalizeUsername(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestValidateUsername(t *testing.T) {
+	canonical, normalized, err := ValidateUsername("  Ａlice_01 ")
+	if err != nil {
+		t.Fatalf("ValidateUsername() error = %v", err)
+	}
+	if canonical != "Alice_01" || normalized != "alice_01" {
+		t.Fatalf("ValidateUsername() = %q, %q", canonical, normalized)
+	}
+
+	for _, bad := range []string{"ab", "_alice", "ali ce", "alice!", "аlice", "用户名", "abcdefghijklmnopqrstuvwxyz0123456"} {
+		if _, _, err := ValidateUsername(bad); !errors.Is(err, ErrUsernameInvalid) {
+			t.Fatalf("ValidateUsername(%q) error = %v, want ErrUsernameInvalid", bad, err)
+		}
+	}
+}
+
+func TestAuthUsecase_Register_NormalizesUsername(t *testing.T) {
+	repo := newMemAuthRepo()
+
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+	uc := NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
+		return "tok", time.Now().Add(time.Hour), nil
+	}, nil, logger, tp)
+
+	if _, _, _, err := uc.Register(context.Background(), "a!", "password123"); !errors.Is(err, ErrUsernameInvalid) {
+		t.Fatalf("Register() error = %v, want ErrUsernameInvalid", err)
+	}
+
+	_, _, u, err := uc.Register(context.Background(), " Ａlice ", "password123")
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if u.Username != "Alice" {
+		t.Fatalf("Register() username = %q, want %q", u.Username, "Alice")
+	}
+}
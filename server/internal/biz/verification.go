@@ -0,0 +1,385 @@
+// server/internal/biz/verification.go
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrUserUnverified              = errors.New("user contact not verified")
+	ErrVerificationTooFrequent     = errors.New("verification sent too frequently")
+	ErrVerificationCodeInvalid     = errors.New("verification code invalid")
+	ErrVerificationCodeExpired     = errors.New("verification code expired")
+	ErrVerificationContactInvalid  = errors.New("verification contact invalid")
+	ErrVerificationContactConflict = errors.New("verification contact already used")
+)
+
+const (
+	VerificationChannelEmail = "email"
+	VerificationChannelPhone = "phone"
+)
+
+// Mailer 是邮件发送扩展点；开发环境用 data 层的 log/file 实现，生产替换成真实服务商。
+type Mailer interface {
+	SendMail(ctx context.Context, to, subject, body string) error
+}
+
+// SMSSender 是短信发送扩展点，约定同 Mailer。
+type SMSSender interface {
+	SendSMS(ctx context.Context, phone, message string) error
+}
+
+// VerificationPolicy 控制验证码有效期和发送/校验频率。
+type VerificationPolicy struct {
+	Required        bool
+	CodeTTL         time.Duration
+	SendCooldown    time.Duration
+	MaxSendsPerHour int
+	MaxAttempts     int
+}
+
+func (p *AuthPolicy) RequireVerification() bool {
+	return p != nil && p.Verification.Required
+}
+
+func (p *AuthPolicy) verification() VerificationPolicy {
+	v := VerificationPolicy{}
+	if p != nil {
+		v = p.Verification
+	}
+	if v.CodeTTL <= 0 {
+		v.CodeTTL = 10 * time.Minute
+	}
+	if v.SendCooldown <= 0 {
+		v.SendCooldown = time.Minute
+	}
+	if v.MaxSendsPerHour <= 0 {
+		v.MaxSendsPerHour = 5
+	}
+	if v.MaxAttempts <= 0 {
+		v.MaxAttempts = 5
+	}
+	return v
+}
+
+type VerificationCode struct {
+	ID         int
+	UserID     int
+	Channel    string
+	Target     string
+	CodeHash   string
+	Attempts   int
+	ExpiresAt  time.Time
+	ConsumedAt *time.Time
+	CreatedAt  time.Time
+}
+
+type VerificationRepo interface {
+	CreateVerificationCode(ctx context.Context, in *VerificationCode) (*VerificationCode, error)
+	CountVerificationCodesSince(ctx context.Context, userID int, channel string, since time.Time) (int, error)
+	// LatestVerificationCode 返回最近一条未核销的验证码，没有时返回 (nil, nil)。
+	LatestVerificationCode(ctx context.Context, userID int, channel string) (*VerificationCode, error)
+	// ClaimVerificationAttempt 原子地占用一次尝试（attempts < max 且未核销时加一），次数已用完时返回 false。
+	ClaimVerificationAttempt(ctx context.Context, id, max int) (bool, error)
+	// ListVerificationCodes 返回用户的全部验证码记录（含已核销、已过期的），按创建时间升序。
+	ListVerificationCodes(ctx context.Context, userID int) ([]*VerificationCode, error)
+	// ConsumeVerificationCode 核销验证码，把 target 写入用户对应渠道并标记为已验证；target 已被其他用户占用时返回 ErrVerificationContactConflict。
+	ConsumeVerificationCode(ctx context.Context, code *VerificationCode, at time.Time) error
+}
+
+type VerificationUsecase struct {
+	repo   VerificationRepo
+	users  AuthRepo
+	mailer Mailer
+	sms    SMSSender
+	policy *AuthPolicy
+	log    *log.Helper
+	tracer trace.Tracer
+}
+
+func NewVerificationUsecase(repo VerificationRepo, users AuthRepo, mailer Mailer, sms SMSSender, policy *AuthPolicy, logger log.Logger, tp *tracesdk.TracerProvider) *VerificationUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "biz.verification"))
+
+	var tr trace.Tracer
+	if tp != nil {
+		tr = tp.Tracer("biz.verification")
+	} else {
+		tr = otel.Tracer("biz.verification")
+	}
+
+	return &VerificationUsecase{
+		repo:   repo,
+		users:  users,
+		mailer: mailer,
+		sms:    sms,
+		policy: policy,
+		log:    helper,
+		tracer: tr,
+	}
+}
+
+func (uc *VerificationUsecase) UserDataSection() string { return "verification_codes" }
+
+// ExportUserData 导出验证码发送记录，包括尚未验证的邮箱与手机号；验证码哈希不导出。
+func (uc *VerificationUsecase) ExportUserData(ctx context.Context, userID int) (any, error) {
+	list, err := uc.repo.ListVerificationCodes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]map[string]any, 0, len(list))
+	for _, c := range list {
+		out = append(out, map[string]any{
+			"channel":     c.Channel,
+			"target":      c.Target,
+			"attempts":    c.Attempts,
+			"created_at":  c.CreatedAt,
+			"expires_at":  c.ExpiresAt,
+			"consumed_at": c.ConsumedAt,
+		})
+	}
+	return out, nil
+}
+
+func (uc *VerificationUsecase) Tracer() trace.Tracer {
+	if uc.tracer != nil {
+		return uc.tracer
+	}
+	return otel.Tracer("biz.verification")
+}
+
+// NormalizeVerificationTarget 统一邮箱/手机号格式：邮箱转小写，手机号只保留数字和开头的 +。
+func NormalizeVerificationTarget(channel, target string) (string, error) {
+	target = strings.TrimSpace(target)
+	switch channel {
+	case VerificationChannelEmail:
+		addr, err := mail.ParseAddress(target)
+		if err != nil || addr.Address != target || len(target) > 254 {
+			return "", ErrVerificationContactInvalid
+		}
+		return strings.ToLower(target), nil
+	case VerificationChannelPhone:
+		var b strings.Builder
+		for i, r := range target {
+			switch {
+			case r >= '0' && r <= '9':
+				b.WriteRune(r)
+			case r == '+' && i == 0:
+				b.WriteRune(r)
+			case r == ' ' || r == '-':
+			default:
+				return "", ErrVerificationContactInvalid
+			}
+		}
+		out := b.String()
+		digits := strings.TrimPrefix(out, "+")
+		if len(digits) < 6 || len(digits) > 20 {
+			return "", ErrVerificationContactInvalid
+		}
+		return out, nil
+	default:
+		return "", ErrBadParam
+	}
+}
+
+func hashVerificationCode(userID int, channel, code string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d:%s:%s", userID, channel, code)))
+	return hex.EncodeToString(sum[:])
+}
+
+func newVerificationCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// SendVerification 给用户的邮箱/手机发送验证码；target 非空时发往新联系方式，验证通过后才写入用户资料。
+func (uc *VerificationUsecase) SendVerification(ctx context.Context, userID int, channel, target string) (expireAt time.Time, err error) {
+	ctx, span := uc.Tracer().Start(ctx, "verification.send",
+		trace.WithAttributes(
+			attribute.Int("user.id", userID),
+			attribute.String("verification.channel", channel),
+		),
+	)
+	defer span.End()
+
+	l := uc.log.WithContext(ctx)
+	policy := uc.policy.verification()
+
+	if userID <= 0 {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return time.Time{}, ErrBadParam
+	}
+
+	u, err := uc.users.GetUserByID(ctx, userID)
+	if err != nil || u == nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, ErrUserNotFound.Error())
+		return time.Time{}, ErrUserNotFound
+	}
+
+	now := time.Now()
+	latest, err := uc.repo.LatestVerificationCode(ctx, userID, channel)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "repo.LatestVerificationCode failed")
+		return time.Time{}, err
+	}
+
+	// target 为空时优先沿用待验证的新联系方式，其次是当前联系方式。
+	if strings.TrimSpace(target) == "" {
+		target = u.Contact(channel)
+		if latest != nil && latest.ExpiresAt.After(now) {
+			target = latest.Target
+		}
+	}
+	target, err = NormalizeVerificationTarget(channel, target)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		l.Infof("SendVerification invalid target user_id=%d channel=%s", userID, channel)
+		return time.Time{}, err
+	}
+	if latest != nil && now.Sub(latest.CreatedAt) < policy.SendCooldown {
+		span.SetStatus(codes.Error, ErrVerificationTooFrequent.Error())
+		l.Infof("SendVerification cooldown user_id=%d channel=%s", userID, channel)
+		return time.Time{}, ErrVerificationTooFrequent
+	}
+	sent, err := uc.repo.CountVerificationCodesSince(ctx, userID, channel, now.Add(-time.Hour))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "repo.CountVerificationCodesSince failed")
+		return time.Time{}, err
+	}
+	if sent >= policy.MaxSendsPerHour {
+		span.SetStatus(codes.Error, ErrVerificationTooFrequent.Error())
+		l.Infof("SendVerification hourly limit user_id=%d channel=%s sent=%d", userID, channel, sent)
+		return time.Time{}, ErrVerificationTooFrequent
+	}
+
+	code, err := newVerificationCode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "generate code failed")
+		return time.Time{}, err
+	}
+
+	expireAt = now.Add(policy.CodeTTL)
+	if _, err := uc.repo.CreateVerificationCode(ctx, &VerificationCode{
+		UserID:    userID,
+		Channel:   channel,
+		Target:    target,
+		CodeHash:  hashVerificationCode(userID, channel, code),
+		ExpiresAt: expireAt,
+	}); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "repo.CreateVerificationCode failed")
+		l.Errorf("SendVerification save code failed user_id=%d channel=%s err=%v", userID, channel, err)
+		return time.Time{}, err
+	}
+
+	minutes := int(policy.CodeTTL / time.Minute)
+	switch channel {
+	case VerificationChannelEmail:
+		err = uc.mailer.SendMail(ctx, target, "验证码", fmt.Sprintf("你的验证码是 %s，%d 分钟内有效。", code, minutes))
+	case VerificationChannelPhone:
+		err = uc.sms.SendSMS(ctx, target, fmt.Sprintf("验证码 %s，%d 分钟内有效。", code, minutes))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "deliver code failed")
+		l.Errorf("SendVerification deliver failed user_id=%d channel=%s err=%v", userID, channel, err)
+		return time.Time{}, err
+	}
+
+	span.SetStatus(codes.Ok, "OK")
+	l.Infof("SendVerification success user_id=%d channel=%s", userID, channel)
+	return expireAt, nil
+}
+
+// Verify 校验验证码；错误次数达到上限后该验证码作废，需要重新发送。
+func (uc *VerificationUsecase) Verify(ctx context.Context, userID int, channel, code string) error {
+	ctx, span := uc.Tracer().Start(ctx, "verification.verify",
+		trace.WithAttributes(
+			attribute.Int("user.id", userID),
+			attribute.String("verification.channel", channel),
+		),
+	)
+	defer span.End()
+
+	l := uc.log.WithContext(ctx)
+	policy := uc.policy.verification()
+
+	code = strings.TrimSpace(code)
+	if userID <= 0 || code == "" || (channel != VerificationChannelEmail && channel != VerificationChannelPhone) {
+		span.SetStatus(codes.Error, ErrBadParam.Error())
+		return ErrBadParam
+	}
+
+	latest, err := uc.repo.LatestVerificationCode(ctx, userID, channel)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "repo.LatestVerificationCode failed")
+		return err
+	}
+	if latest == nil {
+		span.SetStatus(codes.Error, ErrVerificationCodeInvalid.Error())
+		l.Infof("Verify no usable code user_id=%d channel=%s", userID, channel)
+		return ErrVerificationCodeInvalid
+	}
+
+	now := time.Now()
+	if !latest.ExpiresAt.After(now) {
+		span.SetStatus(codes.Error, ErrVerificationCodeExpired.Error())
+		return ErrVerificationCodeExpired
+	}
+
+	// 先占用一次尝试再比对：并发请求无法绕过次数上限，计数失败时直接拒绝。
+	ok, err := uc.repo.ClaimVerificationAttempt(ctx, latest.ID, policy.MaxAttempts)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "repo.ClaimVerificationAttempt failed")
+		l.Errorf("Verify claim attempt failed code_id=%d err=%v", latest.ID, err)
+		return err
+	}
+	if !ok {
+		span.SetStatus(codes.Error, ErrVerificationCodeInvalid.Error())
+		l.Infof("Verify attempts exhausted user_id=%d channel=%s", userID, channel)
+		return ErrVerificationCodeInvalid
+	}
+
+	want := hashVerificationCode(userID, channel, code)
+	if subtle.ConstantTimeCompare([]byte(want), []byte(latest.CodeHash)) != 1 {
+		span.SetStatus(codes.Error, ErrVerificationCodeInvalid.Error())
+		l.Infof("Verify wrong code user_id=%d channel=%s attempts=%d", userID, channel, latest.Attempts+1)
+		return ErrVerificationCodeInvalid
+	}
+
+	if err := uc.repo.ConsumeVerificationCode(ctx, latest, now); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "repo.ConsumeVerificationCode failed")
+		l.Errorf("Verify consume failed user_id=%d channel=%s err=%v", userID, channel, err)
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "OK")
+	l.Infof("Verify success user_id=%d channel=%s", userID, channel)
+	return nil
+}
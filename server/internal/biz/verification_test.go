@@ -0,0 +1,263 @@
+// server/internal/biz/verification_test.go
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type memVerificationRepo struct {
+	mu    sync.Mutex
+	users *memAuthRepo
+	codes []*VerificationCode
+
+	claimErr error
+}
+
+func (r *memVerificationRepo) user(id int) *User {
+	for _, u := range r.users.usersByName {
+		if u.ID == id {
+			return u
+		}
+	}
+	return nil
+}
+
+func (r *memVerificationRepo) CreateVerificationCode(ctx context.Context, in *VerificationCode) (*VerificationCode, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cp := *in
+	cp.ID = len(r.codes) + 1
+	cp.CreatedAt = time.Now()
+	r.codes = append(r.codes, &cp)
+	return &cp, nil
+}
+
+func (r *memVerificationRepo) CountVerificationCodesSince(ctx context.Context, userID int, channel string, since time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, c := range r.codes {
+		if c.UserID == userID && c.Channel == channel && !c.CreatedAt.Before(since) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *memVerificationRepo) LatestVerificationCode(ctx context.Context, userID int, channel string) (*VerificationCode, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := len(r.codes) - 1; i >= 0; i-- {
+		c := r.codes[i]
+		if c.UserID == userID && c.Channel == channel && c.ConsumedAt == nil {
+			cp := *c
+			return &cp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memVerificationRepo) ClaimVerificationAttempt(ctx context.Context, id, max int) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.claimErr != nil {
+		return false, r.claimErr
+	}
+	c := r.codes[id-1]
+	if c.Attempts >= max || c.ConsumedAt != nil {
+		return false, nil
+	}
+	c.Attempts++
+	return true, nil
+}
+
+func (r *memVerificationRepo) ListVerificationCodes(ctx context.Context, userID int) ([]*VerificationCode, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*VerificationCode
+	for _, c := range r.codes {
+		if c.UserID == userID {
+			cp := *c
+			out = append(out, &cp)
+		}
+	}
+	return out, nil
+}
+
+func (r *memVerificationRepo) ConsumeVerificationCode(ctx context.Context, code *VerificationCode, at time.Time) error {
+	r.mu.Lock()
+	r.codes[code.ID-1].ConsumedAt = &at
+	r.mu.Unlock()
+
+	r.users.mu.Lock()
+	defer r.users.mu.Unlock()
+	u := r.user(code.UserID)
+	if code.Channel == VerificationChannelEmail {
+		u.Email, u.EmailVerifiedAt = code.Target, &at
+	} else {
+		u.Phone, u.PhoneVerifiedAt = code.Target, &at
+	}
+	return nil
+}
+
+type captureSender struct {
+	last string
+}
+
+func (s *captureSender) SendMail(ctx context.Context, to, subject, body string) error {
+	s.last = body
+	return nil
+}
+
+func (s *captureSender) SendSMS(ctx context.Context, phone, message string) error {
+	s.last = message
+	return nil
+}
+
+var sixDigits = regexp.MustCompile(`\d{6}`)
+
+func TestVerificationUsecase_SendAndVerify(t *testing.T) {
+	users := newMemAuthRepo()
+	hash, _ := bcrypt.GenerateFromPassword([]byte("p@ss"), bcrypt.DefaultCost)
+	created, _ := users.CreateUser(context.Background(), &User{Username: "alice", PasswordHash: string(hash)})
+
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+	policy := &AuthPolicy{Verification: VerificationPolicy{Required: true, MaxAttempts: 2}}
+	sender := &captureSender{}
+	repo := &memVerificationRepo{users: users}
+	uc := NewVerificationUsecase(repo, users, sender, sender, policy, logger, tp)
+	authUC := NewAuthUsecase(users, func(int, string, int8, int) (string, time.Time, error) {
+		return "tok", time.Now().Add(time.Hour), nil
+	}, policy, logger, tp)
+
+	if _, _, _, err := authUC.Login(context.Background(), "alice", "p@ss"); !errors.Is(err, ErrUserUnverified) {
+		t.Fatalf("expected ErrUserUnverified, got %v", err)
+	}
+
+	if _, err := uc.SendVerification(context.Background(), created.ID, VerificationChannelEmail, "not-an-email"); !errors.Is(err, ErrVerificationContactInvalid) {
+		t.Fatalf("expected ErrVerificationContactInvalid, got %v", err)
+	}
+	if _, err := uc.SendVerification(context.Background(), created.ID, VerificationChannelEmail, "Alice@Example.com"); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if _, err := uc.SendVerification(context.Background(), created.ID, VerificationChannelEmail, ""); !errors.Is(err, ErrVerificationTooFrequent) {
+		t.Fatalf("expected cooldown, got %v", err)
+	}
+
+	code := sixDigits.FindString(sender.last)
+	if code == "" {
+		t.Fatalf("expected code in message, got %q", sender.last)
+	}
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+	if err := uc.Verify(context.Background(), created.ID, VerificationChannelEmail, wrong); !errors.Is(err, ErrVerificationCodeInvalid) {
+		t.Fatalf("expected ErrVerificationCodeInvalid, got %v", err)
+	}
+	if err := uc.Verify(context.Background(), created.ID, VerificationChannelEmail, code); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+
+	if _, _, _, err := authUC.Login(context.Background(), "alice", "p@ss"); err != nil {
+		t.Fatalf("expected login after verification, got %v", err)
+	}
+	u, _ := users.GetUserByID(context.Background(), created.ID)
+	if u.Email != "alice@example.com" {
+		t.Fatalf("expected normalized email, got %q", u.Email)
+	}
+}
+
+func TestVerificationUsecase_MaxAttempts(t *testing.T) {
+	users := newMemAuthRepo()
+	created, _ := users.CreateUser(context.Background(), &User{Username: "bob", PasswordHash: "x"})
+
+	sender := &captureSender{}
+	repo := &memVerificationRepo{users: users}
+	uc := NewVerificationUsecase(repo, users, sender, sender, &AuthPolicy{Verification: VerificationPolicy{MaxAttempts: 1}}, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+
+	if _, err := uc.SendVerification(context.Background(), created.ID, VerificationChannelPhone, "+86 138-0000-0000"); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	code := sixDigits.FindString(sender.last)
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+	_ = uc.Verify(context.Background(), created.ID, VerificationChannelPhone, wrong)
+	if err := uc.Verify(context.Background(), created.ID, VerificationChannelPhone, code); !errors.Is(err, ErrVerificationCodeInvalid) {
+		t.Fatalf("expected code locked after max attempts, got %v", err)
+	}
+}
+
+func TestVerificationUsecase_ClaimFailureFailsClosed(t *testing.T) {
+	users := newMemAuthRepo()
+	created, _ := users.CreateUser(context.Background(), &User{Username: "carol", PasswordHash: "x"})
+
+	sender := &captureSender{}
+	repo := &memVerificationRepo{users: users}
+	uc := NewVerificationUsecase(repo, users, sender, sender, &AuthPolicy{}, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+
+	if _, err := uc.SendVerification(context.Background(), created.ID, VerificationChannelEmail, "carol@example.com"); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	code := sixDigits.FindString(sender.last)
+
+	repo.claimErr = errors.New("db down")
+	if err := uc.Verify(context.Background(), created.ID, VerificationChannelEmail, code); err == nil {
+		t.Fatal("expected error when attempts cannot be counted")
+	}
+	if u, _ := users.GetUserByID(context.Background(), created.ID); u.EmailVerifiedAt != nil {
+		t.Fatal("code must not be consumed when attempts cannot be counted")
+	}
+}
+
+type failingSender struct{ captureSender }
+
+func (s *failingSender) SendMail(ctx context.Context, to, subject, body string) error {
+	return errors.New("smtp down")
+}
+
+func TestVerificationUsecase_NewContactPendingUntilVerified(t *testing.T) {
+	users := newMemAuthRepo()
+	created, _ := users.CreateUser(context.Background(), &User{Username: "dave", PasswordHash: "x"})
+	verifiedAt := time.Now().Add(-time.Hour)
+	users.usersByName["dave"].Email, users.usersByName["dave"].EmailVerifiedAt = "dave@example.com", &verifiedAt
+
+	repo := &memVerificationRepo{users: users}
+	down := &failingSender{}
+	uc := NewVerificationUsecase(repo, users, down, down, &AuthPolicy{}, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	if _, err := uc.SendVerification(context.Background(), created.ID, VerificationChannelEmail, "dave@new.example.com"); err == nil {
+		t.Fatal("expected delivery error")
+	}
+	if u, _ := users.GetUserByID(context.Background(), created.ID); u.Email != "dave@example.com" || u.EmailVerifiedAt == nil {
+		t.Fatalf("contact must stay unchanged when delivery fails, got %q verified=%v", u.Email, u.EmailVerifiedAt != nil)
+	}
+
+	repo.codes = nil
+	sender := &captureSender{}
+	uc = NewVerificationUsecase(repo, users, sender, sender, &AuthPolicy{}, log.NewStdLogger(io.Discard), tracesdk.NewTracerProvider())
+	if _, err := uc.SendVerification(context.Background(), created.ID, VerificationChannelEmail, "dave@new.example.com"); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if u, _ := users.GetUserByID(context.Background(), created.ID); u.Email != "dave@example.com" || u.EmailVerifiedAt == nil {
+		t.Fatalf("contact must stay unchanged before verify, got %q", u.Email)
+	}
+	if err := uc.Verify(context.Background(), created.ID, VerificationChannelEmail, sixDigits.FindString(sender.last)); err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if u, _ := users.GetUserByID(context.Background(), created.ID); u.Email != "dave@new.example.com" || u.EmailVerifiedAt == nil || !u.EmailVerifiedAt.After(verifiedAt) {
+		t.Fatalf("expected new verified contact, got %q", u.Email)
+	}
+}
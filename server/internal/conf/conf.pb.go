@@ -2,7 +2,7 @@
 // versions:
 // 	protoc-gen-go v1.36.10
 // 	protoc        v7.34.0
-// source: conf/conf.proto
+// source: conf.proto
 
 package conf
 
@@ -34,7 +34,7 @@ type Bootstrap struct {
 
 func (x *Bootstrap) Reset() {
 	*x = Bootstrap{}
-	mi := &file_conf_conf_proto_msgTypes[0]
+	mi := &file_conf_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -46,7 +46,7 @@ func (x *Bootstrap) String() string {
 func (*Bootstrap) ProtoMessage() {}
 
 func (x *Bootstrap) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[0]
+	mi := &file_conf_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,7 +59,7 @@ func (x *Bootstrap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Bootstrap.ProtoReflect.Descriptor instead.
 func (*Bootstrap) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{0}
+	return file_conf_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *Bootstrap) GetServer() *Server {
@@ -100,7 +100,7 @@ type Server struct {
 
 func (x *Server) Reset() {
 	*x = Server{}
-	mi := &file_conf_conf_proto_msgTypes[1]
+	mi := &file_conf_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -112,7 +112,7 @@ func (x *Server) String() string {
 func (*Server) ProtoMessage() {}
 
 func (x *Server) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[1]
+	mi := &file_conf_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -125,7 +125,7 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server.ProtoReflect.Descriptor instead.
 func (*Server) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1}
+	return file_conf_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Server) GetHttp() *Server_HTTP {
@@ -153,7 +153,7 @@ type Data struct {
 
 func (x *Data) Reset() {
 	*x = Data{}
-	mi := &file_conf_conf_proto_msgTypes[2]
+	mi := &file_conf_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -165,7 +165,7 @@ func (x *Data) String() string {
 func (*Data) ProtoMessage() {}
 
 func (x *Data) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[2]
+	mi := &file_conf_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -178,7 +178,7 @@ func (x *Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data.ProtoReflect.Descriptor instead.
 func (*Data) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2}
+	return file_conf_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Data) GetPostgres() *Data_Postgres {
@@ -211,7 +211,7 @@ type Trace struct {
 
 func (x *Trace) Reset() {
 	*x = Trace{}
-	mi := &file_conf_conf_proto_msgTypes[3]
+	mi := &file_conf_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -223,7 +223,7 @@ func (x *Trace) String() string {
 func (*Trace) ProtoMessage() {}
 
 func (x *Trace) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[3]
+	mi := &file_conf_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -236,7 +236,7 @@ func (x *Trace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trace.ProtoReflect.Descriptor instead.
 func (*Trace) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3}
+	return file_conf_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Trace) GetJaeger() *Trace_Jaeger {
@@ -255,7 +255,7 @@ type Log struct {
 
 func (x *Log) Reset() {
 	*x = Log{}
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -267,7 +267,7 @@ func (x *Log) String() string {
 func (*Log) ProtoMessage() {}
 
 func (x *Log) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,7 +280,7 @@ func (x *Log) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Log.ProtoReflect.Descriptor instead.
 func (*Log) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{4}
+	return file_conf_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Log) GetDebug() bool {
@@ -299,7 +299,7 @@ type Notify struct {
 
 func (x *Notify) Reset() {
 	*x = Notify{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -311,7 +311,7 @@ func (x *Notify) String() string {
 func (*Notify) ProtoMessage() {}
 
 func (x *Notify) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,7 +324,7 @@ func (x *Notify) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Notify.ProtoReflect.Descriptor instead.
 func (*Notify) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+	return file_conf_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Notify) GetTelegram() *Notify_Telegram {
@@ -345,7 +345,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -357,7 +357,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,7 +370,7 @@ func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
 func (*Server_HTTP) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0}
+	return file_conf_proto_rawDescGZIP(), []int{1, 0}
 }
 
 func (x *Server_HTTP) GetNetwork() string {
@@ -405,7 +405,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -417,7 +417,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,7 +430,7 @@ func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
 func (*Server_GRPC) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1, 1}
+	return file_conf_proto_rawDescGZIP(), []int{1, 1}
 }
 
 func (x *Server_GRPC) GetNetwork() string {
@@ -464,7 +464,7 @@ type Data_Postgres struct {
 
 func (x *Data_Postgres) Reset() {
 	*x = Data_Postgres{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -476,7 +476,7 @@ func (x *Data_Postgres) String() string {
 func (*Data_Postgres) ProtoMessage() {}
 
 func (x *Data_Postgres) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,7 +489,7 @@ func (x *Data_Postgres) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Postgres.ProtoReflect.Descriptor instead.
 func (*Data_Postgres) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 0}
+	return file_conf_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *Data_Postgres) GetDsn() string {
@@ -515,7 +515,7 @@ type Data_Etcd struct {
 
 func (x *Data_Etcd) Reset() {
 	*x = Data_Etcd{}
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -527,7 +527,7 @@ func (x *Data_Etcd) String() string {
 func (*Data_Etcd) ProtoMessage() {}
 
 func (x *Data_Etcd) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +540,7 @@ func (x *Data_Etcd) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Etcd.ProtoReflect.Descriptor instead.
 func (*Data_Etcd) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1}
+	return file_conf_proto_rawDescGZIP(), []int{2, 1}
 }
 
 func (x *Data_Etcd) GetHosts() []string {
@@ -555,13 +555,32 @@ type Data_Auth struct {
 	JwtSecret        string                 `protobuf:"bytes,1,opt,name=jwtSecret,proto3" json:"jwtSecret,omitempty"`
 	JwtExpireSeconds int32                  `protobuf:"varint,2,opt,name=jwtExpireSeconds,proto3" json:"jwtExpireSeconds,omitempty"`
 	Admin            *Data_Auth_Admin       `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// 管理员模拟登录签发的用户 token 有效期，默认 900 秒。
+	ImpersonationExpireSeconds int32 `protobuf:"varint,4,opt,name=impersonationExpireSeconds,proto3" json:"impersonationExpireSeconds,omitempty"`
+	// 注册模式：open（默认）/ invite_only / closed。
+	RegistrationMode string                  `protobuf:"bytes,5,opt,name=registrationMode,proto3" json:"registrationMode,omitempty"`
+	Verification     *Data_Auth_Verification `protobuf:"bytes,6,opt,name=verification,proto3" json:"verification,omitempty"`
+	// 登录流水 login_events 保留天数，默认 90；小于 0 表示不自动清理。
+	LoginHistoryRetentionDays int32 `protobuf:"varint,7,opt,name=loginHistoryRetentionDays,proto3" json:"loginHistoryRetentionDays,omitempty"`
+	// 管理员权限进程内缓存秒数，默认 5；小于 0 表示不缓存（同一请求内仍只查一次）。
+	AdminAccessCacheSeconds int32 `protobuf:"varint,8,opt,name=adminAccessCacheSeconds,proto3" json:"adminAccessCacheSeconds,omitempty"`
+	// 软删除用户的保留天数，默认 30；到期后由后台任务匿名化，之后不能再恢复。小于 0 表示不自动匿名化。
+	DeletedUserRetentionDays int32 `protobuf:"varint,9,opt,name=deletedUserRetentionDays,proto3" json:"deletedUserRetentionDays,omitempty"`
+	// 软删除时用户名的处理：tombstone（默认，保留到匿名化为止）/ release（立即释放，可被重新注册）。
+	DeletedUsernamePolicy string `protobuf:"bytes,10,opt,name=deletedUsernamePolicy,proto3" json:"deletedUsernamePolicy,omitempty"`
+	// 用户自助注销后的宽限天数，默认 7；期间可以撤销，到期由后台任务删除并匿名化。小于 0 表示不设宽限期。
+	AccountDeletionGraceDays int32 `protobuf:"varint,11,opt,name=accountDeletionGraceDays,proto3" json:"accountDeletionGraceDays,omitempty"`
+	// 同一账号在窗口内登录失败（含验证码接口里的用户名密码校验）达到次数后暂时拒绝，默认 10；小于 0 表示不限制。
+	LoginMaxFailures int32 `protobuf:"varint,12,opt,name=loginMaxFailures,proto3" json:"loginMaxFailures,omitempty"`
+	// 登录失败计数窗口秒数，默认 900。
+	LoginFailureWindowSeconds int32 `protobuf:"varint,13,opt,name=loginFailureWindowSeconds,proto3" json:"loginFailureWindowSeconds,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *Data_Auth) Reset() {
 	*x = Data_Auth{}
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +592,7 @@ func (x *Data_Auth) String() string {
 func (*Data_Auth) ProtoMessage() {}
 
 func (x *Data_Auth) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +605,7 @@ func (x *Data_Auth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Auth.ProtoReflect.Descriptor instead.
 func (*Data_Auth) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2}
+	return file_conf_proto_rawDescGZIP(), []int{2, 2}
 }
 
 func (x *Data_Auth) GetJwtSecret() string {
@@ -610,6 +629,76 @@ func (x *Data_Auth) GetAdmin() *Data_Auth_Admin {
 	return nil
 }
 
+func (x *Data_Auth) GetImpersonationExpireSeconds() int32 {
+	if x != nil {
+		return x.ImpersonationExpireSeconds
+	}
+	return 0
+}
+
+func (x *Data_Auth) GetRegistrationMode() string {
+	if x != nil {
+		return x.RegistrationMode
+	}
+	return ""
+}
+
+func (x *Data_Auth) GetVerification() *Data_Auth_Verification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+func (x *Data_Auth) GetLoginHistoryRetentionDays() int32 {
+	if x != nil {
+		return x.LoginHistoryRetentionDays
+	}
+	return 0
+}
+
+func (x *Data_Auth) GetAdminAccessCacheSeconds() int32 {
+	if x != nil {
+		return x.AdminAccessCacheSeconds
+	}
+	return 0
+}
+
+func (x *Data_Auth) GetDeletedUserRetentionDays() int32 {
+	if x != nil {
+		return x.DeletedUserRetentionDays
+	}
+	return 0
+}
+
+func (x *Data_Auth) GetDeletedUsernamePolicy() string {
+	if x != nil {
+		return x.DeletedUsernamePolicy
+	}
+	return ""
+}
+
+func (x *Data_Auth) GetAccountDeletionGraceDays() int32 {
+	if x != nil {
+		return x.AccountDeletionGraceDays
+	}
+	return 0
+}
+
+func (x *Data_Auth) GetLoginMaxFailures() int32 {
+	if x != nil {
+		return x.LoginMaxFailures
+	}
+	return 0
+}
+
+func (x *Data_Auth) GetLoginFailureWindowSeconds() int32 {
+	if x != nil {
+		return x.LoginFailureWindowSeconds
+	}
+	return 0
+}
+
 type Data_Auth_Admin struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -620,7 +709,7 @@ type Data_Auth_Admin struct {
 
 func (x *Data_Auth_Admin) Reset() {
 	*x = Data_Auth_Admin{}
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -632,7 +721,7 @@ func (x *Data_Auth_Admin) String() string {
 func (*Data_Auth_Admin) ProtoMessage() {}
 
 func (x *Data_Auth_Admin) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -645,7 +734,7 @@ func (x *Data_Auth_Admin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Auth_Admin.ProtoReflect.Descriptor instead.
 func (*Data_Auth_Admin) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2, 0}
+	return file_conf_proto_rawDescGZIP(), []int{2, 2, 0}
 }
 
 func (x *Data_Auth_Admin) GetUsername() string {
@@ -662,6 +751,107 @@ func (x *Data_Auth_Admin) GetPassword() string {
 	return ""
 }
 
+// 邮箱/手机验证：发送方式（log / file）、频率限制，以及是否要求验证后才能登录。
+type Data_Auth_Verification struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Required            bool                   `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	Mailer              string                 `protobuf:"bytes,2,opt,name=mailer,proto3" json:"mailer,omitempty"`
+	SmsSender           string                 `protobuf:"bytes,3,opt,name=smsSender,proto3" json:"smsSender,omitempty"`
+	OutboxDir           string                 `protobuf:"bytes,4,opt,name=outboxDir,proto3" json:"outboxDir,omitempty"`
+	CodeTtlSeconds      int32                  `protobuf:"varint,5,opt,name=codeTtlSeconds,proto3" json:"codeTtlSeconds,omitempty"`
+	SendCooldownSeconds int32                  `protobuf:"varint,6,opt,name=sendCooldownSeconds,proto3" json:"sendCooldownSeconds,omitempty"`
+	MaxSendsPerHour     int32                  `protobuf:"varint,7,opt,name=maxSendsPerHour,proto3" json:"maxSendsPerHour,omitempty"`
+	MaxAttempts         int32                  `protobuf:"varint,8,opt,name=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Data_Auth_Verification) Reset() {
+	*x = Data_Auth_Verification{}
+	mi := &file_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Auth_Verification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Auth_Verification) ProtoMessage() {}
+
+func (x *Data_Auth_Verification) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Auth_Verification.ProtoReflect.Descriptor instead.
+func (*Data_Auth_Verification) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{2, 2, 1}
+}
+
+func (x *Data_Auth_Verification) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *Data_Auth_Verification) GetMailer() string {
+	if x != nil {
+		return x.Mailer
+	}
+	return ""
+}
+
+func (x *Data_Auth_Verification) GetSmsSender() string {
+	if x != nil {
+		return x.SmsSender
+	}
+	return ""
+}
+
+func (x *Data_Auth_Verification) GetOutboxDir() string {
+	if x != nil {
+		return x.OutboxDir
+	}
+	return ""
+}
+
+func (x *Data_Auth_Verification) GetCodeTtlSeconds() int32 {
+	if x != nil {
+		return x.CodeTtlSeconds
+	}
+	return 0
+}
+
+func (x *Data_Auth_Verification) GetSendCooldownSeconds() int32 {
+	if x != nil {
+		return x.SendCooldownSeconds
+	}
+	return 0
+}
+
+func (x *Data_Auth_Verification) GetMaxSendsPerHour() int32 {
+	if x != nil {
+		return x.MaxSendsPerHour
+	}
+	return 0
+}
+
+func (x *Data_Auth_Verification) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
 type Trace_Jaeger struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TraceName     string                 `protobuf:"bytes,1,opt,name=traceName,proto3" json:"traceName,omitempty"` // trace name, 因为配置公用，直接在main.go中硬编码
@@ -673,7 +863,7 @@ type Trace_Jaeger struct {
 
 func (x *Trace_Jaeger) Reset() {
 	*x = Trace_Jaeger{}
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -685,7 +875,7 @@ func (x *Trace_Jaeger) String() string {
 func (*Trace_Jaeger) ProtoMessage() {}
 
 func (x *Trace_Jaeger) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +888,7 @@ func (x *Trace_Jaeger) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trace_Jaeger.ProtoReflect.Descriptor instead.
 func (*Trace_Jaeger) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3, 0}
+	return file_conf_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *Trace_Jaeger) GetTraceName() string {
@@ -732,7 +922,7 @@ type Notify_Telegram struct {
 
 func (x *Notify_Telegram) Reset() {
 	*x = Notify_Telegram{}
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -744,7 +934,7 @@ func (x *Notify_Telegram) String() string {
 func (*Notify_Telegram) ProtoMessage() {}
 
 func (x *Notify_Telegram) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -757,7 +947,7 @@ func (x *Notify_Telegram) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Notify_Telegram.ProtoReflect.Descriptor instead.
 func (*Notify_Telegram) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{5, 0}
+	return file_conf_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *Notify_Telegram) GetApiToken() string {
@@ -774,11 +964,12 @@ func (x *Notify_Telegram) GetChatId() int64 {
 	return 0
 }
 
-var File_conf_conf_proto protoreflect.FileDescriptor
+var File_conf_proto protoreflect.FileDescriptor
 
-const file_conf_conf_proto_rawDesc = "" +
+const file_conf_proto_rawDesc = "" +
+	"\n" +
 	"\n" +
-	"\x0fconf/conf.proto\x12\n" +
+	"conf.proto\x12\n" +
 	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xa9\x01\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
@@ -795,7 +986,8 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xb2\x03\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x9d\n" +
+	"\n" +
 	"\x04Data\x125\n" +
 	"\bpostgres\x18\x01 \x01(\v2\x19.kratos.api.Data.PostgresR\bpostgres\x12)\n" +
 	"\x04etcd\x18\x02 \x01(\v2\x15.kratos.api.Data.EtcdR\x04etcd\x12)\n" +
@@ -804,14 +996,34 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x03dsn\x18\x01 \x01(\tR\x03dsn\x12\x14\n" +
 	"\x05debug\x18\x02 \x01(\bR\x05debug\x1a\x1c\n" +
 	"\x04Etcd\x12\x14\n" +
-	"\x05hosts\x18\x01 \x03(\tR\x05hosts\x1a\xc4\x01\n" +
+	"\x05hosts\x18\x01 \x03(\tR\x05hosts\x1a\xaf\b\n" +
 	"\x04Auth\x12\x1c\n" +
 	"\tjwtSecret\x18\x01 \x01(\tR\tjwtSecret\x12*\n" +
 	"\x10jwtExpireSeconds\x18\x02 \x01(\x05R\x10jwtExpireSeconds\x121\n" +
-	"\x05admin\x18\x03 \x01(\v2\x1b.kratos.api.Data.Auth.AdminR\x05admin\x1a?\n" +
+	"\x05admin\x18\x03 \x01(\v2\x1b.kratos.api.Data.Auth.AdminR\x05admin\x12>\n" +
+	"\x1aimpersonationExpireSeconds\x18\x04 \x01(\x05R\x1aimpersonationExpireSeconds\x12*\n" +
+	"\x10registrationMode\x18\x05 \x01(\tR\x10registrationMode\x12F\n" +
+	"\fverification\x18\x06 \x01(\v2\".kratos.api.Data.Auth.VerificationR\fverification\x12<\n" +
+	"\x19loginHistoryRetentionDays\x18\a \x01(\x05R\x19loginHistoryRetentionDays\x128\n" +
+	"\x17adminAccessCacheSeconds\x18\b \x01(\x05R\x17adminAccessCacheSeconds\x12:\n" +
+	"\x18deletedUserRetentionDays\x18\t \x01(\x05R\x18deletedUserRetentionDays\x124\n" +
+	"\x15deletedUsernamePolicy\x18\n" +
+	" \x01(\tR\x15deletedUsernamePolicy\x12:\n" +
+	"\x18accountDeletionGraceDays\x18\v \x01(\x05R\x18accountDeletionGraceDays\x12*\n" +
+	"\x10loginMaxFailures\x18\f \x01(\x05R\x10loginMaxFailures\x12<\n" +
+	"\x19loginFailureWindowSeconds\x18\r \x01(\x05R\x19loginFailureWindowSeconds\x1a?\n" +
 	"\x05Admin\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpasswordJ\x04\b\x04\x10\x05\"\x93\x01\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x1a\xa4\x02\n" +
+	"\fVerification\x12\x1a\n" +
+	"\brequired\x18\x01 \x01(\bR\brequired\x12\x16\n" +
+	"\x06mailer\x18\x02 \x01(\tR\x06mailer\x12\x1c\n" +
+	"\tsmsSender\x18\x03 \x01(\tR\tsmsSender\x12\x1c\n" +
+	"\toutboxDir\x18\x04 \x01(\tR\toutboxDir\x12&\n" +
+	"\x0ecodeTtlSeconds\x18\x05 \x01(\x05R\x0ecodeTtlSeconds\x120\n" +
+	"\x13sendCooldownSeconds\x18\x06 \x01(\x05R\x13sendCooldownSeconds\x12(\n" +
+	"\x0fmaxSendsPerHour\x18\a \x01(\x05R\x0fmaxSendsPerHour\x12 \n" +
+	"\vmaxAttempts\x18\b \x01(\x05R\vmaxAttemptsJ\x04\b\x04\x10\x05\"\x93\x01\n" +
 	"\x05Trace\x120\n" +
 	"\x06jaeger\x18\x01 \x01(\v2\x18.kratos.api.Trace.JaegerR\x06jaeger\x1aX\n" +
 	"\x06Jaeger\x12\x1c\n" +
@@ -827,36 +1039,37 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x06chatId\x18\x02 \x01(\x03R\x06chatIdB\x1bZ\x19server/internal/conf;confb\x06proto3"
 
 var (
-	file_conf_conf_proto_rawDescOnce sync.Once
-	file_conf_conf_proto_rawDescData []byte
+	file_conf_proto_rawDescOnce sync.Once
+	file_conf_proto_rawDescData []byte
 )
 
-func file_conf_conf_proto_rawDescGZIP() []byte {
-	file_conf_conf_proto_rawDescOnce.Do(func() {
-		file_conf_conf_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)))
+func file_conf_proto_rawDescGZIP() []byte {
+	file_conf_proto_rawDescOnce.Do(func() {
+		file_conf_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_conf_proto_rawDesc), len(file_conf_proto_rawDesc)))
 	})
-	return file_conf_conf_proto_rawDescData
-}
-
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
-var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Data)(nil),                // 2: kratos.api.Data
-	(*Trace)(nil),               // 3: kratos.api.Trace
-	(*Log)(nil),                 // 4: kratos.api.Log
-	(*Notify)(nil),              // 5: kratos.api.Notify
-	(*Server_HTTP)(nil),         // 6: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 7: kratos.api.Server.GRPC
-	(*Data_Postgres)(nil),       // 8: kratos.api.Data.Postgres
-	(*Data_Etcd)(nil),           // 9: kratos.api.Data.Etcd
-	(*Data_Auth)(nil),           // 10: kratos.api.Data.Auth
-	(*Data_Auth_Admin)(nil),     // 11: kratos.api.Data.Auth.Admin
-	(*Trace_Jaeger)(nil),        // 12: kratos.api.Trace.Jaeger
-	(*Notify_Telegram)(nil),     // 13: kratos.api.Notify.Telegram
-	(*durationpb.Duration)(nil), // 14: google.protobuf.Duration
-}
-var file_conf_conf_proto_depIdxs = []int32{
+	return file_conf_proto_rawDescData
+}
+
+var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_conf_proto_goTypes = []any{
+	(*Bootstrap)(nil),              // 0: kratos.api.Bootstrap
+	(*Server)(nil),                 // 1: kratos.api.Server
+	(*Data)(nil),                   // 2: kratos.api.Data
+	(*Trace)(nil),                  // 3: kratos.api.Trace
+	(*Log)(nil),                    // 4: kratos.api.Log
+	(*Notify)(nil),                 // 5: kratos.api.Notify
+	(*Server_HTTP)(nil),            // 6: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),            // 7: kratos.api.Server.GRPC
+	(*Data_Postgres)(nil),          // 8: kratos.api.Data.Postgres
+	(*Data_Etcd)(nil),              // 9: kratos.api.Data.Etcd
+	(*Data_Auth)(nil),              // 10: kratos.api.Data.Auth
+	(*Data_Auth_Admin)(nil),        // 11: kratos.api.Data.Auth.Admin
+	(*Data_Auth_Verification)(nil), // 12: kratos.api.Data.Auth.Verification
+	(*Trace_Jaeger)(nil),           // 13: kratos.api.Trace.Jaeger
+	(*Notify_Telegram)(nil),        // 14: kratos.api.Notify.Telegram
+	(*durationpb.Duration)(nil),    // 15: google.protobuf.Duration
+}
+var file_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
 	3,  // 2: kratos.api.Bootstrap.trace:type_name -> kratos.api.Trace
@@ -866,38 +1079,39 @@ var file_conf_conf_proto_depIdxs = []int32{
 	8,  // 6: kratos.api.Data.postgres:type_name -> kratos.api.Data.Postgres
 	9,  // 7: kratos.api.Data.etcd:type_name -> kratos.api.Data.Etcd
 	10, // 8: kratos.api.Data.auth:type_name -> kratos.api.Data.Auth
-	12, // 9: kratos.api.Trace.jaeger:type_name -> kratos.api.Trace.Jaeger
-	13, // 10: kratos.api.Notify.telegram:type_name -> kratos.api.Notify.Telegram
-	14, // 11: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	14, // 12: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	13, // 9: kratos.api.Trace.jaeger:type_name -> kratos.api.Trace.Jaeger
+	14, // 10: kratos.api.Notify.telegram:type_name -> kratos.api.Notify.Telegram
+	15, // 11: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	15, // 12: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
 	11, // 13: kratos.api.Data.Auth.admin:type_name -> kratos.api.Data.Auth.Admin
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	12, // 14: kratos.api.Data.Auth.verification:type_name -> kratos.api.Data.Auth.Verification
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
-func init() { file_conf_conf_proto_init() }
-func file_conf_conf_proto_init() {
-	if File_conf_conf_proto != nil {
+func init() { file_conf_proto_init() }
+func file_conf_proto_init() {
+	if File_conf_proto != nil {
 		return
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_proto_rawDesc), len(file_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
-		GoTypes:           file_conf_conf_proto_goTypes,
-		DependencyIndexes: file_conf_conf_proto_depIdxs,
-		MessageInfos:      file_conf_conf_proto_msgTypes,
+		GoTypes:           file_conf_proto_goTypes,
+		DependencyIndexes: file_conf_proto_depIdxs,
+		MessageInfos:      file_conf_proto_msgTypes,
 	}.Build()
-	File_conf_conf_proto = out.File
-	file_conf_conf_proto_goTypes = nil
-	file_conf_conf_proto_depIdxs = nil
+	File_conf_proto = out.File
+	file_conf_proto_goTypes = nil
+	file_conf_proto_depIdxs = nil
 }
@@ -0,0 +1,111 @@
+// server/internal/data/access_policy_repo.go
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type accessPolicyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAccessPolicyRepo(data *Data, logger log.Logger) *accessPolicyRepo {
+	return &accessPolicyRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data.access_policy_repo")),
+	}
+}
+
+var _ biz.AccessPolicyRepo = (*accessPolicyRepo)(nil)
+
+const accessPolicyColumns = `id, url, method, expression, COALESCE(description, ''), enabled, COALESCE(updated_by, 0), updated_at`
+
+func (r *accessPolicyRepo) ListAccessPolicies(ctx context.Context) ([]biz.AccessPolicy, error) {
+	rows, err := r.data.sqldb.QueryContext(
+		ctx,
+		`SELECT `+accessPolicyColumns+` FROM access_policies ORDER BY url ASC, method ASC`,
+	)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListAccessPolicies failed err=%v", err)
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.WithContext(ctx).Warnf("ListAccessPolicies close rows failed err=%v", err)
+		}
+	}()
+
+	out := make([]biz.AccessPolicy, 0)
+	for rows.Next() {
+		p, err := scanAccessPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *p)
+	}
+	return out, rows.Err()
+}
+
+func (r *accessPolicyRepo) UpsertAccessPolicy(ctx context.Context, in *biz.AccessPolicy) (*biz.AccessPolicy, error) {
+	var updatedBy any
+	if in.UpdatedBy > 0 {
+		updatedBy = in.UpdatedBy
+	}
+	p, err := scanAccessPolicy(r.data.sqldb.QueryRowContext(
+		ctx,
+		`INSERT INTO access_policies (url, method, expression, description, enabled, updated_by, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+		 ON CONFLICT (url, method) DO UPDATE SET
+		   expression = EXCLUDED.expression,
+		   description = EXCLUDED.description,
+		   enabled = EXCLUDED.enabled,
+		   updated_by = EXCLUDED.updated_by,
+		   updated_at = EXCLUDED.updated_at
+		 RETURNING `+accessPolicyColumns,
+		in.URL,
+		in.Method,
+		in.Expression,
+		in.Description,
+		in.Enabled,
+		updatedBy,
+		time.Now(),
+	))
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("UpsertAccessPolicy failed url=%s method=%s err=%v", in.URL, in.Method, err)
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *accessPolicyRepo) DeleteAccessPolicy(ctx context.Context, url, method string) (*biz.AccessPolicy, error) {
+	p, err := scanAccessPolicy(r.data.sqldb.QueryRowContext(
+		ctx,
+		`DELETE FROM access_policies WHERE url = $1 AND method = $2 RETURNING `+accessPolicyColumns,
+		url,
+		method,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrAccessPolicyNotFound
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("DeleteAccessPolicy failed url=%s method=%s err=%v", url, method, err)
+		return nil, err
+	}
+	return p, nil
+}
+
+func scanAccessPolicy(row rowScanner) (*biz.AccessPolicy, error) {
+	var p biz.AccessPolicy
+	if err := row.Scan(&p.ID, &p.URL, &p.Method, &p.Expression, &p.Description, &p.Enabled, &p.UpdatedBy, &p.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
@@ -0,0 +1,501 @@
+// server/internal/data/admin_account_repo.go
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type adminAccountRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAdminAccountRepo(data *Data, logger log.Logger) *adminAccountRepo {
+	return &adminAccountRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data.admin_account_repo")),
+	}
+}
+
+var _ biz.AdminAccountRepo = (*adminAccountRepo)(nil)
+
+// adminRoleGrantActive 是 admin_user_roles（别名 aur）当前生效的条件：长期授权，或处于 [starts_at, expires_at) 内的临时授权。
+const adminRoleGrantActive = `(aur.starts_at IS NULL OR aur.starts_at <= now()) AND (aur.expires_at IS NULL OR aur.expires_at > now())`
+
+// adminAccountSelect 一次查出管理员及其当前生效的角色 key（逗号拼接，角色 key 不含逗号）。
+const adminAccountSelect = `SELECT u.id, u.username, u.disabled, u.last_login_at, u.created_at,
+        COALESCE(string_agg(ar.key, ',' ORDER BY ar.key), ''),
+        COALESCE(u.attributes, '{}'::jsonb)
+ FROM admin_users u
+ LEFT JOIN admin_user_roles aur ON aur.admin_user_id = u.id AND ` + adminRoleGrantActive + `
+ LEFT JOIN admin_roles ar ON ar.id = aur.admin_role_id`
+
+func (r *adminAccountRepo) ListAdmins(ctx context.Context, limit, offset int, usernameLike string) ([]*biz.AdminUser, int, error) {
+	l := r.log.WithContext(ctx)
+
+	// 按规范化后的用户名做子串匹配，与登录查找保持同一大小写语义；position 避免 LIKE 通配符转义。
+	search := ""
+	if s := strings.TrimSpace(usernameLike); s != "" {
+		search = biz.NormalizeUsername(s)
+	}
+
+	var total int
+	if err := r.data.sqldb.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*) FROM admin_users WHERE $1 = '' OR position($1 in username_normalized) > 0`,
+		search,
+	).Scan(&total); err != nil {
+		l.Errorf("ListAdmins count failed err=%v", err)
+		return nil, 0, err
+	}
+
+	rows, err := r.data.sqldb.QueryContext(
+		ctx,
+		adminAccountSelect+`
+		 WHERE $1 = '' OR position($1 in u.username_normalized) > 0
+		 GROUP BY u.id
+		 ORDER BY u.id DESC
+		 LIMIT $2 OFFSET $3`,
+		search,
+		limit,
+		offset,
+	)
+	if err != nil {
+		l.Errorf("ListAdmins query failed err=%v", err)
+		return nil, 0, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			l.Warnf("ListAdmins close rows failed err=%v", err)
+		}
+	}()
+
+	out := make([]*biz.AdminUser, 0, limit)
+	for rows.Next() {
+		a, err := scanAdminAccount(rows)
+		if err != nil {
+			l.Errorf("ListAdmins scan failed err=%v", err)
+			return nil, 0, err
+		}
+		out = append(out, a)
+	}
+	if err := rows.Err(); err != nil {
+		l.Errorf("ListAdmins rows failed err=%v", err)
+		return nil, 0, err
+	}
+	if err := r.fillTemporaryRoles(ctx, out); err != nil {
+		l.Errorf("ListAdmins temporary roles failed err=%v", err)
+		return nil, 0, err
+	}
+	return out, total, nil
+}
+
+func (r *adminAccountRepo) CreateAdmin(ctx context.Context, in *biz.AdminUser, roleKeys []string) (*biz.AdminUser, error) {
+	if in == nil || in.Username == "" || in.PasswordHash == "" {
+		return nil, biz.ErrBadParam
+	}
+	normalized := biz.NormalizeUsername(in.Username)
+
+	var id int
+	err := withSuperAdminGuardTx(ctx, r.data.sqldb, r.log, func(tx *sql.Tx) error {
+		// 用户与管理员共用用户名命名空间，避免同名账号在登录页混淆。
+		var usedByUser bool
+		if err := tx.QueryRowContext(
+			ctx,
+			`SELECT EXISTS (SELECT 1 FROM users WHERE username_normalized = $1)`,
+			normalized,
+		).Scan(&usedByUser); err != nil {
+			return err
+		}
+		if usedByUser {
+			return biz.ErrUserExists
+		}
+
+		now := time.Now()
+		err := tx.QueryRowContext(
+			ctx,
+			`INSERT INTO admin_users (username, username_normalized, password_hash, disabled, created_at, updated_at)
+			 VALUES ($1, $2, $3, FALSE, $4, $4)
+			 ON CONFLICT (username_normalized) DO NOTHING
+			 RETURNING id`,
+			in.Username,
+			normalized,
+			in.PasswordHash,
+			now,
+		).Scan(&id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return biz.ErrUserExists
+		}
+		if err != nil {
+			return err
+		}
+		return replaceAdminRolesTx(ctx, tx, id, roleKeys)
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("CreateAdmin failed username=%s err=%v", in.Username, err)
+		return nil, err
+	}
+	return r.getAdmin(ctx, id)
+}
+
+func (r *adminAccountRepo) SetAdminDisabled(ctx context.Context, id int, disabled bool) error {
+	err := withSuperAdminGuardTx(ctx, r.data.sqldb, r.log, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(
+			ctx,
+			`UPDATE admin_users SET disabled = $1, updated_at = $2 WHERE id = $3`,
+			disabled,
+			time.Now(),
+			id,
+		)
+		if err != nil {
+			return err
+		}
+		if n, err := result.RowsAffected(); err == nil && n == 0 {
+			return biz.ErrAdminNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("SetAdminDisabled failed id=%d disabled=%v err=%v", id, disabled, err)
+	}
+	return err
+}
+
+func (r *adminAccountRepo) UpdateAdminPassword(ctx context.Context, id int, passwordHash string) error {
+	result, err := r.data.sqldb.ExecContext(
+		ctx,
+		`UPDATE admin_users SET password_hash = $1, updated_at = $2 WHERE id = $3`,
+		passwordHash,
+		time.Now(),
+		id,
+	)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("UpdateAdminPassword failed id=%d err=%v", id, err)
+		return err
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return biz.ErrAdminNotFound
+	}
+	return nil
+}
+
+func (r *adminAccountRepo) SetAdminAttributes(ctx context.Context, id int, attributes map[string]any) (*biz.AdminUser, error) {
+	raw, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, err
+	}
+	result, err := r.data.sqldb.ExecContext(
+		ctx,
+		`UPDATE admin_users SET attributes = $1, updated_at = $2 WHERE id = $3`,
+		string(raw),
+		time.Now(),
+		id,
+	)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("SetAdminAttributes failed id=%d err=%v", id, err)
+		return nil, err
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return nil, biz.ErrAdminNotFound
+	}
+	return r.getAdmin(ctx, id)
+}
+
+func (r *adminAccountRepo) SetAdminRoles(ctx context.Context, id int, roleKeys []string) (*biz.AdminUser, error) {
+	err := withSuperAdminGuardTx(ctx, r.data.sqldb, r.log, func(tx *sql.Tx) error {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM admin_users WHERE id = $1)`, id).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return biz.ErrAdminNotFound
+		}
+		return replaceAdminRolesTx(ctx, tx, id, roleKeys)
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("SetAdminRoles failed id=%d err=%v", id, err)
+		return nil, err
+	}
+	return r.getAdmin(ctx, id)
+}
+
+func (r *adminAccountRepo) GrantAdminRole(ctx context.Context, grant biz.AdminRoleGrant) (*biz.AdminUser, error) {
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM admin_users WHERE id = $1)`, grant.AdminID).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return biz.ErrAdminNotFound
+		}
+		var rid int
+		err := tx.QueryRowContext(ctx, `SELECT id FROM admin_roles WHERE key = $1`, grant.RoleKey).Scan(&rid)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: %s", biz.ErrRoleNotFound, grant.RoleKey)
+		}
+		if err != nil {
+			return err
+		}
+		// 已有长期授权时 WHERE 不成立，保持长期；已有临时授权时以本次的时间窗为准。
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO admin_user_roles (admin_user_id, admin_role_id, starts_at, expires_at, created_at)
+			 VALUES ($1, $2, $3, $4, $5)
+			 ON CONFLICT (admin_user_id, admin_role_id) DO UPDATE SET
+			   starts_at = EXCLUDED.starts_at,
+			   expires_at = EXCLUDED.expires_at
+			 WHERE admin_user_roles.expires_at IS NOT NULL`,
+			grant.AdminID,
+			rid,
+			grant.StartsAt,
+			grant.ExpiresAt,
+			time.Now(),
+		)
+		return err
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("GrantAdminRole failed id=%d role=%s err=%v", grant.AdminID, grant.RoleKey, err)
+		return nil, err
+	}
+	return r.getAdmin(ctx, grant.AdminID)
+}
+
+func (r *adminAccountRepo) RevokeAdminRole(ctx context.Context, id int, roleKey string) (*biz.AdminUser, bool, error) {
+	revoked := false
+	// 撤销长期的 super_admin 可能让超管清零，与 SetAdminRoles 一样走超管保护事务。
+	err := withSuperAdminGuardTx(ctx, r.data.sqldb, r.log, func(tx *sql.Tx) error {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM admin_users WHERE id = $1)`, id).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return biz.ErrAdminNotFound
+		}
+		var rid int
+		err := tx.QueryRowContext(ctx, `SELECT id FROM admin_roles WHERE key = $1`, roleKey).Scan(&rid)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: %s", biz.ErrRoleNotFound, roleKey)
+		}
+		if err != nil {
+			return err
+		}
+		res, err := tx.ExecContext(ctx, `DELETE FROM admin_user_roles WHERE admin_user_id = $1 AND admin_role_id = $2`, id, rid)
+		if err != nil {
+			return err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		revoked = n > 0
+		return nil
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("RevokeAdminRole failed id=%d role=%s err=%v", id, roleKey, err)
+		return nil, false, err
+	}
+	admin, err := r.getAdmin(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+	return admin, revoked, nil
+}
+
+func (r *adminAccountRepo) DeleteExpiredRoleGrants(ctx context.Context, now time.Time) ([]biz.AdminRoleGrant, error) {
+	rows, err := r.data.sqldb.QueryContext(
+		ctx,
+		`DELETE FROM admin_user_roles aur
+		 USING admin_roles ar
+		 WHERE ar.id = aur.admin_role_id AND aur.expires_at IS NOT NULL AND aur.expires_at <= $1
+		 RETURNING aur.admin_user_id, ar.key, aur.starts_at, aur.expires_at`,
+		now,
+	)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("DeleteExpiredRoleGrants failed err=%v", err)
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.WithContext(ctx).Warnf("DeleteExpiredRoleGrants close rows failed err=%v", err)
+		}
+	}()
+
+	var out []biz.AdminRoleGrant
+	for rows.Next() {
+		g, err := scanAdminRoleGrant(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, g)
+	}
+	return out, rows.Err()
+}
+
+func (r *adminAccountRepo) getAdmin(ctx context.Context, id int) (*biz.AdminUser, error) {
+	a, err := scanAdminAccount(r.data.sqldb.QueryRowContext(
+		ctx,
+		adminAccountSelect+`
+		 WHERE u.id = $1
+		 GROUP BY u.id`,
+		id,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrAdminNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := r.fillTemporaryRoles(ctx, []*biz.AdminUser{a}); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// fillTemporaryRoles 补充尚未过期的临时授权（含还没开始的），按管理员分组。
+func (r *adminAccountRepo) fillTemporaryRoles(ctx context.Context, admins []*biz.AdminUser) error {
+	if len(admins) == 0 {
+		return nil
+	}
+	byID := make(map[int]*biz.AdminUser, len(admins))
+	placeholders := make([]string, 0, len(admins))
+	args := make([]any, 0, len(admins))
+	for _, a := range admins {
+		byID[a.ID] = a
+		args = append(args, a.ID)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+	}
+
+	rows, err := r.data.sqldb.QueryContext(
+		ctx,
+		`SELECT aur.admin_user_id, ar.key, aur.starts_at, aur.expires_at
+		 FROM admin_user_roles aur
+		 JOIN admin_roles ar ON ar.id = aur.admin_role_id
+		 WHERE aur.expires_at > now() AND aur.admin_user_id IN (`+strings.Join(placeholders, ", ")+`)
+		 ORDER BY aur.expires_at ASC, ar.key ASC`,
+		args...,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.WithContext(ctx).Warnf("fillTemporaryRoles close rows failed err=%v", err)
+		}
+	}()
+
+	for rows.Next() {
+		g, err := scanAdminRoleGrant(rows)
+		if err != nil {
+			return err
+		}
+		if a, ok := byID[g.AdminID]; ok {
+			a.TemporaryRoles = append(a.TemporaryRoles, g)
+		}
+	}
+	return rows.Err()
+}
+
+// withTx 用于不影响超管数量的写操作（临时授权不计入超管保护）。
+func (r *adminAccountRepo) withTx(ctx context.Context, fn func(tx *sql.Tx) error) (err error) {
+	tx, err := r.data.sqldb.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				r.log.WithContext(ctx).Warnf("admin account tx rollback failed err=%v", rbErr)
+			}
+		}
+	}()
+	if err = fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func replaceAdminRolesTx(ctx context.Context, tx *sql.Tx, adminID int, roleKeys []string) error {
+	roleIDs := make([]int, 0, len(roleKeys))
+	for _, key := range roleKeys {
+		var rid int
+		err := tx.QueryRowContext(ctx, `SELECT id FROM admin_roles WHERE key = $1`, key).Scan(&rid)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: %s", biz.ErrRoleNotFound, key)
+		}
+		if err != nil {
+			return err
+		}
+		roleIDs = append(roleIDs, rid)
+	}
+
+	// 只替换长期角色；临时授权留给到期清理，与本次长期角色重叠的直接转为长期。
+	if _, err := tx.ExecContext(ctx, `DELETE FROM admin_user_roles WHERE admin_user_id = $1 AND expires_at IS NULL`, adminID); err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, rid := range roleIDs {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO admin_user_roles (admin_user_id, admin_role_id, created_at) VALUES ($1, $2, $3)
+			 ON CONFLICT (admin_user_id, admin_role_id) DO UPDATE SET starts_at = NULL, expires_at = NULL`,
+			adminID,
+			rid,
+			now,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAdminAccount(row rowScanner) (*biz.AdminUser, error) {
+	var (
+		a         biz.AdminUser
+		lastLogin sql.NullTime
+		roles     string
+		attrs     []byte
+	)
+	if err := row.Scan(&a.ID, &a.Username, &a.Disabled, &lastLogin, &a.CreatedAt, &roles, &attrs); err != nil {
+		return nil, err
+	}
+	if lastLogin.Valid {
+		t := lastLogin.Time
+		a.LastLoginAt = &t
+	}
+	a.Roles = splitKeys(roles)
+	attributes, err := decodeAdminAttributes(attrs)
+	if err != nil {
+		return nil, err
+	}
+	a.Attributes = attributes
+	return &a, nil
+}
+
+func scanAdminRoleGrant(row rowScanner) (biz.AdminRoleGrant, error) {
+	var (
+		g        biz.AdminRoleGrant
+		startsAt sql.NullTime
+	)
+	if err := row.Scan(&g.AdminID, &g.RoleKey, &startsAt, &g.ExpiresAt); err != nil {
+		return g, err
+	}
+	if startsAt.Valid {
+		t := startsAt.Time
+		g.StartsAt = &t
+	}
+	return g, nil
+}
@@ -0,0 +1,46 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"server/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestAdminAccountRepoSetAdminDisabledRollsBackWhenLastSuperAdminLost(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT id FROM admin_roles WHERE key = \\$1 FOR UPDATE").
+		WithArgs(biz.SuperAdminRoleKey).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT COUNT\\(DISTINCT u.id\\)").
+		WithArgs(biz.SuperAdminRoleKey).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec("UPDATE admin_users SET disabled").
+		WithArgs(true, sqlmock.AnyArg(), 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT COUNT\\(DISTINCT u.id\\)").
+		WithArgs(biz.SuperAdminRoleKey).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectRollback()
+	mock.ExpectClose()
+
+	repo := NewAdminAccountRepo(&Data{sqldb: db}, log.NewStdLogger(io.Discard))
+	if err := repo.SetAdminDisabled(context.Background(), 3, true); !errors.Is(err, biz.ErrLastSuperAdmin) {
+		t.Fatalf("SetAdminDisabled() error = %v, want ErrLastSuperAdmin", err)
+	}
+	mustCloseDB(t, db)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("ExpectationsWereMet() error = %v", err)
+	}
+}
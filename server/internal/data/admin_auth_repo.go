@@ -4,8 +4,12 @@ package data
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"server/internal/biz"
@@ -27,6 +31,35 @@ func NewAdminAuthRepo(data *Data, logger log.Logger) *adminAuthRepo {
 
 var _ biz.AdminAuthRepo = (*adminAuthRepo)(nil)
 
+// adminAccessSelect 用一条 SQL 取出管理员状态、角色与权限，避免每次鉴权多次往返。
+// 角色 key 与权限码都不含逗号，用 string_agg 拼接后在 Go 侧拆分；拒绝条目带 "!" 前缀，交给 biz.PermissionSet 解释。
+// 权限沿角色继承关系递归收集（roles 仍只返回直接绑定的角色），UNION 去重保证遇到环也能终止。
+// 只统计当前生效的角色绑定，过期或未开始的临时授权不参与鉴权，不依赖后台清理是否已执行。
+// $2 是当前组织，该组织内绑定的角色与平台级角色一起生效；不限定组织时传 0，只有平台级角色。
+const adminAccessSelect = `SELECT u.id, u.username, u.password_hash, u.disabled,
+        COALESCE((SELECT string_agg(DISTINCT ar.key, ',' ORDER BY ar.key)
+                  FROM admin_roles ar
+                  WHERE ar.id IN (` + adminEffectiveRoleIDs + `)), ''),
+        COALESCE((SELECT string_agg(k, ',' ORDER BY k)
+                  FROM (WITH RECURSIVE effective(role_id) AS (
+                          ` + adminEffectiveRoleIDs + `
+                          UNION
+                          SELECT p.parent_role_id FROM admin_role_parents p JOIN effective e ON p.admin_role_id = e.role_id
+                        )
+                        SELECT DISTINCT CASE WHEN arp.deny THEN '!' || ap.key ELSE ap.key END AS k
+                        FROM effective e
+                        JOIN admin_role_permissions arp ON arp.admin_role_id = e.role_id
+                        JOIN admin_permissions ap ON ap.id = arp.admin_permission_id) perms), ''),
+        COALESCE(u.attributes, '{}'::jsonb),
+        COALESCE((SELECT string_agg(om.organization_id::text, ',')
+                  FROM organization_members om
+                  WHERE om.member_kind = 'admin' AND om.member_id = u.id), '')
+ FROM admin_users u`
+
+const adminEffectiveRoleIDs = `SELECT aur.admin_role_id FROM admin_user_roles aur WHERE aur.admin_user_id = u.id AND ` + adminRoleGrantActive + `
+                          UNION
+                          SELECT oar.admin_role_id FROM organization_admin_roles oar WHERE oar.admin_user_id = u.id AND oar.organization_id = $2`
+
 func (r *adminAuthRepo) GetAdminByID(ctx context.Context, id int) (*biz.AdminUser, error) {
 	l := r.log.WithContext(ctx)
 	if id <= 0 {
@@ -34,35 +67,18 @@ func (r *adminAuthRepo) GetAdminByID(ctx context.Context, id int) (*biz.AdminUse
 		return nil, errors.New("admin id is required")
 	}
 
-	var (
-		adminID      int
-		uname        string
-		passwordHash string
-		disabled     bool
-	)
-
-	err := r.data.sqldb.QueryRowContext(
-		ctx,
-		"SELECT id, username, password_hash, disabled FROM admin_users WHERE id = $1 LIMIT 1",
-		id,
-	).Scan(&adminID, &uname, &passwordHash, &disabled)
+	orgID, _ := biz.TenantFromContext(ctx)
+	a, err := scanAdminAccess(r.data.sqldb.QueryRowContext(ctx, adminAccessSelect+" WHERE u.id = $1", id, orgID))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			l.Infof("GetAdminByID not found id=%d", id)
-		} else {
-			l.Errorf("GetAdminByID failed id=%d err=%v", id, err)
+			// 同时保留 sql.ErrNoRows：鉴权链路按它判断账号已不存在。
+			return nil, fmt.Errorf("%w: %w", biz.ErrAdminNotFound, err)
 		}
+		l.Errorf("GetAdminByID failed id=%d err=%v", id, err)
 		return nil, err
 	}
-
-	return &biz.AdminUser{
-		ID:           adminID,
-		Username:     uname,
-		PasswordHash: passwordHash,
-		Disabled:     disabled,
-		Roles:        r.getAdminRoles(ctx, adminID),
-		Permissions:  r.getAdminPermissions(ctx, adminID),
-	}, nil
+	return a, nil
 }
 
 func (r *adminAuthRepo) GetAdminByUsername(ctx context.Context, username string) (*biz.AdminUser, error) {
@@ -72,18 +88,13 @@ func (r *adminAuthRepo) GetAdminByUsername(ctx context.Context, username string)
 		return nil, errors.New("username is required")
 	}
 
-	var (
-		id           int
-		uname        string
-		passwordHash string
-		disabled     bool
-	)
-
-	err := r.data.sqldb.QueryRowContext(
+	orgID, _ := biz.TenantFromContext(ctx)
+	a, err := scanAdminAccess(r.data.sqldb.QueryRowContext(
 		ctx,
-		"SELECT id, username, password_hash, disabled FROM admin_users WHERE username = $1 LIMIT 1",
-		username,
-	).Scan(&id, &uname, &passwordHash, &disabled)
+		adminAccessSelect+" WHERE u.username_normalized = $1",
+		biz.NormalizeUsername(username),
+		orgID,
+	))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			l.Infof("GetAdminByUsername not found username=%s", username)
@@ -92,15 +103,7 @@ func (r *adminAuthRepo) GetAdminByUsername(ctx context.Context, username string)
 		}
 		return nil, err
 	}
-
-	return &biz.AdminUser{
-		ID:           id,
-		Username:     uname,
-		PasswordHash: passwordHash,
-		Disabled:     disabled,
-		Roles:        r.getAdminRoles(ctx, id),
-		Permissions:  r.getAdminPermissions(ctx, id),
-	}, nil
+	return a, nil
 }
 
 func (r *adminAuthRepo) UpdateAdminLastLogin(ctx context.Context, id int, t time.Time) error {
@@ -121,71 +124,70 @@ func (r *adminAuthRepo) UpdateAdminLastLogin(ctx context.Context, id int, t time
 	return err
 }
 
-func (r *adminAuthRepo) getAdminRoles(ctx context.Context, adminID int) []string {
-	rows, err := r.data.sqldb.QueryContext(
-		ctx,
-		`SELECT DISTINCT ar.key
-		 FROM admin_roles ar
-		 JOIN admin_user_roles aur ON aur.admin_role_id = ar.id
-		 WHERE aur.admin_user_id = $1
-		 ORDER BY ar.key`,
-		adminID,
+func scanAdminAccess(row rowScanner) (*biz.AdminUser, error) {
+	var (
+		a           biz.AdminUser
+		roles       string
+		permissions string
+		attributes  []byte
+		orgs        string
 	)
+	if err := row.Scan(&a.ID, &a.Username, &a.PasswordHash, &a.Disabled, &roles, &permissions, &attributes, &orgs); err != nil {
+		return nil, err
+	}
+	organizations, err := splitIDs(orgs)
 	if err != nil {
-		r.log.WithContext(ctx).Warnf("GetAdminRoles failed admin_id=%d err=%v", adminID, err)
-		return nil
+		return nil, err
 	}
-	defer func() {
-		if err := rows.Close(); err != nil {
-			r.log.WithContext(ctx).Warnf("GetAdminRoles close rows failed admin_id=%d err=%v", adminID, err)
-		}
-	}()
+	a.Organizations = organizations
+	a.Roles = splitKeys(roles)
+	a.Permissions = splitKeys(permissions)
+	attrs, err := decodeAdminAttributes(attributes)
+	if err != nil {
+		return nil, err
+	}
+	a.Attributes = attrs
+	return &a, nil
+}
 
-	var out []string
-	for rows.Next() {
-		var key string
-		if err := rows.Scan(&key); err == nil {
-			out = append(out, key)
-		}
+// decodeAdminAttributes 解析 attributes 列；列为空或 NULL 时返回空 map，策略里可以直接按 key 判断。
+func decodeAdminAttributes(raw []byte) (map[string]any, error) {
+	out := map[string]any{}
+	if len(raw) == 0 {
+		return out, nil
 	}
-	if err := rows.Err(); err != nil {
-		r.log.WithContext(ctx).Warnf("GetAdminRoles rows failed admin_id=%d err=%v", adminID, err)
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, fmt.Errorf("decode admin attributes: %w", err)
 	}
-	sort.Strings(out)
-	return out
+	if out == nil {
+		out = map[string]any{}
+	}
+	return out, nil
 }
 
-func (r *adminAuthRepo) getAdminPermissions(ctx context.Context, adminID int) []string {
-	rows, err := r.data.sqldb.QueryContext(
-		ctx,
-		`SELECT DISTINCT ap.key
-		 FROM admin_permissions ap
-		 JOIN admin_role_permissions arp ON arp.admin_permission_id = ap.id
-		 JOIN admin_user_roles aur ON aur.admin_role_id = arp.admin_role_id
-		 WHERE aur.admin_user_id = $1
-		 ORDER BY ap.key`,
-		adminID,
-	)
-	if err != nil {
-		r.log.WithContext(ctx).Warnf("GetAdminPermissions failed admin_id=%d err=%v", adminID, err)
+func splitKeys(joined string) []string {
+	if joined == "" {
 		return nil
 	}
-	defer func() {
-		if err := rows.Close(); err != nil {
-			r.log.WithContext(ctx).Warnf("GetAdminPermissions close rows failed admin_id=%d err=%v", adminID, err)
-		}
-	}()
+	out := strings.Split(joined, ",")
+	sort.Strings(out)
+	return out
+}
 
-	var out []string
-	for rows.Next() {
-		var key string
-		if err := rows.Scan(&key); err == nil {
-			out = append(out, key)
-		}
+// splitIDs 拆分 string_agg 拼接的 id 列表，返回升序结果。
+func splitIDs(joined string) ([]int, error) {
+	if joined == "" {
+		return nil, nil
 	}
-	if err := rows.Err(); err != nil {
-		r.log.WithContext(ctx).Warnf("GetAdminPermissions rows failed admin_id=%d err=%v", adminID, err)
+	parts := strings.Split(joined, ",")
+	out := make([]int, 0, len(parts))
+	for _, p := range parts {
+		id, err := strconv.Atoi(p)
+		if err != nil {
+			return nil, fmt.Errorf("parse id list %q: %w", joined, err)
+		}
+		out = append(out, id)
 	}
-	sort.Strings(out)
-	return out
+	sort.Ints(out)
+	return out, nil
 }
@@ -33,8 +33,8 @@ func NewAdminTokenGenerator(c *conf.Data, logger log.Logger) biz.AdminTokenGener
 
 	l.Infof("admin token generator init ok, expire=%s", exp)
 
-	return func(userID int, username string, role int8) (string, time.Time, error) {
-		l.Infof("gen admin token uid=%d uname=%s role=%d", userID, username, role)
-		return jwtutil.NewToken(cfg, userID, username, role)
+	return func(userID int, username string, role int8, organizationID int) (string, time.Time, error) {
+		l.Infof("gen admin token uid=%d uname=%s role=%d org=%d", userID, username, role, organizationID)
+		return jwtutil.NewToken(cfg, userID, username, role, organizationID)
 	}
 }
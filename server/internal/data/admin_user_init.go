@@ -4,6 +4,7 @@ package data
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"server/internal/biz"
@@ -29,6 +30,12 @@ func InitAdminUsersIfNeeded(ctx context.Context, d *Data, cfg *conf.Data, l *log
 		return nil
 	}
 
+	// 配置里的管理员名同样走用户名规则，写错直接启动失败，避免落库后再也无法按规范化名登录。
+	username, normalized, err := biz.ValidateUsername(username)
+	if err != nil {
+		return fmt.Errorf("InitAdminUsersIfNeeded: admin username %q: %w", cfg.Auth.Admin.Username, err)
+	}
+
 	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		return err
@@ -36,10 +43,12 @@ func InitAdminUsersIfNeeded(ctx context.Context, d *Data, cfg *conf.Data, l *log
 
 	now := time.Now()
 	// 多副本并发启动时，管理员初始化必须保持幂等，避免“先查后插”在唯一键上互相踩踏。
+	// 不指定冲突目标：username 与 username_normalized 任一唯一键冲突都跳过，也不依赖后加的规范化唯一索引已经建好。
 	result, err := d.sqldb.ExecContext(
 		ctx,
-		"INSERT INTO admin_users (username, password_hash, disabled, created_at, updated_at) VALUES ($1, $2, FALSE, $3, $4) ON CONFLICT (username) DO NOTHING",
+		"INSERT INTO admin_users (username, username_normalized, password_hash, disabled, created_at, updated_at) VALUES ($1, $2, $3, FALSE, $4, $5) ON CONFLICT DO NOTHING",
 		username,
+		normalized,
 		string(hash),
 		now,
 		now,
@@ -64,30 +73,8 @@ func InitAdminUsersIfNeeded(ctx context.Context, d *Data, cfg *conf.Data, l *log
 }
 
 func initAdminRBACDefaults(ctx context.Context, d *Data, adminUsername string, l *log.Helper) error {
+	// 权限码本身由 ReconcileAdminPermissions 在此之前同步，这里只负责内置角色与初始化管理员的绑定。
 	now := time.Now()
-	for _, p := range biz.DefaultAdminPermissions {
-		_, err := d.sqldb.ExecContext(
-			ctx,
-			`INSERT INTO admin_permissions (key, name, "group", description, builtin, created_at, updated_at)
-			 VALUES ($1, $2, $3, $4, TRUE, $5, $6)
-			 ON CONFLICT (key) DO UPDATE SET
-			   name = EXCLUDED.name,
-			   "group" = EXCLUDED."group",
-			   description = EXCLUDED.description,
-			   builtin = TRUE,
-			   updated_at = EXCLUDED.updated_at`,
-			p.Key,
-			p.Name,
-			p.Group,
-			p.Description,
-			now,
-			now,
-		)
-		if err != nil {
-			return err
-		}
-	}
-
 	_, err := d.sqldb.ExecContext(
 		ctx,
 		`INSERT INTO admin_roles (key, name, description, builtin, created_at, updated_at)
@@ -108,18 +95,7 @@ func initAdminRBACDefaults(ctx context.Context, d *Data, adminUsername string, l
 	}
 
 	// RBAC 默认值属于模板基线：已有管理员也要补齐 super_admin，避免迁移后旧账号失权。
-	_, err = d.sqldb.ExecContext(
-		ctx,
-		`INSERT INTO admin_role_permissions (admin_role_id, admin_permission_id, created_at)
-		 SELECT r.id, p.id, $1
-		 FROM admin_roles r
-		 CROSS JOIN admin_permissions p
-		 WHERE r.key = $2
-		 ON CONFLICT (admin_role_id, admin_permission_id) DO NOTHING`,
-		now,
-		biz.SuperAdminRoleKey,
-	)
-	if err != nil {
+	if err := grantAllPermissionsToSuperAdmin(ctx, d, now); err != nil {
 		return err
 	}
 
@@ -129,10 +105,10 @@ func initAdminRBACDefaults(ctx context.Context, d *Data, adminUsername string, l
 		 SELECT u.id, r.id, $1
 		 FROM admin_users u
 		 CROSS JOIN admin_roles r
-		 WHERE u.username = $2 AND r.key = $3
+		 WHERE u.username_normalized = $2 AND r.key = $3
 		 ON CONFLICT (admin_user_id, admin_role_id) DO NOTHING`,
 		now,
-		adminUsername,
+		biz.NormalizeUsername(adminUsername),
 		biz.SuperAdminRoleKey,
 	)
 	if err != nil {
@@ -27,9 +27,9 @@ func TestInitAdminUsersIfNeededCreatesAdminOnce(t *testing.T) {
 	}
 
 	mock.ExpectExec(regexp.QuoteMeta(
-		"INSERT INTO admin_users (username, password_hash, disabled, created_at, updated_at) VALUES ($1, $2, FALSE, $3, $4) ON CONFLICT (username) DO NOTHING",
+		"INSERT INTO admin_users (username, username_normalized, password_hash, disabled, created_at, updated_at) VALUES ($1, $2, $3, FALSE, $4, $5) ON CONFLICT DO NOTHING",
 	)).
-		WithArgs("trialadmin", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs("trialadmin", "trialadmin", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 	expectAdminRBACDefaults(mock)
 	mock.ExpectClose()
@@ -52,9 +52,9 @@ func TestInitAdminUsersIfNeededSkipsWhenAdminAlreadyExists(t *testing.T) {
 	}
 
 	mock.ExpectExec(regexp.QuoteMeta(
-		"INSERT INTO admin_users (username, password_hash, disabled, created_at, updated_at) VALUES ($1, $2, FALSE, $3, $4) ON CONFLICT (username) DO NOTHING",
+		"INSERT INTO admin_users (username, username_normalized, password_hash, disabled, created_at, updated_at) VALUES ($1, $2, $3, FALSE, $4, $5) ON CONFLICT DO NOTHING",
 	)).
-		WithArgs("trialadmin", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs("trialadmin", "trialadmin", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 	expectAdminRBACDefaults(mock)
 	mock.ExpectClose()
@@ -71,11 +71,6 @@ func TestInitAdminUsersIfNeededSkipsWhenAdminAlreadyExists(t *testing.T) {
 }
 
 func expectAdminRBACDefaults(mock sqlmock.Sqlmock) {
-	for _, p := range biz.DefaultAdminPermissions {
-		mock.ExpectExec("INSERT INTO admin_permissions").
-			WithArgs(p.Key, p.Name, p.Group, p.Description, sqlmock.AnyArg(), sqlmock.AnyArg()).
-			WillReturnResult(sqlmock.NewResult(0, 1))
-	}
 	mock.ExpectExec("INSERT INTO admin_roles").
 		WithArgs(
 			biz.SuperAdminRoleKey,
@@ -87,7 +82,7 @@ func expectAdminRBACDefaults(mock sqlmock.Sqlmock) {
 		WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectExec("INSERT INTO admin_role_permissions").
 		WithArgs(sqlmock.AnyArg(), biz.SuperAdminRoleKey).
-		WillReturnResult(sqlmock.NewResult(0, int64(len(biz.DefaultAdminPermissions))))
+		WillReturnResult(sqlmock.NewResult(0, int64(len(biz.AdminPermissions()))))
 	mock.ExpectExec("INSERT INTO admin_user_roles").
 		WithArgs(sqlmock.AnyArg(), "trialadmin", biz.SuperAdminRoleKey).
 		WillReturnResult(sqlmock.NewResult(0, 1))
@@ -0,0 +1,99 @@
+// server/internal/data/audit_repo.go
+package data
+
+import (
+	"context"
+	"errors"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/auditlog"
+	pkglogger "server/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type auditRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAuditRepo(data *Data, logger log.Logger) *auditRepo {
+	return &auditRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data.audit_repo")),
+	}
+}
+
+var _ biz.AuditRepo = (*auditRepo)(nil)
+
+func (r *auditRepo) RecordAudit(ctx context.Context, e *biz.AuditEvent) error {
+	l := r.log.WithContext(ctx)
+	if e == nil || e.Action == "" || e.ActorKind == "" {
+		l.Warn("RecordAudit: action and actor_kind are required")
+		return errors.New("audit action and actor kind are required")
+	}
+
+	requestID := e.RequestID
+	if requestID == "" {
+		requestID = pkglogger.RequestIDFromContext(ctx)
+	}
+
+	row, err := r.data.postgres.AuditLog.
+		Create().
+		SetAction(e.Action).
+		SetActorKind(e.ActorKind).
+		SetActorID(e.ActorID).
+		SetActorUsername(e.ActorUsername).
+		SetTargetKind(e.TargetKind).
+		SetTargetID(e.TargetID).
+		SetDetail(e.Detail).
+		SetRequestID(requestID).
+		Save(ctx)
+	if err != nil {
+		l.Errorf("RecordAudit failed action=%s actor=%s:%d target=%s:%d err=%v",
+			e.Action, e.ActorKind, e.ActorID, e.TargetKind, e.TargetID, err,
+		)
+		return err
+	}
+
+	e.ID = row.ID
+	e.RequestID = requestID
+	e.CreatedAt = row.CreatedAt
+	return nil
+}
+
+func (r *auditRepo) ListUserAuditEvents(ctx context.Context, userID, afterID, limit int) ([]*biz.AuditEvent, error) {
+	rows, err := r.data.postgres.AuditLog.
+		Query().
+		Where(
+			auditlog.IDGT(afterID),
+			auditlog.Or(
+				auditlog.And(auditlog.ActorKind(biz.AuditActorUser), auditlog.ActorID(userID)),
+				auditlog.And(auditlog.TargetKind("user"), auditlog.TargetID(userID)),
+			),
+		).
+		Order(ent.Asc(auditlog.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListUserAuditEvents failed user_id=%d err=%v", userID, err)
+		return nil, err
+	}
+	out := make([]*biz.AuditEvent, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &biz.AuditEvent{
+			ID:            row.ID,
+			Action:        row.Action,
+			ActorKind:     row.ActorKind,
+			ActorID:       row.ActorID,
+			ActorUsername: row.ActorUsername,
+			TargetKind:    row.TargetKind,
+			TargetID:      row.TargetID,
+			Detail:        row.Detail,
+			RequestID:     row.RequestID,
+			CreatedAt:     row.CreatedAt,
+		})
+	}
+	return out, nil
+}
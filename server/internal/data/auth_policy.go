@@ -0,0 +1,75 @@
+// server/internal/data/auth_policy.go
+package data
+
+import (
+	"strings"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewAuthPolicy 把 data.auth 里的策略配置转换成 biz.AuthPolicy；未配置时保持开放注册。
+func NewAuthPolicy(c *conf.Data, logger log.Logger) *biz.AuthPolicy {
+	l := log.NewHelper(log.With(logger, "module", "data.auth_policy"))
+
+	mode := biz.RegistrationOpen
+	var verification biz.VerificationPolicy
+	var loginRetention time.Duration
+	var adminAccessCacheTTL time.Duration
+	var deletedRetention time.Duration
+	var deletionGrace time.Duration
+	var loginMaxFailures int
+	var loginFailureWindow time.Duration
+	deletedUsername := biz.DeletedUsernameTombstone
+	if c != nil && c.Auth != nil {
+		switch raw := strings.TrimSpace(strings.ToLower(c.Auth.RegistrationMode)); raw {
+		case "", string(biz.RegistrationOpen):
+		case string(biz.RegistrationInviteOnly), string(biz.RegistrationClosed):
+			mode = biz.RegistrationMode(raw)
+		default:
+			panic("NewAuthPolicy: unknown data.auth.registrationMode " + raw)
+		}
+
+		// 未配置的频率参数留 0，由 biz 层统一套默认值。
+		if v := c.Auth.Verification; v != nil {
+			verification = biz.VerificationPolicy{
+				Required:        v.Required,
+				CodeTTL:         time.Duration(v.CodeTtlSeconds) * time.Second,
+				SendCooldown:    time.Duration(v.SendCooldownSeconds) * time.Second,
+				MaxSendsPerHour: int(v.MaxSendsPerHour),
+				MaxAttempts:     int(v.MaxAttempts),
+			}
+		}
+
+		loginRetention = time.Duration(c.Auth.LoginHistoryRetentionDays) * 24 * time.Hour
+		adminAccessCacheTTL = time.Duration(c.Auth.AdminAccessCacheSeconds) * time.Second
+		deletedRetention = time.Duration(c.Auth.DeletedUserRetentionDays) * 24 * time.Hour
+		deletionGrace = time.Duration(c.Auth.AccountDeletionGraceDays) * 24 * time.Hour
+		loginMaxFailures = int(c.Auth.LoginMaxFailures)
+		loginFailureWindow = time.Duration(c.Auth.LoginFailureWindowSeconds) * time.Second
+
+		switch raw := strings.TrimSpace(strings.ToLower(c.Auth.DeletedUsernamePolicy)); raw {
+		case "", string(biz.DeletedUsernameTombstone):
+		case string(biz.DeletedUsernameRelease):
+			deletedUsername = biz.DeletedUsernameRelease
+		default:
+			panic("NewAuthPolicy: unknown data.auth.deletedUsernamePolicy " + raw)
+		}
+	}
+
+	l.Infof("auth policy init ok, registration_mode=%s require_verification=%v deleted_username=%s", mode, verification.Required, deletedUsername)
+	return &biz.AuthPolicy{
+		RegistrationMode:      mode,
+		Verification:          verification,
+		LoginHistoryRetention: loginRetention,
+		AdminAccessCacheTTL:   adminAccessCacheTTL,
+		DeletedUserRetention:  deletedRetention,
+		DeletedUsername:       deletedUsername,
+		AccountDeletionGrace:  deletionGrace,
+		LoginMaxFailures:      loginMaxFailures,
+		LoginFailureWindow:    loginFailureWindow,
+	}
+}
@@ -7,8 +7,12 @@ import (
 	"time"
 
 	"server/internal/biz"
+	"server/internal/data/model/ent"
 	entadminuser "server/internal/data/model/ent/adminuser"
+	entinvitecode "server/internal/data/model/ent/invitecode"
+	entorgmember "server/internal/data/model/ent/organizationmember"
 	entuser "server/internal/data/model/ent/user"
+	entuserrole "server/internal/data/model/ent/userrole"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -41,7 +45,7 @@ func (r *authRepo) GetUserByUsername(ctx context.Context, username string) (*biz
 
 	u, err := r.data.postgres.User.
 		Query().
-		Where(entuser.Username(username)).
+		Where(entuser.UsernameNormalized(biz.NormalizeUsername(username))).
 		Only(ctx)
 	if err != nil {
 		l.Infof("GetUserByUsername not found username=%s err=%v", username, err)
@@ -54,15 +58,36 @@ func (r *authRepo) GetUserByUsername(ctx context.Context, username string) (*biz
 		lastLoginAt = &t
 	}
 
+	orgIDs, err := r.userOrganizationIDs(ctx, u.ID)
+	if err != nil {
+		l.Errorf("GetUserByUsername load organizations failed user_id=%d err=%v", u.ID, err)
+		return nil, err
+	}
+
 	return &biz.User{
-		ID:           u.ID,
-		Username:     u.Username,
-		PasswordHash: u.PasswordHash,
-		Disabled:     u.Disabled,
-		Role:         int8(biz.RoleUser),
-		LastLoginAt:  lastLoginAt,
-		CreatedAt:    u.CreatedAt,
-		UpdatedAt:    u.UpdatedAt,
+		ID:              u.ID,
+		Username:        u.Username,
+		PasswordHash:    u.PasswordHash,
+		Disabled:        u.Disabled,
+		Role:            int8(biz.RoleUser),
+		LastLoginAt:     lastLoginAt,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+		OrganizationIDs: orgIDs,
+
+		MustChangePassword: u.MustChangePassword,
+
+		Email:           derefString(u.Email),
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		Phone:           derefString(u.Phone),
+		PhoneVerifiedAt: u.PhoneVerifiedAt,
+
+		DisplayName: u.DisplayName,
+		AvatarURL:   u.AvatarURL,
+		Locale:      u.Locale,
+		Timezone:    u.Timezone,
+
+		DeletionScheduledAt: u.DeletionScheduledAt,
 	}, nil
 }
 
@@ -88,18 +113,52 @@ func (r *authRepo) GetUserByID(ctx context.Context, id int) (*biz.User, error) {
 		lastLoginAt = &t
 	}
 
+	orgIDs, err := r.userOrganizationIDs(ctx, u.ID)
+	if err != nil {
+		l.Errorf("GetUserByID load organizations failed user_id=%d err=%v", u.ID, err)
+		return nil, err
+	}
+
 	return &biz.User{
-		ID:           u.ID,
-		Username:     u.Username,
-		PasswordHash: u.PasswordHash,
-		Disabled:     u.Disabled,
-		Role:         int8(biz.RoleUser),
-		LastLoginAt:  lastLoginAt,
-		CreatedAt:    u.CreatedAt,
-		UpdatedAt:    u.UpdatedAt,
+		ID:              u.ID,
+		Username:        u.Username,
+		PasswordHash:    u.PasswordHash,
+		Disabled:        u.Disabled,
+		Role:            int8(biz.RoleUser),
+		LastLoginAt:     lastLoginAt,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+		OrganizationIDs: orgIDs,
+
+		MustChangePassword: u.MustChangePassword,
+
+		Email:           derefString(u.Email),
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		Phone:           derefString(u.Phone),
+		PhoneVerifiedAt: u.PhoneVerifiedAt,
+
+		DisplayName: u.DisplayName,
+		AvatarURL:   u.AvatarURL,
+		Locale:      u.Locale,
+		Timezone:    u.Timezone,
+
+		DeletionScheduledAt: u.DeletionScheduledAt,
 	}, nil
 }
 
+// userOrganizationIDs 返回用户所属的组织 id，升序。
+func (r *authRepo) userOrganizationIDs(ctx context.Context, userID int) ([]int, error) {
+	return r.data.postgres.OrganizationMember.
+		Query().
+		Where(
+			entorgmember.MemberKind(biz.OrganizationMemberUser),
+			entorgmember.MemberID(userID),
+		).
+		Order(ent.Asc(entorgmember.FieldOrganizationID)).
+		Select(entorgmember.FieldOrganizationID).
+		Ints(ctx)
+}
+
 func (r *authRepo) CreateUser(ctx context.Context, in *biz.User) (*biz.User, error) {
 	l := r.log.WithContext(ctx)
 
@@ -114,30 +173,192 @@ func (r *authRepo) CreateUser(ctx context.Context, in *biz.User) (*biz.User, err
 		return nil, biz.ErrUserExists
 	}
 
-	m := r.data.postgres.User.
+	// 指定了组织时，建号与加入组织放在同一事务里，避免留下不属于任何组织、本组织又看不到的用户。
+	tx, err := r.data.postgres.Tx(ctx)
+	if err != nil {
+		l.Errorf("CreateUser begin tx failed err=%v", err)
+		return nil, err
+	}
+	rollback := func(cause error) error {
+		if e := tx.Rollback(); e != nil {
+			l.Errorf("CreateUser rollback failed err=%v", e)
+		}
+		return cause
+	}
+
+	u, err := tx.User.
 		Create().
 		SetUsername(in.Username).
-		SetPasswordHash(in.PasswordHash)
-
-	u, err := m.Save(ctx)
+		SetUsernameNormalized(biz.NormalizeUsername(in.Username)).
+		SetPasswordHash(in.PasswordHash).
+		SetDisabled(in.Disabled).
+		SetMustChangePassword(in.MustChangePassword).
+		Save(ctx)
 	if err != nil {
 		if isDuplicateUsernameConstraint(err) {
 			l.Warnf("CreateUser duplicate username username=%s err=%v", in.Username, err)
-			return nil, biz.ErrUserExists
+			return nil, rollback(biz.ErrUserExists)
 		}
 		l.Errorf("CreateUser failed err=%v", err)
+		return nil, rollback(err)
+	}
+
+	for _, orgID := range in.OrganizationIDs {
+		if err := tx.OrganizationMember.
+			Create().
+			SetOrganizationID(orgID).
+			SetMemberKind(biz.OrganizationMemberUser).
+			SetMemberID(u.ID).
+			Exec(ctx); err != nil {
+			l.Errorf("CreateUser add organization member failed user_id=%d org_id=%d err=%v", u.ID, orgID, err)
+			return nil, rollback(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		l.Errorf("CreateUser commit failed err=%v", err)
 		return nil, err
 	}
 
 	return &biz.User{
-		ID:           u.ID,
-		Username:     u.Username,
-		PasswordHash: u.PasswordHash,
-		Disabled:     u.Disabled,
-		Role:         int8(biz.RoleUser),
-		LastLoginAt:  u.LastLoginAt,
-		CreatedAt:    u.CreatedAt,
-		UpdatedAt:    u.UpdatedAt,
+		ID:                 u.ID,
+		Username:           u.Username,
+		PasswordHash:       u.PasswordHash,
+		Disabled:           u.Disabled,
+		Role:               int8(biz.RoleUser),
+		LastLoginAt:        u.LastLoginAt,
+		CreatedAt:          u.CreatedAt,
+		UpdatedAt:          u.UpdatedAt,
+		OrganizationIDs:    in.OrganizationIDs,
+		MustChangePassword: u.MustChangePassword,
+	}, nil
+}
+
+func (r *authRepo) CreateUserWithInvite(ctx context.Context, in *biz.User, inviteCode string) (*biz.User, error) {
+	l := r.log.WithContext(ctx)
+
+	l.Infof("CreateUserWithInvite start username=%s", in.Username)
+
+	if exists, err := r.isUsernameUsedByAdmin(ctx, in.Username); err != nil {
+		l.Errorf("CreateUserWithInvite check admin username failed username=%s err=%v", in.Username, err)
+		return nil, err
+	} else if exists {
+		l.Warnf("CreateUserWithInvite username conflicts with admin username=%s", in.Username)
+		return nil, biz.ErrUserExists
+	}
+
+	tx, err := r.data.postgres.Tx(ctx)
+	if err != nil {
+		l.Errorf("CreateUserWithInvite begin tx failed err=%v", err)
+		return nil, err
+	}
+	rollback := func(cause error) error {
+		if e := tx.Rollback(); e != nil {
+			l.Errorf("CreateUserWithInvite rollback failed err=%v", e)
+		}
+		return cause
+	}
+
+	now := time.Now()
+	n, err := tx.InviteCode.
+		Update().
+		Where(append(inviteAvailable(now), entinvitecode.Code(inviteCode))...).
+		AddUsedCount(1).
+		Save(ctx)
+	if err != nil {
+		l.Errorf("CreateUserWithInvite redeem failed err=%v", err)
+		return nil, rollback(err)
+	}
+	if n == 0 {
+		_ = rollback(nil)
+		err = inviteUnavailableErr(ctx, r.data.postgres, inviteCode, now)
+		l.Infof("CreateUserWithInvite invite unavailable username=%s err=%v", in.Username, err)
+		return nil, err
+	}
+
+	invite, err := tx.InviteCode.Query().Where(entinvitecode.Code(inviteCode)).Only(ctx)
+	if err != nil {
+		l.Errorf("CreateUserWithInvite load invite failed err=%v", err)
+		return nil, rollback(err)
+	}
+
+	u, err := tx.User.
+		Create().
+		SetUsername(in.Username).
+		SetUsernameNormalized(biz.NormalizeUsername(in.Username)).
+		SetPasswordHash(in.PasswordHash).
+		Save(ctx)
+	if err != nil {
+		if isDuplicateUsernameConstraint(err) {
+			l.Warnf("CreateUserWithInvite duplicate username username=%s err=%v", in.Username, err)
+			return nil, rollback(biz.ErrUserExists)
+		}
+		l.Errorf("CreateUserWithInvite create user failed err=%v", err)
+		return nil, rollback(err)
+	}
+
+	if _, err := tx.InviteRedemption.
+		Create().
+		SetInviteCodeID(invite.ID).
+		SetUserID(u.ID).
+		Save(ctx); err != nil {
+		l.Errorf("CreateUserWithInvite record redemption failed invite_id=%d user_id=%d err=%v", invite.ID, u.ID, err)
+		return nil, rollback(err)
+	}
+
+	// 组织内创建的邀请码注册后直接加入该组织，与建号同一事务，避免出现本组织看不到的新用户。
+	var orgIDs []int
+	if invite.OrganizationID > 0 {
+		if err := tx.OrganizationMember.
+			Create().
+			SetOrganizationID(invite.OrganizationID).
+			SetMemberKind(biz.OrganizationMemberUser).
+			SetMemberID(u.ID).
+			Exec(ctx); err != nil {
+			l.Errorf("CreateUserWithInvite add organization member failed user_id=%d org_id=%d err=%v", u.ID, invite.OrganizationID, err)
+			return nil, rollback(err)
+		}
+		orgIDs = []int{invite.OrganizationID}
+	}
+
+	// 邀请码预分配的角色在同一事务里绑定；角色在发码后被删除时只告警，不阻断注册。
+	if invite.RoleKey != "" {
+		role, err := tx.UserRole.Query().Where(entuserrole.Key(invite.RoleKey)).Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			l.Warnf("CreateUserWithInvite invite role missing invite_id=%d role_key=%s", invite.ID, invite.RoleKey)
+		case err != nil:
+			l.Errorf("CreateUserWithInvite load invite role failed invite_id=%d role_key=%s err=%v", invite.ID, invite.RoleKey, err)
+			return nil, rollback(err)
+		default:
+			if _, err := tx.UserRoleBinding.
+				Create().
+				SetUserID(u.ID).
+				SetUserRoleID(role.ID).
+				Save(ctx); err != nil {
+				l.Errorf("CreateUserWithInvite bind role failed user_id=%d role_key=%s err=%v", u.ID, invite.RoleKey, err)
+				return nil, rollback(err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		l.Errorf("CreateUserWithInvite commit failed err=%v", err)
+		return nil, err
+	}
+
+	l.Infof("CreateUserWithInvite success user_id=%d invite_id=%d role_key=%q org_id=%d", u.ID, invite.ID, invite.RoleKey, invite.OrganizationID)
+
+	return &biz.User{
+		ID:              u.ID,
+		Username:        u.Username,
+		PasswordHash:    u.PasswordHash,
+		Disabled:        u.Disabled,
+		Role:            int8(biz.RoleUser),
+		LastLoginAt:     u.LastLoginAt,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+		OrganizationIDs: orgIDs,
 	}, nil
 }
 
@@ -157,12 +378,36 @@ func (r *authRepo) UpdateUserLastLogin(ctx context.Context, id int, t time.Time)
 	return err
 }
 
+func (r *authRepo) UpdateUserPassword(ctx context.Context, id int, passwordHash string) error {
+	l := r.log.WithContext(ctx)
+
+	_, err := r.data.postgres.User.
+		UpdateOneID(id).
+		SetPasswordHash(passwordHash).
+		SetMustChangePassword(false).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+
+	if err != nil {
+		l.Errorf("UpdateUserPassword failed user_id=%d err=%v", id, err)
+	}
+
+	return err
+}
+
 func (r *authRepo) isUsernameUsedByAdmin(ctx context.Context, username string) (bool, error) {
 	if username == "" {
 		return false, nil
 	}
 	return r.data.postgres.AdminUser.
 		Query().
-		Where(entadminuser.Username(username)).
+		Where(entadminuser.UsernameNormalized(biz.NormalizeUsername(username))).
 		Exist(ctx)
 }
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
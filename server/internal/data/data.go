@@ -30,20 +30,55 @@ var ProviderSet = wire.NewSet(
 	NewAuthRepo,
 	wire.Bind(new(biz.AuthRepo), new(*authRepo)),
 	NewTokenGenerator,
+	NewAuthPolicy,
 
 	// admin auth / manage
 	NewAdminAuthRepo,
 	wire.Bind(new(biz.AdminAuthRepo), new(*adminAuthRepo)),
-	wire.Bind(new(biz.AdminAccountReader), new(*adminAuthRepo)),
 	NewAdminTokenGenerator,
+	NewAdminAccountRepo,
+	wire.Bind(new(biz.AdminAccountRepo), new(*adminAccountRepo)),
 
 	// user admin
 	NewUserAdminRepo,
 	wire.Bind(new(biz.UserAdminRepo), new(*userAdminRepo)),
+	NewUserImportRepo,
+	wire.Bind(new(biz.UserImportRepo), new(*userImportRepo)),
+	NewUserProfileRepo,
+	wire.Bind(new(biz.UserProfileRepo), new(*userProfileRepo)),
+	NewUserDeletionRepo,
+	wire.Bind(new(biz.UserDeletionRepo), new(*userDeletionRepo)),
 
 	// rbac
 	NewRBACRepo,
 	wire.Bind(new(biz.RBACRepo), new(*rbacRepo)),
+	NewUserRBACRepo,
+	wire.Bind(new(biz.UserRBACRepo), new(*userRBACRepo)),
+	NewAccessPolicyRepo,
+	wire.Bind(new(biz.AccessPolicyRepo), new(*accessPolicyRepo)),
+
+	// organization
+	NewOrganizationRepo,
+	wire.Bind(new(biz.OrganizationRepo), new(*organizationRepo)),
+
+	// audit / impersonation
+	NewAuditRepo,
+	wire.Bind(new(biz.AuditRepo), new(*auditRepo)),
+	NewImpersonationTokenGenerator,
+
+	// invite
+	NewInviteRepo,
+	wire.Bind(new(biz.InviteRepo), new(*inviteRepo)),
+
+	// verification
+	NewVerificationRepo,
+	wire.Bind(new(biz.VerificationRepo), new(*verificationRepo)),
+	NewMailer,
+	NewSMSSender,
+
+	// login history
+	NewLoginEventRepo,
+	wire.Bind(new(biz.LoginEventRepo), new(*loginEventRepo)),
 )
 
 // Data 聚合所有外部资源（DB、Ent client、SQL DB 等）。
@@ -154,6 +189,9 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 		return nil, nil, fmt.Errorf("failed to create postgres client")
 	}
 
+	registerTenantScope(postgresClient)
+	registerUserSoftDelete(postgresClient)
+
 	if c.Postgres.Debug {
 		postgresClient = postgresClient.Debug()
 	}
@@ -165,6 +203,12 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 		conf:     c,
 	}
 
+	if err := ReconcileAdminPermissions(context.Background(), data, l); err != nil {
+		return nil, nil, err
+	}
+	if err := SyncUsernameNormalized(context.Background(), data, l); err != nil {
+		return nil, nil, err
+	}
 	if err := InitAdminUsersIfNeeded(context.Background(), data, c, l); err != nil {
 		return nil, nil, err
 	}
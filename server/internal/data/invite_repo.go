@@ -0,0 +1,206 @@
+// server/internal/data/invite_repo.go
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/invitecode"
+	"server/internal/data/model/ent/predicate"
+	"server/internal/data/model/ent/userrole"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type inviteRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewInviteRepo(data *Data, logger log.Logger) *inviteRepo {
+	return &inviteRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data.invite_repo")),
+	}
+}
+
+var _ biz.InviteRepo = (*inviteRepo)(nil)
+
+func (r *inviteRepo) CreateInvite(ctx context.Context, in *biz.InviteCode) (*biz.InviteCode, error) {
+	l := r.log.WithContext(ctx)
+
+	// role_key 在注册时才会落到用户上，这里先拦住拼错或不存在的用户角色。
+	if in.RoleKey != "" {
+		exists, err := r.data.postgres.UserRole.Query().Where(userrole.Key(in.RoleKey)).Exist(ctx)
+		if err != nil {
+			l.Errorf("CreateInvite check role failed role_key=%s err=%v", in.RoleKey, err)
+			return nil, err
+		}
+		if !exists {
+			l.Warnf("CreateInvite unknown role_key=%s", in.RoleKey)
+			return nil, biz.ErrRoleNotFound
+		}
+	}
+
+	row, err := r.data.postgres.InviteCode.
+		Create().
+		SetCode(in.Code).
+		SetMaxUses(in.MaxUses).
+		SetNillableExpiresAt(in.ExpiresAt).
+		SetRoleKey(in.RoleKey).
+		SetNote(in.Note).
+		SetOrganizationID(in.OrganizationID).
+		SetCreatedBy(in.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		l.Errorf("CreateInvite failed created_by=%d err=%v", in.CreatedBy, err)
+		return nil, err
+	}
+	return toBizInvite(row), nil
+}
+
+func (r *inviteRepo) ListInvites(ctx context.Context, limit, offset int, activeOnly bool) ([]*biz.InviteCode, int, error) {
+	l := r.log.WithContext(ctx)
+
+	q := r.data.postgres.InviteCode.Query().Where(tenantInviteScope(ctx)...)
+	if activeOnly {
+		q = q.Where(inviteAvailable(time.Now())...)
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		l.Errorf("ListInvites count failed err=%v", err)
+		return nil, 0, err
+	}
+
+	rows, err := q.
+		Order(ent.Desc(invitecode.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		l.Errorf("ListInvites query failed err=%v", err)
+		return nil, 0, err
+	}
+
+	out := make([]*biz.InviteCode, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toBizInvite(row))
+	}
+	return out, total, nil
+}
+
+func (r *inviteRepo) RevokeInvite(ctx context.Context, id int, at time.Time) (*biz.InviteCode, error) {
+	l := r.log.WithContext(ctx)
+
+	row, err := r.data.postgres.InviteCode.Query().
+		Where(append(tenantInviteScope(ctx), invitecode.ID(id))...).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrInviteInvalid
+		}
+		l.Errorf("RevokeInvite load failed id=%d err=%v", id, err)
+		return nil, err
+	}
+	// 已撤销的邀请码保持首次撤销时间，重复撤销视为成功。
+	if row.RevokedAt != nil {
+		return toBizInvite(row), nil
+	}
+
+	row, err = row.Update().SetRevokedAt(at).Save(ctx)
+	if err != nil {
+		l.Errorf("RevokeInvite failed id=%d err=%v", id, err)
+		return nil, err
+	}
+	return toBizInvite(row), nil
+}
+
+func (r *inviteRepo) ListUserInviteRedemptions(ctx context.Context, userID int) ([]biz.InviteRedemption, error) {
+	rows, err := r.data.sqldb.QueryContext(
+		ctx,
+		`SELECT ir.invite_code_id, ic.code, ic.role_key, ir.created_at
+		 FROM invite_redemptions ir
+		 JOIN invite_codes ic ON ic.id = ir.invite_code_id
+		 WHERE ir.user_id = $1
+		 ORDER BY ir.id ASC`,
+		userID,
+	)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListUserInviteRedemptions failed user_id=%d err=%v", userID, err)
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.WithContext(ctx).Warnf("ListUserInviteRedemptions close rows failed err=%v", err)
+		}
+	}()
+
+	out := make([]biz.InviteRedemption, 0)
+	for rows.Next() {
+		var x biz.InviteRedemption
+		if err := rows.Scan(&x.InviteCodeID, &x.Code, &x.RoleKey, &x.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, x)
+	}
+	return out, rows.Err()
+}
+
+// inviteAvailable 是“仍可核销”的条件；注册时把它放进 UPDATE 的 WHERE，保证并发下不会超发。
+func inviteAvailable(now time.Time) []predicate.InviteCode {
+	return []predicate.InviteCode{
+		invitecode.RevokedAtIsNil(),
+		invitecode.Or(invitecode.ExpiresAtIsNil(), invitecode.ExpiresAtGT(now)),
+		predicate.InviteCode(entsql.FieldsLT(invitecode.FieldUsedCount, invitecode.FieldMaxUses)),
+	}
+}
+
+// inviteUnavailableErr 在核销失败后查明原因，映射成对应的 biz 错误。
+func inviteUnavailableErr(ctx context.Context, client *ent.Client, code string, now time.Time) error {
+	row, err := client.InviteCode.Query().Where(invitecode.Code(code)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return biz.ErrInviteInvalid
+		}
+		return err
+	}
+	switch toBizInvite(row).Status(now) {
+	case biz.InviteStatusExpired:
+		return biz.ErrInviteExpired
+	case biz.InviteStatusExhausted:
+		return biz.ErrInviteExhausted
+	case biz.InviteStatusRevoked:
+		return biz.ErrInviteInvalid
+	default:
+		return errors.New("invite code redeem conflict")
+	}
+}
+
+func toBizInvite(row *ent.InviteCode) *biz.InviteCode {
+	return &biz.InviteCode{
+		ID:             row.ID,
+		Code:           row.Code,
+		MaxUses:        row.MaxUses,
+		UsedCount:      row.UsedCount,
+		ExpiresAt:      row.ExpiresAt,
+		RoleKey:        row.RoleKey,
+		Note:           row.Note,
+		OrganizationID: row.OrganizationID,
+		CreatedBy:      row.CreatedBy,
+		RevokedAt:      row.RevokedAt,
+		CreatedAt:      row.CreatedAt,
+	}
+}
+
+// tenantInviteScope 限定组织时只保留该组织创建的邀请码；平台级邀请码（organization_id=0）不属于任何组织。
+func tenantInviteScope(ctx context.Context) []predicate.InviteCode {
+	if orgID, ok := biz.TenantFromContext(ctx); ok {
+		return []predicate.InviteCode{invitecode.OrganizationID(orgID)}
+	}
+	return nil
+}
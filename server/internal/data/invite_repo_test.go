@@ -0,0 +1,113 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"server/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestInviteRepoCreateRejectsUnknownRole(t *testing.T) {
+	d, mock := newSQLMockTestData(t)
+	repo := NewInviteRepo(d, log.NewStdLogger(io.Discard))
+
+	mock.ExpectQuery(`SELECT .* FROM "user_roles" WHERE "user_roles"."key" = \$1`).
+		WithArgs("gold").
+		WillReturnRows(sqlmock.NewRows([]string{"1"}))
+	mock.ExpectClose()
+
+	_, err := repo.CreateInvite(context.Background(), &biz.InviteCode{Code: "ABCDEFGH23", MaxUses: 1, RoleKey: "gold"})
+	if !errors.Is(err, biz.ErrRoleNotFound) {
+		t.Fatalf("CreateInvite() error = %v, want ErrRoleNotFound", err)
+	}
+	mustCloseDB(t, d.sqldb)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestInviteRepoRevokeScopedToTenant(t *testing.T) {
+	d, mock := newSQLMockTestData(t)
+	repo := NewInviteRepo(d, log.NewStdLogger(io.Discard))
+
+	mock.ExpectQuery(`SELECT .* FROM "invite_codes" WHERE "invite_codes"."organization_id" = \$1 AND "invite_codes"."id" = \$2`).
+		WithArgs(5, 9).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectClose()
+
+	ctx := biz.NewContextWithTenant(context.Background(), 5)
+	if _, err := repo.RevokeInvite(ctx, 9, time.Now()); !errors.Is(err, biz.ErrInviteInvalid) {
+		t.Fatalf("RevokeInvite() error = %v, want ErrInviteInvalid", err)
+	}
+	mustCloseDB(t, d.sqldb)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestInviteRepoListScopedToTenant(t *testing.T) {
+	d, mock := newSQLMockTestData(t)
+	repo := NewInviteRepo(d, log.NewStdLogger(io.Discard))
+
+	mock.ExpectQuery(`SELECT COUNT\(.*\) FROM "invite_codes" WHERE "invite_codes"."organization_id" = \$1`).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`SELECT .* FROM "invite_codes" WHERE "invite_codes"."organization_id" = \$1 ORDER BY`).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code", "organization_id"}).AddRow(3, "ABCDEFGH23", 5))
+	mock.ExpectClose()
+
+	ctx := biz.NewContextWithTenant(context.Background(), 5)
+	list, total, err := repo.ListInvites(ctx, 30, 0, false)
+	if err != nil {
+		t.Fatalf("ListInvites() error = %v", err)
+	}
+	if total != 1 || len(list) != 1 || list[0].OrganizationID != 5 {
+		t.Fatalf("ListInvites() = total %d list %+v", total, list)
+	}
+	mustCloseDB(t, d.sqldb)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestCreateUserWithInviteJoinsInviteOrganization(t *testing.T) {
+	d, mock := newSQLMockTestData(t)
+	repo := NewAuthRepo(d, log.NewStdLogger(io.Discard))
+
+	mock.ExpectQuery(`SELECT .* FROM "admin_users"`).
+		WillReturnRows(sqlmock.NewRows([]string{"1"}))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "invite_codes" SET .*"used_count" = COALESCE`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT .* FROM "invite_codes" WHERE "invite_codes"."code" = \$1`).
+		WithArgs("ABCDEFGH23").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code", "role_key", "organization_id"}).AddRow(4, "ABCDEFGH23", "", 5))
+	mock.ExpectQuery(`INSERT INTO "users"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+	mock.ExpectQuery(`INSERT INTO "invite_redemptions"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`INSERT INTO "organization_members" \("organization_id", "member_kind", "member_id", "created_at"\)`).
+		WithArgs(5, biz.OrganizationMemberUser, 11, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	mock.ExpectClose()
+
+	u, err := repo.CreateUserWithInvite(context.Background(), &biz.User{Username: "alice", PasswordHash: "x"}, "ABCDEFGH23")
+	if err != nil {
+		t.Fatalf("CreateUserWithInvite() error = %v", err)
+	}
+	if u.ID != 11 || len(u.OrganizationIDs) != 1 || u.OrganizationIDs[0] != 5 {
+		t.Fatalf("CreateUserWithInvite() = %+v", u)
+	}
+	mustCloseDB(t, d.sqldb)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("ExpectationsWereMet() error = %v", err)
+	}
+}
@@ -0,0 +1,122 @@
+// server/internal/data/keyset.go
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"server/internal/biz"
+
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// pageCursor 是游标分页的续页位置：上一页最后一行的排序键与 id。
+// 编码为 base64url(JSON)，对调用方不透明；Sort/Desc 用于拒绝换了排序方式后继续使用旧游标。
+type pageCursor struct {
+	Sort  string  `json:"s"`
+	Desc  bool    `json:"d"`
+	Value *string `json:"v,omitempty"`
+	ID    int     `json:"i"`
+}
+
+func encodePageCursor(c pageCursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodePageCursor 解析游标并校验它属于当前排序；失败统一返回 biz.ErrCursorInvalid。
+func decodePageCursor(s, sort string, desc bool) (pageCursor, error) {
+	var c pageCursor
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("%w: 无法解析", biz.ErrCursorInvalid)
+	}
+	if err := json.Unmarshal(raw, &c); err != nil || c.ID <= 0 {
+		return c, fmt.Errorf("%w: 无法解析", biz.ErrCursorInvalid)
+	}
+	if c.Sort != sort || c.Desc != desc {
+		return c, fmt.Errorf("%w: 游标与当前排序不一致", biz.ErrCursorInvalid)
+	}
+	return c, nil
+}
+
+// keysetOrder 描述一种“排序列 + id 倒序兜底”的排序。Column 为空表示直接按 id 排序。
+// 可空列约定空值排在最后（NULLS LAST），与列表排序保持一致。
+type keysetOrder struct {
+	Column   string
+	Desc     bool
+	Nullable bool
+}
+
+// orderBy 返回与 keysetAfter 配套的排序项。
+func (o keysetOrder) orderBy() []func(*entsql.Selector) {
+	dir := entsql.OrderAsc()
+	if o.Desc {
+		dir = entsql.OrderDesc()
+	}
+	if o.Column == "" {
+		return []func(*entsql.Selector){entsql.OrderByField("id", dir).ToFunc()}
+	}
+	opts := []entsql.OrderTermOption{dir}
+	if o.Nullable {
+		opts = append(opts, entsql.OrderNullsLast())
+	}
+	return []func(*entsql.Selector){
+		entsql.OrderByField(o.Column, opts...).ToFunc(),
+		entsql.OrderByField("id", entsql.OrderDesc()).ToFunc(),
+	}
+}
+
+// keysetAfter 返回“排在游标之后”的查询条件，value 为游标行排序列的值（nil 表示空值），可直接转成 ent 的 predicate。
+// 兜底的 id 始终倒序，所以同值时取 id 更小的行。
+func keysetAfter(o keysetOrder, value any, id int) func(*entsql.Selector) {
+	return func(s *entsql.Selector) {
+		idCol := s.C("id")
+		if o.Column == "" {
+			if o.Desc {
+				s.Where(entsql.LT(idCol, id))
+			} else {
+				s.Where(entsql.GT(idCol, id))
+			}
+			return
+		}
+
+		col := s.C(o.Column)
+		if value == nil {
+			// 游标已经落在空值区间：只剩同为空值、id 更小的行。
+			s.Where(entsql.And(entsql.IsNull(col), entsql.LT(idCol, id)))
+			return
+		}
+		beyond := entsql.GT(col, value)
+		if o.Desc {
+			beyond = entsql.LT(col, value)
+		}
+		preds := []*entsql.Predicate{
+			beyond,
+			entsql.And(entsql.EQ(col, value), entsql.LT(idCol, id)),
+		}
+		if o.Nullable {
+			preds = append(preds, entsql.IsNull(col))
+		}
+		s.Where(entsql.Or(preds...))
+	}
+}
+
+// estimateTableRows 读取 PostgreSQL 对整表行数的统计估算；表从未 ANALYZE 过时 ok=false。
+func estimateTableRows(ctx context.Context, db *sql.DB, table string) (n int, ok bool, err error) {
+	var est float64
+	err = db.QueryRowContext(ctx, `SELECT reltuples FROM pg_class WHERE oid = to_regclass($1)`, table).Scan(&est)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if est < 0 {
+		return 0, false, nil
+	}
+	return int(est), true, nil
+}
@@ -0,0 +1,59 @@
+package data
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"server/internal/biz"
+
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+func TestPageCursorRoundTrip(t *testing.T) {
+	v := "2026-10-19T08:00:00.123456Z"
+	s := encodePageCursor(pageCursor{Sort: "last_login_at", Desc: true, Value: &v, ID: 42})
+
+	c, err := decodePageCursor(s, "last_login_at", true)
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if c.ID != 42 || c.Value == nil || *c.Value != v {
+		t.Fatalf("unexpected cursor: %+v", c)
+	}
+
+	if _, err := decodePageCursor(s, "last_login_at", false); !errors.Is(err, biz.ErrCursorInvalid) {
+		t.Fatalf("expected ErrCursorInvalid on direction change, got %v", err)
+	}
+	if _, err := decodePageCursor(s, "created_at", true); !errors.Is(err, biz.ErrCursorInvalid) {
+		t.Fatalf("expected ErrCursorInvalid on sort change, got %v", err)
+	}
+	if _, err := decodePageCursor("not-a-cursor!", "id", true); !errors.Is(err, biz.ErrCursorInvalid) {
+		t.Fatalf("expected ErrCursorInvalid on garbage, got %v", err)
+	}
+}
+
+func TestKeysetAfterSQL(t *testing.T) {
+	build := func(o keysetOrder, value any, id int) (string, []any) {
+		s := entsql.Dialect("postgres").Select("*").From(entsql.Table("users"))
+		keysetAfter(o, value, id)(s)
+		return s.Query()
+	}
+
+	q, args := build(keysetOrder{Desc: true}, nil, 10)
+	if want := `SELECT * FROM "users" WHERE "users"."id" < $1`; q != want || args[0] != 10 {
+		t.Fatalf("unexpected id keyset: %s %v", q, args)
+	}
+
+	ts := time.Unix(1700000000, 0)
+	q, args = build(keysetOrder{Column: "last_login_at", Desc: true, Nullable: true}, ts, 10)
+	want := `SELECT * FROM "users" WHERE "users"."last_login_at" < $1 OR ("users"."last_login_at" = $2 AND "users"."id" < $3) OR "users"."last_login_at" IS NULL`
+	if q != want || len(args) != 3 {
+		t.Fatalf("unexpected nullable keyset:\n got %s %v\nwant %s", q, args, want)
+	}
+
+	q, _ = build(keysetOrder{Column: "last_login_at", Nullable: true}, nil, 10)
+	if want := `SELECT * FROM "users" WHERE "users"."last_login_at" IS NULL AND "users"."id" < $1`; q != want {
+		t.Fatalf("unexpected null-cursor keyset:\n got %s\nwant %s", q, want)
+	}
+}
@@ -0,0 +1,150 @@
+// server/internal/data/login_event_repo.go
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/loginevent"
+	pkglogger "server/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type loginEventRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewLoginEventRepo(data *Data, logger log.Logger) *loginEventRepo {
+	return &loginEventRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data.login_event_repo")),
+	}
+}
+
+var _ biz.LoginEventRepo = (*loginEventRepo)(nil)
+
+func (r *loginEventRepo) RecordLoginEvent(ctx context.Context, e *biz.LoginEvent) error {
+	l := r.log.WithContext(ctx)
+	if e == nil || e.AccountKind == "" {
+		l.Warn("RecordLoginEvent: account_kind is required")
+		return errors.New("login event account kind is required")
+	}
+
+	requestID := e.RequestID
+	if requestID == "" {
+		requestID = pkglogger.RequestIDFromContext(ctx)
+	}
+
+	row, err := r.data.postgres.LoginEvent.
+		Create().
+		SetAccountKind(e.AccountKind).
+		SetAccountID(e.AccountID).
+		SetUsername(e.Username).
+		SetSuccess(e.Success).
+		SetReason(e.Reason).
+		SetIP(e.IP).
+		SetUserAgent(e.UserAgent).
+		SetRequestID(requestID).
+		Save(ctx)
+	if err != nil {
+		l.Errorf("RecordLoginEvent failed kind=%s account_id=%d err=%v", e.AccountKind, e.AccountID, err)
+		return err
+	}
+
+	e.ID = row.ID
+	e.RequestID = requestID
+	e.CreatedAt = row.CreatedAt
+	return nil
+}
+
+func (r *loginEventRepo) ListLoginEvents(ctx context.Context, f biz.LoginEventFilter) ([]*biz.LoginEvent, int, error) {
+	l := r.log.WithContext(ctx)
+
+	q := r.data.postgres.LoginEvent.Query()
+	if p, scoped := tenantLoginEventScope(ctx); scoped {
+		q = q.Where(p)
+	}
+	if f.AccountKind != "" {
+		q = q.Where(loginevent.AccountKind(f.AccountKind))
+	}
+	if f.AccountID > 0 {
+		q = q.Where(loginevent.AccountID(f.AccountID))
+	}
+	if f.Username != "" {
+		q = q.Where(loginevent.UsernameEqualFold(f.Username))
+	}
+	if f.IP != "" {
+		q = q.Where(loginevent.IP(f.IP))
+	}
+	if f.Success != nil {
+		q = q.Where(loginevent.Success(*f.Success))
+	}
+	if f.Since != nil {
+		q = q.Where(loginevent.CreatedAtGTE(*f.Since))
+	}
+	if f.Until != nil {
+		q = q.Where(loginevent.CreatedAtLT(*f.Until))
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		l.Errorf("ListLoginEvents count failed err=%v", err)
+		return nil, 0, err
+	}
+
+	rows, err := q.
+		Order(ent.Desc(loginevent.FieldID)).
+		Limit(f.Limit).
+		Offset(f.Offset).
+		All(ctx)
+	if err != nil {
+		l.Errorf("ListLoginEvents query failed err=%v", err)
+		return nil, 0, err
+	}
+
+	out := make([]*biz.LoginEvent, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &biz.LoginEvent{
+			ID:          row.ID,
+			AccountKind: row.AccountKind,
+			AccountID:   row.AccountID,
+			Username:    row.Username,
+			Success:     row.Success,
+			Reason:      row.Reason,
+			IP:          row.IP,
+			UserAgent:   row.UserAgent,
+			RequestID:   row.RequestID,
+			CreatedAt:   row.CreatedAt,
+		})
+	}
+	return out, total, nil
+}
+
+func (r *loginEventRepo) PurgeLoginEventsBefore(ctx context.Context, before time.Time) (int, error) {
+	n, err := r.data.postgres.LoginEvent.
+		Delete().
+		Where(loginevent.CreatedAtLT(before)).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("PurgeLoginEventsBefore failed err=%v", err)
+		return 0, err
+	}
+	return n, nil
+}
+
+func (r *loginEventRepo) CountLoginFailures(ctx context.Context, accountKind string, accountID int, since time.Time) (int, error) {
+	return r.data.postgres.LoginEvent.
+		Query().
+		Where(
+			loginevent.AccountKind(accountKind),
+			loginevent.AccountID(accountID),
+			loginevent.Reason(biz.LoginReasonBadPassword),
+			loginevent.CreatedAtGTE(since),
+		).
+		Count(ctx)
+}
@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"server/internal/data/model/ent/adminroleparent"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AdminRoleParent is the model entity for the AdminRoleParent schema.
+type AdminRoleParent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AdminRoleID holds the value of the "admin_role_id" field.
+	AdminRoleID int `json:"admin_role_id,omitempty"`
+	// ParentRoleID holds the value of the "parent_role_id" field.
+	ParentRoleID int `json:"parent_role_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AdminRoleParent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case adminroleparent.FieldID, adminroleparent.FieldAdminRoleID, adminroleparent.FieldParentRoleID:
+			values[i] = new(sql.NullInt64)
+		case adminroleparent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AdminRoleParent fields.
+func (_m *AdminRoleParent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case adminroleparent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case adminroleparent.FieldAdminRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_role_id", values[i])
+			} else if value.Valid {
+				_m.AdminRoleID = int(value.Int64)
+			}
+		case adminroleparent.FieldParentRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_role_id", values[i])
+			} else if value.Valid {
+				_m.ParentRoleID = int(value.Int64)
+			}
+		case adminroleparent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AdminRoleParent.
+// This includes values selected through modifiers, order, etc.
+func (_m *AdminRoleParent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AdminRoleParent.
+// Note that you need to call AdminRoleParent.Unwrap() before calling this method if this AdminRoleParent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AdminRoleParent) Update() *AdminRoleParentUpdateOne {
+	return NewAdminRoleParentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AdminRoleParent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AdminRoleParent) Unwrap() *AdminRoleParent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AdminRoleParent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AdminRoleParent) String() string {
+	var builder strings.Builder
+	builder.WriteString("AdminRoleParent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("admin_role_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AdminRoleID))
+	builder.WriteString(", ")
+	builder.WriteString("parent_role_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ParentRoleID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AdminRoleParents is a parsable slice of AdminRoleParent.
+type AdminRoleParents []*AdminRoleParent
@@ -0,0 +1,70 @@
+// Code generated by ent, DO NOT EDIT.
+
+package adminroleparent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the adminroleparent type in the database.
+	Label = "admin_role_parent"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAdminRoleID holds the string denoting the admin_role_id field in the database.
+	FieldAdminRoleID = "admin_role_id"
+	// FieldParentRoleID holds the string denoting the parent_role_id field in the database.
+	FieldParentRoleID = "parent_role_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the adminroleparent in the database.
+	Table = "admin_role_parents"
+)
+
+// Columns holds all SQL columns for adminroleparent fields.
+var Columns = []string{
+	FieldID,
+	FieldAdminRoleID,
+	FieldParentRoleID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the AdminRoleParent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAdminRoleID orders the results by the admin_role_id field.
+func ByAdminRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdminRoleID, opts...).ToFunc()
+}
+
+// ByParentRoleID orders the results by the parent_role_id field.
+func ByParentRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentRoleID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package adminroleparent
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldLTE(FieldID, id))
+}
+
+// AdminRoleID applies equality check predicate on the "admin_role_id" field. It's identical to AdminRoleIDEQ.
+func AdminRoleID(v int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldEQ(FieldAdminRoleID, v))
+}
+
+// ParentRoleID applies equality check predicate on the "parent_role_id" field. It's identical to ParentRoleIDEQ.
+func ParentRoleID(v int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldEQ(FieldParentRoleID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AdminRoleIDEQ applies the EQ predicate on the "admin_role_id" field.
+func AdminRoleIDEQ(v int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldEQ(FieldAdminRoleID, v))
+}
+
+// AdminRoleIDNEQ applies the NEQ predicate on the "admin_role_id" field.
+func AdminRoleIDNEQ(v int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldNEQ(FieldAdminRoleID, v))
+}
+
+// AdminRoleIDIn applies the In predicate on the "admin_role_id" field.
+func AdminRoleIDIn(vs ...int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldIn(FieldAdminRoleID, vs...))
+}
+
+// AdminRoleIDNotIn applies the NotIn predicate on the "admin_role_id" field.
+func AdminRoleIDNotIn(vs ...int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldNotIn(FieldAdminRoleID, vs...))
+}
+
+// AdminRoleIDGT applies the GT predicate on the "admin_role_id" field.
+func AdminRoleIDGT(v int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldGT(FieldAdminRoleID, v))
+}
+
+// AdminRoleIDGTE applies the GTE predicate on the "admin_role_id" field.
+func AdminRoleIDGTE(v int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldGTE(FieldAdminRoleID, v))
+}
+
+// AdminRoleIDLT applies the LT predicate on the "admin_role_id" field.
+func AdminRoleIDLT(v int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldLT(FieldAdminRoleID, v))
+}
+
+// AdminRoleIDLTE applies the LTE predicate on the "admin_role_id" field.
+func AdminRoleIDLTE(v int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldLTE(FieldAdminRoleID, v))
+}
+
+// ParentRoleIDEQ applies the EQ predicate on the "parent_role_id" field.
+func ParentRoleIDEQ(v int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldEQ(FieldParentRoleID, v))
+}
+
+// ParentRoleIDNEQ applies the NEQ predicate on the "parent_role_id" field.
+func ParentRoleIDNEQ(v int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldNEQ(FieldParentRoleID, v))
+}
+
+// ParentRoleIDIn applies the In predicate on the "parent_role_id" field.
+func ParentRoleIDIn(vs ...int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldIn(FieldParentRoleID, vs...))
+}
+
+// ParentRoleIDNotIn applies the NotIn predicate on the "parent_role_id" field.
+func ParentRoleIDNotIn(vs ...int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldNotIn(FieldParentRoleID, vs...))
+}
+
+// ParentRoleIDGT applies the GT predicate on the "parent_role_id" field.
+func ParentRoleIDGT(v int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldGT(FieldParentRoleID, v))
+}
+
+// ParentRoleIDGTE applies the GTE predicate on the "parent_role_id" field.
+func ParentRoleIDGTE(v int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldGTE(FieldParentRoleID, v))
+}
+
+// ParentRoleIDLT applies the LT predicate on the "parent_role_id" field.
+func ParentRoleIDLT(v int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldLT(FieldParentRoleID, v))
+}
+
+// ParentRoleIDLTE applies the LTE predicate on the "parent_role_id" field.
+func ParentRoleIDLTE(v int) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldLTE(FieldParentRoleID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AdminRoleParent) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AdminRoleParent) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AdminRoleParent) predicate.AdminRoleParent {
+	return predicate.AdminRoleParent(sql.NotPredicates(p))
+}
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/adminroleparent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminRoleParentCreate is the builder for creating a AdminRoleParent entity.
+type AdminRoleParentCreate struct {
+	config
+	mutation *AdminRoleParentMutation
+	hooks    []Hook
+}
+
+// SetAdminRoleID sets the "admin_role_id" field.
+func (_c *AdminRoleParentCreate) SetAdminRoleID(v int) *AdminRoleParentCreate {
+	_c.mutation.SetAdminRoleID(v)
+	return _c
+}
+
+// SetParentRoleID sets the "parent_role_id" field.
+func (_c *AdminRoleParentCreate) SetParentRoleID(v int) *AdminRoleParentCreate {
+	_c.mutation.SetParentRoleID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AdminRoleParentCreate) SetCreatedAt(v time.Time) *AdminRoleParentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AdminRoleParentCreate) SetNillableCreatedAt(v *time.Time) *AdminRoleParentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AdminRoleParentMutation object of the builder.
+func (_c *AdminRoleParentCreate) Mutation() *AdminRoleParentMutation {
+	return _c.mutation
+}
+
+// Save creates the AdminRoleParent in the database.
+func (_c *AdminRoleParentCreate) Save(ctx context.Context) (*AdminRoleParent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AdminRoleParentCreate) SaveX(ctx context.Context) *AdminRoleParent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminRoleParentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminRoleParentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AdminRoleParentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := adminroleparent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AdminRoleParentCreate) check() error {
+	if _, ok := _c.mutation.AdminRoleID(); !ok {
+		return &ValidationError{Name: "admin_role_id", err: errors.New(`ent: missing required field "AdminRoleParent.admin_role_id"`)}
+	}
+	if _, ok := _c.mutation.ParentRoleID(); !ok {
+		return &ValidationError{Name: "parent_role_id", err: errors.New(`ent: missing required field "AdminRoleParent.parent_role_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminRoleParent.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AdminRoleParentCreate) sqlSave(ctx context.Context) (*AdminRoleParent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AdminRoleParentCreate) createSpec() (*AdminRoleParent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdminRoleParent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(adminroleparent.Table, sqlgraph.NewFieldSpec(adminroleparent.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.AdminRoleID(); ok {
+		_spec.SetField(adminroleparent.FieldAdminRoleID, field.TypeInt, value)
+		_node.AdminRoleID = value
+	}
+	if value, ok := _c.mutation.ParentRoleID(); ok {
+		_spec.SetField(adminroleparent.FieldParentRoleID, field.TypeInt, value)
+		_node.ParentRoleID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(adminroleparent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AdminRoleParentCreateBulk is the builder for creating many AdminRoleParent entities in bulk.
+type AdminRoleParentCreateBulk struct {
+	config
+	err      error
+	builders []*AdminRoleParentCreate
+}
+
+// Save creates the AdminRoleParent entities in the database.
+func (_c *AdminRoleParentCreateBulk) Save(ctx context.Context) ([]*AdminRoleParent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AdminRoleParent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdminRoleParentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AdminRoleParentCreateBulk) SaveX(ctx context.Context) []*AdminRoleParent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminRoleParentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminRoleParentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/adminroleparent"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminRoleParentUpdate is the builder for updating AdminRoleParent entities.
+type AdminRoleParentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AdminRoleParentMutation
+}
+
+// Where appends a list predicates to the AdminRoleParentUpdate builder.
+func (_u *AdminRoleParentUpdate) Where(ps ...predicate.AdminRoleParent) *AdminRoleParentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAdminRoleID sets the "admin_role_id" field.
+func (_u *AdminRoleParentUpdate) SetAdminRoleID(v int) *AdminRoleParentUpdate {
+	_u.mutation.ResetAdminRoleID()
+	_u.mutation.SetAdminRoleID(v)
+	return _u
+}
+
+// SetNillableAdminRoleID sets the "admin_role_id" field if the given value is not nil.
+func (_u *AdminRoleParentUpdate) SetNillableAdminRoleID(v *int) *AdminRoleParentUpdate {
+	if v != nil {
+		_u.SetAdminRoleID(*v)
+	}
+	return _u
+}
+
+// AddAdminRoleID adds value to the "admin_role_id" field.
+func (_u *AdminRoleParentUpdate) AddAdminRoleID(v int) *AdminRoleParentUpdate {
+	_u.mutation.AddAdminRoleID(v)
+	return _u
+}
+
+// SetParentRoleID sets the "parent_role_id" field.
+func (_u *AdminRoleParentUpdate) SetParentRoleID(v int) *AdminRoleParentUpdate {
+	_u.mutation.ResetParentRoleID()
+	_u.mutation.SetParentRoleID(v)
+	return _u
+}
+
+// SetNillableParentRoleID sets the "parent_role_id" field if the given value is not nil.
+func (_u *AdminRoleParentUpdate) SetNillableParentRoleID(v *int) *AdminRoleParentUpdate {
+	if v != nil {
+		_u.SetParentRoleID(*v)
+	}
+	return _u
+}
+
+// AddParentRoleID adds value to the "parent_role_id" field.
+func (_u *AdminRoleParentUpdate) AddParentRoleID(v int) *AdminRoleParentUpdate {
+	_u.mutation.AddParentRoleID(v)
+	return _u
+}
+
+// Mutation returns the AdminRoleParentMutation object of the builder.
+func (_u *AdminRoleParentUpdate) Mutation() *AdminRoleParentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AdminRoleParentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminRoleParentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AdminRoleParentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminRoleParentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AdminRoleParentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(adminroleparent.Table, adminroleparent.Columns, sqlgraph.NewFieldSpec(adminroleparent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AdminRoleID(); ok {
+		_spec.SetField(adminroleparent.FieldAdminRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdminRoleID(); ok {
+		_spec.AddField(adminroleparent.FieldAdminRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ParentRoleID(); ok {
+		_spec.SetField(adminroleparent.FieldParentRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedParentRoleID(); ok {
+		_spec.AddField(adminroleparent.FieldParentRoleID, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminroleparent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AdminRoleParentUpdateOne is the builder for updating a single AdminRoleParent entity.
+type AdminRoleParentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AdminRoleParentMutation
+}
+
+// SetAdminRoleID sets the "admin_role_id" field.
+func (_u *AdminRoleParentUpdateOne) SetAdminRoleID(v int) *AdminRoleParentUpdateOne {
+	_u.mutation.ResetAdminRoleID()
+	_u.mutation.SetAdminRoleID(v)
+	return _u
+}
+
+// SetNillableAdminRoleID sets the "admin_role_id" field if the given value is not nil.
+func (_u *AdminRoleParentUpdateOne) SetNillableAdminRoleID(v *int) *AdminRoleParentUpdateOne {
+	if v != nil {
+		_u.SetAdminRoleID(*v)
+	}
+	return _u
+}
+
+// AddAdminRoleID adds value to the "admin_role_id" field.
+func (_u *AdminRoleParentUpdateOne) AddAdminRoleID(v int) *AdminRoleParentUpdateOne {
+	_u.mutation.AddAdminRoleID(v)
+	return _u
+}
+
+// SetParentRoleID sets the "parent_role_id" field.
+func (_u *AdminRoleParentUpdateOne) SetParentRoleID(v int) *AdminRoleParentUpdateOne {
+	_u.mutation.ResetParentRoleID()
+	_u.mutation.SetParentRoleID(v)
+	return _u
+}
+
+// SetNillableParentRoleID sets the "parent_role_id" field if the given value is not nil.
+func (_u *AdminRoleParentUpdateOne) SetNillableParentRoleID(v *int) *AdminRoleParentUpdateOne {
+	if v != nil {
+		_u.SetParentRoleID(*v)
+	}
+	return _u
+}
+
+// AddParentRoleID adds value to the "parent_role_id" field.
+func (_u *AdminRoleParentUpdateOne) AddParentRoleID(v int) *AdminRoleParentUpdateOne {
+	_u.mutation.AddParentRoleID(v)
+	return _u
+}
+
+// Mutation returns the AdminRoleParentMutation object of the builder.
+func (_u *AdminRoleParentUpdateOne) Mutation() *AdminRoleParentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AdminRoleParentUpdate builder.
+func (_u *AdminRoleParentUpdateOne) Where(ps ...predicate.AdminRoleParent) *AdminRoleParentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AdminRoleParentUpdateOne) Select(field string, fields ...string) *AdminRoleParentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AdminRoleParent entity.
+func (_u *AdminRoleParentUpdateOne) Save(ctx context.Context) (*AdminRoleParent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminRoleParentUpdateOne) SaveX(ctx context.Context) *AdminRoleParent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AdminRoleParentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminRoleParentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AdminRoleParentUpdateOne) sqlSave(ctx context.Context) (_node *AdminRoleParent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(adminroleparent.Table, adminroleparent.Columns, sqlgraph.NewFieldSpec(adminroleparent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AdminRoleParent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, adminroleparent.FieldID)
+		for _, f := range fields {
+			if !adminroleparent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != adminroleparent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AdminRoleID(); ok {
+		_spec.SetField(adminroleparent.FieldAdminRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdminRoleID(); ok {
+		_spec.AddField(adminroleparent.FieldAdminRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ParentRoleID(); ok {
+		_spec.SetField(adminroleparent.FieldParentRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedParentRoleID(); ok {
+		_spec.AddField(adminroleparent.FieldParentRoleID, field.TypeInt, value)
+	}
+	_node = &AdminRoleParent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminroleparent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -21,6 +21,8 @@ type AdminRolePermission struct {
 	AdminRoleID int `json:"admin_role_id,omitempty"`
 	// AdminPermissionID holds the value of the "admin_permission_id" field.
 	AdminPermissionID int `json:"admin_permission_id,omitempty"`
+	// Deny holds the value of the "deny" field.
+	Deny bool `json:"deny,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt    time.Time `json:"created_at,omitempty"`
 	selectValues sql.SelectValues
@@ -31,6 +33,8 @@ func (*AdminRolePermission) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case adminrolepermission.FieldDeny:
+			values[i] = new(sql.NullBool)
 		case adminrolepermission.FieldID, adminrolepermission.FieldAdminRoleID, adminrolepermission.FieldAdminPermissionID:
 			values[i] = new(sql.NullInt64)
 		case adminrolepermission.FieldCreatedAt:
@@ -68,6 +72,12 @@ func (_m *AdminRolePermission) assignValues(columns []string, values []any) erro
 			} else if value.Valid {
 				_m.AdminPermissionID = int(value.Int64)
 			}
+		case adminrolepermission.FieldDeny:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field deny", values[i])
+			} else if value.Valid {
+				_m.Deny = value.Bool
+			}
 		case adminrolepermission.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -116,6 +126,9 @@ func (_m *AdminRolePermission) String() string {
 	builder.WriteString("admin_permission_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AdminPermissionID))
 	builder.WriteString(", ")
+	builder.WriteString("deny=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Deny))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -17,6 +17,8 @@ const (
 	FieldAdminRoleID = "admin_role_id"
 	// FieldAdminPermissionID holds the string denoting the admin_permission_id field in the database.
 	FieldAdminPermissionID = "admin_permission_id"
+	// FieldDeny holds the string denoting the deny field in the database.
+	FieldDeny = "deny"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// Table holds the table name of the adminrolepermission in the database.
@@ -28,6 +30,7 @@ var Columns = []string{
 	FieldID,
 	FieldAdminRoleID,
 	FieldAdminPermissionID,
+	FieldDeny,
 	FieldCreatedAt,
 }
 
@@ -42,6 +45,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultDeny holds the default value on creation for the "deny" field.
+	DefaultDeny bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -64,6 +69,11 @@ func ByAdminPermissionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAdminPermissionID, opts...).ToFunc()
 }
 
+// ByDeny orders the results by the deny field.
+func ByDeny(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeny, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
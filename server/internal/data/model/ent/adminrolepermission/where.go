@@ -64,6 +64,11 @@ func AdminPermissionID(v int) predicate.AdminRolePermission {
 	return predicate.AdminRolePermission(sql.FieldEQ(FieldAdminPermissionID, v))
 }
 
+// Deny applies equality check predicate on the "deny" field. It's identical to DenyEQ.
+func Deny(v bool) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldEQ(FieldDeny, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.AdminRolePermission {
 	return predicate.AdminRolePermission(sql.FieldEQ(FieldCreatedAt, v))
@@ -149,6 +154,16 @@ func AdminPermissionIDLTE(v int) predicate.AdminRolePermission {
 	return predicate.AdminRolePermission(sql.FieldLTE(FieldAdminPermissionID, v))
 }
 
+// DenyEQ applies the EQ predicate on the "deny" field.
+func DenyEQ(v bool) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldEQ(FieldDeny, v))
+}
+
+// DenyNEQ applies the NEQ predicate on the "deny" field.
+func DenyNEQ(v bool) predicate.AdminRolePermission {
+	return predicate.AdminRolePermission(sql.FieldNEQ(FieldDeny, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.AdminRolePermission {
 	return predicate.AdminRolePermission(sql.FieldEQ(FieldCreatedAt, v))
@@ -32,6 +32,20 @@ func (_c *AdminRolePermissionCreate) SetAdminPermissionID(v int) *AdminRolePermi
 	return _c
 }
 
+// SetDeny sets the "deny" field.
+func (_c *AdminRolePermissionCreate) SetDeny(v bool) *AdminRolePermissionCreate {
+	_c.mutation.SetDeny(v)
+	return _c
+}
+
+// SetNillableDeny sets the "deny" field if the given value is not nil.
+func (_c *AdminRolePermissionCreate) SetNillableDeny(v *bool) *AdminRolePermissionCreate {
+	if v != nil {
+		_c.SetDeny(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *AdminRolePermissionCreate) SetCreatedAt(v time.Time) *AdminRolePermissionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -81,6 +95,10 @@ func (_c *AdminRolePermissionCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *AdminRolePermissionCreate) defaults() {
+	if _, ok := _c.mutation.Deny(); !ok {
+		v := adminrolepermission.DefaultDeny
+		_c.mutation.SetDeny(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := adminrolepermission.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -95,6 +113,9 @@ func (_c *AdminRolePermissionCreate) check() error {
 	if _, ok := _c.mutation.AdminPermissionID(); !ok {
 		return &ValidationError{Name: "admin_permission_id", err: errors.New(`ent: missing required field "AdminRolePermission.admin_permission_id"`)}
 	}
+	if _, ok := _c.mutation.Deny(); !ok {
+		return &ValidationError{Name: "deny", err: errors.New(`ent: missing required field "AdminRolePermission.deny"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminRolePermission.created_at"`)}
 	}
@@ -132,6 +153,10 @@ func (_c *AdminRolePermissionCreate) createSpec() (*AdminRolePermission, *sqlgra
 		_spec.SetField(adminrolepermission.FieldAdminPermissionID, field.TypeInt, value)
 		_node.AdminPermissionID = value
 	}
+	if value, ok := _c.mutation.Deny(); ok {
+		_spec.SetField(adminrolepermission.FieldDeny, field.TypeBool, value)
+		_node.Deny = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(adminrolepermission.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -69,6 +69,20 @@ func (_u *AdminRolePermissionUpdate) AddAdminPermissionID(v int) *AdminRolePermi
 	return _u
 }
 
+// SetDeny sets the "deny" field.
+func (_u *AdminRolePermissionUpdate) SetDeny(v bool) *AdminRolePermissionUpdate {
+	_u.mutation.SetDeny(v)
+	return _u
+}
+
+// SetNillableDeny sets the "deny" field if the given value is not nil.
+func (_u *AdminRolePermissionUpdate) SetNillableDeny(v *bool) *AdminRolePermissionUpdate {
+	if v != nil {
+		_u.SetDeny(*v)
+	}
+	return _u
+}
+
 // Mutation returns the AdminRolePermissionMutation object of the builder.
 func (_u *AdminRolePermissionUpdate) Mutation() *AdminRolePermissionMutation {
 	return _u.mutation
@@ -122,6 +136,9 @@ func (_u *AdminRolePermissionUpdate) sqlSave(ctx context.Context) (_node int, er
 	if value, ok := _u.mutation.AddedAdminPermissionID(); ok {
 		_spec.AddField(adminrolepermission.FieldAdminPermissionID, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Deny(); ok {
+		_spec.SetField(adminrolepermission.FieldDeny, field.TypeBool, value)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{adminrolepermission.Label}
@@ -184,6 +201,20 @@ func (_u *AdminRolePermissionUpdateOne) AddAdminPermissionID(v int) *AdminRolePe
 	return _u
 }
 
+// SetDeny sets the "deny" field.
+func (_u *AdminRolePermissionUpdateOne) SetDeny(v bool) *AdminRolePermissionUpdateOne {
+	_u.mutation.SetDeny(v)
+	return _u
+}
+
+// SetNillableDeny sets the "deny" field if the given value is not nil.
+func (_u *AdminRolePermissionUpdateOne) SetNillableDeny(v *bool) *AdminRolePermissionUpdateOne {
+	if v != nil {
+		_u.SetDeny(*v)
+	}
+	return _u
+}
+
 // Mutation returns the AdminRolePermissionMutation object of the builder.
 func (_u *AdminRolePermissionUpdateOne) Mutation() *AdminRolePermissionMutation {
 	return _u.mutation
@@ -267,6 +298,9 @@ func (_u *AdminRolePermissionUpdateOne) sqlSave(ctx context.Context) (_node *Adm
 	if value, ok := _u.mutation.AddedAdminPermissionID(); ok {
 		_spec.AddField(adminrolepermission.FieldAdminPermissionID, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Deny(); ok {
+		_spec.SetField(adminrolepermission.FieldDeny, field.TypeBool, value)
+	}
 	_node = &AdminRolePermission{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -59,6 +59,11 @@ func Username(v string) predicate.AdminUser {
 	return predicate.AdminUser(sql.FieldEQ(FieldUsername, v))
 }
 
+// UsernameNormalized applies equality check predicate on the "username_normalized" field. It's identical to UsernameNormalizedEQ.
+func UsernameNormalized(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldUsernameNormalized, v))
+}
+
 // PasswordHash applies equality check predicate on the "password_hash" field. It's identical to PasswordHashEQ.
 func PasswordHash(v string) predicate.AdminUser {
 	return predicate.AdminUser(sql.FieldEQ(FieldPasswordHash, v))
@@ -149,6 +154,71 @@ func UsernameContainsFold(v string) predicate.AdminUser {
 	return predicate.AdminUser(sql.FieldContainsFold(FieldUsername, v))
 }
 
+// UsernameNormalizedEQ applies the EQ predicate on the "username_normalized" field.
+func UsernameNormalizedEQ(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEQ(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedNEQ applies the NEQ predicate on the "username_normalized" field.
+func UsernameNormalizedNEQ(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNEQ(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedIn applies the In predicate on the "username_normalized" field.
+func UsernameNormalizedIn(vs ...string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIn(FieldUsernameNormalized, vs...))
+}
+
+// UsernameNormalizedNotIn applies the NotIn predicate on the "username_normalized" field.
+func UsernameNormalizedNotIn(vs ...string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotIn(FieldUsernameNormalized, vs...))
+}
+
+// UsernameNormalizedGT applies the GT predicate on the "username_normalized" field.
+func UsernameNormalizedGT(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGT(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedGTE applies the GTE predicate on the "username_normalized" field.
+func UsernameNormalizedGTE(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldGTE(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedLT applies the LT predicate on the "username_normalized" field.
+func UsernameNormalizedLT(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLT(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedLTE applies the LTE predicate on the "username_normalized" field.
+func UsernameNormalizedLTE(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldLTE(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedContains applies the Contains predicate on the "username_normalized" field.
+func UsernameNormalizedContains(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldContains(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedHasPrefix applies the HasPrefix predicate on the "username_normalized" field.
+func UsernameNormalizedHasPrefix(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldHasPrefix(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedHasSuffix applies the HasSuffix predicate on the "username_normalized" field.
+func UsernameNormalizedHasSuffix(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldHasSuffix(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedEqualFold applies the EqualFold predicate on the "username_normalized" field.
+func UsernameNormalizedEqualFold(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldEqualFold(FieldUsernameNormalized, v))
+}
+
+// UsernameNormalizedContainsFold applies the ContainsFold predicate on the "username_normalized" field.
+func UsernameNormalizedContainsFold(v string) predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldContainsFold(FieldUsernameNormalized, v))
+}
+
 // PasswordHashEQ applies the EQ predicate on the "password_hash" field.
 func PasswordHashEQ(v string) predicate.AdminUser {
 	return predicate.AdminUser(sql.FieldEQ(FieldPasswordHash, v))
@@ -224,6 +294,16 @@ func DisabledNEQ(v bool) predicate.AdminUser {
 	return predicate.AdminUser(sql.FieldNEQ(FieldDisabled, v))
 }
 
+// AttributesIsNil applies the IsNil predicate on the "attributes" field.
+func AttributesIsNil() predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldIsNull(FieldAttributes))
+}
+
+// AttributesNotNil applies the NotNil predicate on the "attributes" field.
+func AttributesNotNil() predicate.AdminUser {
+	return predicate.AdminUser(sql.FieldNotNull(FieldAttributes))
+}
+
 // LastLoginAtEQ applies the EQ predicate on the "last_login_at" field.
 func LastLoginAtEQ(v time.Time) predicate.AdminUser {
 	return predicate.AdminUser(sql.FieldEQ(FieldLastLoginAt, v))
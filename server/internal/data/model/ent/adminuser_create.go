@@ -26,6 +26,12 @@ func (_c *AdminUserCreate) SetUsername(v string) *AdminUserCreate {
 	return _c
 }
 
+// SetUsernameNormalized sets the "username_normalized" field.
+func (_c *AdminUserCreate) SetUsernameNormalized(v string) *AdminUserCreate {
+	_c.mutation.SetUsernameNormalized(v)
+	return _c
+}
+
 // SetPasswordHash sets the "password_hash" field.
 func (_c *AdminUserCreate) SetPasswordHash(v string) *AdminUserCreate {
 	_c.mutation.SetPasswordHash(v)
@@ -46,6 +52,12 @@ func (_c *AdminUserCreate) SetNillableDisabled(v *bool) *AdminUserCreate {
 	return _c
 }
 
+// SetAttributes sets the "attributes" field.
+func (_c *AdminUserCreate) SetAttributes(v map[string]interface{}) *AdminUserCreate {
+	_c.mutation.SetAttributes(v)
+	return _c
+}
+
 // SetLastLoginAt sets the "last_login_at" field.
 func (_c *AdminUserCreate) SetLastLoginAt(v time.Time) *AdminUserCreate {
 	_c.mutation.SetLastLoginAt(v)
@@ -147,6 +159,14 @@ func (_c *AdminUserCreate) check() error {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "AdminUser.username": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.UsernameNormalized(); !ok {
+		return &ValidationError{Name: "username_normalized", err: errors.New(`ent: missing required field "AdminUser.username_normalized"`)}
+	}
+	if v, ok := _c.mutation.UsernameNormalized(); ok {
+		if err := adminuser.UsernameNormalizedValidator(v); err != nil {
+			return &ValidationError{Name: "username_normalized", err: fmt.Errorf(`ent: validator failed for field "AdminUser.username_normalized": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.PasswordHash(); !ok {
 		return &ValidationError{Name: "password_hash", err: errors.New(`ent: missing required field "AdminUser.password_hash"`)}
 	}
@@ -194,6 +214,10 @@ func (_c *AdminUserCreate) createSpec() (*AdminUser, *sqlgraph.CreateSpec) {
 		_spec.SetField(adminuser.FieldUsername, field.TypeString, value)
 		_node.Username = value
 	}
+	if value, ok := _c.mutation.UsernameNormalized(); ok {
+		_spec.SetField(adminuser.FieldUsernameNormalized, field.TypeString, value)
+		_node.UsernameNormalized = value
+	}
 	if value, ok := _c.mutation.PasswordHash(); ok {
 		_spec.SetField(adminuser.FieldPasswordHash, field.TypeString, value)
 		_node.PasswordHash = value
@@ -202,6 +226,10 @@ func (_c *AdminUserCreate) createSpec() (*AdminUser, *sqlgraph.CreateSpec) {
 		_spec.SetField(adminuser.FieldDisabled, field.TypeBool, value)
 		_node.Disabled = value
 	}
+	if value, ok := _c.mutation.Attributes(); ok {
+		_spec.SetField(adminuser.FieldAttributes, field.TypeJSON, value)
+		_node.Attributes = value
+	}
 	if value, ok := _c.mutation.LastLoginAt(); ok {
 		_spec.SetField(adminuser.FieldLastLoginAt, field.TypeTime, value)
 		_node.LastLoginAt = &value
@@ -42,6 +42,20 @@ func (_u *AdminUserUpdate) SetNillableUsername(v *string) *AdminUserUpdate {
 	return _u
 }
 
+// SetUsernameNormalized sets the "username_normalized" field.
+func (_u *AdminUserUpdate) SetUsernameNormalized(v string) *AdminUserUpdate {
+	_u.mutation.SetUsernameNormalized(v)
+	return _u
+}
+
+// SetNillableUsernameNormalized sets the "username_normalized" field if the given value is not nil.
+func (_u *AdminUserUpdate) SetNillableUsernameNormalized(v *string) *AdminUserUpdate {
+	if v != nil {
+		_u.SetUsernameNormalized(*v)
+	}
+	return _u
+}
+
 // SetPasswordHash sets the "password_hash" field.
 func (_u *AdminUserUpdate) SetPasswordHash(v string) *AdminUserUpdate {
 	_u.mutation.SetPasswordHash(v)
@@ -70,6 +84,18 @@ func (_u *AdminUserUpdate) SetNillableDisabled(v *bool) *AdminUserUpdate {
 	return _u
 }
 
+// SetAttributes sets the "attributes" field.
+func (_u *AdminUserUpdate) SetAttributes(v map[string]interface{}) *AdminUserUpdate {
+	_u.mutation.SetAttributes(v)
+	return _u
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (_u *AdminUserUpdate) ClearAttributes() *AdminUserUpdate {
+	_u.mutation.ClearAttributes()
+	return _u
+}
+
 // SetLastLoginAt sets the "last_login_at" field.
 func (_u *AdminUserUpdate) SetLastLoginAt(v time.Time) *AdminUserUpdate {
 	_u.mutation.SetLastLoginAt(v)
@@ -144,6 +170,11 @@ func (_u *AdminUserUpdate) check() error {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "AdminUser.username": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.UsernameNormalized(); ok {
+		if err := adminuser.UsernameNormalizedValidator(v); err != nil {
+			return &ValidationError{Name: "username_normalized", err: fmt.Errorf(`ent: validator failed for field "AdminUser.username_normalized": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.PasswordHash(); ok {
 		if err := adminuser.PasswordHashValidator(v); err != nil {
 			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "AdminUser.password_hash": %w`, err)}
@@ -167,12 +198,21 @@ func (_u *AdminUserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Username(); ok {
 		_spec.SetField(adminuser.FieldUsername, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.UsernameNormalized(); ok {
+		_spec.SetField(adminuser.FieldUsernameNormalized, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.PasswordHash(); ok {
 		_spec.SetField(adminuser.FieldPasswordHash, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Disabled(); ok {
 		_spec.SetField(adminuser.FieldDisabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Attributes(); ok {
+		_spec.SetField(adminuser.FieldAttributes, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributesCleared() {
+		_spec.ClearField(adminuser.FieldAttributes, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.LastLoginAt(); ok {
 		_spec.SetField(adminuser.FieldLastLoginAt, field.TypeTime, value)
 	}
@@ -216,6 +256,20 @@ func (_u *AdminUserUpdateOne) SetNillableUsername(v *string) *AdminUserUpdateOne
 	return _u
 }
 
+// SetUsernameNormalized sets the "username_normalized" field.
+func (_u *AdminUserUpdateOne) SetUsernameNormalized(v string) *AdminUserUpdateOne {
+	_u.mutation.SetUsernameNormalized(v)
+	return _u
+}
+
+// SetNillableUsernameNormalized sets the "username_normalized" field if the given value is not nil.
+func (_u *AdminUserUpdateOne) SetNillableUsernameNormalized(v *string) *AdminUserUpdateOne {
+	if v != nil {
+		_u.SetUsernameNormalized(*v)
+	}
+	return _u
+}
+
 // SetPasswordHash sets the "password_hash" field.
 func (_u *AdminUserUpdateOne) SetPasswordHash(v string) *AdminUserUpdateOne {
 	_u.mutation.SetPasswordHash(v)
@@ -244,6 +298,18 @@ func (_u *AdminUserUpdateOne) SetNillableDisabled(v *bool) *AdminUserUpdateOne {
 	return _u
 }
 
+// SetAttributes sets the "attributes" field.
+func (_u *AdminUserUpdateOne) SetAttributes(v map[string]interface{}) *AdminUserUpdateOne {
+	_u.mutation.SetAttributes(v)
+	return _u
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (_u *AdminUserUpdateOne) ClearAttributes() *AdminUserUpdateOne {
+	_u.mutation.ClearAttributes()
+	return _u
+}
+
 // SetLastLoginAt sets the "last_login_at" field.
 func (_u *AdminUserUpdateOne) SetLastLoginAt(v time.Time) *AdminUserUpdateOne {
 	_u.mutation.SetLastLoginAt(v)
@@ -331,6 +397,11 @@ func (_u *AdminUserUpdateOne) check() error {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "AdminUser.username": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.UsernameNormalized(); ok {
+		if err := adminuser.UsernameNormalizedValidator(v); err != nil {
+			return &ValidationError{Name: "username_normalized", err: fmt.Errorf(`ent: validator failed for field "AdminUser.username_normalized": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.PasswordHash(); ok {
 		if err := adminuser.PasswordHashValidator(v); err != nil {
 			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "AdminUser.password_hash": %w`, err)}
@@ -371,12 +442,21 @@ func (_u *AdminUserUpdateOne) sqlSave(ctx context.Context) (_node *AdminUser, er
 	if value, ok := _u.mutation.Username(); ok {
 		_spec.SetField(adminuser.FieldUsername, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.UsernameNormalized(); ok {
+		_spec.SetField(adminuser.FieldUsernameNormalized, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.PasswordHash(); ok {
 		_spec.SetField(adminuser.FieldPasswordHash, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Disabled(); ok {
 		_spec.SetField(adminuser.FieldDisabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Attributes(); ok {
+		_spec.SetField(adminuser.FieldAttributes, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributesCleared() {
+		_spec.ClearField(adminuser.FieldAttributes, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.LastLoginAt(); ok {
 		_spec.SetField(adminuser.FieldLastLoginAt, field.TypeTime, value)
 	}
@@ -21,6 +21,10 @@ type AdminUserRole struct {
 	AdminUserID int `json:"admin_user_id,omitempty"`
 	// AdminRoleID holds the value of the "admin_role_id" field.
 	AdminRoleID int `json:"admin_role_id,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt    time.Time `json:"created_at,omitempty"`
 	selectValues sql.SelectValues
@@ -33,7 +37,7 @@ func (*AdminUserRole) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case adminuserrole.FieldID, adminuserrole.FieldAdminUserID, adminuserrole.FieldAdminRoleID:
 			values[i] = new(sql.NullInt64)
-		case adminuserrole.FieldCreatedAt:
+		case adminuserrole.FieldStartsAt, adminuserrole.FieldExpiresAt, adminuserrole.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -68,6 +72,20 @@ func (_m *AdminUserRole) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.AdminRoleID = int(value.Int64)
 			}
+		case adminuserrole.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				_m.StartsAt = new(time.Time)
+				*_m.StartsAt = value.Time
+			}
+		case adminuserrole.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
 		case adminuserrole.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -116,6 +134,16 @@ func (_m *AdminUserRole) String() string {
 	builder.WriteString("admin_role_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AdminRoleID))
 	builder.WriteString(", ")
+	if v := _m.StartsAt; v != nil {
+		builder.WriteString("starts_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -17,6 +17,10 @@ const (
 	FieldAdminUserID = "admin_user_id"
 	// FieldAdminRoleID holds the string denoting the admin_role_id field in the database.
 	FieldAdminRoleID = "admin_role_id"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// Table holds the table name of the adminuserrole in the database.
@@ -28,6 +32,8 @@ var Columns = []string{
 	FieldID,
 	FieldAdminUserID,
 	FieldAdminRoleID,
+	FieldStartsAt,
+	FieldExpiresAt,
 	FieldCreatedAt,
 }
 
@@ -64,6 +70,16 @@ func ByAdminRoleID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAdminRoleID, opts...).ToFunc()
 }
 
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -32,6 +32,34 @@ func (_c *AdminUserRoleCreate) SetAdminRoleID(v int) *AdminUserRoleCreate {
 	return _c
 }
 
+// SetStartsAt sets the "starts_at" field.
+func (_c *AdminUserRoleCreate) SetStartsAt(v time.Time) *AdminUserRoleCreate {
+	_c.mutation.SetStartsAt(v)
+	return _c
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_c *AdminUserRoleCreate) SetNillableStartsAt(v *time.Time) *AdminUserRoleCreate {
+	if v != nil {
+		_c.SetStartsAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *AdminUserRoleCreate) SetExpiresAt(v time.Time) *AdminUserRoleCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *AdminUserRoleCreate) SetNillableExpiresAt(v *time.Time) *AdminUserRoleCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *AdminUserRoleCreate) SetCreatedAt(v time.Time) *AdminUserRoleCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -132,6 +160,14 @@ func (_c *AdminUserRoleCreate) createSpec() (*AdminUserRole, *sqlgraph.CreateSpe
 		_spec.SetField(adminuserrole.FieldAdminRoleID, field.TypeInt, value)
 		_node.AdminRoleID = value
 	}
+	if value, ok := _c.mutation.StartsAt(); ok {
+		_spec.SetField(adminuserrole.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(adminuserrole.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(adminuserrole.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
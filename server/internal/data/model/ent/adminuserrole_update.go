@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"server/internal/data/model/ent/adminuserrole"
 	"server/internal/data/model/ent/predicate"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -69,6 +70,46 @@ func (_u *AdminUserRoleUpdate) AddAdminRoleID(v int) *AdminUserRoleUpdate {
 	return _u
 }
 
+// SetStartsAt sets the "starts_at" field.
+func (_u *AdminUserRoleUpdate) SetStartsAt(v time.Time) *AdminUserRoleUpdate {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *AdminUserRoleUpdate) SetNillableStartsAt(v *time.Time) *AdminUserRoleUpdate {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (_u *AdminUserRoleUpdate) ClearStartsAt() *AdminUserRoleUpdate {
+	_u.mutation.ClearStartsAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AdminUserRoleUpdate) SetExpiresAt(v time.Time) *AdminUserRoleUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AdminUserRoleUpdate) SetNillableExpiresAt(v *time.Time) *AdminUserRoleUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *AdminUserRoleUpdate) ClearExpiresAt() *AdminUserRoleUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
 // Mutation returns the AdminUserRoleMutation object of the builder.
 func (_u *AdminUserRoleUpdate) Mutation() *AdminUserRoleMutation {
 	return _u.mutation
@@ -122,6 +163,18 @@ func (_u *AdminUserRoleUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.AddedAdminRoleID(); ok {
 		_spec.AddField(adminuserrole.FieldAdminRoleID, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(adminuserrole.FieldStartsAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartsAtCleared() {
+		_spec.ClearField(adminuserrole.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(adminuserrole.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(adminuserrole.FieldExpiresAt, field.TypeTime)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{adminuserrole.Label}
@@ -184,6 +237,46 @@ func (_u *AdminUserRoleUpdateOne) AddAdminRoleID(v int) *AdminUserRoleUpdateOne
 	return _u
 }
 
+// SetStartsAt sets the "starts_at" field.
+func (_u *AdminUserRoleUpdateOne) SetStartsAt(v time.Time) *AdminUserRoleUpdateOne {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *AdminUserRoleUpdateOne) SetNillableStartsAt(v *time.Time) *AdminUserRoleUpdateOne {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (_u *AdminUserRoleUpdateOne) ClearStartsAt() *AdminUserRoleUpdateOne {
+	_u.mutation.ClearStartsAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AdminUserRoleUpdateOne) SetExpiresAt(v time.Time) *AdminUserRoleUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AdminUserRoleUpdateOne) SetNillableExpiresAt(v *time.Time) *AdminUserRoleUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *AdminUserRoleUpdateOne) ClearExpiresAt() *AdminUserRoleUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
 // Mutation returns the AdminUserRoleMutation object of the builder.
 func (_u *AdminUserRoleUpdateOne) Mutation() *AdminUserRoleMutation {
 	return _u.mutation
@@ -267,6 +360,18 @@ func (_u *AdminUserRoleUpdateOne) sqlSave(ctx context.Context) (_node *AdminUser
 	if value, ok := _u.mutation.AddedAdminRoleID(); ok {
 		_spec.AddField(adminuserrole.FieldAdminRoleID, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(adminuserrole.FieldStartsAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartsAtCleared() {
+		_spec.ClearField(adminuserrole.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(adminuserrole.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(adminuserrole.FieldExpiresAt, field.TypeTime)
+	}
 	_node = &AdminUserRole{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/auditlog"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (_u *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AuditLogUpdate) SetAction(v string) *AuditLogUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableAction(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetActorKind sets the "actor_kind" field.
+func (_u *AuditLogUpdate) SetActorKind(v string) *AuditLogUpdate {
+	_u.mutation.SetActorKind(v)
+	return _u
+}
+
+// SetNillableActorKind sets the "actor_kind" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableActorKind(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetActorKind(*v)
+	}
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *AuditLogUpdate) SetActorID(v int) *AuditLogUpdate {
+	_u.mutation.ResetActorID()
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableActorID(v *int) *AuditLogUpdate {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// AddActorID adds value to the "actor_id" field.
+func (_u *AuditLogUpdate) AddActorID(v int) *AuditLogUpdate {
+	_u.mutation.AddActorID(v)
+	return _u
+}
+
+// SetActorUsername sets the "actor_username" field.
+func (_u *AuditLogUpdate) SetActorUsername(v string) *AuditLogUpdate {
+	_u.mutation.SetActorUsername(v)
+	return _u
+}
+
+// SetNillableActorUsername sets the "actor_username" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableActorUsername(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetActorUsername(*v)
+	}
+	return _u
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (_u *AuditLogUpdate) SetTargetKind(v string) *AuditLogUpdate {
+	_u.mutation.SetTargetKind(v)
+	return _u
+}
+
+// SetNillableTargetKind sets the "target_kind" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableTargetKind(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetTargetKind(*v)
+	}
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *AuditLogUpdate) SetTargetID(v int) *AuditLogUpdate {
+	_u.mutation.ResetTargetID()
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableTargetID(v *int) *AuditLogUpdate {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// AddTargetID adds value to the "target_id" field.
+func (_u *AuditLogUpdate) AddTargetID(v int) *AuditLogUpdate {
+	_u.mutation.AddTargetID(v)
+	return _u
+}
+
+// SetDetail sets the "detail" field.
+func (_u *AuditLogUpdate) SetDetail(v map[string]interface{}) *AuditLogUpdate {
+	_u.mutation.SetDetail(v)
+	return _u
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (_u *AuditLogUpdate) ClearDetail() *AuditLogUpdate {
+	_u.mutation.ClearDetail()
+	return _u
+}
+
+// SetRequestID sets the "request_id" field.
+func (_u *AuditLogUpdate) SetRequestID(v string) *AuditLogUpdate {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *AuditLogUpdate) SetNillableRequestID(v *string) *AuditLogUpdate {
+	if v != nil {
+		_u.SetRequestID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (_u *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditLogUpdate) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := auditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditLog.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ActorKind(); ok {
+		if err := auditlog.ActorKindValidator(v); err != nil {
+			return &ValidationError{Name: "actor_kind", err: fmt.Errorf(`ent: validator failed for field "AuditLog.actor_kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ActorKind(); ok {
+		_spec.SetField(auditlog.FieldActorKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedActorID(); ok {
+		_spec.AddField(auditlog.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ActorUsername(); ok {
+		_spec.SetField(auditlog.FieldActorUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetKind(); ok {
+		_spec.SetField(auditlog.FieldTargetKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(auditlog.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTargetID(); ok {
+		_spec.AddField(auditlog.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Detail(); ok {
+		_spec.SetField(auditlog.FieldDetail, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(auditlog.FieldDetail, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(auditlog.FieldRequestID, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// SetAction sets the "action" field.
+func (_u *AuditLogUpdateOne) SetAction(v string) *AuditLogUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableAction(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetActorKind sets the "actor_kind" field.
+func (_u *AuditLogUpdateOne) SetActorKind(v string) *AuditLogUpdateOne {
+	_u.mutation.SetActorKind(v)
+	return _u
+}
+
+// SetNillableActorKind sets the "actor_kind" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableActorKind(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetActorKind(*v)
+	}
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *AuditLogUpdateOne) SetActorID(v int) *AuditLogUpdateOne {
+	_u.mutation.ResetActorID()
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableActorID(v *int) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// AddActorID adds value to the "actor_id" field.
+func (_u *AuditLogUpdateOne) AddActorID(v int) *AuditLogUpdateOne {
+	_u.mutation.AddActorID(v)
+	return _u
+}
+
+// SetActorUsername sets the "actor_username" field.
+func (_u *AuditLogUpdateOne) SetActorUsername(v string) *AuditLogUpdateOne {
+	_u.mutation.SetActorUsername(v)
+	return _u
+}
+
+// SetNillableActorUsername sets the "actor_username" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableActorUsername(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetActorUsername(*v)
+	}
+	return _u
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (_u *AuditLogUpdateOne) SetTargetKind(v string) *AuditLogUpdateOne {
+	_u.mutation.SetTargetKind(v)
+	return _u
+}
+
+// SetNillableTargetKind sets the "target_kind" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableTargetKind(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetTargetKind(*v)
+	}
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *AuditLogUpdateOne) SetTargetID(v int) *AuditLogUpdateOne {
+	_u.mutation.ResetTargetID()
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableTargetID(v *int) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// AddTargetID adds value to the "target_id" field.
+func (_u *AuditLogUpdateOne) AddTargetID(v int) *AuditLogUpdateOne {
+	_u.mutation.AddTargetID(v)
+	return _u
+}
+
+// SetDetail sets the "detail" field.
+func (_u *AuditLogUpdateOne) SetDetail(v map[string]interface{}) *AuditLogUpdateOne {
+	_u.mutation.SetDetail(v)
+	return _u
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (_u *AuditLogUpdateOne) ClearDetail() *AuditLogUpdateOne {
+	_u.mutation.ClearDetail()
+	return _u
+}
+
+// SetRequestID sets the "request_id" field.
+func (_u *AuditLogUpdateOne) SetRequestID(v string) *AuditLogUpdateOne {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *AuditLogUpdateOne) SetNillableRequestID(v *string) *AuditLogUpdateOne {
+	if v != nil {
+		_u.SetRequestID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (_u *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (_u *AuditLogUpdateOne) Where(ps ...predicate.AuditLog) *AuditLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (_u *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditLogUpdateOne) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := auditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditLog.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ActorKind(); ok {
+		if err := auditlog.ActorKindValidator(v); err != nil {
+			return &ValidationError{Name: "actor_kind", err: fmt.Errorf(`ent: validator failed for field "AuditLog.actor_kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ActorKind(); ok {
+		_spec.SetField(auditlog.FieldActorKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedActorID(); ok {
+		_spec.AddField(auditlog.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ActorUsername(); ok {
+		_spec.SetField(auditlog.FieldActorUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetKind(); ok {
+		_spec.SetField(auditlog.FieldTargetKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(auditlog.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTargetID(); ok {
+		_spec.AddField(auditlog.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Detail(); ok {
+		_spec.SetField(auditlog.FieldDetail, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(auditlog.FieldDetail, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(auditlog.FieldRequestID, field.TypeString, value)
+	}
+	_node = &AuditLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -7,12 +7,26 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"server/internal/data/model/ent/accesspolicy"
 	"server/internal/data/model/ent/adminpermission"
 	"server/internal/data/model/ent/adminrole"
+	"server/internal/data/model/ent/adminroleparent"
 	"server/internal/data/model/ent/adminrolepermission"
 	"server/internal/data/model/ent/adminuser"
 	"server/internal/data/model/ent/adminuserrole"
+	"server/internal/data/model/ent/auditlog"
+	"server/internal/data/model/ent/invitecode"
+	"server/internal/data/model/ent/inviteredemption"
+	"server/internal/data/model/ent/loginevent"
+	"server/internal/data/model/ent/organization"
+	"server/internal/data/model/ent/organizationadminrole"
+	"server/internal/data/model/ent/organizationmember"
 	"server/internal/data/model/ent/user"
+	"server/internal/data/model/ent/userimportjob"
+	"server/internal/data/model/ent/userrole"
+	"server/internal/data/model/ent/userrolebinding"
+	"server/internal/data/model/ent/userrolepermission"
+	"server/internal/data/model/ent/verificationcode"
 	"sync"
 
 	"entgo.io/ent"
@@ -78,12 +92,26 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			adminpermission.Table:     adminpermission.ValidColumn,
-			adminrole.Table:           adminrole.ValidColumn,
-			adminrolepermission.Table: adminrolepermission.ValidColumn,
-			adminuser.Table:           adminuser.ValidColumn,
-			adminuserrole.Table:       adminuserrole.ValidColumn,
-			user.Table:                user.ValidColumn,
+			accesspolicy.Table:          accesspolicy.ValidColumn,
+			adminpermission.Table:       adminpermission.ValidColumn,
+			adminrole.Table:             adminrole.ValidColumn,
+			adminroleparent.Table:       adminroleparent.ValidColumn,
+			adminrolepermission.Table:   adminrolepermission.ValidColumn,
+			adminuser.Table:             adminuser.ValidColumn,
+			adminuserrole.Table:         adminuserrole.ValidColumn,
+			auditlog.Table:              auditlog.ValidColumn,
+			invitecode.Table:            invitecode.ValidColumn,
+			inviteredemption.Table:      inviteredemption.ValidColumn,
+			loginevent.Table:            loginevent.ValidColumn,
+			organization.Table:          organization.ValidColumn,
+			organizationadminrole.Table: organizationadminrole.ValidColumn,
+			organizationmember.Table:    organizationmember.ValidColumn,
+			user.Table:                  user.ValidColumn,
+			userimportjob.Table:         userimportjob.ValidColumn,
+			userrole.Table:              userrole.ValidColumn,
+			userrolebinding.Table:       userrolebinding.ValidColumn,
+			userrolepermission.Table:    userrolepermission.ValidColumn,
+			verificationcode.Table:      verificationcode.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
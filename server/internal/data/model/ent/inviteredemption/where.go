@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inviteredemption
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldLTE(FieldID, id))
+}
+
+// InviteCodeID applies equality check predicate on the "invite_code_id" field. It's identical to InviteCodeIDEQ.
+func InviteCodeID(v int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldEQ(FieldInviteCodeID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldEQ(FieldUserID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// InviteCodeIDEQ applies the EQ predicate on the "invite_code_id" field.
+func InviteCodeIDEQ(v int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldEQ(FieldInviteCodeID, v))
+}
+
+// InviteCodeIDNEQ applies the NEQ predicate on the "invite_code_id" field.
+func InviteCodeIDNEQ(v int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldNEQ(FieldInviteCodeID, v))
+}
+
+// InviteCodeIDIn applies the In predicate on the "invite_code_id" field.
+func InviteCodeIDIn(vs ...int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldIn(FieldInviteCodeID, vs...))
+}
+
+// InviteCodeIDNotIn applies the NotIn predicate on the "invite_code_id" field.
+func InviteCodeIDNotIn(vs ...int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldNotIn(FieldInviteCodeID, vs...))
+}
+
+// InviteCodeIDGT applies the GT predicate on the "invite_code_id" field.
+func InviteCodeIDGT(v int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldGT(FieldInviteCodeID, v))
+}
+
+// InviteCodeIDGTE applies the GTE predicate on the "invite_code_id" field.
+func InviteCodeIDGTE(v int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldGTE(FieldInviteCodeID, v))
+}
+
+// InviteCodeIDLT applies the LT predicate on the "invite_code_id" field.
+func InviteCodeIDLT(v int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldLT(FieldInviteCodeID, v))
+}
+
+// InviteCodeIDLTE applies the LTE predicate on the "invite_code_id" field.
+func InviteCodeIDLTE(v int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldLTE(FieldInviteCodeID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldLTE(FieldUserID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InviteRedemption) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InviteRedemption) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InviteRedemption) predicate.InviteRedemption {
+	return predicate.InviteRedemption(sql.NotPredicates(p))
+}
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/inviteredemption"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InviteRedemptionCreate is the builder for creating a InviteRedemption entity.
+type InviteRedemptionCreate struct {
+	config
+	mutation *InviteRedemptionMutation
+	hooks    []Hook
+}
+
+// SetInviteCodeID sets the "invite_code_id" field.
+func (_c *InviteRedemptionCreate) SetInviteCodeID(v int) *InviteRedemptionCreate {
+	_c.mutation.SetInviteCodeID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *InviteRedemptionCreate) SetUserID(v int) *InviteRedemptionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InviteRedemptionCreate) SetCreatedAt(v time.Time) *InviteRedemptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InviteRedemptionCreate) SetNillableCreatedAt(v *time.Time) *InviteRedemptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the InviteRedemptionMutation object of the builder.
+func (_c *InviteRedemptionCreate) Mutation() *InviteRedemptionMutation {
+	return _c.mutation
+}
+
+// Save creates the InviteRedemption in the database.
+func (_c *InviteRedemptionCreate) Save(ctx context.Context) (*InviteRedemption, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InviteRedemptionCreate) SaveX(ctx context.Context) *InviteRedemption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InviteRedemptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InviteRedemptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InviteRedemptionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := inviteredemption.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InviteRedemptionCreate) check() error {
+	if _, ok := _c.mutation.InviteCodeID(); !ok {
+		return &ValidationError{Name: "invite_code_id", err: errors.New(`ent: missing required field "InviteRedemption.invite_code_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "InviteRedemption.user_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InviteRedemption.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *InviteRedemptionCreate) sqlSave(ctx context.Context) (*InviteRedemption, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InviteRedemptionCreate) createSpec() (*InviteRedemption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InviteRedemption{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(inviteredemption.Table, sqlgraph.NewFieldSpec(inviteredemption.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.InviteCodeID(); ok {
+		_spec.SetField(inviteredemption.FieldInviteCodeID, field.TypeInt, value)
+		_node.InviteCodeID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(inviteredemption.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(inviteredemption.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// InviteRedemptionCreateBulk is the builder for creating many InviteRedemption entities in bulk.
+type InviteRedemptionCreateBulk struct {
+	config
+	err      error
+	builders []*InviteRedemptionCreate
+}
+
+// Save creates the InviteRedemption entities in the database.
+func (_c *InviteRedemptionCreateBulk) Save(ctx context.Context) ([]*InviteRedemption, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InviteRedemption, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InviteRedemptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InviteRedemptionCreateBulk) SaveX(ctx context.Context) []*InviteRedemption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InviteRedemptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InviteRedemptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
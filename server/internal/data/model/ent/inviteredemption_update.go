@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/inviteredemption"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InviteRedemptionUpdate is the builder for updating InviteRedemption entities.
+type InviteRedemptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InviteRedemptionMutation
+}
+
+// Where appends a list predicates to the InviteRedemptionUpdate builder.
+func (_u *InviteRedemptionUpdate) Where(ps ...predicate.InviteRedemption) *InviteRedemptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetInviteCodeID sets the "invite_code_id" field.
+func (_u *InviteRedemptionUpdate) SetInviteCodeID(v int) *InviteRedemptionUpdate {
+	_u.mutation.ResetInviteCodeID()
+	_u.mutation.SetInviteCodeID(v)
+	return _u
+}
+
+// SetNillableInviteCodeID sets the "invite_code_id" field if the given value is not nil.
+func (_u *InviteRedemptionUpdate) SetNillableInviteCodeID(v *int) *InviteRedemptionUpdate {
+	if v != nil {
+		_u.SetInviteCodeID(*v)
+	}
+	return _u
+}
+
+// AddInviteCodeID adds value to the "invite_code_id" field.
+func (_u *InviteRedemptionUpdate) AddInviteCodeID(v int) *InviteRedemptionUpdate {
+	_u.mutation.AddInviteCodeID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *InviteRedemptionUpdate) SetUserID(v int) *InviteRedemptionUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *InviteRedemptionUpdate) SetNillableUserID(v *int) *InviteRedemptionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *InviteRedemptionUpdate) AddUserID(v int) *InviteRedemptionUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// Mutation returns the InviteRedemptionMutation object of the builder.
+func (_u *InviteRedemptionUpdate) Mutation() *InviteRedemptionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InviteRedemptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InviteRedemptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InviteRedemptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InviteRedemptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *InviteRedemptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(inviteredemption.Table, inviteredemption.Columns, sqlgraph.NewFieldSpec(inviteredemption.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.InviteCodeID(); ok {
+		_spec.SetField(inviteredemption.FieldInviteCodeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInviteCodeID(); ok {
+		_spec.AddField(inviteredemption.FieldInviteCodeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(inviteredemption.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(inviteredemption.FieldUserID, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inviteredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InviteRedemptionUpdateOne is the builder for updating a single InviteRedemption entity.
+type InviteRedemptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InviteRedemptionMutation
+}
+
+// SetInviteCodeID sets the "invite_code_id" field.
+func (_u *InviteRedemptionUpdateOne) SetInviteCodeID(v int) *InviteRedemptionUpdateOne {
+	_u.mutation.ResetInviteCodeID()
+	_u.mutation.SetInviteCodeID(v)
+	return _u
+}
+
+// SetNillableInviteCodeID sets the "invite_code_id" field if the given value is not nil.
+func (_u *InviteRedemptionUpdateOne) SetNillableInviteCodeID(v *int) *InviteRedemptionUpdateOne {
+	if v != nil {
+		_u.SetInviteCodeID(*v)
+	}
+	return _u
+}
+
+// AddInviteCodeID adds value to the "invite_code_id" field.
+func (_u *InviteRedemptionUpdateOne) AddInviteCodeID(v int) *InviteRedemptionUpdateOne {
+	_u.mutation.AddInviteCodeID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *InviteRedemptionUpdateOne) SetUserID(v int) *InviteRedemptionUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *InviteRedemptionUpdateOne) SetNillableUserID(v *int) *InviteRedemptionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *InviteRedemptionUpdateOne) AddUserID(v int) *InviteRedemptionUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// Mutation returns the InviteRedemptionMutation object of the builder.
+func (_u *InviteRedemptionUpdateOne) Mutation() *InviteRedemptionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InviteRedemptionUpdate builder.
+func (_u *InviteRedemptionUpdateOne) Where(ps ...predicate.InviteRedemption) *InviteRedemptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InviteRedemptionUpdateOne) Select(field string, fields ...string) *InviteRedemptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InviteRedemption entity.
+func (_u *InviteRedemptionUpdateOne) Save(ctx context.Context) (*InviteRedemption, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InviteRedemptionUpdateOne) SaveX(ctx context.Context) *InviteRedemption {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InviteRedemptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InviteRedemptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *InviteRedemptionUpdateOne) sqlSave(ctx context.Context) (_node *InviteRedemption, err error) {
+	_spec := sqlgraph.NewUpdateSpec(inviteredemption.Table, inviteredemption.Columns, sqlgraph.NewFieldSpec(inviteredemption.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InviteRedemption.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inviteredemption.FieldID)
+		for _, f := range fields {
+			if !inviteredemption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != inviteredemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.InviteCodeID(); ok {
+		_spec.SetField(inviteredemption.FieldInviteCodeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInviteCodeID(); ok {
+		_spec.AddField(inviteredemption.FieldInviteCodeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(inviteredemption.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(inviteredemption.FieldUserID, field.TypeInt, value)
+	}
+	_node = &InviteRedemption{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inviteredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,403 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/loginevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginEventCreate is the builder for creating a LoginEvent entity.
+type LoginEventCreate struct {
+	config
+	mutation *LoginEventMutation
+	hooks    []Hook
+}
+
+// SetAccountKind sets the "account_kind" field.
+func (_c *LoginEventCreate) SetAccountKind(v string) *LoginEventCreate {
+	_c.mutation.SetAccountKind(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *LoginEventCreate) SetAccountID(v int) *LoginEventCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableAccountID(v *int) *LoginEventCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetUsername sets the "username" field.
+func (_c *LoginEventCreate) SetUsername(v string) *LoginEventCreate {
+	_c.mutation.SetUsername(v)
+	return _c
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableUsername(v *string) *LoginEventCreate {
+	if v != nil {
+		_c.SetUsername(*v)
+	}
+	return _c
+}
+
+// SetSuccess sets the "success" field.
+func (_c *LoginEventCreate) SetSuccess(v bool) *LoginEventCreate {
+	_c.mutation.SetSuccess(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *LoginEventCreate) SetReason(v string) *LoginEventCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableReason(v *string) *LoginEventCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *LoginEventCreate) SetIP(v string) *LoginEventCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableIP(v *string) *LoginEventCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *LoginEventCreate) SetUserAgent(v string) *LoginEventCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableUserAgent(v *string) *LoginEventCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *LoginEventCreate) SetRequestID(v string) *LoginEventCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableRequestID(v *string) *LoginEventCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LoginEventCreate) SetCreatedAt(v time.Time) *LoginEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LoginEventCreate) SetNillableCreatedAt(v *time.Time) *LoginEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the LoginEventMutation object of the builder.
+func (_c *LoginEventCreate) Mutation() *LoginEventMutation {
+	return _c.mutation
+}
+
+// Save creates the LoginEvent in the database.
+func (_c *LoginEventCreate) Save(ctx context.Context) (*LoginEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LoginEventCreate) SaveX(ctx context.Context) *LoginEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoginEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoginEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LoginEventCreate) defaults() {
+	if _, ok := _c.mutation.AccountID(); !ok {
+		v := loginevent.DefaultAccountID
+		_c.mutation.SetAccountID(v)
+	}
+	if _, ok := _c.mutation.Username(); !ok {
+		v := loginevent.DefaultUsername
+		_c.mutation.SetUsername(v)
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		v := loginevent.DefaultReason
+		_c.mutation.SetReason(v)
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		v := loginevent.DefaultIP
+		_c.mutation.SetIP(v)
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		v := loginevent.DefaultUserAgent
+		_c.mutation.SetUserAgent(v)
+	}
+	if _, ok := _c.mutation.RequestID(); !ok {
+		v := loginevent.DefaultRequestID
+		_c.mutation.SetRequestID(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := loginevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LoginEventCreate) check() error {
+	if _, ok := _c.mutation.AccountKind(); !ok {
+		return &ValidationError{Name: "account_kind", err: errors.New(`ent: missing required field "LoginEvent.account_kind"`)}
+	}
+	if v, ok := _c.mutation.AccountKind(); ok {
+		if err := loginevent.AccountKindValidator(v); err != nil {
+			return &ValidationError{Name: "account_kind", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.account_kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "LoginEvent.account_id"`)}
+	}
+	if _, ok := _c.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "LoginEvent.username"`)}
+	}
+	if v, ok := _c.mutation.Username(); ok {
+		if err := loginevent.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.username": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "LoginEvent.success"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "LoginEvent.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := loginevent.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "LoginEvent.ip"`)}
+	}
+	if v, ok := _c.mutation.IP(); ok {
+		if err := loginevent.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.ip": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "LoginEvent.user_agent"`)}
+	}
+	if v, ok := _c.mutation.UserAgent(); ok {
+		if err := loginevent.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequestID(); !ok {
+		return &ValidationError{Name: "request_id", err: errors.New(`ent: missing required field "LoginEvent.request_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoginEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *LoginEventCreate) sqlSave(ctx context.Context) (*LoginEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LoginEventCreate) createSpec() (*LoginEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(loginevent.Table, sqlgraph.NewFieldSpec(loginevent.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.AccountKind(); ok {
+		_spec.SetField(loginevent.FieldAccountKind, field.TypeString, value)
+		_node.AccountKind = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(loginevent.FieldAccountID, field.TypeInt, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Username(); ok {
+		_spec.SetField(loginevent.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := _c.mutation.Success(); ok {
+		_spec.SetField(loginevent.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(loginevent.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(loginevent.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(loginevent.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(loginevent.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(loginevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// LoginEventCreateBulk is the builder for creating many LoginEvent entities in bulk.
+type LoginEventCreateBulk struct {
+	config
+	err      error
+	builders []*LoginEventCreate
+}
+
+// Save creates the LoginEvent entities in the database.
+func (_c *LoginEventCreateBulk) Save(ctx context.Context) ([]*LoginEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LoginEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LoginEventCreateBulk) SaveX(ctx context.Context) []*LoginEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoginEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoginEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,533 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/loginevent"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginEventUpdate is the builder for updating LoginEvent entities.
+type LoginEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginEventMutation
+}
+
+// Where appends a list predicates to the LoginEventUpdate builder.
+func (_u *LoginEventUpdate) Where(ps ...predicate.LoginEvent) *LoginEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAccountKind sets the "account_kind" field.
+func (_u *LoginEventUpdate) SetAccountKind(v string) *LoginEventUpdate {
+	_u.mutation.SetAccountKind(v)
+	return _u
+}
+
+// SetNillableAccountKind sets the "account_kind" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableAccountKind(v *string) *LoginEventUpdate {
+	if v != nil {
+		_u.SetAccountKind(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *LoginEventUpdate) SetAccountID(v int) *LoginEventUpdate {
+	_u.mutation.ResetAccountID()
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableAccountID(v *int) *LoginEventUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// AddAccountID adds value to the "account_id" field.
+func (_u *LoginEventUpdate) AddAccountID(v int) *LoginEventUpdate {
+	_u.mutation.AddAccountID(v)
+	return _u
+}
+
+// SetUsername sets the "username" field.
+func (_u *LoginEventUpdate) SetUsername(v string) *LoginEventUpdate {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableUsername(v *string) *LoginEventUpdate {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *LoginEventUpdate) SetSuccess(v bool) *LoginEventUpdate {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableSuccess(v *bool) *LoginEventUpdate {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LoginEventUpdate) SetReason(v string) *LoginEventUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableReason(v *string) *LoginEventUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *LoginEventUpdate) SetIP(v string) *LoginEventUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableIP(v *string) *LoginEventUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *LoginEventUpdate) SetUserAgent(v string) *LoginEventUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableUserAgent(v *string) *LoginEventUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// SetRequestID sets the "request_id" field.
+func (_u *LoginEventUpdate) SetRequestID(v string) *LoginEventUpdate {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *LoginEventUpdate) SetNillableRequestID(v *string) *LoginEventUpdate {
+	if v != nil {
+		_u.SetRequestID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LoginEventMutation object of the builder.
+func (_u *LoginEventUpdate) Mutation() *LoginEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LoginEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoginEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LoginEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoginEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoginEventUpdate) check() error {
+	if v, ok := _u.mutation.AccountKind(); ok {
+		if err := loginevent.AccountKindValidator(v); err != nil {
+			return &ValidationError{Name: "account_kind", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.account_kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Username(); ok {
+		if err := loginevent.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.username": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := loginevent.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := loginevent.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.ip": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := loginevent.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.user_agent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LoginEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginevent.Table, loginevent.Columns, sqlgraph.NewFieldSpec(loginevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AccountKind(); ok {
+		_spec.SetField(loginevent.FieldAccountKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(loginevent.FieldAccountID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAccountID(); ok {
+		_spec.AddField(loginevent.FieldAccountID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(loginevent.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(loginevent.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(loginevent.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(loginevent.FieldIP, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(loginevent.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(loginevent.FieldRequestID, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LoginEventUpdateOne is the builder for updating a single LoginEvent entity.
+type LoginEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginEventMutation
+}
+
+// SetAccountKind sets the "account_kind" field.
+func (_u *LoginEventUpdateOne) SetAccountKind(v string) *LoginEventUpdateOne {
+	_u.mutation.SetAccountKind(v)
+	return _u
+}
+
+// SetNillableAccountKind sets the "account_kind" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableAccountKind(v *string) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetAccountKind(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *LoginEventUpdateOne) SetAccountID(v int) *LoginEventUpdateOne {
+	_u.mutation.ResetAccountID()
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableAccountID(v *int) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// AddAccountID adds value to the "account_id" field.
+func (_u *LoginEventUpdateOne) AddAccountID(v int) *LoginEventUpdateOne {
+	_u.mutation.AddAccountID(v)
+	return _u
+}
+
+// SetUsername sets the "username" field.
+func (_u *LoginEventUpdateOne) SetUsername(v string) *LoginEventUpdateOne {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableUsername(v *string) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *LoginEventUpdateOne) SetSuccess(v bool) *LoginEventUpdateOne {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableSuccess(v *bool) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LoginEventUpdateOne) SetReason(v string) *LoginEventUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableReason(v *string) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *LoginEventUpdateOne) SetIP(v string) *LoginEventUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableIP(v *string) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *LoginEventUpdateOne) SetUserAgent(v string) *LoginEventUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableUserAgent(v *string) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// SetRequestID sets the "request_id" field.
+func (_u *LoginEventUpdateOne) SetRequestID(v string) *LoginEventUpdateOne {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *LoginEventUpdateOne) SetNillableRequestID(v *string) *LoginEventUpdateOne {
+	if v != nil {
+		_u.SetRequestID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LoginEventMutation object of the builder.
+func (_u *LoginEventUpdateOne) Mutation() *LoginEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LoginEventUpdate builder.
+func (_u *LoginEventUpdateOne) Where(ps ...predicate.LoginEvent) *LoginEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LoginEventUpdateOne) Select(field string, fields ...string) *LoginEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LoginEvent entity.
+func (_u *LoginEventUpdateOne) Save(ctx context.Context) (*LoginEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoginEventUpdateOne) SaveX(ctx context.Context) *LoginEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LoginEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoginEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoginEventUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountKind(); ok {
+		if err := loginevent.AccountKindValidator(v); err != nil {
+			return &ValidationError{Name: "account_kind", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.account_kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Username(); ok {
+		if err := loginevent.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.username": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := loginevent.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := loginevent.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.ip": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := loginevent.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "LoginEvent.user_agent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LoginEventUpdateOne) sqlSave(ctx context.Context) (_node *LoginEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginevent.Table, loginevent.Columns, sqlgraph.NewFieldSpec(loginevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginevent.FieldID)
+		for _, f := range fields {
+			if !loginevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AccountKind(); ok {
+		_spec.SetField(loginevent.FieldAccountKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(loginevent.FieldAccountID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAccountID(); ok {
+		_spec.AddField(loginevent.FieldAccountID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(loginevent.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(loginevent.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(loginevent.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(loginevent.FieldIP, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(loginevent.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(loginevent.FieldRequestID, field.TypeString, value)
+	}
+	_node = &LoginEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,11 +3,37 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AccessPoliciesColumns holds the columns for the "access_policies" table.
+	AccessPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "url", Type: field.TypeString, Size: 64},
+		{Name: "method", Type: field.TypeString, Size: 64},
+		{Name: "expression", Type: field.TypeString, Size: 2147483647},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255, Default: ""},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "updated_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AccessPoliciesTable holds the schema information for the "access_policies" table.
+	AccessPoliciesTable = &schema.Table{
+		Name:       "access_policies",
+		Columns:    AccessPoliciesColumns,
+		PrimaryKey: []*schema.Column{AccessPoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accesspolicy_url_method",
+				Unique:  true,
+				Columns: []*schema.Column{AccessPoliciesColumns[1], AccessPoliciesColumns[2]},
+			},
+		},
+	}
 	// AdminPermissionsColumns holds the columns for the "admin_permissions" table.
 	AdminPermissionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -55,11 +81,37 @@ var (
 			},
 		},
 	}
+	// AdminRoleParentsColumns holds the columns for the "admin_role_parents" table.
+	AdminRoleParentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "admin_role_id", Type: field.TypeInt},
+		{Name: "parent_role_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AdminRoleParentsTable holds the schema information for the "admin_role_parents" table.
+	AdminRoleParentsTable = &schema.Table{
+		Name:       "admin_role_parents",
+		Columns:    AdminRoleParentsColumns,
+		PrimaryKey: []*schema.Column{AdminRoleParentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminroleparent_admin_role_id_parent_role_id",
+				Unique:  true,
+				Columns: []*schema.Column{AdminRoleParentsColumns[1], AdminRoleParentsColumns[2]},
+			},
+			{
+				Name:    "adminroleparent_parent_role_id",
+				Unique:  false,
+				Columns: []*schema.Column{AdminRoleParentsColumns[2]},
+			},
+		},
+	}
 	// AdminRolePermissionsColumns holds the columns for the "admin_role_permissions" table.
 	AdminRolePermissionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "admin_role_id", Type: field.TypeInt},
 		{Name: "admin_permission_id", Type: field.TypeInt},
+		{Name: "deny", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// AdminRolePermissionsTable holds the schema information for the "admin_role_permissions" table.
@@ -84,8 +136,10 @@ var (
 	AdminUsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "username", Type: field.TypeString, Size: 64},
+		{Name: "username_normalized", Type: field.TypeString, Size: 64},
 		{Name: "password_hash", Type: field.TypeString},
 		{Name: "disabled", Type: field.TypeBool, Default: false},
+		{Name: "attributes", Type: field.TypeJSON, Nullable: true},
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -101,6 +155,11 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{AdminUsersColumns[1]},
 			},
+			{
+				Name:    "adminuser_username_normalized",
+				Unique:  true,
+				Columns: []*schema.Column{AdminUsersColumns[2]},
+			},
 		},
 	}
 	// AdminUserRolesColumns holds the columns for the "admin_user_roles" table.
@@ -108,6 +167,8 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "admin_user_id", Type: field.TypeInt},
 		{Name: "admin_role_id", Type: field.TypeInt},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// AdminUserRolesTable holds the schema information for the "admin_user_roles" table.
@@ -126,15 +187,243 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{AdminUserRolesColumns[2]},
 			},
+			{
+				Name:    "adminuserrole_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{AdminUserRolesColumns[4]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action", Type: field.TypeString, Size: 64},
+		{Name: "actor_kind", Type: field.TypeString, Size: 16},
+		{Name: "actor_id", Type: field.TypeInt, Default: 0},
+		{Name: "actor_username", Type: field.TypeString, Default: ""},
+		{Name: "target_kind", Type: field.TypeString, Default: ""},
+		{Name: "target_id", Type: field.TypeInt, Default: 0},
+		{Name: "detail", Type: field.TypeJSON, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_actor_kind_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2], AuditLogsColumns[3]},
+			},
+			{
+				Name:    "auditlog_target_kind_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[5], AuditLogsColumns[6]},
+			},
+			{
+				Name:    "auditlog_action_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[9]},
+			},
+		},
+	}
+	// InviteCodesColumns holds the columns for the "invite_codes" table.
+	InviteCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString, Size: 32},
+		{Name: "max_uses", Type: field.TypeInt},
+		{Name: "used_count", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "role_key", Type: field.TypeString, Default: ""},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "organization_id", Type: field.TypeInt, Default: 0},
+		{Name: "created_by", Type: field.TypeInt, Default: 0},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// InviteCodesTable holds the schema information for the "invite_codes" table.
+	InviteCodesTable = &schema.Table{
+		Name:       "invite_codes",
+		Columns:    InviteCodesColumns,
+		PrimaryKey: []*schema.Column{InviteCodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invitecode_code",
+				Unique:  true,
+				Columns: []*schema.Column{InviteCodesColumns[1]},
+			},
+			{
+				Name:    "invitecode_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{InviteCodesColumns[7]},
+			},
+		},
+	}
+	// InviteRedemptionsColumns holds the columns for the "invite_redemptions" table.
+	InviteRedemptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "invite_code_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// InviteRedemptionsTable holds the schema information for the "invite_redemptions" table.
+	InviteRedemptionsTable = &schema.Table{
+		Name:       "invite_redemptions",
+		Columns:    InviteRedemptionsColumns,
+		PrimaryKey: []*schema.Column{InviteRedemptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inviteredemption_invite_code_id",
+				Unique:  false,
+				Columns: []*schema.Column{InviteRedemptionsColumns[1]},
+			},
+			{
+				Name:    "inviteredemption_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{InviteRedemptionsColumns[2]},
+			},
+		},
+	}
+	// LoginEventsColumns holds the columns for the "login_events" table.
+	LoginEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "account_kind", Type: field.TypeString, Size: 16},
+		{Name: "account_id", Type: field.TypeInt, Default: 0},
+		{Name: "username", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "success", Type: field.TypeBool},
+		{Name: "reason", Type: field.TypeString, Size: 32, Default: ""},
+		{Name: "ip", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Size: 512, Default: ""},
+		{Name: "request_id", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// LoginEventsTable holds the schema information for the "login_events" table.
+	LoginEventsTable = &schema.Table{
+		Name:       "login_events",
+		Columns:    LoginEventsColumns,
+		PrimaryKey: []*schema.Column{LoginEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginevent_account_kind_account_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginEventsColumns[1], LoginEventsColumns[2], LoginEventsColumns[9]},
+			},
+			{
+				Name:    "loginevent_ip_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginEventsColumns[6], LoginEventsColumns[9]},
+			},
+			{
+				Name:    "loginevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginEventsColumns[9]},
+			},
+		},
+	}
+	// OrganizationsColumns holds the columns for the "organizations" table.
+	OrganizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "slug", Type: field.TypeString, Size: 64},
+		{Name: "name", Type: field.TypeString, Size: 128},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// OrganizationsTable holds the schema information for the "organizations" table.
+	OrganizationsTable = &schema.Table{
+		Name:       "organizations",
+		Columns:    OrganizationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "organization_slug",
+				Unique:  true,
+				Columns: []*schema.Column{OrganizationsColumns[1]},
+			},
+		},
+	}
+	// OrganizationAdminRolesColumns holds the columns for the "organization_admin_roles" table.
+	OrganizationAdminRolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "organization_id", Type: field.TypeInt},
+		{Name: "admin_user_id", Type: field.TypeInt},
+		{Name: "admin_role_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OrganizationAdminRolesTable holds the schema information for the "organization_admin_roles" table.
+	OrganizationAdminRolesTable = &schema.Table{
+		Name:       "organization_admin_roles",
+		Columns:    OrganizationAdminRolesColumns,
+		PrimaryKey: []*schema.Column{OrganizationAdminRolesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "organizationadminrole_organization_id_admin_user_id_admin_role_id",
+				Unique:  true,
+				Columns: []*schema.Column{OrganizationAdminRolesColumns[1], OrganizationAdminRolesColumns[2], OrganizationAdminRolesColumns[3]},
+			},
+			{
+				Name:    "organizationadminrole_admin_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrganizationAdminRolesColumns[2]},
+			},
+			{
+				Name:    "organizationadminrole_admin_role_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrganizationAdminRolesColumns[3]},
+			},
+		},
+	}
+	// OrganizationMembersColumns holds the columns for the "organization_members" table.
+	OrganizationMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "organization_id", Type: field.TypeInt},
+		{Name: "member_kind", Type: field.TypeString, Size: 16},
+		{Name: "member_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OrganizationMembersTable holds the schema information for the "organization_members" table.
+	OrganizationMembersTable = &schema.Table{
+		Name:       "organization_members",
+		Columns:    OrganizationMembersColumns,
+		PrimaryKey: []*schema.Column{OrganizationMembersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "organizationmember_organization_id_member_kind_member_id",
+				Unique:  true,
+				Columns: []*schema.Column{OrganizationMembersColumns[1], OrganizationMembersColumns[2], OrganizationMembersColumns[3]},
+			},
+			{
+				Name:    "organizationmember_member_kind_member_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrganizationMembersColumns[2], OrganizationMembersColumns[3]},
+			},
 		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "username", Type: field.TypeString, Size: 32},
+		{Name: "username_normalized", Type: field.TypeString, Size: 32},
 		{Name: "password_hash", Type: field.TypeString},
 		{Name: "disabled", Type: field.TypeBool, Default: false},
+		{Name: "must_change_password", Type: field.TypeBool, Default: false},
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 254},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "phone", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "phone_verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "display_name", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "avatar_url", Type: field.TypeString, Size: 512, Default: ""},
+		{Name: "locale", Type: field.TypeString, Size: 35, Default: ""},
+		{Name: "timezone", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "attributes", Type: field.TypeJSON, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_username", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "purged_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_scheduled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -149,16 +438,204 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{UsersColumns[1]},
 			},
+			{
+				Name:    "user_username_normalized",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[2]},
+			},
+			{
+				Name:    "user_email",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[7]},
+			},
+			{
+				Name:    "user_phone",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[9]},
+			},
+			{
+				Name:    "user_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[16]},
+			},
+			{
+				Name:    "user_deletion_scheduled_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[19]},
+			},
+			{
+				Name:    "user_username_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "user_email_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "user_display_name_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[11]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+		},
+	}
+	// UserImportJobsColumns holds the columns for the "user_import_jobs" table.
+	UserImportJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeString, Size: 16},
+		{Name: "dry_run", Type: field.TypeBool, Default: false},
+		{Name: "organization_id", Type: field.TypeInt, Default: 0},
+		{Name: "created_by", Type: field.TypeInt, Default: 0},
+		{Name: "total_rows", Type: field.TypeInt, Default: 0},
+		{Name: "processed_rows", Type: field.TypeInt, Default: 0},
+		{Name: "created_count", Type: field.TypeInt, Default: 0},
+		{Name: "failed_count", Type: field.TypeInt, Default: 0},
+		{Name: "row_errors", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Default: ""},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// UserImportJobsTable holds the schema information for the "user_import_jobs" table.
+	UserImportJobsTable = &schema.Table{
+		Name:       "user_import_jobs",
+		Columns:    UserImportJobsColumns,
+		PrimaryKey: []*schema.Column{UserImportJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userimportjob_organization_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserImportJobsColumns[3], UserImportJobsColumns[12]},
+			},
+		},
+	}
+	// UserRolesColumns holds the columns for the "user_roles" table.
+	UserRolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Size: 64},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// UserRolesTable holds the schema information for the "user_roles" table.
+	UserRolesTable = &schema.Table{
+		Name:       "user_roles",
+		Columns:    UserRolesColumns,
+		PrimaryKey: []*schema.Column{UserRolesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userrole_key",
+				Unique:  true,
+				Columns: []*schema.Column{UserRolesColumns[1]},
+			},
+		},
+	}
+	// UserRoleBindingsColumns holds the columns for the "user_role_bindings" table.
+	UserRoleBindingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "user_role_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UserRoleBindingsTable holds the schema information for the "user_role_bindings" table.
+	UserRoleBindingsTable = &schema.Table{
+		Name:       "user_role_bindings",
+		Columns:    UserRoleBindingsColumns,
+		PrimaryKey: []*schema.Column{UserRoleBindingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userrolebinding_user_id_user_role_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserRoleBindingsColumns[1], UserRoleBindingsColumns[2]},
+			},
+			{
+				Name:    "userrolebinding_user_role_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserRoleBindingsColumns[2]},
+			},
+		},
+	}
+	// UserRolePermissionsColumns holds the columns for the "user_role_permissions" table.
+	UserRolePermissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_role_id", Type: field.TypeInt},
+		{Name: "permission_key", Type: field.TypeString, Size: 128},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UserRolePermissionsTable holds the schema information for the "user_role_permissions" table.
+	UserRolePermissionsTable = &schema.Table{
+		Name:       "user_role_permissions",
+		Columns:    UserRolePermissionsColumns,
+		PrimaryKey: []*schema.Column{UserRolePermissionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userrolepermission_user_role_id_permission_key",
+				Unique:  true,
+				Columns: []*schema.Column{UserRolePermissionsColumns[1], UserRolePermissionsColumns[2]},
+			},
+		},
+	}
+	// VerificationCodesColumns holds the columns for the "verification_codes" table.
+	VerificationCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "channel", Type: field.TypeString, Size: 16},
+		{Name: "target", Type: field.TypeString},
+		{Name: "code_hash", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "consumed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// VerificationCodesTable holds the schema information for the "verification_codes" table.
+	VerificationCodesTable = &schema.Table{
+		Name:       "verification_codes",
+		Columns:    VerificationCodesColumns,
+		PrimaryKey: []*schema.Column{VerificationCodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "verificationcode_user_id_channel_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{VerificationCodesColumns[1], VerificationCodesColumns[2], VerificationCodesColumns[8]},
+			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessPoliciesTable,
 		AdminPermissionsTable,
 		AdminRolesTable,
+		AdminRoleParentsTable,
 		AdminRolePermissionsTable,
 		AdminUsersTable,
 		AdminUserRolesTable,
+		AuditLogsTable,
+		InviteCodesTable,
+		InviteRedemptionsTable,
+		LoginEventsTable,
+		OrganizationsTable,
+		OrganizationAdminRolesTable,
+		OrganizationMembersTable,
 		UsersTable,
+		UserImportJobsTable,
+		UserRolesTable,
+		UserRoleBindingsTable,
+		UserRolePermissionsTable,
+		VerificationCodesTable,
 	}
 )
 
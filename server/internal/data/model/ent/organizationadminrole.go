@@ -0,0 +1,137 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"server/internal/data/model/ent/organizationadminrole"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// OrganizationAdminRole is the model entity for the OrganizationAdminRole schema.
+type OrganizationAdminRole struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID int `json:"organization_id,omitempty"`
+	// AdminUserID holds the value of the "admin_user_id" field.
+	AdminUserID int `json:"admin_user_id,omitempty"`
+	// AdminRoleID holds the value of the "admin_role_id" field.
+	AdminRoleID int `json:"admin_role_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrganizationAdminRole) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case organizationadminrole.FieldID, organizationadminrole.FieldOrganizationID, organizationadminrole.FieldAdminUserID, organizationadminrole.FieldAdminRoleID:
+			values[i] = new(sql.NullInt64)
+		case organizationadminrole.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrganizationAdminRole fields.
+func (_m *OrganizationAdminRole) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case organizationadminrole.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case organizationadminrole.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				_m.OrganizationID = int(value.Int64)
+			}
+		case organizationadminrole.FieldAdminUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_user_id", values[i])
+			} else if value.Valid {
+				_m.AdminUserID = int(value.Int64)
+			}
+		case organizationadminrole.FieldAdminRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_role_id", values[i])
+			} else if value.Valid {
+				_m.AdminRoleID = int(value.Int64)
+			}
+		case organizationadminrole.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrganizationAdminRole.
+// This includes values selected through modifiers, order, etc.
+func (_m *OrganizationAdminRole) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OrganizationAdminRole.
+// Note that you need to call OrganizationAdminRole.Unwrap() before calling this method if this OrganizationAdminRole
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OrganizationAdminRole) Update() *OrganizationAdminRoleUpdateOne {
+	return NewOrganizationAdminRoleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OrganizationAdminRole entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OrganizationAdminRole) Unwrap() *OrganizationAdminRole {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OrganizationAdminRole is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OrganizationAdminRole) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrganizationAdminRole(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("admin_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AdminUserID))
+	builder.WriteString(", ")
+	builder.WriteString("admin_role_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AdminRoleID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrganizationAdminRoles is a parsable slice of OrganizationAdminRole.
+type OrganizationAdminRoles []*OrganizationAdminRole
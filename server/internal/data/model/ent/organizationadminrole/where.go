@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package organizationadminrole
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// AdminUserID applies equality check predicate on the "admin_user_id" field. It's identical to AdminUserIDEQ.
+func AdminUserID(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldEQ(FieldAdminUserID, v))
+}
+
+// AdminRoleID applies equality check predicate on the "admin_role_id" field. It's identical to AdminRoleIDEQ.
+func AdminRoleID(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldEQ(FieldAdminRoleID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// AdminUserIDEQ applies the EQ predicate on the "admin_user_id" field.
+func AdminUserIDEQ(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldEQ(FieldAdminUserID, v))
+}
+
+// AdminUserIDNEQ applies the NEQ predicate on the "admin_user_id" field.
+func AdminUserIDNEQ(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldNEQ(FieldAdminUserID, v))
+}
+
+// AdminUserIDIn applies the In predicate on the "admin_user_id" field.
+func AdminUserIDIn(vs ...int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldIn(FieldAdminUserID, vs...))
+}
+
+// AdminUserIDNotIn applies the NotIn predicate on the "admin_user_id" field.
+func AdminUserIDNotIn(vs ...int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldNotIn(FieldAdminUserID, vs...))
+}
+
+// AdminUserIDGT applies the GT predicate on the "admin_user_id" field.
+func AdminUserIDGT(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldGT(FieldAdminUserID, v))
+}
+
+// AdminUserIDGTE applies the GTE predicate on the "admin_user_id" field.
+func AdminUserIDGTE(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldGTE(FieldAdminUserID, v))
+}
+
+// AdminUserIDLT applies the LT predicate on the "admin_user_id" field.
+func AdminUserIDLT(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldLT(FieldAdminUserID, v))
+}
+
+// AdminUserIDLTE applies the LTE predicate on the "admin_user_id" field.
+func AdminUserIDLTE(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldLTE(FieldAdminUserID, v))
+}
+
+// AdminRoleIDEQ applies the EQ predicate on the "admin_role_id" field.
+func AdminRoleIDEQ(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldEQ(FieldAdminRoleID, v))
+}
+
+// AdminRoleIDNEQ applies the NEQ predicate on the "admin_role_id" field.
+func AdminRoleIDNEQ(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldNEQ(FieldAdminRoleID, v))
+}
+
+// AdminRoleIDIn applies the In predicate on the "admin_role_id" field.
+func AdminRoleIDIn(vs ...int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldIn(FieldAdminRoleID, vs...))
+}
+
+// AdminRoleIDNotIn applies the NotIn predicate on the "admin_role_id" field.
+func AdminRoleIDNotIn(vs ...int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldNotIn(FieldAdminRoleID, vs...))
+}
+
+// AdminRoleIDGT applies the GT predicate on the "admin_role_id" field.
+func AdminRoleIDGT(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldGT(FieldAdminRoleID, v))
+}
+
+// AdminRoleIDGTE applies the GTE predicate on the "admin_role_id" field.
+func AdminRoleIDGTE(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldGTE(FieldAdminRoleID, v))
+}
+
+// AdminRoleIDLT applies the LT predicate on the "admin_role_id" field.
+func AdminRoleIDLT(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldLT(FieldAdminRoleID, v))
+}
+
+// AdminRoleIDLTE applies the LTE predicate on the "admin_role_id" field.
+func AdminRoleIDLTE(v int) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldLTE(FieldAdminRoleID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OrganizationAdminRole) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OrganizationAdminRole) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OrganizationAdminRole) predicate.OrganizationAdminRole {
+	return predicate.OrganizationAdminRole(sql.NotPredicates(p))
+}
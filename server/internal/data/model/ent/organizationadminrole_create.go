@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/organizationadminrole"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OrganizationAdminRoleCreate is the builder for creating a OrganizationAdminRole entity.
+type OrganizationAdminRoleCreate struct {
+	config
+	mutation *OrganizationAdminRoleMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *OrganizationAdminRoleCreate) SetOrganizationID(v int) *OrganizationAdminRoleCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetAdminUserID sets the "admin_user_id" field.
+func (_c *OrganizationAdminRoleCreate) SetAdminUserID(v int) *OrganizationAdminRoleCreate {
+	_c.mutation.SetAdminUserID(v)
+	return _c
+}
+
+// SetAdminRoleID sets the "admin_role_id" field.
+func (_c *OrganizationAdminRoleCreate) SetAdminRoleID(v int) *OrganizationAdminRoleCreate {
+	_c.mutation.SetAdminRoleID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OrganizationAdminRoleCreate) SetCreatedAt(v time.Time) *OrganizationAdminRoleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OrganizationAdminRoleCreate) SetNillableCreatedAt(v *time.Time) *OrganizationAdminRoleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the OrganizationAdminRoleMutation object of the builder.
+func (_c *OrganizationAdminRoleCreate) Mutation() *OrganizationAdminRoleMutation {
+	return _c.mutation
+}
+
+// Save creates the OrganizationAdminRole in the database.
+func (_c *OrganizationAdminRoleCreate) Save(ctx context.Context) (*OrganizationAdminRole, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OrganizationAdminRoleCreate) SaveX(ctx context.Context) *OrganizationAdminRole {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrganizationAdminRoleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrganizationAdminRoleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OrganizationAdminRoleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := organizationadminrole.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OrganizationAdminRoleCreate) check() error {
+	if _, ok := _c.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "OrganizationAdminRole.organization_id"`)}
+	}
+	if _, ok := _c.mutation.AdminUserID(); !ok {
+		return &ValidationError{Name: "admin_user_id", err: errors.New(`ent: missing required field "OrganizationAdminRole.admin_user_id"`)}
+	}
+	if _, ok := _c.mutation.AdminRoleID(); !ok {
+		return &ValidationError{Name: "admin_role_id", err: errors.New(`ent: missing required field "OrganizationAdminRole.admin_role_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OrganizationAdminRole.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *OrganizationAdminRoleCreate) sqlSave(ctx context.Context) (*OrganizationAdminRole, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OrganizationAdminRoleCreate) createSpec() (*OrganizationAdminRole, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrganizationAdminRole{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(organizationadminrole.Table, sqlgraph.NewFieldSpec(organizationadminrole.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.OrganizationID(); ok {
+		_spec.SetField(organizationadminrole.FieldOrganizationID, field.TypeInt, value)
+		_node.OrganizationID = value
+	}
+	if value, ok := _c.mutation.AdminUserID(); ok {
+		_spec.SetField(organizationadminrole.FieldAdminUserID, field.TypeInt, value)
+		_node.AdminUserID = value
+	}
+	if value, ok := _c.mutation.AdminRoleID(); ok {
+		_spec.SetField(organizationadminrole.FieldAdminRoleID, field.TypeInt, value)
+		_node.AdminRoleID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(organizationadminrole.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OrganizationAdminRoleCreateBulk is the builder for creating many OrganizationAdminRole entities in bulk.
+type OrganizationAdminRoleCreateBulk struct {
+	config
+	err      error
+	builders []*OrganizationAdminRoleCreate
+}
+
+// Save creates the OrganizationAdminRole entities in the database.
+func (_c *OrganizationAdminRoleCreateBulk) Save(ctx context.Context) ([]*OrganizationAdminRole, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OrganizationAdminRole, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrganizationAdminRoleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OrganizationAdminRoleCreateBulk) SaveX(ctx context.Context) []*OrganizationAdminRole {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrganizationAdminRoleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrganizationAdminRoleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/organizationadminrole"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OrganizationAdminRoleUpdate is the builder for updating OrganizationAdminRole entities.
+type OrganizationAdminRoleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrganizationAdminRoleMutation
+}
+
+// Where appends a list predicates to the OrganizationAdminRoleUpdate builder.
+func (_u *OrganizationAdminRoleUpdate) Where(ps ...predicate.OrganizationAdminRole) *OrganizationAdminRoleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *OrganizationAdminRoleUpdate) SetOrganizationID(v int) *OrganizationAdminRoleUpdate {
+	_u.mutation.ResetOrganizationID()
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *OrganizationAdminRoleUpdate) SetNillableOrganizationID(v *int) *OrganizationAdminRoleUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// AddOrganizationID adds value to the "organization_id" field.
+func (_u *OrganizationAdminRoleUpdate) AddOrganizationID(v int) *OrganizationAdminRoleUpdate {
+	_u.mutation.AddOrganizationID(v)
+	return _u
+}
+
+// SetAdminUserID sets the "admin_user_id" field.
+func (_u *OrganizationAdminRoleUpdate) SetAdminUserID(v int) *OrganizationAdminRoleUpdate {
+	_u.mutation.ResetAdminUserID()
+	_u.mutation.SetAdminUserID(v)
+	return _u
+}
+
+// SetNillableAdminUserID sets the "admin_user_id" field if the given value is not nil.
+func (_u *OrganizationAdminRoleUpdate) SetNillableAdminUserID(v *int) *OrganizationAdminRoleUpdate {
+	if v != nil {
+		_u.SetAdminUserID(*v)
+	}
+	return _u
+}
+
+// AddAdminUserID adds value to the "admin_user_id" field.
+func (_u *OrganizationAdminRoleUpdate) AddAdminUserID(v int) *OrganizationAdminRoleUpdate {
+	_u.mutation.AddAdminUserID(v)
+	return _u
+}
+
+// SetAdminRoleID sets the "admin_role_id" field.
+func (_u *OrganizationAdminRoleUpdate) SetAdminRoleID(v int) *OrganizationAdminRoleUpdate {
+	_u.mutation.ResetAdminRoleID()
+	_u.mutation.SetAdminRoleID(v)
+	return _u
+}
+
+// SetNillableAdminRoleID sets the "admin_role_id" field if the given value is not nil.
+func (_u *OrganizationAdminRoleUpdate) SetNillableAdminRoleID(v *int) *OrganizationAdminRoleUpdate {
+	if v != nil {
+		_u.SetAdminRoleID(*v)
+	}
+	return _u
+}
+
+// AddAdminRoleID adds value to the "admin_role_id" field.
+func (_u *OrganizationAdminRoleUpdate) AddAdminRoleID(v int) *OrganizationAdminRoleUpdate {
+	_u.mutation.AddAdminRoleID(v)
+	return _u
+}
+
+// Mutation returns the OrganizationAdminRoleMutation object of the builder.
+func (_u *OrganizationAdminRoleUpdate) Mutation() *OrganizationAdminRoleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OrganizationAdminRoleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrganizationAdminRoleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OrganizationAdminRoleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrganizationAdminRoleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *OrganizationAdminRoleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(organizationadminrole.Table, organizationadminrole.Columns, sqlgraph.NewFieldSpec(organizationadminrole.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OrganizationID(); ok {
+		_spec.SetField(organizationadminrole.FieldOrganizationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrganizationID(); ok {
+		_spec.AddField(organizationadminrole.FieldOrganizationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AdminUserID(); ok {
+		_spec.SetField(organizationadminrole.FieldAdminUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdminUserID(); ok {
+		_spec.AddField(organizationadminrole.FieldAdminUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AdminRoleID(); ok {
+		_spec.SetField(organizationadminrole.FieldAdminRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdminRoleID(); ok {
+		_spec.AddField(organizationadminrole.FieldAdminRoleID, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organizationadminrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OrganizationAdminRoleUpdateOne is the builder for updating a single OrganizationAdminRole entity.
+type OrganizationAdminRoleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrganizationAdminRoleMutation
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *OrganizationAdminRoleUpdateOne) SetOrganizationID(v int) *OrganizationAdminRoleUpdateOne {
+	_u.mutation.ResetOrganizationID()
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *OrganizationAdminRoleUpdateOne) SetNillableOrganizationID(v *int) *OrganizationAdminRoleUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// AddOrganizationID adds value to the "organization_id" field.
+func (_u *OrganizationAdminRoleUpdateOne) AddOrganizationID(v int) *OrganizationAdminRoleUpdateOne {
+	_u.mutation.AddOrganizationID(v)
+	return _u
+}
+
+// SetAdminUserID sets the "admin_user_id" field.
+func (_u *OrganizationAdminRoleUpdateOne) SetAdminUserID(v int) *OrganizationAdminRoleUpdateOne {
+	_u.mutation.ResetAdminUserID()
+	_u.mutation.SetAdminUserID(v)
+	return _u
+}
+
+// SetNillableAdminUserID sets the "admin_user_id" field if the given value is not nil.
+func (_u *OrganizationAdminRoleUpdateOne) SetNillableAdminUserID(v *int) *OrganizationAdminRoleUpdateOne {
+	if v != nil {
+		_u.SetAdminUserID(*v)
+	}
+	return _u
+}
+
+// AddAdminUserID adds value to the "admin_user_id" field.
+func (_u *OrganizationAdminRoleUpdateOne) AddAdminUserID(v int) *OrganizationAdminRoleUpdateOne {
+	_u.mutation.AddAdminUserID(v)
+	return _u
+}
+
+// SetAdminRoleID sets the "admin_role_id" field.
+func (_u *OrganizationAdminRoleUpdateOne) SetAdminRoleID(v int) *OrganizationAdminRoleUpdateOne {
+	_u.mutation.ResetAdminRoleID()
+	_u.mutation.SetAdminRoleID(v)
+	return _u
+}
+
+// SetNillableAdminRoleID sets the "admin_role_id" field if the given value is not nil.
+func (_u *OrganizationAdminRoleUpdateOne) SetNillableAdminRoleID(v *int) *OrganizationAdminRoleUpdateOne {
+	if v != nil {
+		_u.SetAdminRoleID(*v)
+	}
+	return _u
+}
+
+// AddAdminRoleID adds value to the "admin_role_id" field.
+func (_u *OrganizationAdminRoleUpdateOne) AddAdminRoleID(v int) *OrganizationAdminRoleUpdateOne {
+	_u.mutation.AddAdminRoleID(v)
+	return _u
+}
+
+// Mutation returns the OrganizationAdminRoleMutation object of the builder.
+func (_u *OrganizationAdminRoleUpdateOne) Mutation() *OrganizationAdminRoleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OrganizationAdminRoleUpdate builder.
+func (_u *OrganizationAdminRoleUpdateOne) Where(ps ...predicate.OrganizationAdminRole) *OrganizationAdminRoleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OrganizationAdminRoleUpdateOne) Select(field string, fields ...string) *OrganizationAdminRoleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OrganizationAdminRole entity.
+func (_u *OrganizationAdminRoleUpdateOne) Save(ctx context.Context) (*OrganizationAdminRole, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrganizationAdminRoleUpdateOne) SaveX(ctx context.Context) *OrganizationAdminRole {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OrganizationAdminRoleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrganizationAdminRoleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *OrganizationAdminRoleUpdateOne) sqlSave(ctx context.Context) (_node *OrganizationAdminRole, err error) {
+	_spec := sqlgraph.NewUpdateSpec(organizationadminrole.Table, organizationadminrole.Columns, sqlgraph.NewFieldSpec(organizationadminrole.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrganizationAdminRole.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, organizationadminrole.FieldID)
+		for _, f := range fields {
+			if !organizationadminrole.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != organizationadminrole.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OrganizationID(); ok {
+		_spec.SetField(organizationadminrole.FieldOrganizationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrganizationID(); ok {
+		_spec.AddField(organizationadminrole.FieldOrganizationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AdminUserID(); ok {
+		_spec.SetField(organizationadminrole.FieldAdminUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdminUserID(); ok {
+		_spec.AddField(organizationadminrole.FieldAdminUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AdminRoleID(); ok {
+		_spec.SetField(organizationadminrole.FieldAdminRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdminRoleID(); ok {
+		_spec.AddField(organizationadminrole.FieldAdminRoleID, field.TypeInt, value)
+	}
+	_node = &OrganizationAdminRole{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organizationadminrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
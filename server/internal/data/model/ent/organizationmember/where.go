@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package organizationmember
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// MemberKind applies equality check predicate on the "member_kind" field. It's identical to MemberKindEQ.
+func MemberKind(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldMemberKind, v))
+}
+
+// MemberID applies equality check predicate on the "member_id" field. It's identical to MemberIDEQ.
+func MemberID(v int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldMemberID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// MemberKindEQ applies the EQ predicate on the "member_kind" field.
+func MemberKindEQ(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldMemberKind, v))
+}
+
+// MemberKindNEQ applies the NEQ predicate on the "member_kind" field.
+func MemberKindNEQ(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldNEQ(FieldMemberKind, v))
+}
+
+// MemberKindIn applies the In predicate on the "member_kind" field.
+func MemberKindIn(vs ...string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldIn(FieldMemberKind, vs...))
+}
+
+// MemberKindNotIn applies the NotIn predicate on the "member_kind" field.
+func MemberKindNotIn(vs ...string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldNotIn(FieldMemberKind, vs...))
+}
+
+// MemberKindGT applies the GT predicate on the "member_kind" field.
+func MemberKindGT(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldGT(FieldMemberKind, v))
+}
+
+// MemberKindGTE applies the GTE predicate on the "member_kind" field.
+func MemberKindGTE(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldGTE(FieldMemberKind, v))
+}
+
+// MemberKindLT applies the LT predicate on the "member_kind" field.
+func MemberKindLT(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldLT(FieldMemberKind, v))
+}
+
+// MemberKindLTE applies the LTE predicate on the "member_kind" field.
+func MemberKindLTE(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldLTE(FieldMemberKind, v))
+}
+
+// MemberKindContains applies the Contains predicate on the "member_kind" field.
+func MemberKindContains(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldContains(FieldMemberKind, v))
+}
+
+// MemberKindHasPrefix applies the HasPrefix predicate on the "member_kind" field.
+func MemberKindHasPrefix(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldHasPrefix(FieldMemberKind, v))
+}
+
+// MemberKindHasSuffix applies the HasSuffix predicate on the "member_kind" field.
+func MemberKindHasSuffix(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldHasSuffix(FieldMemberKind, v))
+}
+
+// MemberKindEqualFold applies the EqualFold predicate on the "member_kind" field.
+func MemberKindEqualFold(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEqualFold(FieldMemberKind, v))
+}
+
+// MemberKindContainsFold applies the ContainsFold predicate on the "member_kind" field.
+func MemberKindContainsFold(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldContainsFold(FieldMemberKind, v))
+}
+
+// MemberIDEQ applies the EQ predicate on the "member_id" field.
+func MemberIDEQ(v int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldMemberID, v))
+}
+
+// MemberIDNEQ applies the NEQ predicate on the "member_id" field.
+func MemberIDNEQ(v int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldNEQ(FieldMemberID, v))
+}
+
+// MemberIDIn applies the In predicate on the "member_id" field.
+func MemberIDIn(vs ...int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldIn(FieldMemberID, vs...))
+}
+
+// MemberIDNotIn applies the NotIn predicate on the "member_id" field.
+func MemberIDNotIn(vs ...int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldNotIn(FieldMemberID, vs...))
+}
+
+// MemberIDGT applies the GT predicate on the "member_id" field.
+func MemberIDGT(v int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldGT(FieldMemberID, v))
+}
+
+// MemberIDGTE applies the GTE predicate on the "member_id" field.
+func MemberIDGTE(v int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldGTE(FieldMemberID, v))
+}
+
+// MemberIDLT applies the LT predicate on the "member_id" field.
+func MemberIDLT(v int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldLT(FieldMemberID, v))
+}
+
+// MemberIDLTE applies the LTE predicate on the "member_id" field.
+func MemberIDLTE(v int) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldLTE(FieldMemberID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OrganizationMember) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OrganizationMember) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OrganizationMember) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.NotPredicates(p))
+}
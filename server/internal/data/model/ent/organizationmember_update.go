@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/organizationmember"
+	"server/internal/data/model/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OrganizationMemberUpdate is the builder for updating OrganizationMember entities.
+type OrganizationMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrganizationMemberMutation
+}
+
+// Where appends a list predicates to the OrganizationMemberUpdate builder.
+func (_u *OrganizationMemberUpdate) Where(ps ...predicate.OrganizationMember) *OrganizationMemberUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *OrganizationMemberUpdate) SetOrganizationID(v int) *OrganizationMemberUpdate {
+	_u.mutation.ResetOrganizationID()
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *OrganizationMemberUpdate) SetNillableOrganizationID(v *int) *OrganizationMemberUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// AddOrganizationID adds value to the "organization_id" field.
+func (_u *OrganizationMemberUpdate) AddOrganizationID(v int) *OrganizationMemberUpdate {
+	_u.mutation.AddOrganizationID(v)
+	return _u
+}
+
+// SetMemberKind sets the "member_kind" field.
+func (_u *OrganizationMemberUpdate) SetMemberKind(v string) *OrganizationMemberUpdate {
+	_u.mutation.SetMemberKind(v)
+	return _u
+}
+
+// SetNillableMemberKind sets the "member_kind" field if the given value is not nil.
+func (_u *OrganizationMemberUpdate) SetNillableMemberKind(v *string) *OrganizationMemberUpdate {
+	if v != nil {
+		_u.SetMemberKind(*v)
+	}
+	return _u
+}
+
+// SetMemberID sets the "member_id" field.
+func (_u *OrganizationMemberUpdate) SetMemberID(v int) *OrganizationMemberUpdate {
+	_u.mutation.ResetMemberID()
+	_u.mutation.SetMemberID(v)
+	return _u
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (_u *OrganizationMemberUpdate) SetNillableMemberID(v *int) *OrganizationMemberUpdate {
+	if v != nil {
+		_u.SetMemberID(*v)
+	}
+	return _u
+}
+
+// AddMemberID adds value to the "member_id" field.
+func (_u *OrganizationMemberUpdate) AddMemberID(v int) *OrganizationMemberUpdate {
+	_u.mutation.AddMemberID(v)
+	return _u
+}
+
+// Mutation returns the OrganizationMemberMutation object of the builder.
+func (_u *OrganizationMemberUpdate) Mutation() *OrganizationMemberMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OrganizationMemberUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrganizationMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OrganizationMemberUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrganizationMemberUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrganizationMemberUpdate) check() error {
+	if v, ok := _u.mutation.MemberKind(); ok {
+		if err := organizationmember.MemberKindValidator(v); err != nil {
+			return &ValidationError{Name: "member_kind", err: fmt.Errorf(`ent: validator failed for field "OrganizationMember.member_kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OrganizationMemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(organizationmember.Table, organizationmember.Columns, sqlgraph.NewFieldSpec(organizationmember.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OrganizationID(); ok {
+		_spec.SetField(organizationmember.FieldOrganizationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrganizationID(); ok {
+		_spec.AddField(organizationmember.FieldOrganizationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MemberKind(); ok {
+		_spec.SetField(organizationmember.FieldMemberKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MemberID(); ok {
+		_spec.SetField(organizationmember.FieldMemberID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMemberID(); ok {
+		_spec.AddField(organizationmember.FieldMemberID, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organizationmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OrganizationMemberUpdateOne is the builder for updating a single OrganizationMember entity.
+type OrganizationMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrganizationMemberMutation
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *OrganizationMemberUpdateOne) SetOrganizationID(v int) *OrganizationMemberUpdateOne {
+	_u.mutation.ResetOrganizationID()
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *OrganizationMemberUpdateOne) SetNillableOrganizationID(v *int) *OrganizationMemberUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// AddOrganizationID adds value to the "organization_id" field.
+func (_u *OrganizationMemberUpdateOne) AddOrganizationID(v int) *OrganizationMemberUpdateOne {
+	_u.mutation.AddOrganizationID(v)
+	return _u
+}
+
+// SetMemberKind sets the "member_kind" field.
+func (_u *OrganizationMemberUpdateOne) SetMemberKind(v string) *OrganizationMemberUpdateOne {
+	_u.mutation.SetMemberKind(v)
+	return _u
+}
+
+// SetNillableMemberKind sets the "member_kind" field if the given value is not nil.
+func (_u *OrganizationMemberUpdateOne) SetNillableMemberKind(v *string) *OrganizationMemberUpdateOne {
+	if v != nil {
+		_u.SetMemberKind(*v)
+	}
+	return _u
+}
+
+// SetMemberID sets the "member_id" field.
+func (_u *OrganizationMemberUpdateOne) SetMemberID(v int) *OrganizationMemberUpdateOne {
+	_u.mutation.ResetMemberID()
+	_u.mutation.SetMemberID(v)
+	return _u
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (_u *OrganizationMemberUpdateOne) SetNillableMemberID(v *int) *OrganizationMemberUpdateOne {
+	if v != nil {
+		_u.SetMemberID(*v)
+	}
+	return _u
+}
+
+// AddMemberID adds value to the "member_id" field.
+func (_u *OrganizationMemberUpdateOne) AddMemberID(v int) *OrganizationMemberUpdateOne {
+	_u.mutation.AddMemberID(v)
+	return _u
+}
+
+// Mutation returns the OrganizationMemberMutation object of the builder.
+func (_u *OrganizationMemberUpdateOne) Mutation() *OrganizationMemberMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OrganizationMemberUpdate builder.
+func (_u *OrganizationMemberUpdateOne) Where(ps ...predicate.OrganizationMember) *OrganizationMemberUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OrganizationMemberUpdateOne) Select(field string, fields ...string) *OrganizationMemberUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OrganizationMember entity.
+func (_u *OrganizationMemberUpdateOne) Save(ctx context.Context) (*OrganizationMember, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrganizationMemberUpdateOne) SaveX(ctx context.Context) *OrganizationMember {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OrganizationMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrganizationMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrganizationMemberUpdateOne) check() error {
+	if v, ok := _u.mutation.MemberKind(); ok {
+		if err := organizationmember.MemberKindValidator(v); err != nil {
+			return &ValidationError{Name: "member_kind", err: fmt.Errorf(`ent: validator failed for field "OrganizationMember.member_kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OrganizationMemberUpdateOne) sqlSave(ctx context.Context) (_node *OrganizationMember, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(organizationmember.Table, organizationmember.Columns, sqlgraph.NewFieldSpec(organizationmember.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrganizationMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, organizationmember.FieldID)
+		for _, f := range fields {
+			if !organizationmember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != organizationmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OrganizationID(); ok {
+		_spec.SetField(organizationmember.FieldOrganizationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrganizationID(); ok {
+		_spec.AddField(organizationmember.FieldOrganizationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MemberKind(); ok {
+		_spec.SetField(organizationmember.FieldMemberKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MemberID(); ok {
+		_spec.SetField(organizationmember.FieldMemberID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMemberID(); ok {
+		_spec.AddField(organizationmember.FieldMemberID, field.TypeInt, value)
+	}
+	_node = &OrganizationMember{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organizationmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,12 +6,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccessPolicy is the predicate function for accesspolicy builders.
+type AccessPolicy func(*sql.Selector)
+
 // AdminPermission is the predicate function for adminpermission builders.
 type AdminPermission func(*sql.Selector)
 
 // AdminRole is the predicate function for adminrole builders.
 type AdminRole func(*sql.Selector)
 
+// AdminRoleParent is the predicate function for adminroleparent builders.
+type AdminRoleParent func(*sql.Selector)
+
 // AdminRolePermission is the predicate function for adminrolepermission builders.
 type AdminRolePermission func(*sql.Selector)
 
@@ -21,5 +27,41 @@ type AdminUser func(*sql.Selector)
 // AdminUserRole is the predicate function for adminuserrole builders.
 type AdminUserRole func(*sql.Selector)
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// InviteCode is the predicate function for invitecode builders.
+type InviteCode func(*sql.Selector)
+
+// InviteRedemption is the predicate function for inviteredemption builders.
+type InviteRedemption func(*sql.Selector)
+
+// LoginEvent is the predicate function for loginevent builders.
+type LoginEvent func(*sql.Selector)
+
+// Organization is the predicate function for organization builders.
+type Organization func(*sql.Selector)
+
+// OrganizationAdminRole is the predicate function for organizationadminrole builders.
+type OrganizationAdminRole func(*sql.Selector)
+
+// OrganizationMember is the predicate function for organizationmember builders.
+type OrganizationMember func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserImportJob is the predicate function for userimportjob builders.
+type UserImportJob func(*sql.Selector)
+
+// UserRole is the predicate function for userrole builders.
+type UserRole func(*sql.Selector)
+
+// UserRoleBinding is the predicate function for userrolebinding builders.
+type UserRoleBinding func(*sql.Selector)
+
+// UserRolePermission is the predicate function for userrolepermission builders.
+type UserRolePermission func(*sql.Selector)
+
+// VerificationCode is the predicate function for verificationcode builders.
+type VerificationCode func(*sql.Selector)
@@ -3,12 +3,26 @@
 package ent
 
 import (
+	"server/internal/data/model/ent/accesspolicy"
 	"server/internal/data/model/ent/adminpermission"
 	"server/internal/data/model/ent/adminrole"
+	"server/internal/data/model/ent/adminroleparent"
 	"server/internal/data/model/ent/adminrolepermission"
 	"server/internal/data/model/ent/adminuser"
 	"server/internal/data/model/ent/adminuserrole"
+	"server/internal/data/model/ent/auditlog"
+	"server/internal/data/model/ent/invitecode"
+	"server/internal/data/model/ent/inviteredemption"
+	"server/internal/data/model/ent/loginevent"
+	"server/internal/data/model/ent/organization"
+	"server/internal/data/model/ent/organizationadminrole"
+	"server/internal/data/model/ent/organizationmember"
 	"server/internal/data/model/ent/user"
+	"server/internal/data/model/ent/userimportjob"
+	"server/internal/data/model/ent/userrole"
+	"server/internal/data/model/ent/userrolebinding"
+	"server/internal/data/model/ent/userrolepermission"
+	"server/internal/data/model/ent/verificationcode"
 	"server/internal/data/model/schema"
 	"time"
 )
@@ -17,6 +31,68 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accesspolicyFields := schema.AccessPolicy{}.Fields()
+	_ = accesspolicyFields
+	// accesspolicyDescURL is the schema descriptor for url field.
+	accesspolicyDescURL := accesspolicyFields[0].Descriptor()
+	// accesspolicy.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	accesspolicy.URLValidator = func() func(string) error {
+		validators := accesspolicyDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accesspolicyDescMethod is the schema descriptor for method field.
+	accesspolicyDescMethod := accesspolicyFields[1].Descriptor()
+	// accesspolicy.MethodValidator is a validator for the "method" field. It is called by the builders before save.
+	accesspolicy.MethodValidator = func() func(string) error {
+		validators := accesspolicyDescMethod.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(method string) error {
+			for _, fn := range fns {
+				if err := fn(method); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accesspolicyDescExpression is the schema descriptor for expression field.
+	accesspolicyDescExpression := accesspolicyFields[2].Descriptor()
+	// accesspolicy.ExpressionValidator is a validator for the "expression" field. It is called by the builders before save.
+	accesspolicy.ExpressionValidator = accesspolicyDescExpression.Validators[0].(func(string) error)
+	// accesspolicyDescDescription is the schema descriptor for description field.
+	accesspolicyDescDescription := accesspolicyFields[3].Descriptor()
+	// accesspolicy.DefaultDescription holds the default value on creation for the description field.
+	accesspolicy.DefaultDescription = accesspolicyDescDescription.Default.(string)
+	// accesspolicy.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	accesspolicy.DescriptionValidator = accesspolicyDescDescription.Validators[0].(func(string) error)
+	// accesspolicyDescEnabled is the schema descriptor for enabled field.
+	accesspolicyDescEnabled := accesspolicyFields[4].Descriptor()
+	// accesspolicy.DefaultEnabled holds the default value on creation for the enabled field.
+	accesspolicy.DefaultEnabled = accesspolicyDescEnabled.Default.(bool)
+	// accesspolicyDescCreatedAt is the schema descriptor for created_at field.
+	accesspolicyDescCreatedAt := accesspolicyFields[6].Descriptor()
+	// accesspolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accesspolicy.DefaultCreatedAt = accesspolicyDescCreatedAt.Default.(func() time.Time)
+	// accesspolicyDescUpdatedAt is the schema descriptor for updated_at field.
+	accesspolicyDescUpdatedAt := accesspolicyFields[7].Descriptor()
+	// accesspolicy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	accesspolicy.DefaultUpdatedAt = accesspolicyDescUpdatedAt.Default.(func() time.Time)
+	// accesspolicy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	accesspolicy.UpdateDefaultUpdatedAt = accesspolicyDescUpdatedAt.UpdateDefault.(func() time.Time)
 	adminpermissionFields := schema.AdminPermission{}.Fields()
 	_ = adminpermissionFields
 	// adminpermissionDescKey is the schema descriptor for key field.
@@ -139,10 +215,20 @@ func init() {
 	adminrole.DefaultUpdatedAt = adminroleDescUpdatedAt.Default.(func() time.Time)
 	// adminrole.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	adminrole.UpdateDefaultUpdatedAt = adminroleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	adminroleparentFields := schema.AdminRoleParent{}.Fields()
+	_ = adminroleparentFields
+	// adminroleparentDescCreatedAt is the schema descriptor for created_at field.
+	adminroleparentDescCreatedAt := adminroleparentFields[2].Descriptor()
+	// adminroleparent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminroleparent.DefaultCreatedAt = adminroleparentDescCreatedAt.Default.(func() time.Time)
 	adminrolepermissionFields := schema.AdminRolePermission{}.Fields()
 	_ = adminrolepermissionFields
+	// adminrolepermissionDescDeny is the schema descriptor for deny field.
+	adminrolepermissionDescDeny := adminrolepermissionFields[2].Descriptor()
+	// adminrolepermission.DefaultDeny holds the default value on creation for the deny field.
+	adminrolepermission.DefaultDeny = adminrolepermissionDescDeny.Default.(bool)
 	// adminrolepermissionDescCreatedAt is the schema descriptor for created_at field.
-	adminrolepermissionDescCreatedAt := adminrolepermissionFields[2].Descriptor()
+	adminrolepermissionDescCreatedAt := adminrolepermissionFields[3].Descriptor()
 	// adminrolepermission.DefaultCreatedAt holds the default value on creation for the created_at field.
 	adminrolepermission.DefaultCreatedAt = adminrolepermissionDescCreatedAt.Default.(func() time.Time)
 	adminuserFields := schema.AdminUser{}.Fields()
@@ -165,20 +251,38 @@ func init() {
 			return nil
 		}
 	}()
+	// adminuserDescUsernameNormalized is the schema descriptor for username_normalized field.
+	adminuserDescUsernameNormalized := adminuserFields[1].Descriptor()
+	// adminuser.UsernameNormalizedValidator is a validator for the "username_normalized" field. It is called by the builders before save.
+	adminuser.UsernameNormalizedValidator = func() func(string) error {
+		validators := adminuserDescUsernameNormalized.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(username_normalized string) error {
+			for _, fn := range fns {
+				if err := fn(username_normalized); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	// adminuserDescPasswordHash is the schema descriptor for password_hash field.
-	adminuserDescPasswordHash := adminuserFields[1].Descriptor()
+	adminuserDescPasswordHash := adminuserFields[2].Descriptor()
 	// adminuser.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
 	adminuser.PasswordHashValidator = adminuserDescPasswordHash.Validators[0].(func(string) error)
 	// adminuserDescDisabled is the schema descriptor for disabled field.
-	adminuserDescDisabled := adminuserFields[2].Descriptor()
+	adminuserDescDisabled := adminuserFields[3].Descriptor()
 	// adminuser.DefaultDisabled holds the default value on creation for the disabled field.
 	adminuser.DefaultDisabled = adminuserDescDisabled.Default.(bool)
 	// adminuserDescCreatedAt is the schema descriptor for created_at field.
-	adminuserDescCreatedAt := adminuserFields[4].Descriptor()
+	adminuserDescCreatedAt := adminuserFields[6].Descriptor()
 	// adminuser.DefaultCreatedAt holds the default value on creation for the created_at field.
 	adminuser.DefaultCreatedAt = adminuserDescCreatedAt.Default.(func() time.Time)
 	// adminuserDescUpdatedAt is the schema descriptor for updated_at field.
-	adminuserDescUpdatedAt := adminuserFields[5].Descriptor()
+	adminuserDescUpdatedAt := adminuserFields[7].Descriptor()
 	// adminuser.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	adminuser.DefaultUpdatedAt = adminuserDescUpdatedAt.Default.(func() time.Time)
 	// adminuser.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -186,9 +290,267 @@ func init() {
 	adminuserroleFields := schema.AdminUserRole{}.Fields()
 	_ = adminuserroleFields
 	// adminuserroleDescCreatedAt is the schema descriptor for created_at field.
-	adminuserroleDescCreatedAt := adminuserroleFields[2].Descriptor()
+	adminuserroleDescCreatedAt := adminuserroleFields[4].Descriptor()
 	// adminuserrole.DefaultCreatedAt holds the default value on creation for the created_at field.
 	adminuserrole.DefaultCreatedAt = adminuserroleDescCreatedAt.Default.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[0].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = func() func(string) error {
+		validators := auditlogDescAction.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(action string) error {
+			for _, fn := range fns {
+				if err := fn(action); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// auditlogDescActorKind is the schema descriptor for actor_kind field.
+	auditlogDescActorKind := auditlogFields[1].Descriptor()
+	// auditlog.ActorKindValidator is a validator for the "actor_kind" field. It is called by the builders before save.
+	auditlog.ActorKindValidator = func() func(string) error {
+		validators := auditlogDescActorKind.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(actor_kind string) error {
+			for _, fn := range fns {
+				if err := fn(actor_kind); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// auditlogDescActorID is the schema descriptor for actor_id field.
+	auditlogDescActorID := auditlogFields[2].Descriptor()
+	// auditlog.DefaultActorID holds the default value on creation for the actor_id field.
+	auditlog.DefaultActorID = auditlogDescActorID.Default.(int)
+	// auditlogDescActorUsername is the schema descriptor for actor_username field.
+	auditlogDescActorUsername := auditlogFields[3].Descriptor()
+	// auditlog.DefaultActorUsername holds the default value on creation for the actor_username field.
+	auditlog.DefaultActorUsername = auditlogDescActorUsername.Default.(string)
+	// auditlogDescTargetKind is the schema descriptor for target_kind field.
+	auditlogDescTargetKind := auditlogFields[4].Descriptor()
+	// auditlog.DefaultTargetKind holds the default value on creation for the target_kind field.
+	auditlog.DefaultTargetKind = auditlogDescTargetKind.Default.(string)
+	// auditlogDescTargetID is the schema descriptor for target_id field.
+	auditlogDescTargetID := auditlogFields[5].Descriptor()
+	// auditlog.DefaultTargetID holds the default value on creation for the target_id field.
+	auditlog.DefaultTargetID = auditlogDescTargetID.Default.(int)
+	// auditlogDescRequestID is the schema descriptor for request_id field.
+	auditlogDescRequestID := auditlogFields[7].Descriptor()
+	// auditlog.DefaultRequestID holds the default value on creation for the request_id field.
+	auditlog.DefaultRequestID = auditlogDescRequestID.Default.(string)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[8].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	invitecodeFields := schema.InviteCode{}.Fields()
+	_ = invitecodeFields
+	// invitecodeDescCode is the schema descriptor for code field.
+	invitecodeDescCode := invitecodeFields[0].Descriptor()
+	// invitecode.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	invitecode.CodeValidator = func() func(string) error {
+		validators := invitecodeDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// invitecodeDescMaxUses is the schema descriptor for max_uses field.
+	invitecodeDescMaxUses := invitecodeFields[1].Descriptor()
+	// invitecode.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	invitecode.MaxUsesValidator = invitecodeDescMaxUses.Validators[0].(func(int) error)
+	// invitecodeDescUsedCount is the schema descriptor for used_count field.
+	invitecodeDescUsedCount := invitecodeFields[2].Descriptor()
+	// invitecode.DefaultUsedCount holds the default value on creation for the used_count field.
+	invitecode.DefaultUsedCount = invitecodeDescUsedCount.Default.(int)
+	// invitecode.UsedCountValidator is a validator for the "used_count" field. It is called by the builders before save.
+	invitecode.UsedCountValidator = invitecodeDescUsedCount.Validators[0].(func(int) error)
+	// invitecodeDescRoleKey is the schema descriptor for role_key field.
+	invitecodeDescRoleKey := invitecodeFields[4].Descriptor()
+	// invitecode.DefaultRoleKey holds the default value on creation for the role_key field.
+	invitecode.DefaultRoleKey = invitecodeDescRoleKey.Default.(string)
+	// invitecodeDescNote is the schema descriptor for note field.
+	invitecodeDescNote := invitecodeFields[5].Descriptor()
+	// invitecode.DefaultNote holds the default value on creation for the note field.
+	invitecode.DefaultNote = invitecodeDescNote.Default.(string)
+	// invitecodeDescOrganizationID is the schema descriptor for organization_id field.
+	invitecodeDescOrganizationID := invitecodeFields[6].Descriptor()
+	// invitecode.DefaultOrganizationID holds the default value on creation for the organization_id field.
+	invitecode.DefaultOrganizationID = invitecodeDescOrganizationID.Default.(int)
+	// invitecodeDescCreatedBy is the schema descriptor for created_by field.
+	invitecodeDescCreatedBy := invitecodeFields[7].Descriptor()
+	// invitecode.DefaultCreatedBy holds the default value on creation for the created_by field.
+	invitecode.DefaultCreatedBy = invitecodeDescCreatedBy.Default.(int)
+	// invitecodeDescCreatedAt is the schema descriptor for created_at field.
+	invitecodeDescCreatedAt := invitecodeFields[9].Descriptor()
+	// invitecode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invitecode.DefaultCreatedAt = invitecodeDescCreatedAt.Default.(func() time.Time)
+	// invitecodeDescUpdatedAt is the schema descriptor for updated_at field.
+	invitecodeDescUpdatedAt := invitecodeFields[10].Descriptor()
+	// invitecode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	invitecode.DefaultUpdatedAt = invitecodeDescUpdatedAt.Default.(func() time.Time)
+	// invitecode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	invitecode.UpdateDefaultUpdatedAt = invitecodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	inviteredemptionFields := schema.InviteRedemption{}.Fields()
+	_ = inviteredemptionFields
+	// inviteredemptionDescCreatedAt is the schema descriptor for created_at field.
+	inviteredemptionDescCreatedAt := inviteredemptionFields[2].Descriptor()
+	// inviteredemption.DefaultCreatedAt holds the default value on creation for the created_at field.
+	inviteredemption.DefaultCreatedAt = inviteredemptionDescCreatedAt.Default.(func() time.Time)
+	logineventFields := schema.LoginEvent{}.Fields()
+	_ = logineventFields
+	// logineventDescAccountKind is the schema descriptor for account_kind field.
+	logineventDescAccountKind := logineventFields[0].Descriptor()
+	// loginevent.AccountKindValidator is a validator for the "account_kind" field. It is called by the builders before save.
+	loginevent.AccountKindValidator = func() func(string) error {
+		validators := logineventDescAccountKind.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(account_kind string) error {
+			for _, fn := range fns {
+				if err := fn(account_kind); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// logineventDescAccountID is the schema descriptor for account_id field.
+	logineventDescAccountID := logineventFields[1].Descriptor()
+	// loginevent.DefaultAccountID holds the default value on creation for the account_id field.
+	loginevent.DefaultAccountID = logineventDescAccountID.Default.(int)
+	// logineventDescUsername is the schema descriptor for username field.
+	logineventDescUsername := logineventFields[2].Descriptor()
+	// loginevent.DefaultUsername holds the default value on creation for the username field.
+	loginevent.DefaultUsername = logineventDescUsername.Default.(string)
+	// loginevent.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	loginevent.UsernameValidator = logineventDescUsername.Validators[0].(func(string) error)
+	// logineventDescReason is the schema descriptor for reason field.
+	logineventDescReason := logineventFields[4].Descriptor()
+	// loginevent.DefaultReason holds the default value on creation for the reason field.
+	loginevent.DefaultReason = logineventDescReason.Default.(string)
+	// loginevent.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	loginevent.ReasonValidator = logineventDescReason.Validators[0].(func(string) error)
+	// logineventDescIP is the schema descriptor for ip field.
+	logineventDescIP := logineventFields[5].Descriptor()
+	// loginevent.DefaultIP holds the default value on creation for the ip field.
+	loginevent.DefaultIP = logineventDescIP.Default.(string)
+	// loginevent.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	loginevent.IPValidator = logineventDescIP.Validators[0].(func(string) error)
+	// logineventDescUserAgent is the schema descriptor for user_agent field.
+	logineventDescUserAgent := logineventFields[6].Descriptor()
+	// loginevent.DefaultUserAgent holds the default value on creation for the user_agent field.
+	loginevent.DefaultUserAgent = logineventDescUserAgent.Default.(string)
+	// loginevent.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	loginevent.UserAgentValidator = logineventDescUserAgent.Validators[0].(func(string) error)
+	// logineventDescRequestID is the schema descriptor for request_id field.
+	logineventDescRequestID := logineventFields[7].Descriptor()
+	// loginevent.DefaultRequestID holds the default value on creation for the request_id field.
+	loginevent.DefaultRequestID = logineventDescRequestID.Default.(string)
+	// logineventDescCreatedAt is the schema descriptor for created_at field.
+	logineventDescCreatedAt := logineventFields[8].Descriptor()
+	// loginevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginevent.DefaultCreatedAt = logineventDescCreatedAt.Default.(func() time.Time)
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescSlug is the schema descriptor for slug field.
+	organizationDescSlug := organizationFields[0].Descriptor()
+	// organization.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	organization.SlugValidator = func() func(string) error {
+		validators := organizationDescSlug.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(slug string) error {
+			for _, fn := range fns {
+				if err := fn(slug); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// organizationDescName is the schema descriptor for name field.
+	organizationDescName := organizationFields[1].Descriptor()
+	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organization.NameValidator = func() func(string) error {
+		validators := organizationDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// organizationDescCreatedAt is the schema descriptor for created_at field.
+	organizationDescCreatedAt := organizationFields[2].Descriptor()
+	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
+	// organizationDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationDescUpdatedAt := organizationFields[3].Descriptor()
+	// organization.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
+	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	organizationadminroleFields := schema.OrganizationAdminRole{}.Fields()
+	_ = organizationadminroleFields
+	// organizationadminroleDescCreatedAt is the schema descriptor for created_at field.
+	organizationadminroleDescCreatedAt := organizationadminroleFields[3].Descriptor()
+	// organizationadminrole.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organizationadminrole.DefaultCreatedAt = organizationadminroleDescCreatedAt.Default.(func() time.Time)
+	organizationmemberFields := schema.OrganizationMember{}.Fields()
+	_ = organizationmemberFields
+	// organizationmemberDescMemberKind is the schema descriptor for member_kind field.
+	organizationmemberDescMemberKind := organizationmemberFields[1].Descriptor()
+	// organizationmember.MemberKindValidator is a validator for the "member_kind" field. It is called by the builders before save.
+	organizationmember.MemberKindValidator = func() func(string) error {
+		validators := organizationmemberDescMemberKind.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(member_kind string) error {
+			for _, fn := range fns {
+				if err := fn(member_kind); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// organizationmemberDescCreatedAt is the schema descriptor for created_at field.
+	organizationmemberDescCreatedAt := organizationmemberFields[3].Descriptor()
+	// organizationmember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organizationmember.DefaultCreatedAt = organizationmemberDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescUsername is the schema descriptor for username field.
@@ -209,22 +571,270 @@ func init() {
 			return nil
 		}
 	}()
+	// userDescUsernameNormalized is the schema descriptor for username_normalized field.
+	userDescUsernameNormalized := userFields[1].Descriptor()
+	// user.UsernameNormalizedValidator is a validator for the "username_normalized" field. It is called by the builders before save.
+	user.UsernameNormalizedValidator = func() func(string) error {
+		validators := userDescUsernameNormalized.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(username_normalized string) error {
+			for _, fn := range fns {
+				if err := fn(username_normalized); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	// userDescPasswordHash is the schema descriptor for password_hash field.
-	userDescPasswordHash := userFields[1].Descriptor()
+	userDescPasswordHash := userFields[2].Descriptor()
 	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
 	user.PasswordHashValidator = userDescPasswordHash.Validators[0].(func(string) error)
 	// userDescDisabled is the schema descriptor for disabled field.
-	userDescDisabled := userFields[2].Descriptor()
+	userDescDisabled := userFields[3].Descriptor()
 	// user.DefaultDisabled holds the default value on creation for the disabled field.
 	user.DefaultDisabled = userDescDisabled.Default.(bool)
+	// userDescMustChangePassword is the schema descriptor for must_change_password field.
+	userDescMustChangePassword := userFields[4].Descriptor()
+	// user.DefaultMustChangePassword holds the default value on creation for the must_change_password field.
+	user.DefaultMustChangePassword = userDescMustChangePassword.Default.(bool)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[6].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescPhone is the schema descriptor for phone field.
+	userDescPhone := userFields[8].Descriptor()
+	// user.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	user.PhoneValidator = userDescPhone.Validators[0].(func(string) error)
+	// userDescDisplayName is the schema descriptor for display_name field.
+	userDescDisplayName := userFields[10].Descriptor()
+	// user.DefaultDisplayName holds the default value on creation for the display_name field.
+	user.DefaultDisplayName = userDescDisplayName.Default.(string)
+	// user.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	user.DisplayNameValidator = userDescDisplayName.Validators[0].(func(string) error)
+	// userDescAvatarURL is the schema descriptor for avatar_url field.
+	userDescAvatarURL := userFields[11].Descriptor()
+	// user.DefaultAvatarURL holds the default value on creation for the avatar_url field.
+	user.DefaultAvatarURL = userDescAvatarURL.Default.(string)
+	// user.AvatarURLValidator is a validator for the "avatar_url" field. It is called by the builders before save.
+	user.AvatarURLValidator = userDescAvatarURL.Validators[0].(func(string) error)
+	// userDescLocale is the schema descriptor for locale field.
+	userDescLocale := userFields[12].Descriptor()
+	// user.DefaultLocale holds the default value on creation for the locale field.
+	user.DefaultLocale = userDescLocale.Default.(string)
+	// user.LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	user.LocaleValidator = userDescLocale.Validators[0].(func(string) error)
+	// userDescTimezone is the schema descriptor for timezone field.
+	userDescTimezone := userFields[13].Descriptor()
+	// user.DefaultTimezone holds the default value on creation for the timezone field.
+	user.DefaultTimezone = userDescTimezone.Default.(string)
+	// user.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	user.TimezoneValidator = userDescTimezone.Validators[0].(func(string) error)
+	// userDescDeletedUsername is the schema descriptor for deleted_username field.
+	userDescDeletedUsername := userFields[16].Descriptor()
+	// user.DeletedUsernameValidator is a validator for the "deleted_username" field. It is called by the builders before save.
+	user.DeletedUsernameValidator = userDescDeletedUsername.Validators[0].(func(string) error)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[4].Descriptor()
+	userDescCreatedAt := userFields[19].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[5].Descriptor()
+	userDescUpdatedAt := userFields[20].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	userimportjobFields := schema.UserImportJob{}.Fields()
+	_ = userimportjobFields
+	// userimportjobDescStatus is the schema descriptor for status field.
+	userimportjobDescStatus := userimportjobFields[0].Descriptor()
+	// userimportjob.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	userimportjob.StatusValidator = func() func(string) error {
+		validators := userimportjobDescStatus.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(status string) error {
+			for _, fn := range fns {
+				if err := fn(status); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userimportjobDescDryRun is the schema descriptor for dry_run field.
+	userimportjobDescDryRun := userimportjobFields[1].Descriptor()
+	// userimportjob.DefaultDryRun holds the default value on creation for the dry_run field.
+	userimportjob.DefaultDryRun = userimportjobDescDryRun.Default.(bool)
+	// userimportjobDescOrganizationID is the schema descriptor for organization_id field.
+	userimportjobDescOrganizationID := userimportjobFields[2].Descriptor()
+	// userimportjob.DefaultOrganizationID holds the default value on creation for the organization_id field.
+	userimportjob.DefaultOrganizationID = userimportjobDescOrganizationID.Default.(int)
+	// userimportjobDescCreatedBy is the schema descriptor for created_by field.
+	userimportjobDescCreatedBy := userimportjobFields[3].Descriptor()
+	// userimportjob.DefaultCreatedBy holds the default value on creation for the created_by field.
+	userimportjob.DefaultCreatedBy = userimportjobDescCreatedBy.Default.(int)
+	// userimportjobDescTotalRows is the schema descriptor for total_rows field.
+	userimportjobDescTotalRows := userimportjobFields[4].Descriptor()
+	// userimportjob.DefaultTotalRows holds the default value on creation for the total_rows field.
+	userimportjob.DefaultTotalRows = userimportjobDescTotalRows.Default.(int)
+	// userimportjob.TotalRowsValidator is a validator for the "total_rows" field. It is called by the builders before save.
+	userimportjob.TotalRowsValidator = userimportjobDescTotalRows.Validators[0].(func(int) error)
+	// userimportjobDescProcessedRows is the schema descriptor for processed_rows field.
+	userimportjobDescProcessedRows := userimportjobFields[5].Descriptor()
+	// userimportjob.DefaultProcessedRows holds the default value on creation for the processed_rows field.
+	userimportjob.DefaultProcessedRows = userimportjobDescProcessedRows.Default.(int)
+	// userimportjob.ProcessedRowsValidator is a validator for the "processed_rows" field. It is called by the builders before save.
+	userimportjob.ProcessedRowsValidator = userimportjobDescProcessedRows.Validators[0].(func(int) error)
+	// userimportjobDescCreatedCount is the schema descriptor for created_count field.
+	userimportjobDescCreatedCount := userimportjobFields[6].Descriptor()
+	// userimportjob.DefaultCreatedCount holds the default value on creation for the created_count field.
+	userimportjob.DefaultCreatedCount = userimportjobDescCreatedCount.Default.(int)
+	// userimportjob.CreatedCountValidator is a validator for the "created_count" field. It is called by the builders before save.
+	userimportjob.CreatedCountValidator = userimportjobDescCreatedCount.Validators[0].(func(int) error)
+	// userimportjobDescFailedCount is the schema descriptor for failed_count field.
+	userimportjobDescFailedCount := userimportjobFields[7].Descriptor()
+	// userimportjob.DefaultFailedCount holds the default value on creation for the failed_count field.
+	userimportjob.DefaultFailedCount = userimportjobDescFailedCount.Default.(int)
+	// userimportjob.FailedCountValidator is a validator for the "failed_count" field. It is called by the builders before save.
+	userimportjob.FailedCountValidator = userimportjobDescFailedCount.Validators[0].(func(int) error)
+	// userimportjobDescError is the schema descriptor for error field.
+	userimportjobDescError := userimportjobFields[9].Descriptor()
+	// userimportjob.DefaultError holds the default value on creation for the error field.
+	userimportjob.DefaultError = userimportjobDescError.Default.(string)
+	// userimportjobDescCreatedAt is the schema descriptor for created_at field.
+	userimportjobDescCreatedAt := userimportjobFields[11].Descriptor()
+	// userimportjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userimportjob.DefaultCreatedAt = userimportjobDescCreatedAt.Default.(func() time.Time)
+	// userimportjobDescUpdatedAt is the schema descriptor for updated_at field.
+	userimportjobDescUpdatedAt := userimportjobFields[12].Descriptor()
+	// userimportjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userimportjob.DefaultUpdatedAt = userimportjobDescUpdatedAt.Default.(func() time.Time)
+	// userimportjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userimportjob.UpdateDefaultUpdatedAt = userimportjobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	userroleFields := schema.UserRole{}.Fields()
+	_ = userroleFields
+	// userroleDescKey is the schema descriptor for key field.
+	userroleDescKey := userroleFields[0].Descriptor()
+	// userrole.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	userrole.KeyValidator = func() func(string) error {
+		validators := userroleDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userroleDescName is the schema descriptor for name field.
+	userroleDescName := userroleFields[1].Descriptor()
+	// userrole.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	userrole.NameValidator = func() func(string) error {
+		validators := userroleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userroleDescDescription is the schema descriptor for description field.
+	userroleDescDescription := userroleFields[2].Descriptor()
+	// userrole.DefaultDescription holds the default value on creation for the description field.
+	userrole.DefaultDescription = userroleDescDescription.Default.(string)
+	// userrole.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	userrole.DescriptionValidator = userroleDescDescription.Validators[0].(func(string) error)
+	// userroleDescCreatedAt is the schema descriptor for created_at field.
+	userroleDescCreatedAt := userroleFields[3].Descriptor()
+	// userrole.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userrole.DefaultCreatedAt = userroleDescCreatedAt.Default.(func() time.Time)
+	// userroleDescUpdatedAt is the schema descriptor for updated_at field.
+	userroleDescUpdatedAt := userroleFields[4].Descriptor()
+	// userrole.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userrole.DefaultUpdatedAt = userroleDescUpdatedAt.Default.(func() time.Time)
+	// userrole.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userrole.UpdateDefaultUpdatedAt = userroleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	userrolebindingFields := schema.UserRoleBinding{}.Fields()
+	_ = userrolebindingFields
+	// userrolebindingDescCreatedAt is the schema descriptor for created_at field.
+	userrolebindingDescCreatedAt := userrolebindingFields[2].Descriptor()
+	// userrolebinding.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userrolebinding.DefaultCreatedAt = userrolebindingDescCreatedAt.Default.(func() time.Time)
+	userrolepermissionFields := schema.UserRolePermission{}.Fields()
+	_ = userrolepermissionFields
+	// userrolepermissionDescPermissionKey is the schema descriptor for permission_key field.
+	userrolepermissionDescPermissionKey := userrolepermissionFields[1].Descriptor()
+	// userrolepermission.PermissionKeyValidator is a validator for the "permission_key" field. It is called by the builders before save.
+	userrolepermission.PermissionKeyValidator = func() func(string) error {
+		validators := userrolepermissionDescPermissionKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(permission_key string) error {
+			for _, fn := range fns {
+				if err := fn(permission_key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userrolepermissionDescCreatedAt is the schema descriptor for created_at field.
+	userrolepermissionDescCreatedAt := userrolepermissionFields[2].Descriptor()
+	// userrolepermission.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userrolepermission.DefaultCreatedAt = userrolepermissionDescCreatedAt.Default.(func() time.Time)
+	verificationcodeFields := schema.VerificationCode{}.Fields()
+	_ = verificationcodeFields
+	// verificationcodeDescChannel is the schema descriptor for channel field.
+	verificationcodeDescChannel := verificationcodeFields[1].Descriptor()
+	// verificationcode.ChannelValidator is a validator for the "channel" field. It is called by the builders before save.
+	verificationcode.ChannelValidator = func() func(string) error {
+		validators := verificationcodeDescChannel.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(channel string) error {
+			for _, fn := range fns {
+				if err := fn(channel); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// verificationcodeDescTarget is the schema descriptor for target field.
+	verificationcodeDescTarget := verificationcodeFields[2].Descriptor()
+	// verificationcode.TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	verificationcode.TargetValidator = verificationcodeDescTarget.Validators[0].(func(string) error)
+	// verificationcodeDescCodeHash is the schema descriptor for code_hash field.
+	verificationcodeDescCodeHash := verificationcodeFields[3].Descriptor()
+	// verificationcode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	verificationcode.CodeHashValidator = verificationcodeDescCodeHash.Validators[0].(func(string) error)
+	// verificationcodeDescAttempts is the schema descriptor for attempts field.
+	verificationcodeDescAttempts := verificationcodeFields[4].Descriptor()
+	// verificationcode.DefaultAttempts holds the default value on creation for the attempts field.
+	verificationcode.DefaultAttempts = verificationcodeDescAttempts.Default.(int)
+	// verificationcodeDescCreatedAt is the schema descriptor for created_at field.
+	verificationcodeDescCreatedAt := verificationcodeFields[7].Descriptor()
+	// verificationcode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	verificationcode.DefaultCreatedAt = verificationcodeDescCreatedAt.Default.(func() time.Time)
 }
@@ -12,18 +12,46 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessPolicy is the client for interacting with the AccessPolicy builders.
+	AccessPolicy *AccessPolicyClient
 	// AdminPermission is the client for interacting with the AdminPermission builders.
 	AdminPermission *AdminPermissionClient
 	// AdminRole is the client for interacting with the AdminRole builders.
 	AdminRole *AdminRoleClient
+	// AdminRoleParent is the client for interacting with the AdminRoleParent builders.
+	AdminRoleParent *AdminRoleParentClient
 	// AdminRolePermission is the client for interacting with the AdminRolePermission builders.
 	AdminRolePermission *AdminRolePermissionClient
 	// AdminUser is the client for interacting with the AdminUser builders.
 	AdminUser *AdminUserClient
 	// AdminUserRole is the client for interacting with the AdminUserRole builders.
 	AdminUserRole *AdminUserRoleClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// InviteCode is the client for interacting with the InviteCode builders.
+	InviteCode *InviteCodeClient
+	// InviteRedemption is the client for interacting with the InviteRedemption builders.
+	InviteRedemption *InviteRedemptionClient
+	// LoginEvent is the client for interacting with the LoginEvent builders.
+	LoginEvent *LoginEventClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
+	// OrganizationAdminRole is the client for interacting with the OrganizationAdminRole builders.
+	OrganizationAdminRole *OrganizationAdminRoleClient
+	// OrganizationMember is the client for interacting with the OrganizationMember builders.
+	OrganizationMember *OrganizationMemberClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserImportJob is the client for interacting with the UserImportJob builders.
+	UserImportJob *UserImportJobClient
+	// UserRole is the client for interacting with the UserRole builders.
+	UserRole *UserRoleClient
+	// UserRoleBinding is the client for interacting with the UserRoleBinding builders.
+	UserRoleBinding *UserRoleBindingClient
+	// UserRolePermission is the client for interacting with the UserRolePermission builders.
+	UserRolePermission *UserRolePermissionClient
+	// VerificationCode is the client for interacting with the VerificationCode builders.
+	VerificationCode *VerificationCodeClient
 
 	// lazily loaded.
 	client     *Client
@@ -155,12 +183,26 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessPolicy = NewAccessPolicyClient(tx.config)
 	tx.AdminPermission = NewAdminPermissionClient(tx.config)
 	tx.AdminRole = NewAdminRoleClient(tx.config)
+	tx.AdminRoleParent = NewAdminRoleParentClient(tx.config)
 	tx.AdminRolePermission = NewAdminRolePermissionClient(tx.config)
 	tx.AdminUser = NewAdminUserClient(tx.config)
 	tx.AdminUserRole = NewAdminUserRoleClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.InviteCode = NewInviteCodeClient(tx.config)
+	tx.InviteRedemption = NewInviteRedemptionClient(tx.config)
+	tx.LoginEvent = NewLoginEventClient(tx.config)
+	tx.Organization = NewOrganizationClient(tx.config)
+	tx.OrganizationAdminRole = NewOrganizationAdminRoleClient(tx.config)
+	tx.OrganizationMember = NewOrganizationMemberClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserImportJob = NewUserImportJobClient(tx.config)
+	tx.UserRole = NewUserRoleClient(tx.config)
+	tx.UserRoleBinding = NewUserRoleBindingClient(tx.config)
+	tx.UserRolePermission = NewUserRolePermissionClient(tx.config)
+	tx.VerificationCode = NewVerificationCodeClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -170,7 +212,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AdminPermission.QueryXXX(), the query will be executed
+// applies a query, for example: AccessPolicy.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
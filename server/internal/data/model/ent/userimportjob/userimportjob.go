@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userimportjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the userimportjob type in the database.
+	Label = "user_import_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDryRun holds the string denoting the dry_run field in the database.
+	FieldDryRun = "dry_run"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldTotalRows holds the string denoting the total_rows field in the database.
+	FieldTotalRows = "total_rows"
+	// FieldProcessedRows holds the string denoting the processed_rows field in the database.
+	FieldProcessedRows = "processed_rows"
+	// FieldCreatedCount holds the string denoting the created_count field in the database.
+	FieldCreatedCount = "created_count"
+	// FieldFailedCount holds the string denoting the failed_count field in the database.
+	FieldFailedCount = "failed_count"
+	// FieldRowErrors holds the string denoting the row_errors field in the database.
+	FieldRowErrors = "row_errors"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the userimportjob in the database.
+	Table = "user_import_jobs"
+)
+
+// Columns holds all SQL columns for userimportjob fields.
+var Columns = []string{
+	FieldID,
+	FieldStatus,
+	FieldDryRun,
+	FieldOrganizationID,
+	FieldCreatedBy,
+	FieldTotalRows,
+	FieldProcessedRows,
+	FieldCreatedCount,
+	FieldFailedCount,
+	FieldRowErrors,
+	FieldError,
+	FieldFinishedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// DefaultDryRun holds the default value on creation for the "dry_run" field.
+	DefaultDryRun bool
+	// DefaultOrganizationID holds the default value on creation for the "organization_id" field.
+	DefaultOrganizationID int
+	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
+	DefaultCreatedBy int
+	// DefaultTotalRows holds the default value on creation for the "total_rows" field.
+	DefaultTotalRows int
+	// TotalRowsValidator is a validator for the "total_rows" field. It is called by the builders before save.
+	TotalRowsValidator func(int) error
+	// DefaultProcessedRows holds the default value on creation for the "processed_rows" field.
+	DefaultProcessedRows int
+	// ProcessedRowsValidator is a validator for the "processed_rows" field. It is called by the builders before save.
+	ProcessedRowsValidator func(int) error
+	// DefaultCreatedCount holds the default value on creation for the "created_count" field.
+	DefaultCreatedCount int
+	// CreatedCountValidator is a validator for the "created_count" field. It is called by the builders before save.
+	CreatedCountValidator func(int) error
+	// DefaultFailedCount holds the default value on creation for the "failed_count" field.
+	DefaultFailedCount int
+	// FailedCountValidator is a validator for the "failed_count" field. It is called by the builders before save.
+	FailedCountValidator func(int) error
+	// DefaultError holds the default value on creation for the "error" field.
+	DefaultError string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the UserImportJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDryRun orders the results by the dry_run field.
+func ByDryRun(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDryRun, opts...).ToFunc()
+}
+
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByTotalRows orders the results by the total_rows field.
+func ByTotalRows(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalRows, opts...).ToFunc()
+}
+
+// ByProcessedRows orders the results by the processed_rows field.
+func ByProcessedRows(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessedRows, opts...).ToFunc()
+}
+
+// ByCreatedCount orders the results by the created_count field.
+func ByCreatedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedCount, opts...).ToFunc()
+}
+
+// ByFailedCount orders the results by the failed_count field.
+func ByFailedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedCount, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
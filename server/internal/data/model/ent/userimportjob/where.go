@@ -0,0 +1,650 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userimportjob
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLTE(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// DryRun applies equality check predicate on the "dry_run" field. It's identical to DryRunEQ.
+func DryRun(v bool) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldDryRun, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// TotalRows applies equality check predicate on the "total_rows" field. It's identical to TotalRowsEQ.
+func TotalRows(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldTotalRows, v))
+}
+
+// ProcessedRows applies equality check predicate on the "processed_rows" field. It's identical to ProcessedRowsEQ.
+func ProcessedRows(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldProcessedRows, v))
+}
+
+// CreatedCount applies equality check predicate on the "created_count" field. It's identical to CreatedCountEQ.
+func CreatedCount(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldCreatedCount, v))
+}
+
+// FailedCount applies equality check predicate on the "failed_count" field. It's identical to FailedCountEQ.
+func FailedCount(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldFailedCount, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldError, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// DryRunEQ applies the EQ predicate on the "dry_run" field.
+func DryRunEQ(v bool) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldDryRun, v))
+}
+
+// DryRunNEQ applies the NEQ predicate on the "dry_run" field.
+func DryRunNEQ(v bool) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNEQ(FieldDryRun, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// TotalRowsEQ applies the EQ predicate on the "total_rows" field.
+func TotalRowsEQ(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldTotalRows, v))
+}
+
+// TotalRowsNEQ applies the NEQ predicate on the "total_rows" field.
+func TotalRowsNEQ(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNEQ(FieldTotalRows, v))
+}
+
+// TotalRowsIn applies the In predicate on the "total_rows" field.
+func TotalRowsIn(vs ...int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldIn(FieldTotalRows, vs...))
+}
+
+// TotalRowsNotIn applies the NotIn predicate on the "total_rows" field.
+func TotalRowsNotIn(vs ...int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNotIn(FieldTotalRows, vs...))
+}
+
+// TotalRowsGT applies the GT predicate on the "total_rows" field.
+func TotalRowsGT(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGT(FieldTotalRows, v))
+}
+
+// TotalRowsGTE applies the GTE predicate on the "total_rows" field.
+func TotalRowsGTE(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGTE(FieldTotalRows, v))
+}
+
+// TotalRowsLT applies the LT predicate on the "total_rows" field.
+func TotalRowsLT(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLT(FieldTotalRows, v))
+}
+
+// TotalRowsLTE applies the LTE predicate on the "total_rows" field.
+func TotalRowsLTE(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLTE(FieldTotalRows, v))
+}
+
+// ProcessedRowsEQ applies the EQ predicate on the "processed_rows" field.
+func ProcessedRowsEQ(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldProcessedRows, v))
+}
+
+// ProcessedRowsNEQ applies the NEQ predicate on the "processed_rows" field.
+func ProcessedRowsNEQ(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNEQ(FieldProcessedRows, v))
+}
+
+// ProcessedRowsIn applies the In predicate on the "processed_rows" field.
+func ProcessedRowsIn(vs ...int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldIn(FieldProcessedRows, vs...))
+}
+
+// ProcessedRowsNotIn applies the NotIn predicate on the "processed_rows" field.
+func ProcessedRowsNotIn(vs ...int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNotIn(FieldProcessedRows, vs...))
+}
+
+// ProcessedRowsGT applies the GT predicate on the "processed_rows" field.
+func ProcessedRowsGT(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGT(FieldProcessedRows, v))
+}
+
+// ProcessedRowsGTE applies the GTE predicate on the "processed_rows" field.
+func ProcessedRowsGTE(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGTE(FieldProcessedRows, v))
+}
+
+// ProcessedRowsLT applies the LT predicate on the "processed_rows" field.
+func ProcessedRowsLT(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLT(FieldProcessedRows, v))
+}
+
+// ProcessedRowsLTE applies the LTE predicate on the "processed_rows" field.
+func ProcessedRowsLTE(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLTE(FieldProcessedRows, v))
+}
+
+// CreatedCountEQ applies the EQ predicate on the "created_count" field.
+func CreatedCountEQ(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldCreatedCount, v))
+}
+
+// CreatedCountNEQ applies the NEQ predicate on the "created_count" field.
+func CreatedCountNEQ(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNEQ(FieldCreatedCount, v))
+}
+
+// CreatedCountIn applies the In predicate on the "created_count" field.
+func CreatedCountIn(vs ...int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldIn(FieldCreatedCount, vs...))
+}
+
+// CreatedCountNotIn applies the NotIn predicate on the "created_count" field.
+func CreatedCountNotIn(vs ...int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNotIn(FieldCreatedCount, vs...))
+}
+
+// CreatedCountGT applies the GT predicate on the "created_count" field.
+func CreatedCountGT(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGT(FieldCreatedCount, v))
+}
+
+// CreatedCountGTE applies the GTE predicate on the "created_count" field.
+func CreatedCountGTE(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGTE(FieldCreatedCount, v))
+}
+
+// CreatedCountLT applies the LT predicate on the "created_count" field.
+func CreatedCountLT(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLT(FieldCreatedCount, v))
+}
+
+// CreatedCountLTE applies the LTE predicate on the "created_count" field.
+func CreatedCountLTE(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLTE(FieldCreatedCount, v))
+}
+
+// FailedCountEQ applies the EQ predicate on the "failed_count" field.
+func FailedCountEQ(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldFailedCount, v))
+}
+
+// FailedCountNEQ applies the NEQ predicate on the "failed_count" field.
+func FailedCountNEQ(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNEQ(FieldFailedCount, v))
+}
+
+// FailedCountIn applies the In predicate on the "failed_count" field.
+func FailedCountIn(vs ...int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldIn(FieldFailedCount, vs...))
+}
+
+// FailedCountNotIn applies the NotIn predicate on the "failed_count" field.
+func FailedCountNotIn(vs ...int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNotIn(FieldFailedCount, vs...))
+}
+
+// FailedCountGT applies the GT predicate on the "failed_count" field.
+func FailedCountGT(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGT(FieldFailedCount, v))
+}
+
+// FailedCountGTE applies the GTE predicate on the "failed_count" field.
+func FailedCountGTE(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGTE(FieldFailedCount, v))
+}
+
+// FailedCountLT applies the LT predicate on the "failed_count" field.
+func FailedCountLT(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLT(FieldFailedCount, v))
+}
+
+// FailedCountLTE applies the LTE predicate on the "failed_count" field.
+func FailedCountLTE(v int) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLTE(FieldFailedCount, v))
+}
+
+// RowErrorsIsNil applies the IsNil predicate on the "row_errors" field.
+func RowErrorsIsNil() predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldIsNull(FieldRowErrors))
+}
+
+// RowErrorsNotNil applies the NotNil predicate on the "row_errors" field.
+func RowErrorsNotNil() predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNotNull(FieldRowErrors))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldContainsFold(FieldError, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserImportJob) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserImportJob) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserImportJob) predicate.UserImportJob {
+	return predicate.UserImportJob(sql.NotPredicates(p))
+}
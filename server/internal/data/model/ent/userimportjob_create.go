@@ -0,0 +1,494 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/userimportjob"
+	"server/internal/data/model/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserImportJobCreate is the builder for creating a UserImportJob entity.
+type UserImportJobCreate struct {
+	config
+	mutation *UserImportJobMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (_c *UserImportJobCreate) SetStatus(v string) *UserImportJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_c *UserImportJobCreate) SetDryRun(v bool) *UserImportJobCreate {
+	_c.mutation.SetDryRun(v)
+	return _c
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_c *UserImportJobCreate) SetNillableDryRun(v *bool) *UserImportJobCreate {
+	if v != nil {
+		_c.SetDryRun(*v)
+	}
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *UserImportJobCreate) SetOrganizationID(v int) *UserImportJobCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_c *UserImportJobCreate) SetNillableOrganizationID(v *int) *UserImportJobCreate {
+	if v != nil {
+		_c.SetOrganizationID(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *UserImportJobCreate) SetCreatedBy(v int) *UserImportJobCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *UserImportJobCreate) SetNillableCreatedBy(v *int) *UserImportJobCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetTotalRows sets the "total_rows" field.
+func (_c *UserImportJobCreate) SetTotalRows(v int) *UserImportJobCreate {
+	_c.mutation.SetTotalRows(v)
+	return _c
+}
+
+// SetNillableTotalRows sets the "total_rows" field if the given value is not nil.
+func (_c *UserImportJobCreate) SetNillableTotalRows(v *int) *UserImportJobCreate {
+	if v != nil {
+		_c.SetTotalRows(*v)
+	}
+	return _c
+}
+
+// SetProcessedRows sets the "processed_rows" field.
+func (_c *UserImportJobCreate) SetProcessedRows(v int) *UserImportJobCreate {
+	_c.mutation.SetProcessedRows(v)
+	return _c
+}
+
+// SetNillableProcessedRows sets the "processed_rows" field if the given value is not nil.
+func (_c *UserImportJobCreate) SetNillableProcessedRows(v *int) *UserImportJobCreate {
+	if v != nil {
+		_c.SetProcessedRows(*v)
+	}
+	return _c
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (_c *UserImportJobCreate) SetCreatedCount(v int) *UserImportJobCreate {
+	_c.mutation.SetCreatedCount(v)
+	return _c
+}
+
+// SetNillableCreatedCount sets the "created_count" field if the given value is not nil.
+func (_c *UserImportJobCreate) SetNillableCreatedCount(v *int) *UserImportJobCreate {
+	if v != nil {
+		_c.SetCreatedCount(*v)
+	}
+	return _c
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (_c *UserImportJobCreate) SetFailedCount(v int) *UserImportJobCreate {
+	_c.mutation.SetFailedCount(v)
+	return _c
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (_c *UserImportJobCreate) SetNillableFailedCount(v *int) *UserImportJobCreate {
+	if v != nil {
+		_c.SetFailedCount(*v)
+	}
+	return _c
+}
+
+// SetRowErrors sets the "row_errors" field.
+func (_c *UserImportJobCreate) SetRowErrors(v []schema.UserImportRowError) *UserImportJobCreate {
+	_c.mutation.SetRowErrors(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *UserImportJobCreate) SetError(v string) *UserImportJobCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *UserImportJobCreate) SetNillableError(v *string) *UserImportJobCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *UserImportJobCreate) SetFinishedAt(v time.Time) *UserImportJobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *UserImportJobCreate) SetNillableFinishedAt(v *time.Time) *UserImportJobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserImportJobCreate) SetCreatedAt(v time.Time) *UserImportJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserImportJobCreate) SetNillableCreatedAt(v *time.Time) *UserImportJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UserImportJobCreate) SetUpdatedAt(v time.Time) *UserImportJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UserImportJobCreate) SetNillableUpdatedAt(v *time.Time) *UserImportJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the UserImportJobMutation object of the builder.
+func (_c *UserImportJobCreate) Mutation() *UserImportJobMutation {
+	return _c.mutation
+}
+
+// Save creates the UserImportJob in the database.
+func (_c *UserImportJobCreate) Save(ctx context.Context) (*UserImportJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserImportJobCreate) SaveX(ctx context.Context) *UserImportJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserImportJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserImportJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserImportJobCreate) defaults() {
+	if _, ok := _c.mutation.DryRun(); !ok {
+		v := userimportjob.DefaultDryRun
+		_c.mutation.SetDryRun(v)
+	}
+	if _, ok := _c.mutation.OrganizationID(); !ok {
+		v := userimportjob.DefaultOrganizationID
+		_c.mutation.SetOrganizationID(v)
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := userimportjob.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+	if _, ok := _c.mutation.TotalRows(); !ok {
+		v := userimportjob.DefaultTotalRows
+		_c.mutation.SetTotalRows(v)
+	}
+	if _, ok := _c.mutation.ProcessedRows(); !ok {
+		v := userimportjob.DefaultProcessedRows
+		_c.mutation.SetProcessedRows(v)
+	}
+	if _, ok := _c.mutation.CreatedCount(); !ok {
+		v := userimportjob.DefaultCreatedCount
+		_c.mutation.SetCreatedCount(v)
+	}
+	if _, ok := _c.mutation.FailedCount(); !ok {
+		v := userimportjob.DefaultFailedCount
+		_c.mutation.SetFailedCount(v)
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		v := userimportjob.DefaultError
+		_c.mutation.SetError(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := userimportjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := userimportjob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserImportJobCreate) check() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "UserImportJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := userimportjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DryRun(); !ok {
+		return &ValidationError{Name: "dry_run", err: errors.New(`ent: missing required field "UserImportJob.dry_run"`)}
+	}
+	if _, ok := _c.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "UserImportJob.organization_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "UserImportJob.created_by"`)}
+	}
+	if _, ok := _c.mutation.TotalRows(); !ok {
+		return &ValidationError{Name: "total_rows", err: errors.New(`ent: missing required field "UserImportJob.total_rows"`)}
+	}
+	if v, ok := _c.mutation.TotalRows(); ok {
+		if err := userimportjob.TotalRowsValidator(v); err != nil {
+			return &ValidationError{Name: "total_rows", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.total_rows": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProcessedRows(); !ok {
+		return &ValidationError{Name: "processed_rows", err: errors.New(`ent: missing required field "UserImportJob.processed_rows"`)}
+	}
+	if v, ok := _c.mutation.ProcessedRows(); ok {
+		if err := userimportjob.ProcessedRowsValidator(v); err != nil {
+			return &ValidationError{Name: "processed_rows", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.processed_rows": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedCount(); !ok {
+		return &ValidationError{Name: "created_count", err: errors.New(`ent: missing required field "UserImportJob.created_count"`)}
+	}
+	if v, ok := _c.mutation.CreatedCount(); ok {
+		if err := userimportjob.CreatedCountValidator(v); err != nil {
+			return &ValidationError{Name: "created_count", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.created_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FailedCount(); !ok {
+		return &ValidationError{Name: "failed_count", err: errors.New(`ent: missing required field "UserImportJob.failed_count"`)}
+	}
+	if v, ok := _c.mutation.FailedCount(); ok {
+		if err := userimportjob.FailedCountValidator(v); err != nil {
+			return &ValidationError{Name: "failed_count", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.failed_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "UserImportJob.error"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserImportJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserImportJob.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *UserImportJobCreate) sqlSave(ctx context.Context) (*UserImportJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserImportJobCreate) createSpec() (*UserImportJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserImportJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userimportjob.Table, sqlgraph.NewFieldSpec(userimportjob.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(userimportjob.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.DryRun(); ok {
+		_spec.SetField(userimportjob.FieldDryRun, field.TypeBool, value)
+		_node.DryRun = value
+	}
+	if value, ok := _c.mutation.OrganizationID(); ok {
+		_spec.SetField(userimportjob.FieldOrganizationID, field.TypeInt, value)
+		_node.OrganizationID = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(userimportjob.FieldCreatedBy, field.TypeInt, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.TotalRows(); ok {
+		_spec.SetField(userimportjob.FieldTotalRows, field.TypeInt, value)
+		_node.TotalRows = value
+	}
+	if value, ok := _c.mutation.ProcessedRows(); ok {
+		_spec.SetField(userimportjob.FieldProcessedRows, field.TypeInt, value)
+		_node.ProcessedRows = value
+	}
+	if value, ok := _c.mutation.CreatedCount(); ok {
+		_spec.SetField(userimportjob.FieldCreatedCount, field.TypeInt, value)
+		_node.CreatedCount = value
+	}
+	if value, ok := _c.mutation.FailedCount(); ok {
+		_spec.SetField(userimportjob.FieldFailedCount, field.TypeInt, value)
+		_node.FailedCount = value
+	}
+	if value, ok := _c.mutation.RowErrors(); ok {
+		_spec.SetField(userimportjob.FieldRowErrors, field.TypeJSON, value)
+		_node.RowErrors = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(userimportjob.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(userimportjob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(userimportjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(userimportjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// UserImportJobCreateBulk is the builder for creating many UserImportJob entities in bulk.
+type UserImportJobCreateBulk struct {
+	config
+	err      error
+	builders []*UserImportJobCreate
+}
+
+// Save creates the UserImportJob entities in the database.
+func (_c *UserImportJobCreateBulk) Save(ctx context.Context) ([]*UserImportJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserImportJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserImportJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserImportJobCreateBulk) SaveX(ctx context.Context) []*UserImportJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserImportJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserImportJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,816 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/predicate"
+	"server/internal/data/model/ent/userimportjob"
+	"server/internal/data/model/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// UserImportJobUpdate is the builder for updating UserImportJob entities.
+type UserImportJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserImportJobMutation
+}
+
+// Where appends a list predicates to the UserImportJobUpdate builder.
+func (_u *UserImportJobUpdate) Where(ps ...predicate.UserImportJob) *UserImportJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *UserImportJobUpdate) SetStatus(v string) *UserImportJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *UserImportJobUpdate) SetNillableStatus(v *string) *UserImportJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_u *UserImportJobUpdate) SetDryRun(v bool) *UserImportJobUpdate {
+	_u.mutation.SetDryRun(v)
+	return _u
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_u *UserImportJobUpdate) SetNillableDryRun(v *bool) *UserImportJobUpdate {
+	if v != nil {
+		_u.SetDryRun(*v)
+	}
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *UserImportJobUpdate) SetOrganizationID(v int) *UserImportJobUpdate {
+	_u.mutation.ResetOrganizationID()
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *UserImportJobUpdate) SetNillableOrganizationID(v *int) *UserImportJobUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// AddOrganizationID adds value to the "organization_id" field.
+func (_u *UserImportJobUpdate) AddOrganizationID(v int) *UserImportJobUpdate {
+	_u.mutation.AddOrganizationID(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *UserImportJobUpdate) SetCreatedBy(v int) *UserImportJobUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *UserImportJobUpdate) SetNillableCreatedBy(v *int) *UserImportJobUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *UserImportJobUpdate) AddCreatedBy(v int) *UserImportJobUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// SetTotalRows sets the "total_rows" field.
+func (_u *UserImportJobUpdate) SetTotalRows(v int) *UserImportJobUpdate {
+	_u.mutation.ResetTotalRows()
+	_u.mutation.SetTotalRows(v)
+	return _u
+}
+
+// SetNillableTotalRows sets the "total_rows" field if the given value is not nil.
+func (_u *UserImportJobUpdate) SetNillableTotalRows(v *int) *UserImportJobUpdate {
+	if v != nil {
+		_u.SetTotalRows(*v)
+	}
+	return _u
+}
+
+// AddTotalRows adds value to the "total_rows" field.
+func (_u *UserImportJobUpdate) AddTotalRows(v int) *UserImportJobUpdate {
+	_u.mutation.AddTotalRows(v)
+	return _u
+}
+
+// SetProcessedRows sets the "processed_rows" field.
+func (_u *UserImportJobUpdate) SetProcessedRows(v int) *UserImportJobUpdate {
+	_u.mutation.ResetProcessedRows()
+	_u.mutation.SetProcessedRows(v)
+	return _u
+}
+
+// SetNillableProcessedRows sets the "processed_rows" field if the given value is not nil.
+func (_u *UserImportJobUpdate) SetNillableProcessedRows(v *int) *UserImportJobUpdate {
+	if v != nil {
+		_u.SetProcessedRows(*v)
+	}
+	return _u
+}
+
+// AddProcessedRows adds value to the "processed_rows" field.
+func (_u *UserImportJobUpdate) AddProcessedRows(v int) *UserImportJobUpdate {
+	_u.mutation.AddProcessedRows(v)
+	return _u
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (_u *UserImportJobUpdate) SetCreatedCount(v int) *UserImportJobUpdate {
+	_u.mutation.ResetCreatedCount()
+	_u.mutation.SetCreatedCount(v)
+	return _u
+}
+
+// SetNillableCreatedCount sets the "created_count" field if the given value is not nil.
+func (_u *UserImportJobUpdate) SetNillableCreatedCount(v *int) *UserImportJobUpdate {
+	if v != nil {
+		_u.SetCreatedCount(*v)
+	}
+	return _u
+}
+
+// AddCreatedCount adds value to the "created_count" field.
+func (_u *UserImportJobUpdate) AddCreatedCount(v int) *UserImportJobUpdate {
+	_u.mutation.AddCreatedCount(v)
+	return _u
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (_u *UserImportJobUpdate) SetFailedCount(v int) *UserImportJobUpdate {
+	_u.mutation.ResetFailedCount()
+	_u.mutation.SetFailedCount(v)
+	return _u
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (_u *UserImportJobUpdate) SetNillableFailedCount(v *int) *UserImportJobUpdate {
+	if v != nil {
+		_u.SetFailedCount(*v)
+	}
+	return _u
+}
+
+// AddFailedCount adds value to the "failed_count" field.
+func (_u *UserImportJobUpdate) AddFailedCount(v int) *UserImportJobUpdate {
+	_u.mutation.AddFailedCount(v)
+	return _u
+}
+
+// SetRowErrors sets the "row_errors" field.
+func (_u *UserImportJobUpdate) SetRowErrors(v []schema.UserImportRowError) *UserImportJobUpdate {
+	_u.mutation.SetRowErrors(v)
+	return _u
+}
+
+// AppendRowErrors appends value to the "row_errors" field.
+func (_u *UserImportJobUpdate) AppendRowErrors(v []schema.UserImportRowError) *UserImportJobUpdate {
+	_u.mutation.AppendRowErrors(v)
+	return _u
+}
+
+// ClearRowErrors clears the value of the "row_errors" field.
+func (_u *UserImportJobUpdate) ClearRowErrors() *UserImportJobUpdate {
+	_u.mutation.ClearRowErrors()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *UserImportJobUpdate) SetError(v string) *UserImportJobUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *UserImportJobUpdate) SetNillableError(v *string) *UserImportJobUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *UserImportJobUpdate) SetFinishedAt(v time.Time) *UserImportJobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *UserImportJobUpdate) SetNillableFinishedAt(v *time.Time) *UserImportJobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *UserImportJobUpdate) ClearFinishedAt() *UserImportJobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserImportJobUpdate) SetUpdatedAt(v time.Time) *UserImportJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the UserImportJobMutation object of the builder.
+func (_u *UserImportJobUpdate) Mutation() *UserImportJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserImportJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserImportJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserImportJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserImportJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserImportJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userimportjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserImportJobUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := userimportjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalRows(); ok {
+		if err := userimportjob.TotalRowsValidator(v); err != nil {
+			return &ValidationError{Name: "total_rows", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.total_rows": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProcessedRows(); ok {
+		if err := userimportjob.ProcessedRowsValidator(v); err != nil {
+			return &ValidationError{Name: "processed_rows", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.processed_rows": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreatedCount(); ok {
+		if err := userimportjob.CreatedCountValidator(v); err != nil {
+			return &ValidationError{Name: "created_count", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.created_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FailedCount(); ok {
+		if err := userimportjob.FailedCountValidator(v); err != nil {
+			return &ValidationError{Name: "failed_count", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.failed_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserImportJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userimportjob.Table, userimportjob.Columns, sqlgraph.NewFieldSpec(userimportjob.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(userimportjob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DryRun(); ok {
+		_spec.SetField(userimportjob.FieldDryRun, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.OrganizationID(); ok {
+		_spec.SetField(userimportjob.FieldOrganizationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrganizationID(); ok {
+		_spec.AddField(userimportjob.FieldOrganizationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(userimportjob.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(userimportjob.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalRows(); ok {
+		_spec.SetField(userimportjob.FieldTotalRows, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalRows(); ok {
+		_spec.AddField(userimportjob.FieldTotalRows, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProcessedRows(); ok {
+		_spec.SetField(userimportjob.FieldProcessedRows, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProcessedRows(); ok {
+		_spec.AddField(userimportjob.FieldProcessedRows, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedCount(); ok {
+		_spec.SetField(userimportjob.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedCount(); ok {
+		_spec.AddField(userimportjob.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailedCount(); ok {
+		_spec.SetField(userimportjob.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedCount(); ok {
+		_spec.AddField(userimportjob.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RowErrors(); ok {
+		_spec.SetField(userimportjob.FieldRowErrors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRowErrors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, userimportjob.FieldRowErrors, value)
+		})
+	}
+	if _u.mutation.RowErrorsCleared() {
+		_spec.ClearField(userimportjob.FieldRowErrors, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(userimportjob.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(userimportjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(userimportjob.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userimportjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userimportjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserImportJobUpdateOne is the builder for updating a single UserImportJob entity.
+type UserImportJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserImportJobMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *UserImportJobUpdateOne) SetStatus(v string) *UserImportJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *UserImportJobUpdateOne) SetNillableStatus(v *string) *UserImportJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_u *UserImportJobUpdateOne) SetDryRun(v bool) *UserImportJobUpdateOne {
+	_u.mutation.SetDryRun(v)
+	return _u
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_u *UserImportJobUpdateOne) SetNillableDryRun(v *bool) *UserImportJobUpdateOne {
+	if v != nil {
+		_u.SetDryRun(*v)
+	}
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *UserImportJobUpdateOne) SetOrganizationID(v int) *UserImportJobUpdateOne {
+	_u.mutation.ResetOrganizationID()
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *UserImportJobUpdateOne) SetNillableOrganizationID(v *int) *UserImportJobUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// AddOrganizationID adds value to the "organization_id" field.
+func (_u *UserImportJobUpdateOne) AddOrganizationID(v int) *UserImportJobUpdateOne {
+	_u.mutation.AddOrganizationID(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *UserImportJobUpdateOne) SetCreatedBy(v int) *UserImportJobUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *UserImportJobUpdateOne) SetNillableCreatedBy(v *int) *UserImportJobUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *UserImportJobUpdateOne) AddCreatedBy(v int) *UserImportJobUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// SetTotalRows sets the "total_rows" field.
+func (_u *UserImportJobUpdateOne) SetTotalRows(v int) *UserImportJobUpdateOne {
+	_u.mutation.ResetTotalRows()
+	_u.mutation.SetTotalRows(v)
+	return _u
+}
+
+// SetNillableTotalRows sets the "total_rows" field if the given value is not nil.
+func (_u *UserImportJobUpdateOne) SetNillableTotalRows(v *int) *UserImportJobUpdateOne {
+	if v != nil {
+		_u.SetTotalRows(*v)
+	}
+	return _u
+}
+
+// AddTotalRows adds value to the "total_rows" field.
+func (_u *UserImportJobUpdateOne) AddTotalRows(v int) *UserImportJobUpdateOne {
+	_u.mutation.AddTotalRows(v)
+	return _u
+}
+
+// SetProcessedRows sets the "processed_rows" field.
+func (_u *UserImportJobUpdateOne) SetProcessedRows(v int) *UserImportJobUpdateOne {
+	_u.mutation.ResetProcessedRows()
+	_u.mutation.SetProcessedRows(v)
+	return _u
+}
+
+// SetNillableProcessedRows sets the "processed_rows" field if the given value is not nil.
+func (_u *UserImportJobUpdateOne) SetNillableProcessedRows(v *int) *UserImportJobUpdateOne {
+	if v != nil {
+		_u.SetProcessedRows(*v)
+	}
+	return _u
+}
+
+// AddProcessedRows adds value to the "processed_rows" field.
+func (_u *UserImportJobUpdateOne) AddProcessedRows(v int) *UserImportJobUpdateOne {
+	_u.mutation.AddProcessedRows(v)
+	return _u
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (_u *UserImportJobUpdateOne) SetCreatedCount(v int) *UserImportJobUpdateOne {
+	_u.mutation.ResetCreatedCount()
+	_u.mutation.SetCreatedCount(v)
+	return _u
+}
+
+// SetNillableCreatedCount sets the "created_count" field if the given value is not nil.
+func (_u *UserImportJobUpdateOne) SetNillableCreatedCount(v *int) *UserImportJobUpdateOne {
+	if v != nil {
+		_u.SetCreatedCount(*v)
+	}
+	return _u
+}
+
+// AddCreatedCount adds value to the "created_count" field.
+func (_u *UserImportJobUpdateOne) AddCreatedCount(v int) *UserImportJobUpdateOne {
+	_u.mutation.AddCreatedCount(v)
+	return _u
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (_u *UserImportJobUpdateOne) SetFailedCount(v int) *UserImportJobUpdateOne {
+	_u.mutation.ResetFailedCount()
+	_u.mutation.SetFailedCount(v)
+	return _u
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (_u *UserImportJobUpdateOne) SetNillableFailedCount(v *int) *UserImportJobUpdateOne {
+	if v != nil {
+		_u.SetFailedCount(*v)
+	}
+	return _u
+}
+
+// AddFailedCount adds value to the "failed_count" field.
+func (_u *UserImportJobUpdateOne) AddFailedCount(v int) *UserImportJobUpdateOne {
+	_u.mutation.AddFailedCount(v)
+	return _u
+}
+
+// SetRowErrors sets the "row_errors" field.
+func (_u *UserImportJobUpdateOne) SetRowErrors(v []schema.UserImportRowError) *UserImportJobUpdateOne {
+	_u.mutation.SetRowErrors(v)
+	return _u
+}
+
+// AppendRowErrors appends value to the "row_errors" field.
+func (_u *UserImportJobUpdateOne) AppendRowErrors(v []schema.UserImportRowError) *UserImportJobUpdateOne {
+	_u.mutation.AppendRowErrors(v)
+	return _u
+}
+
+// ClearRowErrors clears the value of the "row_errors" field.
+func (_u *UserImportJobUpdateOne) ClearRowErrors() *UserImportJobUpdateOne {
+	_u.mutation.ClearRowErrors()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *UserImportJobUpdateOne) SetError(v string) *UserImportJobUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *UserImportJobUpdateOne) SetNillableError(v *string) *UserImportJobUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *UserImportJobUpdateOne) SetFinishedAt(v time.Time) *UserImportJobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *UserImportJobUpdateOne) SetNillableFinishedAt(v *time.Time) *UserImportJobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *UserImportJobUpdateOne) ClearFinishedAt() *UserImportJobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserImportJobUpdateOne) SetUpdatedAt(v time.Time) *UserImportJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the UserImportJobMutation object of the builder.
+func (_u *UserImportJobUpdateOne) Mutation() *UserImportJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserImportJobUpdate builder.
+func (_u *UserImportJobUpdateOne) Where(ps ...predicate.UserImportJob) *UserImportJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserImportJobUpdateOne) Select(field string, fields ...string) *UserImportJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserImportJob entity.
+func (_u *UserImportJobUpdateOne) Save(ctx context.Context) (*UserImportJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserImportJobUpdateOne) SaveX(ctx context.Context) *UserImportJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserImportJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserImportJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserImportJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userimportjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserImportJobUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := userimportjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalRows(); ok {
+		if err := userimportjob.TotalRowsValidator(v); err != nil {
+			return &ValidationError{Name: "total_rows", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.total_rows": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProcessedRows(); ok {
+		if err := userimportjob.ProcessedRowsValidator(v); err != nil {
+			return &ValidationError{Name: "processed_rows", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.processed_rows": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreatedCount(); ok {
+		if err := userimportjob.CreatedCountValidator(v); err != nil {
+			return &ValidationError{Name: "created_count", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.created_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FailedCount(); ok {
+		if err := userimportjob.FailedCountValidator(v); err != nil {
+			return &ValidationError{Name: "failed_count", err: fmt.Errorf(`ent: validator failed for field "UserImportJob.failed_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserImportJobUpdateOne) sqlSave(ctx context.Context) (_node *UserImportJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userimportjob.Table, userimportjob.Columns, sqlgraph.NewFieldSpec(userimportjob.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserImportJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userimportjob.FieldID)
+		for _, f := range fields {
+			if !userimportjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userimportjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(userimportjob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DryRun(); ok {
+		_spec.SetField(userimportjob.FieldDryRun, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.OrganizationID(); ok {
+		_spec.SetField(userimportjob.FieldOrganizationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrganizationID(); ok {
+		_spec.AddField(userimportjob.FieldOrganizationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(userimportjob.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(userimportjob.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalRows(); ok {
+		_spec.SetField(userimportjob.FieldTotalRows, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalRows(); ok {
+		_spec.AddField(userimportjob.FieldTotalRows, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProcessedRows(); ok {
+		_spec.SetField(userimportjob.FieldProcessedRows, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProcessedRows(); ok {
+		_spec.AddField(userimportjob.FieldProcessedRows, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedCount(); ok {
+		_spec.SetField(userimportjob.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedCount(); ok {
+		_spec.AddField(userimportjob.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailedCount(); ok {
+		_spec.SetField(userimportjob.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedCount(); ok {
+		_spec.AddField(userimportjob.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RowErrors(); ok {
+		_spec.SetField(userimportjob.FieldRowErrors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRowErrors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, userimportjob.FieldRowErrors, value)
+		})
+	}
+	if _u.mutation.RowErrorsCleared() {
+		_spec.ClearField(userimportjob.FieldRowErrors, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(userimportjob.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(userimportjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(userimportjob.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userimportjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &UserImportJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userimportjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
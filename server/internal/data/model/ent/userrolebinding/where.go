@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userrolebinding
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserRoleID applies equality check predicate on the "user_role_id" field. It's identical to UserRoleIDEQ.
+func UserRoleID(v int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldEQ(FieldUserRoleID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserRoleIDEQ applies the EQ predicate on the "user_role_id" field.
+func UserRoleIDEQ(v int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldEQ(FieldUserRoleID, v))
+}
+
+// UserRoleIDNEQ applies the NEQ predicate on the "user_role_id" field.
+func UserRoleIDNEQ(v int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldNEQ(FieldUserRoleID, v))
+}
+
+// UserRoleIDIn applies the In predicate on the "user_role_id" field.
+func UserRoleIDIn(vs ...int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldIn(FieldUserRoleID, vs...))
+}
+
+// UserRoleIDNotIn applies the NotIn predicate on the "user_role_id" field.
+func UserRoleIDNotIn(vs ...int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldNotIn(FieldUserRoleID, vs...))
+}
+
+// UserRoleIDGT applies the GT predicate on the "user_role_id" field.
+func UserRoleIDGT(v int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldGT(FieldUserRoleID, v))
+}
+
+// UserRoleIDGTE applies the GTE predicate on the "user_role_id" field.
+func UserRoleIDGTE(v int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldGTE(FieldUserRoleID, v))
+}
+
+// UserRoleIDLT applies the LT predicate on the "user_role_id" field.
+func UserRoleIDLT(v int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldLT(FieldUserRoleID, v))
+}
+
+// UserRoleIDLTE applies the LTE predicate on the "user_role_id" field.
+func UserRoleIDLTE(v int) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldLTE(FieldUserRoleID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserRoleBinding) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserRoleBinding) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserRoleBinding) predicate.UserRoleBinding {
+	return predicate.UserRoleBinding(sql.NotPredicates(p))
+}
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/userrolebinding"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserRoleBindingCreate is the builder for creating a UserRoleBinding entity.
+type UserRoleBindingCreate struct {
+	config
+	mutation *UserRoleBindingMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UserRoleBindingCreate) SetUserID(v int) *UserRoleBindingCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetUserRoleID sets the "user_role_id" field.
+func (_c *UserRoleBindingCreate) SetUserRoleID(v int) *UserRoleBindingCreate {
+	_c.mutation.SetUserRoleID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserRoleBindingCreate) SetCreatedAt(v time.Time) *UserRoleBindingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserRoleBindingCreate) SetNillableCreatedAt(v *time.Time) *UserRoleBindingCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the UserRoleBindingMutation object of the builder.
+func (_c *UserRoleBindingCreate) Mutation() *UserRoleBindingMutation {
+	return _c.mutation
+}
+
+// Save creates the UserRoleBinding in the database.
+func (_c *UserRoleBindingCreate) Save(ctx context.Context) (*UserRoleBinding, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserRoleBindingCreate) SaveX(ctx context.Context) *UserRoleBinding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserRoleBindingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserRoleBindingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserRoleBindingCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := userrolebinding.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserRoleBindingCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserRoleBinding.user_id"`)}
+	}
+	if _, ok := _c.mutation.UserRoleID(); !ok {
+		return &ValidationError{Name: "user_role_id", err: errors.New(`ent: missing required field "UserRoleBinding.user_role_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserRoleBinding.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *UserRoleBindingCreate) sqlSave(ctx context.Context) (*UserRoleBinding, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserRoleBindingCreate) createSpec() (*UserRoleBinding, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserRoleBinding{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userrolebinding.Table, sqlgraph.NewFieldSpec(userrolebinding.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(userrolebinding.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.UserRoleID(); ok {
+		_spec.SetField(userrolebinding.FieldUserRoleID, field.TypeInt, value)
+		_node.UserRoleID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(userrolebinding.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// UserRoleBindingCreateBulk is the builder for creating many UserRoleBinding entities in bulk.
+type UserRoleBindingCreateBulk struct {
+	config
+	err      error
+	builders []*UserRoleBindingCreate
+}
+
+// Save creates the UserRoleBinding entities in the database.
+func (_c *UserRoleBindingCreateBulk) Save(ctx context.Context) ([]*UserRoleBinding, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserRoleBinding, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserRoleBindingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserRoleBindingCreateBulk) SaveX(ctx context.Context) []*UserRoleBinding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserRoleBindingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserRoleBindingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/predicate"
+	"server/internal/data/model/ent/userrolebinding"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserRoleBindingUpdate is the builder for updating UserRoleBinding entities.
+type UserRoleBindingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserRoleBindingMutation
+}
+
+// Where appends a list predicates to the UserRoleBindingUpdate builder.
+func (_u *UserRoleBindingUpdate) Where(ps ...predicate.UserRoleBinding) *UserRoleBindingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserRoleBindingUpdate) SetUserID(v int) *UserRoleBindingUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserRoleBindingUpdate) SetNillableUserID(v *int) *UserRoleBindingUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *UserRoleBindingUpdate) AddUserID(v int) *UserRoleBindingUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetUserRoleID sets the "user_role_id" field.
+func (_u *UserRoleBindingUpdate) SetUserRoleID(v int) *UserRoleBindingUpdate {
+	_u.mutation.ResetUserRoleID()
+	_u.mutation.SetUserRoleID(v)
+	return _u
+}
+
+// SetNillableUserRoleID sets the "user_role_id" field if the given value is not nil.
+func (_u *UserRoleBindingUpdate) SetNillableUserRoleID(v *int) *UserRoleBindingUpdate {
+	if v != nil {
+		_u.SetUserRoleID(*v)
+	}
+	return _u
+}
+
+// AddUserRoleID adds value to the "user_role_id" field.
+func (_u *UserRoleBindingUpdate) AddUserRoleID(v int) *UserRoleBindingUpdate {
+	_u.mutation.AddUserRoleID(v)
+	return _u
+}
+
+// Mutation returns the UserRoleBindingMutation object of the builder.
+func (_u *UserRoleBindingUpdate) Mutation() *UserRoleBindingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserRoleBindingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserRoleBindingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserRoleBindingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserRoleBindingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *UserRoleBindingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(userrolebinding.Table, userrolebinding.Columns, sqlgraph.NewFieldSpec(userrolebinding.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(userrolebinding.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(userrolebinding.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserRoleID(); ok {
+		_spec.SetField(userrolebinding.FieldUserRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserRoleID(); ok {
+		_spec.AddField(userrolebinding.FieldUserRoleID, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userrolebinding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserRoleBindingUpdateOne is the builder for updating a single UserRoleBinding entity.
+type UserRoleBindingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserRoleBindingMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserRoleBindingUpdateOne) SetUserID(v int) *UserRoleBindingUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserRoleBindingUpdateOne) SetNillableUserID(v *int) *UserRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *UserRoleBindingUpdateOne) AddUserID(v int) *UserRoleBindingUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetUserRoleID sets the "user_role_id" field.
+func (_u *UserRoleBindingUpdateOne) SetUserRoleID(v int) *UserRoleBindingUpdateOne {
+	_u.mutation.ResetUserRoleID()
+	_u.mutation.SetUserRoleID(v)
+	return _u
+}
+
+// SetNillableUserRoleID sets the "user_role_id" field if the given value is not nil.
+func (_u *UserRoleBindingUpdateOne) SetNillableUserRoleID(v *int) *UserRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetUserRoleID(*v)
+	}
+	return _u
+}
+
+// AddUserRoleID adds value to the "user_role_id" field.
+func (_u *UserRoleBindingUpdateOne) AddUserRoleID(v int) *UserRoleBindingUpdateOne {
+	_u.mutation.AddUserRoleID(v)
+	return _u
+}
+
+// Mutation returns the UserRoleBindingMutation object of the builder.
+func (_u *UserRoleBindingUpdateOne) Mutation() *UserRoleBindingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserRoleBindingUpdate builder.
+func (_u *UserRoleBindingUpdateOne) Where(ps ...predicate.UserRoleBinding) *UserRoleBindingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserRoleBindingUpdateOne) Select(field string, fields ...string) *UserRoleBindingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserRoleBinding entity.
+func (_u *UserRoleBindingUpdateOne) Save(ctx context.Context) (*UserRoleBinding, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserRoleBindingUpdateOne) SaveX(ctx context.Context) *UserRoleBinding {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserRoleBindingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserRoleBindingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *UserRoleBindingUpdateOne) sqlSave(ctx context.Context) (_node *UserRoleBinding, err error) {
+	_spec := sqlgraph.NewUpdateSpec(userrolebinding.Table, userrolebinding.Columns, sqlgraph.NewFieldSpec(userrolebinding.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserRoleBinding.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userrolebinding.FieldID)
+		for _, f := range fields {
+			if !userrolebinding.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userrolebinding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(userrolebinding.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(userrolebinding.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserRoleID(); ok {
+		_spec.SetField(userrolebinding.FieldUserRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserRoleID(); ok {
+		_spec.AddField(userrolebinding.FieldUserRoleID, field.TypeInt, value)
+	}
+	_node = &UserRoleBinding{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userrolebinding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
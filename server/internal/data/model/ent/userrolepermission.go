@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"server/internal/data/model/ent/userrolepermission"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// UserRolePermission is the model entity for the UserRolePermission schema.
+type UserRolePermission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserRoleID holds the value of the "user_role_id" field.
+	UserRoleID int `json:"user_role_id,omitempty"`
+	// PermissionKey holds the value of the "permission_key" field.
+	PermissionKey string `json:"permission_key,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserRolePermission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userrolepermission.FieldID, userrolepermission.FieldUserRoleID:
+			values[i] = new(sql.NullInt64)
+		case userrolepermission.FieldPermissionKey:
+			values[i] = new(sql.NullString)
+		case userrolepermission.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserRolePermission fields.
+func (_m *UserRolePermission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userrolepermission.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case userrolepermission.FieldUserRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_role_id", values[i])
+			} else if value.Valid {
+				_m.UserRoleID = int(value.Int64)
+			}
+		case userrolepermission.FieldPermissionKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field permission_key", values[i])
+			} else if value.Valid {
+				_m.PermissionKey = value.String
+			}
+		case userrolepermission.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserRolePermission.
+// This includes values selected through modifiers, order, etc.
+func (_m *UserRolePermission) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserRolePermission.
+// Note that you need to call UserRolePermission.Unwrap() before calling this method if this UserRolePermission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UserRolePermission) Update() *UserRolePermissionUpdateOne {
+	return NewUserRolePermissionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UserRolePermission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UserRolePermission) Unwrap() *UserRolePermission {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserRolePermission is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UserRolePermission) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserRolePermission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_role_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserRoleID))
+	builder.WriteString(", ")
+	builder.WriteString("permission_key=")
+	builder.WriteString(_m.PermissionKey)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserRolePermissions is a parsable slice of UserRolePermission.
+type UserRolePermissions []*UserRolePermission
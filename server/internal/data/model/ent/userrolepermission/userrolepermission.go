@@ -0,0 +1,72 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userrolepermission
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the userrolepermission type in the database.
+	Label = "user_role_permission"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserRoleID holds the string denoting the user_role_id field in the database.
+	FieldUserRoleID = "user_role_id"
+	// FieldPermissionKey holds the string denoting the permission_key field in the database.
+	FieldPermissionKey = "permission_key"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the userrolepermission in the database.
+	Table = "user_role_permissions"
+)
+
+// Columns holds all SQL columns for userrolepermission fields.
+var Columns = []string{
+	FieldID,
+	FieldUserRoleID,
+	FieldPermissionKey,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PermissionKeyValidator is a validator for the "permission_key" field. It is called by the builders before save.
+	PermissionKeyValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the UserRolePermission queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserRoleID orders the results by the user_role_id field.
+func ByUserRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserRoleID, opts...).ToFunc()
+}
+
+// ByPermissionKey orders the results by the permission_key field.
+func ByPermissionKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPermissionKey, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userrolepermission
+
+import (
+	"server/internal/data/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldLTE(FieldID, id))
+}
+
+// UserRoleID applies equality check predicate on the "user_role_id" field. It's identical to UserRoleIDEQ.
+func UserRoleID(v int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldEQ(FieldUserRoleID, v))
+}
+
+// PermissionKey applies equality check predicate on the "permission_key" field. It's identical to PermissionKeyEQ.
+func PermissionKey(v string) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldEQ(FieldPermissionKey, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserRoleIDEQ applies the EQ predicate on the "user_role_id" field.
+func UserRoleIDEQ(v int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldEQ(FieldUserRoleID, v))
+}
+
+// UserRoleIDNEQ applies the NEQ predicate on the "user_role_id" field.
+func UserRoleIDNEQ(v int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldNEQ(FieldUserRoleID, v))
+}
+
+// UserRoleIDIn applies the In predicate on the "user_role_id" field.
+func UserRoleIDIn(vs ...int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldIn(FieldUserRoleID, vs...))
+}
+
+// UserRoleIDNotIn applies the NotIn predicate on the "user_role_id" field.
+func UserRoleIDNotIn(vs ...int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldNotIn(FieldUserRoleID, vs...))
+}
+
+// UserRoleIDGT applies the GT predicate on the "user_role_id" field.
+func UserRoleIDGT(v int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldGT(FieldUserRoleID, v))
+}
+
+// UserRoleIDGTE applies the GTE predicate on the "user_role_id" field.
+func UserRoleIDGTE(v int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldGTE(FieldUserRoleID, v))
+}
+
+// UserRoleIDLT applies the LT predicate on the "user_role_id" field.
+func UserRoleIDLT(v int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldLT(FieldUserRoleID, v))
+}
+
+// UserRoleIDLTE applies the LTE predicate on the "user_role_id" field.
+func UserRoleIDLTE(v int) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldLTE(FieldUserRoleID, v))
+}
+
+// PermissionKeyEQ applies the EQ predicate on the "permission_key" field.
+func PermissionKeyEQ(v string) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldEQ(FieldPermissionKey, v))
+}
+
+// PermissionKeyNEQ applies the NEQ predicate on the "permission_key" field.
+func PermissionKeyNEQ(v string) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldNEQ(FieldPermissionKey, v))
+}
+
+// PermissionKeyIn applies the In predicate on the "permission_key" field.
+func PermissionKeyIn(vs ...string) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldIn(FieldPermissionKey, vs...))
+}
+
+// PermissionKeyNotIn applies the NotIn predicate on the "permission_key" field.
+func PermissionKeyNotIn(vs ...string) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldNotIn(FieldPermissionKey, vs...))
+}
+
+// PermissionKeyGT applies the GT predicate on the "permission_key" field.
+func PermissionKeyGT(v string) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldGT(FieldPermissionKey, v))
+}
+
+// PermissionKeyGTE applies the GTE predicate on the "permission_key" field.
+func PermissionKeyGTE(v string) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldGTE(FieldPermissionKey, v))
+}
+
+// PermissionKeyLT applies the LT predicate on the "permission_key" field.
+func PermissionKeyLT(v string) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldLT(FieldPermissionKey, v))
+}
+
+// PermissionKeyLTE applies the LTE predicate on the "permission_key" field.
+func PermissionKeyLTE(v string) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldLTE(FieldPermissionKey, v))
+}
+
+// PermissionKeyContains applies the Contains predicate on the "permission_key" field.
+func PermissionKeyContains(v string) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldContains(FieldPermissionKey, v))
+}
+
+// PermissionKeyHasPrefix applies the HasPrefix predicate on the "permission_key" field.
+func PermissionKeyHasPrefix(v string) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldHasPrefix(FieldPermissionKey, v))
+}
+
+// PermissionKeyHasSuffix applies the HasSuffix predicate on the "permission_key" field.
+func PermissionKeyHasSuffix(v string) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldHasSuffix(FieldPermissionKey, v))
+}
+
+// PermissionKeyEqualFold applies the EqualFold predicate on the "permission_key" field.
+func PermissionKeyEqualFold(v string) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldEqualFold(FieldPermissionKey, v))
+}
+
+// PermissionKeyContainsFold applies the ContainsFold predicate on the "permission_key" field.
+func PermissionKeyContainsFold(v string) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldContainsFold(FieldPermissionKey, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserRolePermission) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserRolePermission) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserRolePermission) predicate.UserRolePermission {
+	return predicate.UserRolePermission(sql.NotPredicates(p))
+}
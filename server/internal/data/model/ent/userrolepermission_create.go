@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/userrolepermission"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserRolePermissionCreate is the builder for creating a UserRolePermission entity.
+type UserRolePermissionCreate struct {
+	config
+	mutation *UserRolePermissionMutation
+	hooks    []Hook
+}
+
+// SetUserRoleID sets the "user_role_id" field.
+func (_c *UserRolePermissionCreate) SetUserRoleID(v int) *UserRolePermissionCreate {
+	_c.mutation.SetUserRoleID(v)
+	return _c
+}
+
+// SetPermissionKey sets the "permission_key" field.
+func (_c *UserRolePermissionCreate) SetPermissionKey(v string) *UserRolePermissionCreate {
+	_c.mutation.SetPermissionKey(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserRolePermissionCreate) SetCreatedAt(v time.Time) *UserRolePermissionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserRolePermissionCreate) SetNillableCreatedAt(v *time.Time) *UserRolePermissionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the UserRolePermissionMutation object of the builder.
+func (_c *UserRolePermissionCreate) Mutation() *UserRolePermissionMutation {
+	return _c.mutation
+}
+
+// Save creates the UserRolePermission in the database.
+func (_c *UserRolePermissionCreate) Save(ctx context.Context) (*UserRolePermission, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserRolePermissionCreate) SaveX(ctx context.Context) *UserRolePermission {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserRolePermissionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserRolePermissionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserRolePermissionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := userrolepermission.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserRolePermissionCreate) check() error {
+	if _, ok := _c.mutation.UserRoleID(); !ok {
+		return &ValidationError{Name: "user_role_id", err: errors.New(`ent: missing required field "UserRolePermission.user_role_id"`)}
+	}
+	if _, ok := _c.mutation.PermissionKey(); !ok {
+		return &ValidationError{Name: "permission_key", err: errors.New(`ent: missing required field "UserRolePermission.permission_key"`)}
+	}
+	if v, ok := _c.mutation.PermissionKey(); ok {
+		if err := userrolepermission.PermissionKeyValidator(v); err != nil {
+			return &ValidationError{Name: "permission_key", err: fmt.Errorf(`ent: validator failed for field "UserRolePermission.permission_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserRolePermission.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *UserRolePermissionCreate) sqlSave(ctx context.Context) (*UserRolePermission, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserRolePermissionCreate) createSpec() (*UserRolePermission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserRolePermission{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userrolepermission.Table, sqlgraph.NewFieldSpec(userrolepermission.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.UserRoleID(); ok {
+		_spec.SetField(userrolepermission.FieldUserRoleID, field.TypeInt, value)
+		_node.UserRoleID = value
+	}
+	if value, ok := _c.mutation.PermissionKey(); ok {
+		_spec.SetField(userrolepermission.FieldPermissionKey, field.TypeString, value)
+		_node.PermissionKey = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(userrolepermission.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// UserRolePermissionCreateBulk is the builder for creating many UserRolePermission entities in bulk.
+type UserRolePermissionCreateBulk struct {
+	config
+	err      error
+	builders []*UserRolePermissionCreate
+}
+
+// Save creates the UserRolePermission entities in the database.
+func (_c *UserRolePermissionCreateBulk) Save(ctx context.Context) ([]*UserRolePermission, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserRolePermission, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserRolePermissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserRolePermissionCreateBulk) SaveX(ctx context.Context) []*UserRolePermission {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserRolePermissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserRolePermissionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
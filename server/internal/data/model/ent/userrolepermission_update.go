@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/predicate"
+	"server/internal/data/model/ent/userrolepermission"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserRolePermissionUpdate is the builder for updating UserRolePermission entities.
+type UserRolePermissionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserRolePermissionMutation
+}
+
+// Where appends a list predicates to the UserRolePermissionUpdate builder.
+func (_u *UserRolePermissionUpdate) Where(ps ...predicate.UserRolePermission) *UserRolePermissionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserRoleID sets the "user_role_id" field.
+func (_u *UserRolePermissionUpdate) SetUserRoleID(v int) *UserRolePermissionUpdate {
+	_u.mutation.ResetUserRoleID()
+	_u.mutation.SetUserRoleID(v)
+	return _u
+}
+
+// SetNillableUserRoleID sets the "user_role_id" field if the given value is not nil.
+func (_u *UserRolePermissionUpdate) SetNillableUserRoleID(v *int) *UserRolePermissionUpdate {
+	if v != nil {
+		_u.SetUserRoleID(*v)
+	}
+	return _u
+}
+
+// AddUserRoleID adds value to the "user_role_id" field.
+func (_u *UserRolePermissionUpdate) AddUserRoleID(v int) *UserRolePermissionUpdate {
+	_u.mutation.AddUserRoleID(v)
+	return _u
+}
+
+// SetPermissionKey sets the "permission_key" field.
+func (_u *UserRolePermissionUpdate) SetPermissionKey(v string) *UserRolePermissionUpdate {
+	_u.mutation.SetPermissionKey(v)
+	return _u
+}
+
+// SetNillablePermissionKey sets the "permission_key" field if the given value is not nil.
+func (_u *UserRolePermissionUpdate) SetNillablePermissionKey(v *string) *UserRolePermissionUpdate {
+	if v != nil {
+		_u.SetPermissionKey(*v)
+	}
+	return _u
+}
+
+// Mutation returns the UserRolePermissionMutation object of the builder.
+func (_u *UserRolePermissionUpdate) Mutation() *UserRolePermissionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserRolePermissionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserRolePermissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserRolePermissionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserRolePermissionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserRolePermissionUpdate) check() error {
+	if v, ok := _u.mutation.PermissionKey(); ok {
+		if err := userrolepermission.PermissionKeyValidator(v); err != nil {
+			return &ValidationError{Name: "permission_key", err: fmt.Errorf(`ent: validator failed for field "UserRolePermission.permission_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserRolePermissionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userrolepermission.Table, userrolepermission.Columns, sqlgraph.NewFieldSpec(userrolepermission.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserRoleID(); ok {
+		_spec.SetField(userrolepermission.FieldUserRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserRoleID(); ok {
+		_spec.AddField(userrolepermission.FieldUserRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PermissionKey(); ok {
+		_spec.SetField(userrolepermission.FieldPermissionKey, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userrolepermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserRolePermissionUpdateOne is the builder for updating a single UserRolePermission entity.
+type UserRolePermissionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserRolePermissionMutation
+}
+
+// SetUserRoleID sets the "user_role_id" field.
+func (_u *UserRolePermissionUpdateOne) SetUserRoleID(v int) *UserRolePermissionUpdateOne {
+	_u.mutation.ResetUserRoleID()
+	_u.mutation.SetUserRoleID(v)
+	return _u
+}
+
+// SetNillableUserRoleID sets the "user_role_id" field if the given value is not nil.
+func (_u *UserRolePermissionUpdateOne) SetNillableUserRoleID(v *int) *UserRolePermissionUpdateOne {
+	if v != nil {
+		_u.SetUserRoleID(*v)
+	}
+	return _u
+}
+
+// AddUserRoleID adds value to the "user_role_id" field.
+func (_u *UserRolePermissionUpdateOne) AddUserRoleID(v int) *UserRolePermissionUpdateOne {
+	_u.mutation.AddUserRoleID(v)
+	return _u
+}
+
+// SetPermissionKey sets the "permission_key" field.
+func (_u *UserRolePermissionUpdateOne) SetPermissionKey(v string) *UserRolePermissionUpdateOne {
+	_u.mutation.SetPermissionKey(v)
+	return _u
+}
+
+// SetNillablePermissionKey sets the "permission_key" field if the given value is not nil.
+func (_u *UserRolePermissionUpdateOne) SetNillablePermissionKey(v *string) *UserRolePermissionUpdateOne {
+	if v != nil {
+		_u.SetPermissionKey(*v)
+	}
+	return _u
+}
+
+// Mutation returns the UserRolePermissionMutation object of the builder.
+func (_u *UserRolePermissionUpdateOne) Mutation() *UserRolePermissionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserRolePermissionUpdate builder.
+func (_u *UserRolePermissionUpdateOne) Where(ps ...predicate.UserRolePermission) *UserRolePermissionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserRolePermissionUpdateOne) Select(field string, fields ...string) *UserRolePermissionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserRolePermission entity.
+func (_u *UserRolePermissionUpdateOne) Save(ctx context.Context) (*UserRolePermission, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserRolePermissionUpdateOne) SaveX(ctx context.Context) *UserRolePermission {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserRolePermissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserRolePermissionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserRolePermissionUpdateOne) check() error {
+	if v, ok := _u.mutation.PermissionKey(); ok {
+		if err := userrolepermission.PermissionKeyValidator(v); err != nil {
+			return &ValidationError{Name: "permission_key", err: fmt.Errorf(`ent: validator failed for field "UserRolePermission.permission_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserRolePermissionUpdateOne) sqlSave(ctx context.Context) (_node *UserRolePermission, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userrolepermission.Table, userrolepermission.Columns, sqlgraph.NewFieldSpec(userrolepermission.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserRolePermission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userrolepermission.FieldID)
+		for _, f := range fields {
+			if !userrolepermission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userrolepermission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserRoleID(); ok {
+		_spec.SetField(userrolepermission.FieldUserRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserRoleID(); ok {
+		_spec.AddField(userrolepermission.FieldUserRoleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PermissionKey(); ok {
+		_spec.SetField(userrolepermission.FieldPermissionKey, field.TypeString, value)
+	}
+	_node = &UserRolePermission{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userrolepermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
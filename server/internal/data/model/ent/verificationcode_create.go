@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/verificationcode"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VerificationCodeCreate is the builder for creating a VerificationCode entity.
+type VerificationCodeCreate struct {
+	config
+	mutation *VerificationCodeMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *VerificationCodeCreate) SetUserID(v int) *VerificationCodeCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetChannel sets the "channel" field.
+func (_c *VerificationCodeCreate) SetChannel(v string) *VerificationCodeCreate {
+	_c.mutation.SetChannel(v)
+	return _c
+}
+
+// SetTarget sets the "target" field.
+func (_c *VerificationCodeCreate) SetTarget(v string) *VerificationCodeCreate {
+	_c.mutation.SetTarget(v)
+	return _c
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_c *VerificationCodeCreate) SetCodeHash(v string) *VerificationCodeCreate {
+	_c.mutation.SetCodeHash(v)
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *VerificationCodeCreate) SetAttempts(v int) *VerificationCodeCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *VerificationCodeCreate) SetNillableAttempts(v *int) *VerificationCodeCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *VerificationCodeCreate) SetExpiresAt(v time.Time) *VerificationCodeCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (_c *VerificationCodeCreate) SetConsumedAt(v time.Time) *VerificationCodeCreate {
+	_c.mutation.SetConsumedAt(v)
+	return _c
+}
+
+// SetNillableConsumedAt sets the "consumed_at" field if the given value is not nil.
+func (_c *VerificationCodeCreate) SetNillableConsumedAt(v *time.Time) *VerificationCodeCreate {
+	if v != nil {
+		_c.SetConsumedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VerificationCodeCreate) SetCreatedAt(v time.Time) *VerificationCodeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VerificationCodeCreate) SetNillableCreatedAt(v *time.Time) *VerificationCodeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the VerificationCodeMutation object of the builder.
+func (_c *VerificationCodeCreate) Mutation() *VerificationCodeMutation {
+	return _c.mutation
+}
+
+// Save creates the VerificationCode in the database.
+func (_c *VerificationCodeCreate) Save(ctx context.Context) (*VerificationCode, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VerificationCodeCreate) SaveX(ctx context.Context) *VerificationCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VerificationCodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VerificationCodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VerificationCodeCreate) defaults() {
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := verificationcode.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := verificationcode.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VerificationCodeCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "VerificationCode.user_id"`)}
+	}
+	if _, ok := _c.mutation.Channel(); !ok {
+		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required field "VerificationCode.channel"`)}
+	}
+	if v, ok := _c.mutation.Channel(); ok {
+		if err := verificationcode.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.channel": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "VerificationCode.target"`)}
+	}
+	if v, ok := _c.mutation.Target(); ok {
+		if err := verificationcode.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.target": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "VerificationCode.code_hash"`)}
+	}
+	if v, ok := _c.mutation.CodeHash(); ok {
+		if err := verificationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "VerificationCode.attempts"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "VerificationCode.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VerificationCode.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *VerificationCodeCreate) sqlSave(ctx context.Context) (*VerificationCode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VerificationCodeCreate) createSpec() (*VerificationCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VerificationCode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(verificationcode.Table, sqlgraph.NewFieldSpec(verificationcode.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(verificationcode.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Channel(); ok {
+		_spec.SetField(verificationcode.FieldChannel, field.TypeString, value)
+		_node.Channel = value
+	}
+	if value, ok := _c.mutation.Target(); ok {
+		_spec.SetField(verificationcode.FieldTarget, field.TypeString, value)
+		_node.Target = value
+	}
+	if value, ok := _c.mutation.CodeHash(); ok {
+		_spec.SetField(verificationcode.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(verificationcode.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(verificationcode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.ConsumedAt(); ok {
+		_spec.SetField(verificationcode.FieldConsumedAt, field.TypeTime, value)
+		_node.ConsumedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(verificationcode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// VerificationCodeCreateBulk is the builder for creating many VerificationCode entities in bulk.
+type VerificationCodeCreateBulk struct {
+	config
+	err      error
+	builders []*VerificationCodeCreate
+}
+
+// Save creates the VerificationCode entities in the database.
+func (_c *VerificationCodeCreateBulk) Save(ctx context.Context) ([]*VerificationCode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VerificationCode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VerificationCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VerificationCodeCreateBulk) SaveX(ctx context.Context) []*VerificationCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VerificationCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VerificationCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
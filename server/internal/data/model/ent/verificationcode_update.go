@@ -0,0 +1,518 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/data/model/ent/predicate"
+	"server/internal/data/model/ent/verificationcode"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VerificationCodeUpdate is the builder for updating VerificationCode entities.
+type VerificationCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VerificationCodeMutation
+}
+
+// Where appends a list predicates to the VerificationCodeUpdate builder.
+func (_u *VerificationCodeUpdate) Where(ps ...predicate.VerificationCode) *VerificationCodeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *VerificationCodeUpdate) SetUserID(v int) *VerificationCodeUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *VerificationCodeUpdate) SetNillableUserID(v *int) *VerificationCodeUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *VerificationCodeUpdate) AddUserID(v int) *VerificationCodeUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *VerificationCodeUpdate) SetChannel(v string) *VerificationCodeUpdate {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *VerificationCodeUpdate) SetNillableChannel(v *string) *VerificationCodeUpdate {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *VerificationCodeUpdate) SetTarget(v string) *VerificationCodeUpdate {
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *VerificationCodeUpdate) SetNillableTarget(v *string) *VerificationCodeUpdate {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_u *VerificationCodeUpdate) SetCodeHash(v string) *VerificationCodeUpdate {
+	_u.mutation.SetCodeHash(v)
+	return _u
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (_u *VerificationCodeUpdate) SetNillableCodeHash(v *string) *VerificationCodeUpdate {
+	if v != nil {
+		_u.SetCodeHash(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *VerificationCodeUpdate) SetAttempts(v int) *VerificationCodeUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *VerificationCodeUpdate) SetNillableAttempts(v *int) *VerificationCodeUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *VerificationCodeUpdate) AddAttempts(v int) *VerificationCodeUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *VerificationCodeUpdate) SetExpiresAt(v time.Time) *VerificationCodeUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *VerificationCodeUpdate) SetNillableExpiresAt(v *time.Time) *VerificationCodeUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (_u *VerificationCodeUpdate) SetConsumedAt(v time.Time) *VerificationCodeUpdate {
+	_u.mutation.SetConsumedAt(v)
+	return _u
+}
+
+// SetNillableConsumedAt sets the "consumed_at" field if the given value is not nil.
+func (_u *VerificationCodeUpdate) SetNillableConsumedAt(v *time.Time) *VerificationCodeUpdate {
+	if v != nil {
+		_u.SetConsumedAt(*v)
+	}
+	return _u
+}
+
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (_u *VerificationCodeUpdate) ClearConsumedAt() *VerificationCodeUpdate {
+	_u.mutation.ClearConsumedAt()
+	return _u
+}
+
+// Mutation returns the VerificationCodeMutation object of the builder.
+func (_u *VerificationCodeUpdate) Mutation() *VerificationCodeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VerificationCodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VerificationCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VerificationCodeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VerificationCodeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VerificationCodeUpdate) check() error {
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := verificationcode.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.channel": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Target(); ok {
+		if err := verificationcode.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.target": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CodeHash(); ok {
+		if err := verificationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.code_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *VerificationCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(verificationcode.Table, verificationcode.Columns, sqlgraph.NewFieldSpec(verificationcode.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(verificationcode.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(verificationcode.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(verificationcode.FieldChannel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(verificationcode.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeHash(); ok {
+		_spec.SetField(verificationcode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(verificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(verificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(verificationcode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ConsumedAt(); ok {
+		_spec.SetField(verificationcode.FieldConsumedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ConsumedAtCleared() {
+		_spec.ClearField(verificationcode.FieldConsumedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{verificationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VerificationCodeUpdateOne is the builder for updating a single VerificationCode entity.
+type VerificationCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VerificationCodeMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *VerificationCodeUpdateOne) SetUserID(v int) *VerificationCodeUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *VerificationCodeUpdateOne) SetNillableUserID(v *int) *VerificationCodeUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *VerificationCodeUpdateOne) AddUserID(v int) *VerificationCodeUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *VerificationCodeUpdateOne) SetChannel(v string) *VerificationCodeUpdateOne {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *VerificationCodeUpdateOne) SetNillableChannel(v *string) *VerificationCodeUpdateOne {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *VerificationCodeUpdateOne) SetTarget(v string) *VerificationCodeUpdateOne {
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *VerificationCodeUpdateOne) SetNillableTarget(v *string) *VerificationCodeUpdateOne {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_u *VerificationCodeUpdateOne) SetCodeHash(v string) *VerificationCodeUpdateOne {
+	_u.mutation.SetCodeHash(v)
+	return _u
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (_u *VerificationCodeUpdateOne) SetNillableCodeHash(v *string) *VerificationCodeUpdateOne {
+	if v != nil {
+		_u.SetCodeHash(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *VerificationCodeUpdateOne) SetAttempts(v int) *VerificationCodeUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *VerificationCodeUpdateOne) SetNillableAttempts(v *int) *VerificationCodeUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *VerificationCodeUpdateOne) AddAttempts(v int) *VerificationCodeUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *VerificationCodeUpdateOne) SetExpiresAt(v time.Time) *VerificationCodeUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *VerificationCodeUpdateOne) SetNillableExpiresAt(v *time.Time) *VerificationCodeUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (_u *VerificationCodeUpdateOne) SetConsumedAt(v time.Time) *VerificationCodeUpdateOne {
+	_u.mutation.SetConsumedAt(v)
+	return _u
+}
+
+// SetNillableConsumedAt sets the "consumed_at" field if the given value is not nil.
+func (_u *VerificationCodeUpdateOne) SetNillableConsumedAt(v *time.Time) *VerificationCodeUpdateOne {
+	if v != nil {
+		_u.SetConsumedAt(*v)
+	}
+	return _u
+}
+
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (_u *VerificationCodeUpdateOne) ClearConsumedAt() *VerificationCodeUpdateOne {
+	_u.mutation.ClearConsumedAt()
+	return _u
+}
+
+// Mutation returns the VerificationCodeMutation object of the builder.
+func (_u *VerificationCodeUpdateOne) Mutation() *VerificationCodeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the VerificationCodeUpdate builder.
+func (_u *VerificationCodeUpdateOne) Where(ps ...predicate.VerificationCode) *VerificationCodeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VerificationCodeUpdateOne) Select(field string, fields ...string) *VerificationCodeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VerificationCode entity.
+func (_u *VerificationCodeUpdateOne) Save(ctx context.Context) (*VerificationCode, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VerificationCodeUpdateOne) SaveX(ctx context.Context) *VerificationCode {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VerificationCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VerificationCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VerificationCodeUpdateOne) check() error {
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := verificationcode.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.channel": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Target(); ok {
+		if err := verificationcode.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.target": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CodeHash(); ok {
+		if err := verificationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.code_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *VerificationCodeUpdateOne) sqlSave(ctx context.Context) (_node *VerificationCode, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(verificationcode.Table, verificationcode.Columns, sqlgraph.NewFieldSpec(verificationcode.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VerificationCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, verificationcode.FieldID)
+		for _, f := range fields {
+			if !verificationcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != verificationcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(verificationcode.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(verificationcode.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(verificationcode.FieldChannel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(verificationcode.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeHash(); ok {
+		_spec.SetField(verificationcode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(verificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(verificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(verificationcode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ConsumedAt(); ok {
+		_spec.SetField(verificationcode.FieldConsumedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ConsumedAtCleared() {
+		_spec.ClearField(verificationcode.FieldConsumedAt, field.TypeTime)
+	}
+	_node = &VerificationCode{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{verificationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,20 @@
+-- Create "audit_logs" table
+CREATE TABLE "audit_logs" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "action" character varying NOT NULL,
+  "actor_kind" character varying NOT NULL,
+  "actor_id" bigint NOT NULL DEFAULT 0,
+  "actor_username" character varying NOT NULL DEFAULT '',
+  "target_kind" character varying NOT NULL DEFAULT '',
+  "target_id" bigint NOT NULL DEFAULT 0,
+  "detail" jsonb NULL,
+  "request_id" character varying NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "auditlog_action_created_at" to table: "audit_logs"
+CREATE INDEX "auditlog_action_created_at" ON "audit_logs" ("action", "created_at");
+-- Create index "auditlog_actor_kind_actor_id" to table: "audit_logs"
+CREATE INDEX "auditlog_actor_kind_actor_id" ON "audit_logs" ("actor_kind", "actor_id");
+-- Create index "auditlog_target_kind_target_id" to table: "audit_logs"
+CREATE INDEX "auditlog_target_kind_target_id" ON "audit_logs" ("target_kind", "target_id");
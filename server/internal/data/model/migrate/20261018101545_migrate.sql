@@ -0,0 +1,29 @@
+-- Create "invite_codes" table
+CREATE TABLE "invite_codes" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "code" character varying NOT NULL,
+  "max_uses" bigint NOT NULL,
+  "used_count" bigint NOT NULL DEFAULT 0,
+  "expires_at" timestamptz NULL,
+  "role_key" character varying NOT NULL DEFAULT '',
+  "note" character varying NOT NULL DEFAULT '',
+  "created_by" bigint NOT NULL DEFAULT 0,
+  "revoked_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "invitecode_code" to table: "invite_codes"
+CREATE UNIQUE INDEX "invitecode_code" ON "invite_codes" ("code");
+-- Create "invite_redemptions" table
+CREATE TABLE "invite_redemptions" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "invite_code_id" bigint NOT NULL,
+  "user_id" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "inviteredemption_invite_code_id" to table: "invite_redemptions"
+CREATE INDEX "inviteredemption_invite_code_id" ON "invite_redemptions" ("invite_code_id");
+-- Create index "inviteredemption_user_id" to table: "invite_redemptions"
+CREATE UNIQUE INDEX "inviteredemption_user_id" ON "invite_redemptions" ("user_id");
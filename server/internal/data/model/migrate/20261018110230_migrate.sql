@@ -0,0 +1,21 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "email" character varying NULL, ADD COLUMN "email_verified_at" timestamptz NULL, ADD COLUMN "phone" character varying NULL, ADD COLUMN "phone_verified_at" timestamptz NULL;
+-- Create index "user_email" to table: "users"
+CREATE UNIQUE INDEX "user_email" ON "users" ("email");
+-- Create index "user_phone" to table: "users"
+CREATE UNIQUE INDEX "user_phone" ON "users" ("phone");
+-- Create "verification_codes" table
+CREATE TABLE "verification_codes" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "user_id" bigint NOT NULL,
+  "channel" character varying NOT NULL,
+  "target" character varying NOT NULL,
+  "code_hash" character varying NOT NULL,
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "expires_at" timestamptz NOT NULL,
+  "consumed_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "verificationcode_user_id_channel_created_at" to table: "verification_codes"
+CREATE INDEX "verificationcode_user_id_channel_created_at" ON "verification_codes" ("user_id", "channel", "created_at");
@@ -0,0 +1,10 @@
+-- Modify "admin_users" table
+ALTER TABLE "admin_users" ADD COLUMN "username_normalized" character varying NULL;
+-- Backfill "admin_users"."username_normalized"
+-- SQL 的 lower 不做完整的大小写折叠（如 "ß" 不会变成 "ss"），纯 ASCII 用户名与 biz.NormalizeUsername 结果一致；
+-- 其余账号由服务启动时的 SyncUsernameNormalized 按 Go 规则修正。
+UPDATE "admin_users" SET "username_normalized" = lower(normalize(btrim("username"), NFKC)) WHERE "username_normalized" IS NULL;
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "username_normalized" character varying NULL;
+-- Backfill "users"."username_normalized"
+UPDATE "users" SET "username_normalized" = lower(normalize(btrim("username"), NFKC)) WHERE "username_normalized" IS NULL;
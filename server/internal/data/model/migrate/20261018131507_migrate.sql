@@ -0,0 +1,20 @@
+-- Create "login_events" table
+CREATE TABLE "login_events" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "account_kind" character varying NOT NULL,
+  "account_id" bigint NOT NULL DEFAULT 0,
+  "username" character varying NOT NULL DEFAULT '',
+  "success" boolean NOT NULL,
+  "reason" character varying NOT NULL DEFAULT '',
+  "ip" character varying NOT NULL DEFAULT '',
+  "user_agent" character varying NOT NULL DEFAULT '',
+  "request_id" character varying NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "loginevent_account_kind_account_id_created_at" to table: "login_events"
+CREATE INDEX "loginevent_account_kind_account_id_created_at" ON "login_events" ("account_kind", "account_id", "created_at");
+-- Create index "loginevent_created_at" to table: "login_events"
+CREATE INDEX "loginevent_created_at" ON "login_events" ("created_at");
+-- Create index "loginevent_ip_created_at" to table: "login_events"
+CREATE INDEX "loginevent_ip_created_at" ON "login_events" ("ip", "created_at");
@@ -0,0 +1,2 @@
+-- Modify "admin_role_permissions" table
+ALTER TABLE "admin_role_permissions" ADD COLUMN "deny" boolean NOT NULL DEFAULT false;
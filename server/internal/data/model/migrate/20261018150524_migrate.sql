@@ -0,0 +1,12 @@
+-- Create "admin_role_parents" table
+CREATE TABLE "admin_role_parents" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "admin_role_id" bigint NOT NULL,
+  "parent_role_id" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "adminroleparent_admin_role_id_parent_role_id" to table: "admin_role_parents"
+CREATE UNIQUE INDEX "adminroleparent_admin_role_id_parent_role_id" ON "admin_role_parents" ("admin_role_id", "parent_role_id");
+-- Create index "adminroleparent_parent_role_id" to table: "admin_role_parents"
+CREATE INDEX "adminroleparent_parent_role_id" ON "admin_role_parents" ("parent_role_id");
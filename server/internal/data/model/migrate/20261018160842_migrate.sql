@@ -0,0 +1,4 @@
+-- Modify "admin_user_roles" table
+ALTER TABLE "admin_user_roles" ADD COLUMN "starts_at" timestamptz NULL, ADD COLUMN "expires_at" timestamptz NULL;
+-- Create index "adminuserrole_expires_at" to table: "admin_user_roles"
+CREATE INDEX "adminuserrole_expires_at" ON "admin_user_roles" ("expires_at");
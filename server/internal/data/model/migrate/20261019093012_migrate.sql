@@ -0,0 +1,34 @@
+-- Create "user_role_bindings" table
+CREATE TABLE "user_role_bindings" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "user_id" bigint NOT NULL,
+  "user_role_id" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "userrolebinding_user_id_user_role_id" to table: "user_role_bindings"
+CREATE UNIQUE INDEX "userrolebinding_user_id_user_role_id" ON "user_role_bindings" ("user_id", "user_role_id");
+-- Create index "userrolebinding_user_role_id" to table: "user_role_bindings"
+CREATE INDEX "userrolebinding_user_role_id" ON "user_role_bindings" ("user_role_id");
+-- Create "user_role_permissions" table
+CREATE TABLE "user_role_permissions" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "user_role_id" bigint NOT NULL,
+  "permission_key" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "userrolepermission_user_role_id_permission_key" to table: "user_role_permissions"
+CREATE UNIQUE INDEX "userrolepermission_user_role_id_permission_key" ON "user_role_permissions" ("user_role_id", "permission_key");
+-- Create "user_roles" table
+CREATE TABLE "user_roles" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "key" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "description" character varying NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "userrole_key" to table: "user_roles"
+CREATE UNIQUE INDEX "userrole_key" ON "user_roles" ("key");
@@ -0,0 +1,17 @@
+-- Modify "admin_users" table
+ALTER TABLE "admin_users" ADD COLUMN "attributes" jsonb NULL;
+-- Create "access_policies" table
+CREATE TABLE "access_policies" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "url" character varying NOT NULL,
+  "method" character varying NOT NULL,
+  "expression" text NOT NULL,
+  "description" character varying NULL DEFAULT '',
+  "enabled" boolean NOT NULL DEFAULT true,
+  "updated_by" bigint NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "accesspolicy_url_method" to table: "access_policies"
+CREATE UNIQUE INDEX "accesspolicy_url_method" ON "access_policies" ("url", "method");
@@ -0,0 +1,39 @@
+-- Create "organization_admin_roles" table
+CREATE TABLE "organization_admin_roles" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "organization_id" bigint NOT NULL,
+  "admin_user_id" bigint NOT NULL,
+  "admin_role_id" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "organizationadminrole_admin_role_id" to table: "organization_admin_roles"
+CREATE INDEX "organizationadminrole_admin_role_id" ON "organization_admin_roles" ("admin_role_id");
+-- Create index "organizationadminrole_admin_user_id" to table: "organization_admin_roles"
+CREATE INDEX "organizationadminrole_admin_user_id" ON "organization_admin_roles" ("admin_user_id");
+-- Create index "organizationadminrole_organization_id_admin_user_id_admin_role_id" to table: "organization_admin_roles"
+CREATE UNIQUE INDEX "organizationadminrole_organization_id_admin_user_id_admin_role_id" ON "organization_admin_roles" ("organization_id", "admin_user_id", "admin_role_id");
+-- Create "organization_members" table
+CREATE TABLE "organization_members" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "organization_id" bigint NOT NULL,
+  "member_kind" character varying NOT NULL,
+  "member_id" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "organizationmember_member_kind_member_id" to table: "organization_members"
+CREATE INDEX "organizationmember_member_kind_member_id" ON "organization_members" ("member_kind", "member_id");
+-- Create index "organizationmember_organization_id_member_kind_member_id" to table: "organization_members"
+CREATE UNIQUE INDEX "organizationmember_organization_id_member_kind_member_id" ON "organization_members" ("organization_id", "member_kind", "member_id");
+-- Create "organizations" table
+CREATE TABLE "organizations" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "slug" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "organization_slug" to table: "organizations"
+CREATE UNIQUE INDEX "organization_slug" ON "organizations" ("slug");
@@ -0,0 +1,20 @@
+-- Create "user_import_jobs" table
+CREATE TABLE "user_import_jobs" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "status" character varying NOT NULL,
+  "dry_run" boolean NOT NULL DEFAULT false,
+  "organization_id" bigint NOT NULL DEFAULT 0,
+  "created_by" bigint NOT NULL DEFAULT 0,
+  "total_rows" bigint NOT NULL DEFAULT 0,
+  "processed_rows" bigint NOT NULL DEFAULT 0,
+  "created_count" bigint NOT NULL DEFAULT 0,
+  "failed_count" bigint NOT NULL DEFAULT 0,
+  "row_errors" jsonb NULL,
+  "error" character varying NOT NULL DEFAULT '',
+  "finished_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "userimportjob_organization_id_created_at" to table: "user_import_jobs"
+CREATE INDEX "userimportjob_organization_id_created_at" ON "user_import_jobs" ("organization_id", "created_at");
@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "display_name" character varying NOT NULL DEFAULT '', ADD COLUMN "avatar_url" character varying NOT NULL DEFAULT '', ADD COLUMN "locale" character varying NOT NULL DEFAULT '', ADD COLUMN "timezone" character varying NOT NULL DEFAULT '', ADD COLUMN "attributes" jsonb NULL;
@@ -0,0 +1,4 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "deleted_at" timestamptz NULL, ADD COLUMN "deleted_username" character varying NULL, ADD COLUMN "purged_at" timestamptz NULL;
+-- Create index "user_deleted_at" to table: "users"
+CREATE INDEX "user_deleted_at" ON "users" ("deleted_at");
@@ -0,0 +1,4 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "deletion_scheduled_at" timestamptz NULL;
+-- Create index "user_deletion_scheduled_at" to table: "users"
+CREATE INDEX "user_deletion_scheduled_at" ON "users" ("deletion_scheduled_at");
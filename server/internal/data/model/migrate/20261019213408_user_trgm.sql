@@ -0,0 +1,18 @@
+-- pg_trgm 属于 contrib 扩展，托管数据库可能没有或无权安装；失败时跳过，user.list 的模糊搜索会退化为 ILIKE。
+DO $$
+BEGIN
+  CREATE EXTENSION IF NOT EXISTS pg_trgm;
+EXCEPTION WHEN OTHERS THEN
+  RAISE NOTICE 'pg_trgm unavailable, skip trigram indexes: %', SQLERRM;
+END
+$$;
+-- Create trigram indexes on "users" when pg_trgm is installed
+DO $$
+BEGIN
+  IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_trgm') THEN
+    CREATE INDEX IF NOT EXISTS "user_username_trgm" ON "users" USING GIN ("username" gin_trgm_ops);
+    CREATE INDEX IF NOT EXISTS "user_email_trgm" ON "users" USING GIN ("email" gin_trgm_ops);
+    CREATE INDEX IF NOT EXISTS "user_display_name_trgm" ON "users" USING GIN ("display_name" gin_trgm_ops);
+  END IF;
+END
+$$;
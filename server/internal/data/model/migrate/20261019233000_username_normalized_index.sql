@@ -0,0 +1,21 @@
+-- 按旧版 20261018120418（只加列、不回填）迁移过的库在这里补齐回填，规则同 20261018120418。
+UPDATE "admin_users" SET "username_normalized" = lower(normalize(btrim("username"), NFKC)) WHERE "username_normalized" IS NULL;
+UPDATE "users" SET "username_normalized" = lower(normalize(btrim("username"), NFKC)) WHERE "username_normalized" IS NULL;
+-- 规范化后重名的账号会让唯一索引建不起来，这里给出可读的错误；
+-- 发布前 production-preflight.sh --runtime / migrate_online.sh 会用 deploy/compose/prod/username_collisions.sql 列出冲突账号。
+DO $$
+BEGIN
+  IF EXISTS (SELECT 1 FROM "users" GROUP BY "username_normalized" HAVING COUNT(*) > 1)
+     OR EXISTS (SELECT 1 FROM "admin_users" GROUP BY "username_normalized" HAVING COUNT(*) > 1) THEN
+    RAISE EXCEPTION 'normalized username collisions found, rename the accounts listed by deploy/compose/prod/username_collisions.sql and apply again';
+  END IF;
+END
+$$;
+-- Modify "admin_users" table
+ALTER TABLE "admin_users" ALTER COLUMN "username_normalized" SET NOT NULL;
+-- Create index "adminuser_username_normalized" to table: "admin_users"
+CREATE UNIQUE INDEX IF NOT EXISTS "adminuser_username_normalized" ON "admin_users" ("username_normalized");
+-- Modify "users" table
+ALTER TABLE "users" ALTER COLUMN "username_normalized" SET NOT NULL;
+-- Create index "user_username_normalized" to table: "users"
+CREATE UNIQUE INDEX IF NOT EXISTS "user_username_normalized" ON "users" ("username_normalized");
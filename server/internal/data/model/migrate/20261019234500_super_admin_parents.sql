@@ -0,0 +1,2 @@
+-- super_admin 不允许有父角色：父角色的拒绝条目会被继承，削掉超管的权限。清掉此前可能写入的继承关系。
+DELETE FROM "admin_role_parents" WHERE "admin_role_id" IN (SELECT "id" FROM "admin_roles" WHERE "key" = 'super_admin');
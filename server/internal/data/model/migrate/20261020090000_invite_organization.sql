@@ -0,0 +1,4 @@
+-- Modify "invite_codes" table
+ALTER TABLE "invite_codes" ADD COLUMN "organization_id" bigint NOT NULL DEFAULT 0;
+-- Create index "invitecode_organization_id" to table: "invite_codes"
+CREATE INDEX "invitecode_organization_id" ON "invite_codes" ("organization_id");
@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "must_change_password" boolean NOT NULL DEFAULT false;
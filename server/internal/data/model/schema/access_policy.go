@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccessPolicy 是挂在某个 JSON-RPC 方法上的 CEL 表达式，在 RBAC 校验通过后再判定一次；每个方法至多一条。
+type AccessPolicy struct {
+	ent.Schema
+}
+
+func (AccessPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("url").
+			NotEmpty().
+			MaxLen(64),
+		field.String("method").
+			NotEmpty().
+			MaxLen(64),
+		field.Text("expression").
+			NotEmpty(),
+		field.String("description").
+			Optional().
+			Default("").
+			MaxLen(255),
+		field.Bool("enabled").
+			Default(true),
+		field.Int("updated_by").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (AccessPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("url", "method").Unique(),
+	}
+}
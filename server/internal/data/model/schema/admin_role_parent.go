@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdminRoleParent 记录角色继承关系：admin_role_id 继承 parent_role_id 的全部权限条目（含拒绝）。
+// 继承关系必须是无环图，由 biz 与写入事务共同保证。
+type AdminRoleParent struct {
+	ent.Schema
+}
+
+func (AdminRoleParent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("admin_role_id"),
+		field.Int("parent_role_id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (AdminRoleParent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("admin_role_id", "parent_role_id").Unique(),
+		index.Fields("parent_role_id"),
+	}
+}
@@ -16,6 +16,9 @@ func (AdminRolePermission) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("admin_role_id"),
 		field.Int("admin_permission_id"),
+		// deny=true 表示显式拒绝，优先于任何授予（含通配）。
+		field.Bool("deny").
+			Default(false),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -18,11 +18,18 @@ func (AdminUser) Fields() []ent.Field {
 		field.String("username").
 			NotEmpty().
 			MaxLen(64),
+		// username_normalized 是 NFKC + 大小写折叠后的 key，唯一性与登录查找都以它为准。
+		field.String("username_normalized").
+			NotEmpty().
+			MaxLen(64),
 		field.String("password_hash").
 			NotEmpty().
 			Sensitive(),
 		field.Bool("disabled").
 			Default(false),
+		// attributes 是管理员的业务属性（如 region），只供访问策略表达式读取，不参与 RBAC。
+		field.JSON("attributes", map[string]any{}).
+			Optional(),
 		field.Time("last_login_at").
 			Optional().
 			Nillable(),
@@ -38,5 +45,6 @@ func (AdminUser) Fields() []ent.Field {
 func (AdminUser) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("username").Unique(),
+		index.Fields("username_normalized").Unique(),
 	}
 }
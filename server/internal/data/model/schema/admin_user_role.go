@@ -16,6 +16,13 @@ func (AdminUserRole) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("admin_user_id"),
 		field.Int("admin_role_id"),
+		// starts_at/expires_at 为空表示长期授权；临时授权只在 [starts_at, expires_at) 内生效，过期行由后台任务清理。
+		field.Time("starts_at").
+			Optional().
+			Nillable(),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -26,5 +33,6 @@ func (AdminUserRole) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("admin_user_id", "admin_role_id").Unique(),
 		index.Fields("admin_role_id"),
+		index.Fields("expires_at"),
 	}
 }
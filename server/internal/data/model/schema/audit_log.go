@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog 是只追加的审计流水，记录“谁以什么身份对谁做了什么”。
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("action").
+			NotEmpty().
+			MaxLen(64),
+		field.String("actor_kind").
+			NotEmpty().
+			MaxLen(16),
+		field.Int("actor_id").
+			Default(0),
+		field.String("actor_username").
+			Default(""),
+		field.String("target_kind").
+			Default(""),
+		field.Int("target_id").
+			Default(0),
+		field.JSON("detail", map[string]any{}).
+			Optional(),
+		field.String("request_id").
+			Default(""),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("actor_kind", "actor_id"),
+		index.Fields("target_kind", "target_id"),
+		index.Fields("action", "created_at"),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InviteCode 是注册邀请码；used_count 只在注册事务里原子递增。
+type InviteCode struct {
+	ent.Schema
+}
+
+func (InviteCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").
+			NotEmpty().
+			MaxLen(32),
+		field.Int("max_uses").
+			Positive(),
+		field.Int("used_count").
+			Default(0).
+			NonNegative(),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.String("role_key").
+			Default(""),
+		field.String("note").
+			Default(""),
+		// organization_id 是创建邀请码时所在的组织，0 表示平台级；注册成功后用户加入该组织。
+		field.Int("organization_id").
+			Default(0),
+		field.Int("created_by").
+			Default(0),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (InviteCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("code").Unique(),
+		index.Fields("organization_id"),
+	}
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InviteRedemption 记录哪个用户用哪个邀请码完成了注册。
+type InviteRedemption struct {
+	ent.Schema
+}
+
+func (InviteRedemption) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("invite_code_id"),
+		field.Int("user_id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (InviteRedemption) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("invite_code_id"),
+		index.Fields("user_id").Unique(),
+	}
+}
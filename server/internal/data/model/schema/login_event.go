@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginEvent 记录每一次用户/管理员登录尝试（成功与失败），按保留天数定期清理。
+type LoginEvent struct {
+	ent.Schema
+}
+
+func (LoginEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_kind").
+			NotEmpty().
+			MaxLen(16),
+		// account_id 为 0 表示用户名不存在，只能按 username 追溯。
+		field.Int("account_id").
+			Default(0),
+		field.String("username").
+			Default("").
+			MaxLen(64),
+		field.Bool("success"),
+		field.String("reason").
+			Default("").
+			MaxLen(32),
+		field.String("ip").
+			Default("").
+			MaxLen(64),
+		field.String("user_agent").
+			Default("").
+			MaxLen(512),
+		field.String("request_id").
+			Default(""),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (LoginEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_kind", "account_id", "created_at"),
+		index.Fields("ip", "created_at"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Organization 是一个租户；用户与管理员通过 organization_members 加入。
+type Organization struct {
+	ent.Schema
+}
+
+func (Organization) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("slug").
+			NotEmpty().
+			MaxLen(64),
+		field.String("name").
+			NotEmpty().
+			MaxLen(128),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (Organization) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("slug").Unique(),
+	}
+}
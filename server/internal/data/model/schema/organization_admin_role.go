@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OrganizationAdminRole 是只在某个组织内生效的管理员角色绑定，叠加在 admin_user_roles 的全局绑定之上。
+type OrganizationAdminRole struct {
+	ent.Schema
+}
+
+func (OrganizationAdminRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("organization_id"),
+		field.Int("admin_user_id"),
+		field.Int("admin_role_id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (OrganizationAdminRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "admin_user_id", "admin_role_id").Unique(),
+		index.Fields("admin_user_id"),
+		index.Fields("admin_role_id"),
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OrganizationMember 记录用户或管理员所属的组织；member_kind 为 user / admin，member_id 指向对应表。
+type OrganizationMember struct {
+	ent.Schema
+}
+
+func (OrganizationMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("organization_id"),
+		field.String("member_kind").
+			NotEmpty().
+			MaxLen(16),
+		field.Int("member_id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (OrganizationMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "member_kind", "member_id").Unique(),
+		index.Fields("member_kind", "member_id"),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 )
@@ -18,14 +19,67 @@ func (User) Fields() []ent.Field {
 		field.String("username").
 			NotEmpty().
 			MaxLen(32),
+		// username_normalized 是 NFKC + 大小写折叠后的 key，唯一性与登录查找都以它为准。
+		field.String("username_normalized").
+			NotEmpty().
+			MaxLen(32),
 		field.String("password_hash").
 			NotEmpty().
 			Sensitive(),
 		field.Bool("disabled").
 			Default(false),
+		// must_change_password 表示当前密码是管理员下发的临时密码，用户改密前不能登录。
+		field.Bool("must_change_password").
+			Default(false),
 		field.Time("last_login_at").
 			Optional().
 			Nillable(),
+		field.String("email").
+			Optional().
+			Nillable().
+			MaxLen(254),
+		field.Time("email_verified_at").
+			Optional().
+			Nillable(),
+		field.String("phone").
+			Optional().
+			Nillable().
+			MaxLen(32),
+		field.Time("phone_verified_at").
+			Optional().
+			Nillable(),
+		// 资料字段，空字符串表示未设置。
+		field.String("display_name").
+			Default("").
+			MaxLen(64),
+		field.String("avatar_url").
+			Default("").
+			MaxLen(512),
+		field.String("locale").
+			Default("").
+			MaxLen(35),
+		field.String("timezone").
+			Default("").
+			MaxLen(64),
+		// attributes 是项目自定义的资料字段，key 必须先在 biz.RegisterUserAttributes 中声明。
+		field.JSON("attributes", map[string]any{}).
+			Optional(),
+		// deleted_at 非空表示已软删除，默认查询通过拦截器排除；purged_at 非空表示超过保留期后已匿名化，不能再恢复。
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+		// deleted_username 是删除时释放用户名的情况下保留的原用户名，恢复时写回。
+		field.String("deleted_username").
+			Optional().
+			Nillable().
+			MaxLen(32),
+		field.Time("purged_at").
+			Optional().
+			Nillable(),
+		// deletion_scheduled_at 是用户自助注销后预定抹除的时间，宽限期内可以撤销；到期由后台任务删除并匿名化。
+		field.Time("deletion_scheduled_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -38,5 +92,21 @@ func (User) Fields() []ent.Field {
 func (User) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("username").Unique(),
+		index.Fields("username_normalized").Unique(),
+		index.Fields("email").Unique(),
+		index.Fields("phone").Unique(),
+		index.Fields("deleted_at"),
+		index.Fields("deletion_scheduled_at"),
+		// user.list 模糊搜索用的 pg_trgm 三元组索引，同时让 ILIKE '%x%' 能走索引。
+		// 迁移里只在扩展可用时创建，没有扩展的环境查询会退化为顺序扫描。
+		index.Fields("username").
+			Annotations(entsql.IndexType("GIN"), entsql.OpClass("gin_trgm_ops")).
+			StorageKey("user_username_trgm"),
+		index.Fields("email").
+			Annotations(entsql.IndexType("GIN"), entsql.OpClass("gin_trgm_ops")).
+			StorageKey("user_email_trgm"),
+		index.Fields("display_name").
+			Annotations(entsql.IndexType("GIN"), entsql.OpClass("gin_trgm_ops")).
+			StorageKey("user_display_name_trgm"),
 	}
 }
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserImportRowError 是一条行级错误；Row 为 CSV 中的行号（表头为第 1 行）。
+type UserImportRowError struct {
+	Row      int    `json:"row"`
+	Username string `json:"username,omitempty"`
+	Message  string `json:"message"`
+}
+
+// UserImportJob 记录一次 CSV 批量导入的进度与行级错误；行数据本身不落库。
+type UserImportJob struct {
+	ent.Schema
+}
+
+func (UserImportJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("status").
+			NotEmpty().
+			MaxLen(16),
+		field.Bool("dry_run").
+			Default(false),
+		// organization_id 是发起导入时所在的组织，0 表示不限定组织；查询进度时按它做租户隔离。
+		field.Int("organization_id").
+			Default(0),
+		field.Int("created_by").
+			Default(0),
+		field.Int("total_rows").
+			Default(0).
+			NonNegative(),
+		field.Int("processed_rows").
+			Default(0).
+			NonNegative(),
+		field.Int("created_count").
+			Default(0).
+			NonNegative(),
+		field.Int("failed_count").
+			Default(0).
+			NonNegative(),
+		// row_errors 只保留前若干条行级错误，总数以 failed_count 为准。
+		field.JSON("row_errors", []UserImportRowError{}).
+			Optional(),
+		field.String("error").
+			Default(""),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (UserImportJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "created_at"),
+	}
+}
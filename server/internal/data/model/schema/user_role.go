@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserRole 是普通用户侧的角色（如付费档位、版主），与后台 admin_roles 相互独立。
+type UserRole struct {
+	ent.Schema
+}
+
+func (UserRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			MaxLen(64),
+		field.String("name").
+			NotEmpty().
+			MaxLen(64),
+		field.String("description").
+			Optional().
+			Default("").
+			MaxLen(255),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (UserRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key").Unique(),
+	}
+}
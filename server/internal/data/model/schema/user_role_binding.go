@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type UserRoleBinding struct {
+	ent.Schema
+}
+
+func (UserRoleBinding) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Int("user_role_id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (UserRoleBinding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "user_role_id").Unique(),
+		index.Fields("user_role_id"),
+	}
+}
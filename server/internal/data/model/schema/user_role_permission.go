@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type UserRolePermission struct {
+	ent.Schema
+}
+
+func (UserRolePermission) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_role_id"),
+		// 用户侧权限码只在代码里注册（app.*），这里直接存 key，不单独建权限表。
+		field.String("permission_key").
+			NotEmpty().
+			MaxLen(128),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (UserRolePermission) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_role_id", "permission_key").Unique(),
+	}
+}
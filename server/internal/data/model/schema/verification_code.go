@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VerificationCode 保存发出的验证码哈希；明文只经过 Mailer/SMSSender，不落库。
+type VerificationCode struct {
+	ent.Schema
+}
+
+func (VerificationCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("channel").
+			NotEmpty().
+			MaxLen(16),
+		field.String("target").
+			NotEmpty(),
+		field.String("code_hash").
+			NotEmpty().
+			Sensitive(),
+		field.Int("attempts").
+			Default(0),
+		field.Time("expires_at"),
+		field.Time("consumed_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (VerificationCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "channel", "created_at"),
+	}
+}
@@ -0,0 +1,114 @@
+// server/internal/data/notify_sender.go
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 模板只内置开发用的 log/file 两种发送方式；接入真实邮件/短信服务时实现 biz.Mailer / biz.SMSSender 并在这里按配置返回。
+const (
+	senderLog  = "log"
+	senderFile = "file"
+
+	defaultOutboxDir = "./outbox"
+)
+
+func NewMailer(c *conf.Data, logger log.Logger) biz.Mailer {
+	kind, dir := senderConfig(c, func(v *conf.Data_Auth_Verification) string { return v.GetMailer() })
+	switch kind {
+	case senderLog:
+		return &logSender{log: log.NewHelper(log.With(logger, "module", "data.mailer"))}
+	case senderFile:
+		return &fileSender{path: filepath.Join(dir, "mail.jsonl")}
+	default:
+		panic("NewMailer: unsupported data.auth.verification.mailer " + kind)
+	}
+}
+
+func NewSMSSender(c *conf.Data, logger log.Logger) biz.SMSSender {
+	kind, dir := senderConfig(c, func(v *conf.Data_Auth_Verification) string { return v.GetSmsSender() })
+	switch kind {
+	case senderLog:
+		return &logSender{log: log.NewHelper(log.With(logger, "module", "data.sms_sender"))}
+	case senderFile:
+		return &fileSender{path: filepath.Join(dir, "sms.jsonl")}
+	default:
+		panic("NewSMSSender: unsupported data.auth.verification.smsSender " + kind)
+	}
+}
+
+func senderConfig(c *conf.Data, pick func(*conf.Data_Auth_Verification) string) (kind, dir string) {
+	kind, dir = senderLog, defaultOutboxDir
+	if c == nil || c.Auth == nil || c.Auth.Verification == nil {
+		return kind, dir
+	}
+	if v := pick(c.Auth.Verification); v != "" {
+		kind = v
+	}
+	if v := c.Auth.Verification.GetOutboxDir(); v != "" {
+		dir = v
+	}
+	return kind, dir
+}
+
+// logSender 把消息直接打到日志里，验证码明文可见，只适合本地开发。
+type logSender struct {
+	log *log.Helper
+}
+
+func (s *logSender) SendMail(ctx context.Context, to, subject, body string) error {
+	s.log.WithContext(ctx).Infof("[outbox] mail to=%s subject=%q body=%q", to, subject, body)
+	return nil
+}
+
+func (s *logSender) SendSMS(ctx context.Context, phone, message string) error {
+	s.log.WithContext(ctx).Infof("[outbox] sms to=%s message=%q", phone, message)
+	return nil
+}
+
+// fileSender 以 JSON Lines 追加写入本地文件，方便联调时由脚本读取验证码。
+type fileSender struct {
+	mu   sync.Mutex
+	path string
+}
+
+func (s *fileSender) SendMail(ctx context.Context, to, subject, body string) error {
+	return s.append(map[string]any{"kind": "mail", "to": to, "subject": subject, "body": body})
+}
+
+func (s *fileSender) SendSMS(ctx context.Context, phone, message string) error {
+	return s.append(map[string]any{"kind": "sms", "to": phone, "body": message})
+}
+
+func (s *fileSender) append(msg map[string]any) error {
+	msg["sent_at"] = time.Now().Unix()
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(b, '\n'))
+	return err
+}
@@ -0,0 +1,316 @@
+// server/internal/data/organization_repo.go
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	entorganization "server/internal/data/model/ent/organization"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type organizationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewOrganizationRepo(data *Data, logger log.Logger) *organizationRepo {
+	return &organizationRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data.organization_repo")),
+	}
+}
+
+var _ biz.OrganizationRepo = (*organizationRepo)(nil)
+
+func (r *organizationRepo) ListOrganizations(ctx context.Context) ([]biz.Organization, error) {
+	rows, err := r.data.postgres.Organization.
+		Query().
+		Order(ent.Asc(entorganization.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListOrganizations failed err=%v", err)
+		return nil, err
+	}
+	out := make([]biz.Organization, 0, len(rows))
+	for _, o := range rows {
+		out = append(out, *toBizOrganization(o))
+	}
+	return out, nil
+}
+
+func (r *organizationRepo) GetOrganization(ctx context.Context, id int) (*biz.Organization, error) {
+	o, err := r.data.postgres.Organization.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrOrganizationNotFound
+		}
+		r.log.WithContext(ctx).Errorf("GetOrganization failed id=%d err=%v", id, err)
+		return nil, err
+	}
+	return toBizOrganization(o), nil
+}
+
+func (r *organizationRepo) CreateOrganization(ctx context.Context, in *biz.Organization) (*biz.Organization, error) {
+	o, err := r.data.postgres.Organization.
+		Create().
+		SetSlug(in.Slug).
+		SetName(in.Name).
+		Save(ctx)
+	if err != nil {
+		if isDuplicateUniqueConstraint(err, "organization_slug", "organizations.slug", "slug") {
+			return nil, biz.ErrOrganizationExists
+		}
+		r.log.WithContext(ctx).Errorf("CreateOrganization failed slug=%s err=%v", in.Slug, err)
+		return nil, err
+	}
+	return toBizOrganization(o), nil
+}
+
+// ListOrganizationMembers 成员用户名按账号类型分别关联 users / admin_users；管理员成员附带其组织内角色。
+// 已软删除的用户保留成员关系（恢复后仍在组织内），但不出现在列表里。
+func (r *organizationRepo) ListOrganizationMembers(ctx context.Context, orgID int) ([]biz.OrganizationMember, error) {
+	rows, err := r.data.sqldb.QueryContext(
+		ctx,
+		`SELECT om.organization_id, om.member_kind, om.member_id, om.created_at,
+		        COALESCE(CASE om.member_kind
+		                   WHEN 'user' THEN (SELECT u.username FROM users u WHERE u.id = om.member_id)
+		                   ELSE (SELECT a.username FROM admin_users a WHERE a.id = om.member_id)
+		                 END, ''),
+		        COALESCE((SELECT string_agg(ar.key, ',' ORDER BY ar.key)
+		                  FROM organization_admin_roles oar
+		                  JOIN admin_roles ar ON ar.id = oar.admin_role_id
+		                  WHERE om.member_kind = 'admin'
+		                    AND oar.organization_id = om.organization_id
+		                    AND oar.admin_user_id = om.member_id), '')
+		 FROM organization_members om
+		 WHERE om.organization_id = $1
+		   AND NOT (om.member_kind = 'user' AND EXISTS (SELECT 1 FROM users u WHERE u.id = om.member_id AND u.deleted_at IS NOT NULL))
+		 ORDER BY om.member_kind ASC, om.member_id ASC`,
+		orgID,
+	)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListOrganizationMembers failed org_id=%d err=%v", orgID, err)
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.WithContext(ctx).Warnf("ListOrganizationMembers close rows failed err=%v", err)
+		}
+	}()
+
+	out := make([]biz.OrganizationMember, 0)
+	for rows.Next() {
+		var (
+			m     biz.OrganizationMember
+			roles string
+		)
+		if err := rows.Scan(&m.OrganizationID, &m.Kind, &m.MemberID, &m.CreatedAt, &m.Username, &roles); err != nil {
+			return nil, err
+		}
+		m.Roles = splitKeys(roles)
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
+
+func (r *organizationRepo) ListMemberOrganizations(ctx context.Context, kind string, memberID int) ([]biz.OrganizationMembership, error) {
+	rows, err := r.data.sqldb.QueryContext(
+		ctx,
+		`SELECT o.id, o.slug, o.name, o.created_at, o.updated_at, om.created_at
+		 FROM organization_members om
+		 JOIN organizations o ON o.id = om.organization_id
+		 WHERE om.member_kind = $1 AND om.member_id = $2
+		 ORDER BY o.id ASC`,
+		kind,
+		memberID,
+	)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListMemberOrganizations failed kind=%s member_id=%d err=%v", kind, memberID, err)
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.WithContext(ctx).Warnf("ListMemberOrganizations close rows failed err=%v", err)
+		}
+	}()
+
+	out := make([]biz.OrganizationMembership, 0)
+	for rows.Next() {
+		var m biz.OrganizationMembership
+		o := &m.Organization
+		if err := rows.Scan(&o.ID, &o.Slug, &o.Name, &o.CreatedAt, &o.UpdatedAt, &m.JoinedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
+
+func (r *organizationRepo) AddOrganizationMember(ctx context.Context, orgID int, kind string, memberID int) error {
+	query, notFound := `SELECT id FROM users WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, biz.ErrUserNotFound
+	if kind == biz.OrganizationMemberAdmin {
+		query, notFound = `SELECT id FROM admin_users WHERE id = $1 FOR UPDATE`, biz.ErrAdminNotFound
+	}
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		// 账号校验直接走 SQL，不受当前组织范围影响；锁住账号行，避免并发加入其他组织绕过下面的检查。
+		var locked int
+		err := tx.QueryRowContext(ctx, query, memberID).Scan(&locked)
+		if errors.Is(err, sql.ErrNoRows) {
+			return notFound
+		}
+		if err != nil {
+			return err
+		}
+		// 限定组织时只能拉入还不属于任何其他组织的账号，不能把别的组织的成员拉过来。
+		if _, scoped := biz.TenantFromContext(ctx); scoped {
+			var elsewhere bool
+			if err := tx.QueryRowContext(
+				ctx,
+				`SELECT EXISTS (SELECT 1 FROM organization_members WHERE member_kind = $1 AND member_id = $2 AND organization_id <> $3)`,
+				kind, memberID, orgID,
+			).Scan(&elsewhere); err != nil {
+				return err
+			}
+			if elsewhere {
+				return biz.ErrOrganizationForbidden
+			}
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO organization_members (organization_id, member_kind, member_id, created_at)
+			 VALUES ($1, $2, $3, $4)
+			 ON CONFLICT (organization_id, member_kind, member_id) DO NOTHING`,
+			orgID,
+			kind,
+			memberID,
+			time.Now(),
+		)
+		return err
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("AddOrganizationMember failed org_id=%d kind=%s member_id=%d err=%v", orgID, kind, memberID, err)
+	}
+	return err
+}
+
+func (r *organizationRepo) RemoveOrganizationMember(ctx context.Context, orgID int, kind string, memberID int) error {
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(
+			ctx,
+			`DELETE FROM organization_members WHERE organization_id = $1 AND member_kind = $2 AND member_id = $3`,
+			orgID, kind, memberID,
+		)
+		if err != nil {
+			return err
+		}
+		if kind != biz.OrganizationMemberAdmin {
+			return nil
+		}
+		if n, err := result.RowsAffected(); err == nil && n > 0 {
+			// 管理员没有任何组织即成为平台管理员，移除最后一个组织会扩大其权限范围。
+			var remaining int
+			if err := tx.QueryRowContext(
+				ctx,
+				`SELECT COUNT(*) FROM organization_members WHERE member_kind = 'admin' AND member_id = $1`,
+				memberID,
+			).Scan(&remaining); err != nil {
+				return err
+			}
+			if remaining == 0 {
+				return biz.ErrOrganizationLastMembership
+			}
+		}
+		_, err = tx.ExecContext(
+			ctx,
+			`DELETE FROM organization_admin_roles WHERE organization_id = $1 AND admin_user_id = $2`,
+			orgID, memberID,
+		)
+		return err
+	})
+	if err != nil && !errors.Is(err, biz.ErrOrganizationLastMembership) {
+		r.log.WithContext(ctx).Errorf("RemoveOrganizationMember failed org_id=%d kind=%s member_id=%d err=%v", orgID, kind, memberID, err)
+	}
+	return err
+}
+
+func (r *organizationRepo) SetOrganizationAdminRoles(ctx context.Context, orgID, adminID int, roleKeys []string) ([]string, error) {
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		var member bool
+		if err := tx.QueryRowContext(
+			ctx,
+			`SELECT EXISTS (SELECT 1 FROM organization_members WHERE organization_id = $1 AND member_kind = 'admin' AND member_id = $2)`,
+			orgID, adminID,
+		).Scan(&member); err != nil {
+			return err
+		}
+		if !member {
+			return biz.ErrAdminNotFound
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			`DELETE FROM organization_admin_roles WHERE organization_id = $1 AND admin_user_id = $2`,
+			orgID, adminID,
+		); err != nil {
+			return err
+		}
+		now := time.Now()
+		for _, key := range roleKeys {
+			result, err := tx.ExecContext(
+				ctx,
+				`INSERT INTO organization_admin_roles (organization_id, admin_user_id, admin_role_id, created_at)
+				 SELECT $1, $2, ar.id, $4 FROM admin_roles ar WHERE ar.key = $3`,
+				orgID, adminID, key, now,
+			)
+			if err != nil {
+				return err
+			}
+			if n, err := result.RowsAffected(); err == nil && n == 0 {
+				return biz.ErrRoleNotFound
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, biz.ErrAdminNotFound) && !errors.Is(err, biz.ErrRoleNotFound) {
+			r.log.WithContext(ctx).Errorf("SetOrganizationAdminRoles failed org_id=%d admin_id=%d err=%v", orgID, adminID, err)
+		}
+		return nil, err
+	}
+	return append([]string{}, roleKeys...), nil
+}
+
+func (r *organizationRepo) withTx(ctx context.Context, fn func(tx *sql.Tx) error) (err error) {
+	tx, err := r.data.sqldb.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				r.log.WithContext(ctx).Warnf("organization tx rollback failed err=%v", rbErr)
+			}
+		}
+	}()
+	if err = fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func toBizOrganization(o *ent.Organization) *biz.Organization {
+	return &biz.Organization{
+		ID:        o.ID,
+		Slug:      o.Slug,
+		Name:      o.Name,
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
@@ -0,0 +1,100 @@
+// server/internal/data/permission_reconciler.go
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ReconcileAdminPermissions 启动时把代码里注册的权限码同步到 admin_permissions：
+// 新增的插入（含推导出的通配权限）、已有的以代码为准更新名称/分组/描述，并补齐 super_admin 的全量权限。
+// 数据库里不再注册的权限码只告警不删除，清理交给人工（可能仍绑定在自定义角色上）。
+func ReconcileAdminPermissions(ctx context.Context, d *Data, l *log.Helper) error {
+	if d == nil || d.sqldb == nil {
+		return errors.New("ReconcileAdminPermissions: missing db")
+	}
+
+	now := time.Now()
+	registered := biz.AdminPermissionCatalog()
+	for _, p := range registered {
+		_, err := d.sqldb.ExecContext(
+			ctx,
+			`INSERT INTO admin_permissions (key, name, "group", description, builtin, created_at, updated_at)
+			 VALUES ($1, $2, $3, $4, TRUE, $5, $6)
+			 ON CONFLICT (key) DO UPDATE SET
+			   name = EXCLUDED.name,
+			   "group" = EXCLUDED."group",
+			   description = EXCLUDED.description,
+			   builtin = TRUE,
+			   updated_at = EXCLUDED.updated_at`,
+			p.Key,
+			p.Name,
+			p.Group,
+			p.Description,
+			now,
+			now,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// 首次启动时 super_admin 角色可能尚未创建，此时这条语句不影响任何行，由管理员初始化流程补齐。
+	if err := grantAllPermissionsToSuperAdmin(ctx, d, now); err != nil {
+		return err
+	}
+
+	inDB, err := listAdminPermissions(ctx, d)
+	if err != nil {
+		return err
+	}
+	diff := biz.DiffAdminPermissions(inDB)
+	for _, p := range diff.Orphaned {
+		l.Warnf("admin permission orphaned key=%s name=%s: no longer registered in code, bound roles keep it until removed manually", p.Key, p.Name)
+	}
+	l.Infof("admin permissions reconciled registered=%d orphaned=%d", len(registered), len(diff.Orphaned))
+	return nil
+}
+
+func grantAllPermissionsToSuperAdmin(ctx context.Context, d *Data, now time.Time) error {
+	_, err := d.sqldb.ExecContext(
+		ctx,
+		`INSERT INTO admin_role_permissions (admin_role_id, admin_permission_id, created_at)
+		 SELECT r.id, p.id, $1
+		 FROM admin_roles r
+		 CROSS JOIN admin_permissions p
+		 WHERE r.key = $2
+		 ON CONFLICT (admin_role_id, admin_permission_id) DO NOTHING`,
+		now,
+		biz.SuperAdminRoleKey,
+	)
+	return err
+}
+
+func listAdminPermissions(ctx context.Context, d *Data) ([]biz.RBACPermissionSummary, error) {
+	rows, err := d.sqldb.QueryContext(
+		ctx,
+		`SELECT key, name, "group", description, builtin FROM admin_permissions ORDER BY key`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var out []biz.RBACPermissionSummary
+	for rows.Next() {
+		var p biz.RBACPermissionSummary
+		if err := rows.Scan(&p.Key, &p.Name, &p.Group, &p.Description, &p.Builtin); err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,44 @@
+package data
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"server/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestReconcileAdminPermissionsUpsertsRegisteredAndKeepsOrphans(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+
+	rows := sqlmock.NewRows([]string{"key", "name", "group", "description", "builtin"})
+	for _, p := range biz.AdminPermissionCatalog() {
+		mock.ExpectExec("INSERT INTO admin_permissions").
+			WithArgs(p.Key, p.Name, p.Group, p.Description, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		rows.AddRow(p.Key, p.Name, p.Group, p.Description, true)
+	}
+	rows.AddRow("legacy.points.write", "积分", "积分", "", true)
+	mock.ExpectExec("INSERT INTO admin_role_permissions").
+		WithArgs(sqlmock.AnyArg(), biz.SuperAdminRoleKey).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT key, name, \"group\", description, builtin FROM admin_permissions").
+		WillReturnRows(rows)
+	mock.ExpectClose()
+
+	// 孤儿权限码只告警，不应出现 DELETE。
+	if err := ReconcileAdminPermissions(context.Background(), &Data{sqldb: db}, log.NewHelper(log.NewStdLogger(io.Discard))); err != nil {
+		t.Fatalf("ReconcileAdminPermissions() error = %v", err)
+	}
+	mustCloseDB(t, db)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("ExpectationsWereMet() error = %v", err)
+	}
+}
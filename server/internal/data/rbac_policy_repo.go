@@ -0,0 +1,194 @@
+// server/internal/data/rbac_policy_repo.go
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"server/internal/biz"
+)
+
+func (r *rbacRepo) ListPolicyBindings(ctx context.Context) ([]biz.RBACPolicyBinding, error) {
+	return r.listPolicyBindings(ctx, r.data.sqldb)
+}
+
+func (r *rbacRepo) listPolicyBindings(ctx context.Context, q sqlQuerier) ([]biz.RBACPolicyBinding, error) {
+	rows, err := q.QueryContext(
+		ctx,
+		`SELECT u.id, u.username, COALESCE(string_agg(ar.key, ',' ORDER BY ar.key), '')
+		 FROM admin_users u
+		 LEFT JOIN admin_user_roles aur ON aur.admin_user_id = u.id AND aur.expires_at IS NULL
+		 LEFT JOIN admin_roles ar ON ar.id = aur.admin_role_id
+		 GROUP BY u.id
+		 ORDER BY u.username_normalized`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.WithContext(ctx).Warnf("close policy binding rows failed err=%v", err)
+		}
+	}()
+
+	var out []biz.RBACPolicyBinding
+	for rows.Next() {
+		var (
+			b     biz.RBACPolicyBinding
+			roles string
+		)
+		if err := rows.Scan(&b.AdminID, &b.Admin, &roles); err != nil {
+			return nil, err
+		}
+		b.Roles = splitKeys(roles)
+		out = append(out, b)
+	}
+	return out, rows.Err()
+}
+
+// ListSuperAdminRoleKeys 包括临时授权：临时超管同样不能被非超管改角色削权。
+func (r *rbacRepo) ListSuperAdminRoleKeys(ctx context.Context) ([]string, error) {
+	return r.queryKeys(
+		ctx,
+		`SELECT DISTINCT ar.key
+		 FROM admin_user_roles aur
+		 JOIN admin_roles ar ON ar.id = aur.admin_role_id
+		 WHERE `+adminRoleGrantActive+` AND aur.admin_user_id IN (
+		   SELECT aur.admin_user_id
+		   FROM admin_user_roles aur
+		   JOIN admin_roles ar ON ar.id = aur.admin_role_id
+		   WHERE ar.key = $1 AND `+adminRoleGrantActive+`
+		 )
+		 ORDER BY ar.key`,
+		biz.SuperAdminRoleKey,
+	)
+}
+
+// ApplyPolicy 在 withRoleTx 里（已锁住 super_admin 角色行，其他角色与账号变更都会等待）重新读取现状并计算计划，
+// 保证应用的计划与事务内看到的状态一致。
+func (r *rbacRepo) ApplyPolicy(ctx context.Context, planFn func(*biz.RBACOverview, []biz.RBACPolicyBinding) (*biz.RBACPolicyPlan, error)) (*biz.RBACPolicyPlan, error) {
+	var plan *biz.RBACPolicyPlan
+	err := r.withRoleTx(ctx, func(tx *sql.Tx) error {
+		overview, err := r.overview(ctx, tx)
+		if err != nil {
+			return err
+		}
+		bindings, err := r.listPolicyBindings(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if plan, err = planFn(overview, bindings); err != nil {
+			return err
+		}
+		return applyPolicyPlanTx(ctx, tx, plan)
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("ApplyPolicy failed err=%v", err)
+		return nil, err
+	}
+	r.log.WithContext(ctx).Infof("ApplyPolicy done create=%d update=%d delete=%d bindings=%d",
+		len(plan.CreateRoles), len(plan.UpdateRoles), len(plan.DeleteRoles), len(plan.Bindings))
+	return plan, nil
+}
+
+// applyPolicyPlanTx 按依赖顺序执行：先删被裁剪的角色，再建新角色、覆盖名称与权限，
+// 然后统一清掉再写入父角色（先清后写，中间状态不会因为交换父子关系而误判成环），最后替换管理员绑定。
+func applyPolicyPlanTx(ctx context.Context, tx *sql.Tx, plan *biz.RBACPolicyPlan) error {
+	for _, key := range plan.DeleteRoles {
+		id, err := roleIDByKeyTx(ctx, tx, key)
+		if err != nil {
+			return err
+		}
+		if err := deleteRoleTx(ctx, tx, id); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	roleIDs := map[string]int{}
+	var parentsChanged []biz.RBACPolicyRole
+	for _, role := range plan.CreateRoles {
+		var id int
+		err := tx.QueryRowContext(
+			ctx,
+			`INSERT INTO admin_roles (key, name, description, builtin, created_at, updated_at)
+			 VALUES ($1, $2, $3, FALSE, $4, $4)
+			 ON CONFLICT (key) DO NOTHING
+			 RETURNING id`,
+			role.Key,
+			role.Name,
+			role.Description,
+			now,
+		).Scan(&id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return biz.ErrRoleExists
+		}
+		if err != nil {
+			return err
+		}
+		roleIDs[role.Key] = id
+		if err := replaceRolePermissionsTx(ctx, tx, id, role.Permissions); err != nil {
+			return err
+		}
+		if len(role.Parents) > 0 {
+			parentsChanged = append(parentsChanged, role)
+		}
+	}
+
+	for _, change := range plan.UpdateRoles {
+		role := change.After
+		id, err := roleIDByKeyTx(ctx, tx, role.Key)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(
+			ctx,
+			`UPDATE admin_roles SET name = $1, description = $2, updated_at = $3 WHERE id = $4`,
+			role.Name,
+			role.Description,
+			now,
+			id,
+		); err != nil {
+			return err
+		}
+		roleIDs[role.Key] = id
+		if !slices.Equal(change.Before.Permissions, role.Permissions) {
+			if err := replaceRolePermissionsTx(ctx, tx, id, role.Permissions); err != nil {
+				return err
+			}
+		}
+		if !slices.Equal(change.Before.Parents, role.Parents) {
+			parentsChanged = append(parentsChanged, role)
+		}
+	}
+
+	for _, role := range parentsChanged {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM admin_role_parents WHERE admin_role_id = $1`, roleIDs[role.Key]); err != nil {
+			return err
+		}
+	}
+	for _, role := range parentsChanged {
+		if err := insertRoleParentsTx(ctx, tx, roleIDs[role.Key], role.Parents); err != nil {
+			return err
+		}
+	}
+
+	for _, b := range plan.Bindings {
+		if err := replaceAdminRolesTx(ctx, tx, b.AdminID, b.After); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func roleIDByKeyTx(ctx context.Context, tx *sql.Tx, key string) (int, error) {
+	var id int
+	err := tx.QueryRowContext(ctx, `SELECT id FROM admin_roles WHERE key = $1`, key).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, biz.ErrRoleNotFound
+	}
+	return id, err
+}
@@ -2,6 +2,10 @@ package data
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
 
 	"server/internal/biz"
 
@@ -22,8 +26,17 @@ func NewRBACRepo(data *Data, logger log.Logger) *rbacRepo {
 
 var _ biz.RBACRepo = (*rbacRepo)(nil)
 
+// sqlQuerier 让同一段查询既能直接走连接池，也能在事务内执行。
+type sqlQuerier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
 func (r *rbacRepo) Overview(ctx context.Context) (*biz.RBACOverview, error) {
-	roleRows, err := r.data.sqldb.QueryContext(
+	return r.overview(ctx, r.data.sqldb)
+}
+
+func (r *rbacRepo) overview(ctx context.Context, q sqlQuerier) (*biz.RBACOverview, error) {
+	roleRows, err := q.QueryContext(
 		ctx,
 		`SELECT ar.id, ar.key, ar.name, ar.description, ar.builtin, COUNT(aur.admin_user_id) AS admin_count
 		 FROM admin_roles ar
@@ -51,8 +64,15 @@ func (r *rbacRepo) Overview(ctx context.Context) (*biz.RBACOverview, error) {
 	if err := roleRows.Err(); err != nil {
 		return nil, err
 	}
+	if err := r.fillRolePermissions(ctx, q, roles); err != nil {
+		return nil, err
+	}
+	if err := r.fillRoleParents(ctx, q, roles); err != nil {
+		return nil, err
+	}
+	biz.ApplyRoleInheritance(roles)
 
-	permissionRows, err := r.data.sqldb.QueryContext(
+	permissionRows, err := q.QueryContext(
 		ctx,
 		`SELECT key, name, "group", description, builtin
 		 FROM admin_permissions
@@ -84,3 +104,465 @@ func (r *rbacRepo) Overview(ctx context.Context) (*biz.RBACOverview, error) {
 		Permissions: permissions,
 	}, nil
 }
+
+func (r *rbacRepo) fillRolePermissions(ctx context.Context, q sqlQuerier, roles []biz.RBACRoleSummary) error {
+	rows, err := q.QueryContext(
+		ctx,
+		`SELECT arp.admin_role_id, CASE WHEN arp.deny THEN '!' || ap.key ELSE ap.key END
+		 FROM admin_role_permissions arp
+		 JOIN admin_permissions ap ON ap.id = arp.admin_permission_id
+		 ORDER BY ap.key ASC, arp.deny ASC`,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.WithContext(ctx).Warnf("close role permission rows failed err=%v", err)
+		}
+	}()
+
+	byRole := map[int][]string{}
+	for rows.Next() {
+		var roleID int
+		var key string
+		if err := rows.Scan(&roleID, &key); err != nil {
+			return err
+		}
+		byRole[roleID] = append(byRole[roleID], key)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i := range roles {
+		roles[i].Permissions = byRole[roles[i].ID]
+		if roles[i].Permissions == nil {
+			roles[i].Permissions = []string{}
+		}
+	}
+	return nil
+}
+
+func (r *rbacRepo) fillRoleParents(ctx context.Context, q sqlQuerier, roles []biz.RBACRoleSummary) error {
+	rows, err := q.QueryContext(
+		ctx,
+		`SELECT arp.admin_role_id, p.key
+		 FROM admin_role_parents arp
+		 JOIN admin_roles p ON p.id = arp.parent_role_id
+		 ORDER BY p.key ASC`,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.WithContext(ctx).Warnf("close role parent rows failed err=%v", err)
+		}
+	}()
+
+	byRole := map[int][]string{}
+	for rows.Next() {
+		var roleID int
+		var key string
+		if err := rows.Scan(&roleID, &key); err != nil {
+			return err
+		}
+		byRole[roleID] = append(byRole[roleID], key)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i := range roles {
+		roles[i].Parents = byRole[roles[i].ID]
+		if roles[i].Parents == nil {
+			roles[i].Parents = []string{}
+		}
+	}
+	return nil
+}
+
+func (r *rbacRepo) CreateRole(ctx context.Context, in *biz.RBACRoleSummary) (*biz.RBACRoleSummary, error) {
+	var id int
+	err := r.withRoleTx(ctx, func(tx *sql.Tx) error {
+		now := time.Now()
+		err := tx.QueryRowContext(
+			ctx,
+			`INSERT INTO admin_roles (key, name, description, builtin, created_at, updated_at)
+			 VALUES ($1, $2, $3, FALSE, $4, $5)
+			 ON CONFLICT (key) DO NOTHING
+			 RETURNING id`,
+			in.Key,
+			in.Name,
+			in.Description,
+			now,
+			now,
+		).Scan(&id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return biz.ErrRoleExists
+		}
+		if err != nil {
+			return err
+		}
+		return replaceRolePermissionsTx(ctx, tx, id, in.Permissions)
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("CreateRole failed key=%s err=%v", in.Key, err)
+		return nil, err
+	}
+	return r.GetRole(ctx, id)
+}
+
+func (r *rbacRepo) UpdateRole(ctx context.Context, id int, name, description string) (*biz.RBACRoleSummary, error) {
+	result, err := r.data.sqldb.ExecContext(
+		ctx,
+		`UPDATE admin_roles SET name = $1, description = $2, updated_at = $3 WHERE id = $4`,
+		name,
+		description,
+		time.Now(),
+		id,
+	)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("UpdateRole failed id=%d err=%v", id, err)
+		return nil, err
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return nil, biz.ErrRoleNotFound
+	}
+	return r.GetRole(ctx, id)
+}
+
+func (r *rbacRepo) DeleteRole(ctx context.Context, id int) error {
+	err := r.withRoleTx(ctx, func(tx *sql.Tx) error {
+		return deleteRoleTx(ctx, tx, id)
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("DeleteRole failed id=%d err=%v", id, err)
+	}
+	return err
+}
+
+// deleteRoleTx 删除自定义角色及其权限、管理员绑定（含组织内绑定）与继承关系。
+func deleteRoleTx(ctx context.Context, tx *sql.Tx, id int) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM admin_role_permissions WHERE admin_role_id = $1`, id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM admin_user_roles WHERE admin_role_id = $1`, id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM organization_admin_roles WHERE admin_role_id = $1`, id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM admin_role_parents WHERE admin_role_id = $1 OR parent_role_id = $1`, id); err != nil {
+		return err
+	}
+	// builtin 条件是 biz 校验之外的兜底，避免并发下误删内置角色。
+	result, err := tx.ExecContext(ctx, `DELETE FROM admin_roles WHERE id = $1 AND builtin = FALSE`, id)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return biz.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (r *rbacRepo) SetRolePermissions(ctx context.Context, id int, permissionKeys []string) (*biz.RBACRoleSummary, error) {
+	err := r.withRoleTx(ctx, func(tx *sql.Tx) error {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM admin_roles WHERE id = $1)`, id).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return biz.ErrRoleNotFound
+		}
+		return replaceRolePermissionsTx(ctx, tx, id, permissionKeys)
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("SetRolePermissions failed id=%d err=%v", id, err)
+		return nil, err
+	}
+	return r.GetRole(ctx, id)
+}
+
+func (r *rbacRepo) SetRoleParents(ctx context.Context, id int, parentKeys []string) (*biz.RBACRoleSummary, error) {
+	err := r.withRoleTx(ctx, func(tx *sql.Tx) error {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM admin_roles WHERE id = $1)`, id).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return biz.ErrRoleNotFound
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM admin_role_parents WHERE admin_role_id = $1`, id); err != nil {
+			return err
+		}
+		return insertRoleParentsTx(ctx, tx, id, parentKeys)
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("SetRoleParents failed id=%d err=%v", id, err)
+		return nil, err
+	}
+	return r.GetRole(ctx, id)
+}
+
+// insertRoleParentsTx 追加父角色，调用方需先清掉旧的父角色。
+// biz 已按快照校验过无环；这里在同一把锁下逐条再查一次，防止并发修改拼出环。
+func insertRoleParentsTx(ctx context.Context, tx *sql.Tx, id int, parentKeys []string) error {
+	now := time.Now()
+	for _, key := range parentKeys {
+		var parentID int
+		err := tx.QueryRowContext(ctx, `SELECT id FROM admin_roles WHERE key = $1`, key).Scan(&parentID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: %s", biz.ErrRoleNotFound, key)
+		}
+		if err != nil {
+			return err
+		}
+		var cycle bool
+		if err := tx.QueryRowContext(
+			ctx,
+			`WITH RECURSIVE ancestors(id) AS (
+			   SELECT $1::bigint
+			   UNION
+			   SELECT p.parent_role_id FROM admin_role_parents p JOIN ancestors a ON p.admin_role_id = a.id
+			 )
+			 SELECT EXISTS (SELECT 1 FROM ancestors WHERE id = $2)`,
+			parentID,
+			id,
+		).Scan(&cycle); err != nil {
+			return err
+		}
+		if cycle {
+			return fmt.Errorf("%w: %s", biz.ErrRoleCycle, key)
+		}
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO admin_role_parents (admin_role_id, parent_role_id, created_at) VALUES ($1, $2, $3)`,
+			id,
+			parentID,
+			now,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withRoleTx 执行角色变更，并在提交前确认变更没有让启用中的 super_admin 管理员清零。
+func (r *rbacRepo) withRoleTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	return withSuperAdminGuardTx(ctx, r.data.sqldb, r.log, fn)
+}
+
+// withSuperAdminGuardTx 是角色变更与管理员账号变更共用的事务封装。
+// 先锁住 super_admin 角色行，让所有会影响超管数量的变更串行执行，避免并发下各自检查都通过。
+func withSuperAdminGuardTx(ctx context.Context, db *sql.DB, l *log.Helper, fn func(tx *sql.Tx) error) (err error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				l.WithContext(ctx).Warnf("super admin guard tx rollback failed err=%v", rbErr)
+			}
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, `SELECT id FROM admin_roles WHERE key = $1 FOR UPDATE`, biz.SuperAdminRoleKey); err != nil {
+		return err
+	}
+	before, err := countActiveSuperAdminsTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+	if err = fn(tx); err != nil {
+		return err
+	}
+	after, err := countActiveSuperAdminsTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+	// 本来就没有超管（例如未配置初始化管理员）时不拦截，只拦“把最后一个超管变没”的变更。
+	if before > 0 && after == 0 {
+		err = biz.ErrLastSuperAdmin
+		return err
+	}
+	return tx.Commit()
+}
+
+// countActiveSuperAdminsTx 只统计长期绑定 super_admin 的启用管理员；临时授权到期会自动消失，不能算作兜底的超管。
+func countActiveSuperAdminsTx(ctx context.Context, tx *sql.Tx) (int, error) {
+	var count int
+	err := tx.QueryRowContext(
+		ctx,
+		`SELECT COUNT(DISTINCT u.id)
+		 FROM admin_users u
+		 JOIN admin_user_roles aur ON aur.admin_user_id = u.id
+		 JOIN admin_roles r ON r.id = aur.admin_role_id
+		 WHERE r.key = $1 AND u.disabled = FALSE
+		   AND aur.expires_at IS NULL AND (aur.starts_at IS NULL OR aur.starts_at <= now())`,
+		biz.SuperAdminRoleKey,
+	).Scan(&count)
+	return count, err
+}
+
+// replaceRolePermissionsTx 整体替换角色的权限条目；"!" 前缀的条目写成 deny=true。
+// 同一权限码同时出现授予和拒绝时只保留拒绝，反正拒绝优先。
+func replaceRolePermissionsTx(ctx context.Context, tx *sql.Tx, roleID int, permissionKeys []string) error {
+	type entry struct {
+		pid  int
+		deny bool
+	}
+	entries := make([]entry, 0, len(permissionKeys))
+	index := map[int]int{}
+	for _, raw := range permissionKeys {
+		key, deny := biz.ParsePermissionEntry(raw)
+		var pid int
+		err := tx.QueryRowContext(ctx, `SELECT id FROM admin_permissions WHERE key = $1`, key).Scan(&pid)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: %s", biz.ErrPermissionUnknown, key)
+		}
+		if err != nil {
+			return err
+		}
+		if i, ok := index[pid]; ok {
+			entries[i].deny = entries[i].deny || deny
+			continue
+		}
+		index[pid] = len(entries)
+		entries = append(entries, entry{pid: pid, deny: deny})
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM admin_role_permissions WHERE admin_role_id = $1`, roleID); err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, e := range entries {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO admin_role_permissions (admin_role_id, admin_permission_id, deny, created_at) VALUES ($1, $2, $3, $4)`,
+			roleID,
+			e.pid,
+			e.deny,
+			now,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *rbacRepo) GetRole(ctx context.Context, id int) (*biz.RBACRoleSummary, error) {
+	var role biz.RBACRoleSummary
+	err := r.data.sqldb.QueryRowContext(
+		ctx,
+		`SELECT ar.id, ar.key, ar.name, ar.description, ar.builtin,
+		        (SELECT COUNT(*) FROM admin_user_roles aur WHERE aur.admin_role_id = ar.id)
+		 FROM admin_roles ar
+		 WHERE ar.id = $1`,
+		id,
+	).Scan(&role.ID, &role.Key, &role.Name, &role.Description, &role.Builtin, &role.AdminCount)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrRoleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.data.sqldb.QueryContext(
+		ctx,
+		`SELECT CASE WHEN arp.deny THEN '!' || ap.key ELSE ap.key END
+		 FROM admin_permissions ap
+		 JOIN admin_role_permissions arp ON arp.admin_permission_id = ap.id
+		 WHERE arp.admin_role_id = $1
+		 ORDER BY ap.key, arp.deny`,
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.WithContext(ctx).Warnf("close role permission rows failed role_id=%d err=%v", id, err)
+		}
+	}()
+
+	role.Permissions = make([]string, 0)
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		role.Permissions = append(role.Permissions, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if role.Parents, err = r.queryKeys(
+		ctx,
+		`SELECT p.key
+		 FROM admin_role_parents arp
+		 JOIN admin_roles p ON p.id = arp.parent_role_id
+		 WHERE arp.admin_role_id = $1
+		 ORDER BY p.key`,
+		id,
+	); err != nil {
+		return nil, err
+	}
+
+	// 继承的条目沿父角色递归展开；UNION 去重，历史脏数据里即使有环也会终止。
+	inherited, err := r.queryKeys(
+		ctx,
+		`WITH RECURSIVE ancestors(id) AS (
+		   SELECT parent_role_id FROM admin_role_parents WHERE admin_role_id = $1
+		   UNION
+		   SELECT p.parent_role_id FROM admin_role_parents p JOIN ancestors a ON p.admin_role_id = a.id
+		 )
+		 SELECT DISTINCT CASE WHEN arp.deny THEN '!' || ap.key ELSE ap.key END AS k
+		 FROM ancestors a
+		 JOIN admin_role_permissions arp ON arp.admin_role_id = a.id
+		 JOIN admin_permissions ap ON ap.id = arp.admin_permission_id
+		 WHERE a.id <> $1
+		 ORDER BY k`,
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	direct := make(map[string]bool, len(role.Permissions))
+	for _, p := range role.Permissions {
+		direct[p] = true
+	}
+	role.InheritedPermissions = make([]string, 0, len(inherited))
+	for _, p := range inherited {
+		if !direct[p] {
+			role.InheritedPermissions = append(role.InheritedPermissions, p)
+		}
+	}
+	return &role, nil
+}
+
+func (r *rbacRepo) queryKeys(ctx context.Context, query string, args ...any) ([]string, error) {
+	rows, err := r.data.sqldb.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.WithContext(ctx).Warnf("close key rows failed err=%v", err)
+		}
+	}()
+
+	out := make([]string, 0)
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		out = append(out, key)
+	}
+	return out, rows.Err()
+}
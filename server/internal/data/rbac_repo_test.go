@@ -0,0 +1,83 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"server/internal/biz"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestRBACRepoDeleteRoleRollsBackWhenLastSuperAdminLost(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT id FROM admin_roles WHERE key = \\$1 FOR UPDATE").
+		WithArgs(biz.SuperAdminRoleKey).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT COUNT\\(DISTINCT u.id\\)").
+		WithArgs(biz.SuperAdminRoleKey).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec("DELETE FROM admin_role_permissions").WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM admin_user_roles").WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM organization_admin_roles").WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM admin_role_parents").WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM admin_roles").WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT COUNT\\(DISTINCT u.id\\)").
+		WithArgs(biz.SuperAdminRoleKey).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectRollback()
+	mock.ExpectClose()
+
+	repo := NewRBACRepo(&Data{sqldb: db}, log.NewStdLogger(io.Discard))
+	if err := repo.DeleteRole(context.Background(), 5); !errors.Is(err, biz.ErrLastSuperAdmin) {
+		t.Fatalf("DeleteRole() error = %v, want ErrLastSuperAdmin", err)
+	}
+	mustCloseDB(t, db)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestRBACRepoSetRoleParentsRollsBackOnCycle(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT id FROM admin_roles WHERE key = \\$1 FOR UPDATE").
+		WithArgs(biz.SuperAdminRoleKey).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT COUNT\\(DISTINCT u.id\\)").
+		WithArgs(biz.SuperAdminRoleKey).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery("SELECT EXISTS").WithArgs(5).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectExec("DELETE FROM admin_role_parents").WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT id FROM admin_roles WHERE key = \\$1").
+		WithArgs("ops_lead").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(6))
+	mock.ExpectQuery("WITH RECURSIVE ancestors").
+		WithArgs(6, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+	mock.ExpectClose()
+
+	repo := NewRBACRepo(&Data{sqldb: db}, log.NewStdLogger(io.Discard))
+	if _, err := repo.SetRoleParents(context.Background(), 5, []string{"ops_lead"}); !errors.Is(err, biz.ErrRoleCycle) {
+		t.Fatalf("SetRoleParents() error = %v, want ErrRoleCycle", err)
+	}
+	mustCloseDB(t, db)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("ExpectationsWereMet() error = %v", err)
+	}
+}
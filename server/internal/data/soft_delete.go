@@ -0,0 +1,45 @@
+// server/internal/data/soft_delete.go
+package data
+
+import (
+	"context"
+
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/hook"
+	entuser "server/internal/data/model/ent/user"
+)
+
+type includeDeletedUsersKey struct{}
+
+// withDeletedUsers 让 ctx 上的 users 查询与更新包含已软删除的账号；只给删除、恢复、回收站列表和清理任务使用。
+func withDeletedUsers(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedUsersKey{}, true)
+}
+
+func includesDeletedUsers(ctx context.Context) bool {
+	v, _ := ctx.Value(includeDeletedUsersKey{}).(bool)
+	return v
+}
+
+// registerUserSoftDelete 让已软删除的用户对业务代码不可见：
+// 查询（含事务内与关联查询）通过拦截器排除，更新通过 hook 追加同样的条件，按 id 更新已删除用户会得到 NotFound。
+// 原生 SQL 不经过这里，需要自行决定是否包含已删除用户。
+func registerUserSoftDelete(client *ent.Client) {
+	client.User.Intercept(ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+		uq, ok := q.(*ent.UserQuery)
+		if !ok || includesDeletedUsers(ctx) {
+			return nil
+		}
+		uq.Where(entuser.DeletedAtIsNil())
+		return nil
+	}))
+
+	client.User.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.UserFunc(func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) && !includesDeletedUsers(ctx) {
+				m.Where(entuser.DeletedAtIsNil())
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+}
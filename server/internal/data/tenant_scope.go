@@ -0,0 +1,81 @@
+// server/internal/data/tenant_scope.go
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	entloginevent "server/internal/data/model/ent/loginevent"
+	entorgmember "server/internal/data/model/ent/organizationmember"
+	"server/internal/data/model/ent/predicate"
+	entuser "server/internal/data/model/ent/user"
+
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// registerTenantScope 给 users 的所有查询（含事务内）挂上组织范围：
+// ctx 限定了组织时只能查到该组织的成员，不限定组织（平台级或跨组织模式）时不做过滤。
+// 拦截器只作用于查询；按 id 直接更新不会经过它，写操作需要用 tenantUserScope 显式加条件。
+func registerTenantScope(client *ent.Client) {
+	client.User.Intercept(ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+		uq, ok := q.(*ent.UserQuery)
+		if !ok {
+			return nil
+		}
+		if p, scoped := tenantUserScope(ctx); scoped {
+			uq.Where(p)
+		}
+		return nil
+	}))
+}
+
+// tenantUserScope 返回当前组织的用户范围条件；不限定组织时 scoped=false。
+func tenantUserScope(ctx context.Context) (p predicate.User, scoped bool) {
+	orgID, ok := biz.TenantFromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	return predicate.User(func(s *entsql.Selector) {
+		s.Where(entsql.In(s.C(entuser.FieldID), tenantUserIDs(orgID)))
+	}), true
+}
+
+// tenantLoginEventScope 限定组织时只保留该组织用户的登录流水；管理员登录与未匹配到账号的失败记录都不属于任何组织。
+func tenantLoginEventScope(ctx context.Context) (p predicate.LoginEvent, scoped bool) {
+	orgID, ok := biz.TenantFromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	return predicate.LoginEvent(func(s *entsql.Selector) {
+		s.Where(entsql.And(
+			entsql.EQ(s.C(entloginevent.FieldAccountKind), biz.LoginAccountUser),
+			entsql.In(s.C(entloginevent.FieldAccountID), tenantUserIDs(orgID)),
+		))
+	}), true
+}
+
+// tenantUserIDs 是组织内用户 id 的子查询。
+func tenantUserIDs(orgID int) *entsql.Selector {
+	t := entsql.Table(entorgmember.Table)
+	return entsql.Select(t.C(entorgmember.FieldMemberID)).
+		From(t).
+		Where(entsql.And(
+			entsql.EQ(t.C(entorgmember.FieldOrganizationID), orgID),
+			entsql.EQ(t.C(entorgmember.FieldMemberKind), biz.OrganizationMemberUser),
+		))
+}
+
+// tenantUserSQL 给手写 SQL 用：限定组织时返回 " AND <col> IN (...)" 片段及其参数，占位符从 $next 开始编号；
+// 不限定组织时返回空串。
+func tenantUserSQL(ctx context.Context, col string, next int) (string, []any) {
+	orgID, ok := biz.TenantFromContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	return fmt.Sprintf(
+		` AND %s IN (SELECT member_id FROM organization_members WHERE organization_id = $%d AND member_kind = '%s')`,
+		col, next, biz.OrganizationMemberUser,
+	), []any{orgID}
+}
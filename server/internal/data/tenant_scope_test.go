@@ -0,0 +1,111 @@
+package data
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// tenantMembersSQL 是 tenantUserIDs 生成的子查询，参数依次为组织 id 与 'user'。
+const tenantMembersSQL = `IN \(SELECT "organization_members"."member_id" FROM "organization_members" WHERE "organization_members"."organization_id" = \$\d+ AND "organization_members"."member_kind" = \$\d+\)`
+
+func newSQLMockTestData(t *testing.T) (*Data, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
+	registerTenantScope(client)
+	registerUserSoftDelete(client)
+	return &Data{postgres: client, sqldb: db}, mock
+}
+
+func TestTenantScopeListUsersOnlyReturnsMembers(t *testing.T) {
+	d, mock := newSQLMockTestData(t)
+	repo := NewUserAdminRepo(d, log.NewStdLogger(io.Discard))
+
+	mock.ExpectQuery(`SELECT COUNT\("users"."id"\) FROM "users" WHERE .*"users"."id" `+tenantMembersSQL).
+		WithArgs(7, biz.OrganizationMemberUser).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`SELECT .* FROM "users" WHERE .*"users"."id" `+tenantMembersSQL).
+		WithArgs(7, biz.OrganizationMemberUser).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow(3, "alice"))
+	mock.ExpectClose()
+
+	ctx := biz.NewContextWithTenant(context.Background(), 7)
+	page, err := repo.ListUsers(ctx, biz.UserListQuery{PageRequest: biz.PageRequest{Total: biz.TotalExact}})
+	if err != nil {
+		t.Fatalf("ListUsers() error = %v", err)
+	}
+	if page.Total != 1 || len(page.Users) != 1 || page.Users[0].ID != 3 {
+		t.Fatalf("ListUsers() = total %d users %+v", page.Total, page.Users)
+	}
+	mustCloseDB(t, d.sqldb)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestTenantScopeListUsersUnscoped(t *testing.T) {
+	d, mock := newSQLMockTestData(t)
+	repo := NewUserAdminRepo(d, log.NewStdLogger(io.Discard))
+
+	mock.ExpectQuery(`^SELECT .* FROM "users" WHERE "users"."deleted_at" IS NULL ORDER BY`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow(3, "alice").AddRow(4, "bob"))
+	mock.ExpectClose()
+
+	page, err := repo.ListUsers(context.Background(), biz.UserListQuery{PageRequest: biz.PageRequest{Total: biz.TotalNone}})
+	if err != nil {
+		t.Fatalf("ListUsers() error = %v", err)
+	}
+	if len(page.Users) != 2 {
+		t.Fatalf("ListUsers() users = %+v, want both users", page.Users)
+	}
+	mustCloseDB(t, d.sqldb)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestTenantScopeListLoginEventsOnlyMemberUsers(t *testing.T) {
+	d, mock := newSQLMockTestData(t)
+	repo := NewLoginEventRepo(d, log.NewStdLogger(io.Discard))
+
+	scoped := `WHERE "login_events"."account_kind" = \$1 AND "login_events"."account_id" ` + tenantMembersSQL
+	mock.ExpectQuery(`SELECT COUNT\(.*\) FROM "login_events" `+scoped).
+		WithArgs(biz.LoginAccountUser, 7, biz.OrganizationMemberUser).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(`SELECT .* FROM "login_events" ` + scoped).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectClose()
+
+	ctx := biz.NewContextWithTenant(context.Background(), 7)
+	if _, _, err := repo.ListLoginEvents(ctx, biz.LoginEventFilter{Limit: 10}); err != nil {
+		t.Fatalf("ListLoginEvents() error = %v", err)
+	}
+	mustCloseDB(t, d.sqldb)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("ExpectationsWereMet() error = %v", err)
+	}
+}
+
+func TestTenantUserSQL(t *testing.T) {
+	if frag, args := tenantUserSQL(context.Background(), "id", 2); frag != "" || args != nil {
+		t.Fatalf("unscoped tenantUserSQL() = %q %v, want empty", frag, args)
+	}
+
+	frag, args := tenantUserSQL(biz.NewContextWithTenant(context.Background(), 7), "u.id", 3)
+	want := ` AND u.id IN (SELECT member_id FROM organization_members WHERE organization_id = $3 AND member_kind = 'user')`
+	if frag != want || len(args) != 1 || args[0] != 7 {
+		t.Fatalf("tenantUserSQL() = %q %v, want %q [7]", frag, args, want)
+	}
+}
@@ -36,9 +36,9 @@ func NewTokenGenerator(c *conf.Data, logger log.Logger) biz.TokenGenerator {
 
 	l.Infof("token generator init ok, expire=%s", exp)
 
-	// 返回闭包：符合 biz.TokenGenerator(userID, username, role)
-	return func(userID int, username string, role int8) (string, time.Time, error) {
-		l.Infof("gen token uid=%d uname=%s role=%d", userID, username, role)
-		return jwtutil.NewToken(cfg, userID, username, role)
+	// 返回闭包：符合 biz.TokenGenerator(userID, username, role, organizationID)
+	return func(userID int, username string, role int8, organizationID int) (string, time.Time, error) {
+		l.Infof("gen token uid=%d uname=%s role=%d org=%d", userID, username, role, organizationID)
+		return jwtutil.NewToken(cfg, userID, username, role, organizationID)
 	}
 }
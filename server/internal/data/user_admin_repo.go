@@ -3,69 +3,96 @@ package data
 
 import (
 	"context"
-	"strings"
+	"fmt"
+	"time"
 
 	"server/internal/biz"
 	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/predicate"
 	"server/internal/data/model/ent/user"
 
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 type userAdminRepo struct {
 	log  *log.Helper
 	data *Data
+	trgm *pgExtension
 }
 
 func NewUserAdminRepo(d *Data, logger log.Logger) *userAdminRepo {
 	return &userAdminRepo{
 		log:  log.NewHelper(log.With(logger, "module", "data.useradmin_repo")),
 		data: d,
+		trgm: &pgExtension{name: "pg_trgm"},
 	}
 }
 
 var _ biz.UserAdminRepo = (*userAdminRepo)(nil)
 
-func (r *userAdminRepo) ListUsers(ctx context.Context, limit, offset int, usernameLike string) ([]*biz.User, int, error) {
+func (r *userAdminRepo) ListUsers(ctx context.Context, in biz.UserListQuery) (*biz.UserListPage, error) {
 	l := r.log.WithContext(ctx)
 
-	if limit <= 0 {
-		limit = 30
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
-	if limit > 200 {
-		limit = 200
-	}
-	if offset < 0 {
-		offset = 0
-	}
-	usernameLike = strings.TrimSpace(usernameLike)
+	f := in.Filter
 
-	l.Infof("ListUsers start limit=%d offset=%d username_like=%q", limit, offset, usernameLike)
+	l.Infof("ListUsers start limit=%d offset=%d search=%q search_mode=%s sort=%s desc=%v", in.Limit, in.Offset, f.Search, f.SearchMode, in.Sort.Field, in.Sort.Desc)
 
-	q := r.data.postgres.User.Query()
-	if usernameLike != "" {
-		q = q.Where(user.UsernameContains(usernameLike))
-	}
+	trgm := r.useTrgm(ctx, f)
+	q := r.data.postgres.User.Query().Where(userFilterPredicates(f, trgm)...)
 
-	total, err := q.Clone().Count(ctx)
-	if err != nil {
+	page := &biz.UserListPage{}
+	if err := r.countUsers(ctx, q, in, &page.PageInfo); err != nil {
 		l.Errorf("ListUsers count failed err=%v", err)
-		return nil, 0, err
+		return nil, err
 	}
 
-	rows, err := q.
-		Order(ent.Desc(user.FieldID)).
-		Limit(limit).
-		Offset(offset).
-		All(ctx)
+	order := userKeysetOrder(in.Sort)
+	if in.Sort.Field == biz.UserSortRelevance {
+		q = q.Order(userRelevanceOrder(f.Search, trgm))
+	} else {
+		q = q.Order(toUserOrder(order.orderBy())...)
+	}
+	if in.UseCursor {
+		if in.Cursor != "" {
+			c, err := decodePageCursor(in.Cursor, in.Sort.Field, in.Sort.Desc)
+			if err != nil {
+				return nil, err
+			}
+			value, err := userCursorArg(in.Sort.Field, c.Value)
+			if err != nil {
+				return nil, err
+			}
+			q = q.Where(predicate.User(keysetAfter(order, value, c.ID)))
+		}
+		// 多取一行判断是否还有下一页。
+		q = q.Limit(in.Limit + 1)
+	} else {
+		q = q.Limit(in.Limit).Offset(in.Offset)
+	}
+
+	rows, err := q.All(ctx)
 	if err != nil {
 		l.Errorf("ListUsers query failed err=%v", err)
-		return nil, 0, err
+		return nil, err
+	}
+	if in.UseCursor && len(rows) > in.Limit {
+		rows = rows[:in.Limit]
+		last := rows[len(rows)-1]
+		page.NextCursor = encodePageCursor(pageCursor{
+			Sort:  in.Sort.Field,
+			Desc:  in.Sort.Desc,
+			Value: userCursorValue(last, in.Sort.Field),
+			ID:    last.ID,
+		})
 	}
 
-	out := make([]*biz.User, 0, len(rows))
+	page.Users = make([]*biz.User, 0, len(rows))
 	for _, u := range rows {
-		out = append(out, &biz.User{
+		bu := &biz.User{
 			ID:          u.ID,
 			Username:    u.Username,
 			Disabled:    u.Disabled,
@@ -73,11 +100,88 @@ func (r *userAdminRepo) ListUsers(ctx context.Context, limit, offset int, userna
 			LastLoginAt: u.LastLoginAt,
 			CreatedAt:   u.CreatedAt,
 			UpdatedAt:   u.UpdatedAt,
-		})
+			DisplayName: u.DisplayName,
+		}
+		if u.Email != nil {
+			bu.Email = *u.Email
+		}
+		page.Users = append(page.Users, bu)
+	}
+
+	l.Infof("ListUsers success count=%d total=%d estimated=%v has_next=%v", len(page.Users), page.Total, page.TotalEstimated, page.NextCursor != "")
+	return page, nil
+}
+
+// useTrgm 判断模糊搜索能否使用 pg_trgm；查询扩展失败时按没有扩展处理，不让列表因此失败。
+func (r *userAdminRepo) useTrgm(ctx context.Context, f biz.UserListFilter) bool {
+	if f.Search == "" || f.SearchMode != biz.UserSearchFuzzy {
+		return false
+	}
+	ok, err := r.trgm.Installed(ctx, r.data.sqldb)
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("check pg_trgm failed, fall back to ILIKE err=%v", err)
+		return false
+	}
+	return ok
+}
+
+// userFilterPredicates 把用户列表筛选条件转换为查询条件，user.list 与批量操作共用；trgm 表示模糊搜索可以使用 pg_trgm。
+func userFilterPredicates(f biz.UserListFilter, trgm bool) []predicate.User {
+	var preds []predicate.User
+	if f.Search != "" {
+		if f.SearchMode == biz.UserSearchFuzzy {
+			preds = append(preds, userFuzzySearch(f.Search, trgm))
+		} else {
+			preds = append(preds, user.UsernameContains(f.Search))
+		}
+	}
+	if len(f.IDs) > 0 {
+		preds = append(preds, user.IDIn(f.IDs...))
+	}
+	if f.Disabled != nil {
+		preds = append(preds, user.Disabled(*f.Disabled))
+	}
+	if f.CreatedFrom != nil {
+		preds = append(preds, user.CreatedAtGTE(*f.CreatedFrom))
+	}
+	if f.CreatedTo != nil {
+		preds = append(preds, user.CreatedAtLT(*f.CreatedTo))
 	}
+	if f.LastLoginFrom != nil {
+		preds = append(preds, user.LastLoginAtGTE(*f.LastLoginFrom))
+	}
+	if f.LastLoginTo != nil {
+		preds = append(preds, user.LastLoginAtLT(*f.LastLoginTo))
+	}
+	if f.NeverLoggedIn {
+		preds = append(preds, user.LastLoginAtIsNil())
+	}
+	return preds
+}
 
-	l.Infof("ListUsers success count=%d total=%d", len(out), total)
-	return out, total, nil
+// countUsers 按 total 模式计算总数。估算只在没有筛选、也不限定组织时使用表统计信息，其余情况退化为精确计数。
+func (r *userAdminRepo) countUsers(ctx context.Context, q *ent.UserQuery, in biz.UserListQuery, out *biz.PageInfo) error {
+	switch in.Total {
+	case biz.TotalNone:
+		return nil
+	case biz.TotalEstimate:
+		if _, scoped := biz.TenantFromContext(ctx); !scoped && in.Filter.IsEmpty() {
+			n, ok, err := estimateTableRows(ctx, r.data.sqldb, user.Table)
+			if err != nil {
+				return err
+			}
+			if ok {
+				out.Total, out.TotalEstimated = n, true
+				return nil
+			}
+		}
+	}
+	n, err := q.Clone().Count(ctx)
+	if err != nil {
+		return err
+	}
+	out.Total = n
+	return nil
 }
 
 func (r *userAdminRepo) SetUserDisabled(ctx context.Context, userID int, disabled bool) error {
@@ -89,16 +193,168 @@ func (r *userAdminRepo) SetUserDisabled(ctx context.Context, userID int, disable
 		return biz.ErrBadParam
 	}
 
-	_, err := r.data.postgres.User.UpdateOneID(userID).SetDisabled(disabled).Save(ctx)
+	// 按 id 更新不经过查询拦截器，这里显式带上组织范围，避免越权修改其他组织的用户。
+	preds := []predicate.User{user.ID(userID)}
+	if p, scoped := tenantUserScope(ctx); scoped {
+		preds = append(preds, p)
+	}
+	n, err := r.data.postgres.User.Update().Where(preds...).SetDisabled(disabled).Save(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			l.Warnf("SetUserDisabled not found user_id=%d", userID)
-			return biz.ErrUserNotFound
-		}
 		l.Errorf("SetUserDisabled failed user_id=%d err=%v", userID, err)
 		return err
 	}
+	if n == 0 {
+		l.Warnf("SetUserDisabled not found user_id=%d", userID)
+		return biz.ErrUserNotFound
+	}
 
 	l.Infof("SetUserDisabled success user_id=%d disabled=%v", userID, disabled)
 	return nil
 }
+
+// userKeysetOrder 把排序条件转换为 keyset 排序；从未登录的用户（last_login_at 为空）始终排在最后。
+func userKeysetOrder(s biz.UserListSort) keysetOrder {
+	switch s.Field {
+	case biz.UserSortUsername:
+		return keysetOrder{Column: user.FieldUsername, Desc: s.Desc}
+	case biz.UserSortCreatedAt:
+		return keysetOrder{Column: user.FieldCreatedAt, Desc: s.Desc}
+	case biz.UserSortLastLoginAt:
+		return keysetOrder{Column: user.FieldLastLoginAt, Desc: s.Desc, Nullable: true}
+	default:
+		return keysetOrder{Desc: s.Desc}
+	}
+}
+
+func toUserOrder(in []func(*entsql.Selector)) []user.OrderOption {
+	out := make([]user.OrderOption, 0, len(in))
+	for _, o := range in {
+		out = append(out, o)
+	}
+	return out
+}
+
+// userCursorValue 取出游标行排序列的值；时间统一编码成 RFC3339Nano，空值返回 nil。
+func userCursorValue(u *ent.User, field string) *string {
+	var v string
+	switch field {
+	case biz.UserSortUsername:
+		v = u.Username
+	case biz.UserSortCreatedAt:
+		v = u.CreatedAt.Format(time.RFC3339Nano)
+	case biz.UserSortLastLoginAt:
+		if u.LastLoginAt == nil {
+			return nil
+		}
+		v = u.LastLoginAt.Format(time.RFC3339Nano)
+	default:
+		return nil
+	}
+	return &v
+}
+
+// userCursorArg 把游标里的排序值还原成查询参数。
+func userCursorArg(field string, v *string) (any, error) {
+	if field == biz.UserSortID || v == nil {
+		return nil, nil
+	}
+	if field == biz.UserSortUsername {
+		return *v, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, *v)
+	if err != nil {
+		return nil, fmt.Errorf("%w: 无法解析", biz.ErrCursorInvalid)
+	}
+	return t, nil
+}
+
+// userBulkBatchSize 批量操作每批（每个事务）处理的 id 数，避免长事务和超长 IN 列表。
+const userBulkBatchSize = 200
+
+func (r *userAdminRepo) ListUserIDs(ctx context.Context, f biz.UserListFilter, limit int) ([]int, error) {
+	ids, err := r.data.postgres.User.
+		Query().
+		Where(userFilterPredicates(f, r.useTrgm(ctx, f))...).
+		Order(user.ByID()).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListUserIDs failed err=%v", err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+// BulkSetUserDisabled 分批执行：每批在一个事务里先读出当前状态（受组织范围限制），再只更新状态不同的行；
+// 已提交的批次不会因后续批次失败而回滚，出错时返回错误，调用方可用相同参数重试。
+func (r *userAdminRepo) BulkSetUserDisabled(ctx context.Context, ids []int, disabled, dryRun bool) ([]biz.UserBulkOutcome, error) {
+	l := r.log.WithContext(ctx)
+	l.Infof("BulkSetUserDisabled start count=%d disabled=%v dry_run=%v", len(ids), disabled, dryRun)
+
+	status := make(map[int]string, len(ids))
+	for start := 0; start < len(ids); start += userBulkBatchSize {
+		end := start + userBulkBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		if err := r.bulkSetDisabledBatch(ctx, ids[start:end], disabled, dryRun, status); err != nil {
+			l.Errorf("BulkSetUserDisabled batch failed offset=%d err=%v", start, err)
+			return nil, err
+		}
+	}
+
+	out := make([]biz.UserBulkOutcome, 0, len(ids))
+	for _, id := range ids {
+		st, ok := status[id]
+		if !ok {
+			st = biz.UserBulkNotFound
+		}
+		out = append(out, biz.UserBulkOutcome{UserID: id, Status: st})
+	}
+	return out, nil
+}
+
+func (r *userAdminRepo) bulkSetDisabledBatch(ctx context.Context, batch []int, disabled, dryRun bool, status map[int]string) error {
+	tx, err := r.data.postgres.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	rollback := func(cause error) error {
+		if e := tx.Rollback(); e != nil {
+			r.log.WithContext(ctx).Errorf("BulkSetUserDisabled rollback failed err=%v", e)
+		}
+		return cause
+	}
+
+	rows, err := tx.User.
+		Query().
+		Where(user.IDIn(batch...)).
+		Select(user.FieldID, user.FieldDisabled).
+		All(ctx)
+	if err != nil {
+		return rollback(err)
+	}
+
+	change := make([]int, 0, len(rows))
+	for _, u := range rows {
+		if u.Disabled == disabled {
+			status[u.ID] = biz.UserBulkUnchanged
+			continue
+		}
+		status[u.ID] = biz.UserBulkChanged
+		change = append(change, u.ID)
+	}
+
+	if dryRun || len(change) == 0 {
+		return rollback(nil)
+	}
+	// 只更新仍处于原状态的行。
+	if _, err := tx.User.
+		Update().
+		Where(user.IDIn(change...), user.Disabled(!disabled)).
+		SetDisabled(disabled).
+		Save(ctx); err != nil {
+		return rollback(err)
+	}
+	return tx.Commit()
+}
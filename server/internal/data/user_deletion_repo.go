@@ -0,0 +1,363 @@
+// server/internal/data/user_deletion_repo.go
+package data
+
+import (
+	"context"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	entadminuser "server/internal/data/model/ent/adminuser"
+	"server/internal/data/model/ent/auditlog"
+	"server/internal/data/model/ent/loginevent"
+	entorgmember "server/internal/data/model/ent/organizationmember"
+	entuser "server/internal/data/model/ent/user"
+	"server/internal/data/model/ent/userrolebinding"
+	"server/internal/data/model/ent/verificationcode"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// purgedPasswordHash 不是合法的 bcrypt 哈希，任何密码都校验不过。
+const purgedPasswordHash = "!"
+
+type userDeletionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewUserDeletionRepo(data *Data, logger log.Logger) *userDeletionRepo {
+	return &userDeletionRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data.user_deletion_repo")),
+	}
+}
+
+var _ biz.UserDeletionRepo = (*userDeletionRepo)(nil)
+
+func (r *userDeletionRepo) SoftDeleteUser(ctx context.Context, userID int, at time.Time, releaseUsername bool) (*biz.DeletedUser, error) {
+	l := r.log.WithContext(ctx)
+
+	u, err := r.data.postgres.User.Query().Where(entuser.ID(userID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrUserNotFound
+		}
+		l.Errorf("SoftDeleteUser load failed user_id=%d err=%v", userID, err)
+		return nil, err
+	}
+
+	// 按 id 更新不经过查询拦截器，显式带上组织范围；未删除的条件由软删除 hook 追加。
+	m := r.data.postgres.User.UpdateOneID(userID).SetDeletedAt(at)
+	if p, scoped := tenantUserScope(ctx); scoped {
+		m = m.Where(p)
+	}
+	if releaseUsername {
+		tombstone := biz.DeletedUserTombstone(userID)
+		m = m.SetDeletedUsername(u.Username).SetUsername(tombstone).SetUsernameNormalized(tombstone)
+	}
+	if _, err := m.Save(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrUserNotFound
+		}
+		l.Errorf("SoftDeleteUser failed user_id=%d err=%v", userID, err)
+		return nil, err
+	}
+
+	return &biz.DeletedUser{
+		ID:               u.ID,
+		Username:         u.Username,
+		UsernameReleased: releaseUsername,
+		Disabled:         u.Disabled,
+		DeletedAt:        at,
+		CreatedAt:        u.CreatedAt,
+	}, nil
+}
+
+func (r *userDeletionRepo) RestoreUser(ctx context.Context, userID int) (*biz.User, error) {
+	l := r.log.WithContext(ctx)
+	ctx = withDeletedUsers(ctx)
+
+	u, err := r.data.postgres.User.Query().
+		Where(entuser.ID(userID), entuser.DeletedAtNotNil(), entuser.PurgedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrUserNotFound
+		}
+		l.Errorf("RestoreUser load failed user_id=%d err=%v", userID, err)
+		return nil, err
+	}
+
+	m := r.data.postgres.User.UpdateOneID(userID).
+		Where(entuser.DeletedAtNotNil(), entuser.PurgedAtIsNil()).
+		ClearDeletedAt()
+	if p, scoped := tenantUserScope(ctx); scoped {
+		m = m.Where(p)
+	}
+	username := u.Username
+	if u.DeletedUsername != nil {
+		// 用户名已释放：users 表的冲突由唯一索引兜底，管理员同名需要单独检查。
+		username = *u.DeletedUsername
+		taken, err := r.data.postgres.AdminUser.Query().
+			Where(entadminuser.UsernameNormalized(biz.NormalizeUsername(username))).
+			Exist(ctx)
+		if err != nil {
+			l.Errorf("RestoreUser check admin username failed user_id=%d err=%v", userID, err)
+			return nil, err
+		}
+		if taken {
+			return nil, biz.ErrUserRestoreConflict
+		}
+		m = m.SetUsername(username).
+			SetUsernameNormalized(biz.NormalizeUsername(username)).
+			ClearDeletedUsername()
+	}
+
+	restored, err := m.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrUserNotFound
+		}
+		if isDuplicateUniqueConstraint(err, "user_username", "users.username") {
+			l.Warnf("RestoreUser username taken user_id=%d username=%s", userID, username)
+			return nil, biz.ErrUserRestoreConflict
+		}
+		l.Errorf("RestoreUser failed user_id=%d err=%v", userID, err)
+		return nil, err
+	}
+
+	return &biz.User{
+		ID:          restored.ID,
+		Username:    restored.Username,
+		Disabled:    restored.Disabled,
+		Role:        int8(biz.RoleUser),
+		LastLoginAt: restored.LastLoginAt,
+		CreatedAt:   restored.CreatedAt,
+		UpdatedAt:   restored.UpdatedAt,
+	}, nil
+}
+
+func (r *userDeletionRepo) ListDeletedUsers(ctx context.Context, limit, offset int) ([]*biz.DeletedUser, int, error) {
+	ctx = withDeletedUsers(ctx)
+
+	q := r.data.postgres.User.Query().Where(entuser.DeletedAtNotNil(), entuser.PurgedAtIsNil())
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListDeletedUsers count failed err=%v", err)
+		return nil, 0, err
+	}
+
+	rows, err := q.
+		Order(entuser.ByDeletedAt(entsql.OrderDesc()), entuser.ByID(entsql.OrderDesc())).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListDeletedUsers failed err=%v", err)
+		return nil, 0, err
+	}
+
+	out := make([]*biz.DeletedUser, 0, len(rows))
+	for _, u := range rows {
+		d := &biz.DeletedUser{
+			ID:        u.ID,
+			Username:  u.Username,
+			Disabled:  u.Disabled,
+			CreatedAt: u.CreatedAt,
+		}
+		if u.DeletedAt != nil {
+			d.DeletedAt = *u.DeletedAt
+		}
+		if u.DeletedUsername != nil {
+			d.Username, d.UsernameReleased = *u.DeletedUsername, true
+		}
+		out = append(out, d)
+	}
+	return out, total, nil
+}
+
+// PurgeDeletedUsers 在一个事务里匿名化一批超过保留期的已删除用户。
+func (r *userDeletionRepo) PurgeDeletedUsers(ctx context.Context, before time.Time, limit int) ([]int, error) {
+	l := r.log.WithContext(ctx)
+	ctx = withDeletedUsers(ctx)
+
+	ids, err := r.data.postgres.User.Query().
+		Where(entuser.DeletedAtLT(before), entuser.PurgedAtIsNil()).
+		Order(entuser.ByID()).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		l.Errorf("PurgeDeletedUsers list failed err=%v", err)
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	if err := r.anonymizeUsers(ctx, ids, time.Now()); err != nil {
+		l.Errorf("PurgeDeletedUsers failed count=%d err=%v", len(ids), err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *userDeletionRepo) ScheduleUserDeletion(ctx context.Context, userID int, at time.Time) (time.Time, error) {
+	l := r.log.WithContext(ctx)
+
+	u, err := r.data.postgres.User.Query().Where(entuser.ID(userID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return time.Time{}, biz.ErrUserNotFound
+		}
+		l.Errorf("ScheduleUserDeletion load failed user_id=%d err=%v", userID, err)
+		return time.Time{}, err
+	}
+	if u.DeletionScheduledAt != nil {
+		return *u.DeletionScheduledAt, nil
+	}
+
+	n, err := r.data.postgres.User.Update().
+		Where(entuser.ID(userID), entuser.DeletionScheduledAtIsNil()).
+		SetDeletionScheduledAt(at).
+		Save(ctx)
+	if err != nil {
+		l.Errorf("ScheduleUserDeletion failed user_id=%d err=%v", userID, err)
+		return time.Time{}, err
+	}
+	if n == 0 {
+		// 并发申请时以先写入的时间为准。
+		u, err = r.data.postgres.User.Query().Where(entuser.ID(userID)).Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return time.Time{}, biz.ErrUserNotFound
+			}
+			return time.Time{}, err
+		}
+		if u.DeletionScheduledAt != nil {
+			return *u.DeletionScheduledAt, nil
+		}
+	}
+	return at, nil
+}
+
+func (r *userDeletionRepo) CancelUserDeletion(ctx context.Context, userID int) (bool, error) {
+	n, err := r.data.postgres.User.Update().
+		Where(entuser.ID(userID), entuser.DeletionScheduledAtNotNil()).
+		ClearDeletionScheduledAt().
+		Save(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("CancelUserDeletion failed user_id=%d err=%v", userID, err)
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// EraseScheduledUsers 不经过回收站：账号在同一个事务里标记删除并匿名化。
+func (r *userDeletionRepo) EraseScheduledUsers(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	l := r.log.WithContext(ctx)
+	ctx = withDeletedUsers(ctx)
+
+	ids, err := r.data.postgres.User.Query().
+		Where(entuser.DeletionScheduledAtLTE(now), entuser.PurgedAtIsNil()).
+		Order(entuser.ByID()).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		l.Errorf("EraseScheduledUsers list failed err=%v", err)
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	if err := r.anonymizeUsers(ctx, ids, now); err != nil {
+		l.Errorf("EraseScheduledUsers failed count=%d err=%v", len(ids), err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+// anonymizeUsers 在一个事务里匿名化用户：清空用户名、联系方式与资料，并删除组织成员关系、角色绑定、
+// 验证码与登录流水；尚未标记删除的一并标记。id 本身保留，审计等引用它的记录不受影响。ctx 需带 withDeletedUsers。
+// 审计记录保留动作、双方类型与 id、request_id 和时间，用于追溯；用户作为操作者时记下的用户名换成墓碑，
+// 与该用户相关的 detail 清空（资料修改前后的值等可能含个人信息）。邀请码使用记录只含 id，保留。
+func (r *userDeletionRepo) anonymizeUsers(ctx context.Context, ids []int, now time.Time) error {
+	tx, err := r.data.postgres.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	rollback := func(cause error) error {
+		if e := tx.Rollback(); e != nil {
+			r.log.WithContext(ctx).Errorf("anonymizeUsers rollback failed err=%v", e)
+		}
+		return cause
+	}
+
+	if _, err := tx.User.Update().
+		Where(entuser.IDIn(ids...), entuser.DeletedAtIsNil()).
+		SetDeletedAt(now).
+		Save(ctx); err != nil {
+		return rollback(err)
+	}
+	for _, id := range ids {
+		tombstone := biz.DeletedUserTombstone(id)
+		if err := tx.User.UpdateOneID(id).
+			Where(entuser.PurgedAtIsNil()).
+			SetUsername(tombstone).
+			SetUsernameNormalized(tombstone).
+			SetPasswordHash(purgedPasswordHash).
+			SetDisabled(true).
+			ClearDeletedUsername().
+			ClearEmail().
+			ClearEmailVerifiedAt().
+			ClearPhone().
+			ClearPhoneVerifiedAt().
+			ClearLastLoginAt().
+			SetDisplayName("").
+			SetAvatarURL("").
+			SetLocale("").
+			SetTimezone("").
+			ClearAttributes().
+			SetPurgedAt(now).
+			Exec(ctx); err != nil {
+			return rollback(err)
+		}
+		if _, err := tx.AuditLog.Update().
+			Where(auditlog.ActorKind(biz.AuditActorUser), auditlog.ActorID(id)).
+			SetActorUsername(tombstone).
+			Save(ctx); err != nil {
+			return rollback(err)
+		}
+	}
+
+	if _, err := tx.AuditLog.Update().
+		Where(auditlog.Or(
+			auditlog.And(auditlog.ActorKind(biz.AuditActorUser), auditlog.ActorIDIn(ids...)),
+			auditlog.And(auditlog.TargetKind("user"), auditlog.TargetIDIn(ids...)),
+		)).
+		ClearDetail().
+		Save(ctx); err != nil {
+		return rollback(err)
+	}
+
+	if _, err := tx.OrganizationMember.Delete().
+		Where(entorgmember.MemberKind(biz.OrganizationMemberUser), entorgmember.MemberIDIn(ids...)).
+		Exec(ctx); err != nil {
+		return rollback(err)
+	}
+	if _, err := tx.UserRoleBinding.Delete().Where(userrolebinding.UserIDIn(ids...)).Exec(ctx); err != nil {
+		return rollback(err)
+	}
+	if _, err := tx.VerificationCode.Delete().Where(verificationcode.UserIDIn(ids...)).Exec(ctx); err != nil {
+		return rollback(err)
+	}
+	if _, err := tx.LoginEvent.Delete().
+		Where(loginevent.AccountKind(biz.LoginAccountUser), loginevent.AccountIDIn(ids...)).
+		Exec(ctx); err != nil {
+		return rollback(err)
+	}
+
+	return tx.Commit()
+}
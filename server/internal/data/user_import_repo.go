@@ -0,0 +1,137 @@
+// server/internal/data/user_import_repo.go
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/schema"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type userImportRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewUserImportRepo(data *Data, logger log.Logger) *userImportRepo {
+	return &userImportRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data.user_import_repo")),
+	}
+}
+
+var _ biz.UserImportRepo = (*userImportRepo)(nil)
+
+func (r *userImportRepo) CreateImportJob(ctx context.Context, in *biz.UserImportJob) (*biz.UserImportJob, error) {
+	row, err := r.data.postgres.UserImportJob.
+		Create().
+		SetStatus(in.Status).
+		SetDryRun(in.DryRun).
+		SetOrganizationID(in.OrganizationID).
+		SetCreatedBy(in.CreatedBy).
+		SetTotalRows(in.TotalRows).
+		Save(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("CreateImportJob failed created_by=%d err=%v", in.CreatedBy, err)
+		return nil, err
+	}
+	return toBizUserImportJob(row), nil
+}
+
+func (r *userImportRepo) UpdateImportJob(ctx context.Context, job *biz.UserImportJob) error {
+	rowErrors := make([]schema.UserImportRowError, 0, len(job.RowErrors))
+	for _, e := range job.RowErrors {
+		rowErrors = append(rowErrors, schema.UserImportRowError{Row: e.Row, Username: e.Username, Message: e.Message})
+	}
+	m := r.data.postgres.UserImportJob.
+		UpdateOneID(job.ID).
+		SetStatus(job.Status).
+		SetProcessedRows(job.ProcessedRows).
+		SetCreatedCount(job.CreatedCount).
+		SetFailedCount(job.FailedCount).
+		SetRowErrors(rowErrors).
+		SetError(job.Error).
+		SetNillableFinishedAt(job.FinishedAt)
+	row, err := m.Save(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("UpdateImportJob failed id=%d status=%s err=%v", job.ID, job.Status, err)
+		return err
+	}
+	job.UpdatedAt = row.UpdatedAt
+	return nil
+}
+
+func (r *userImportRepo) GetImportJob(ctx context.Context, id int) (*biz.UserImportJob, error) {
+	row, err := r.data.postgres.UserImportJob.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrUserImportJobNotFound
+		}
+		r.log.WithContext(ctx).Errorf("GetImportJob failed id=%d err=%v", id, err)
+		return nil, err
+	}
+	return toBizUserImportJob(row), nil
+}
+
+// TakenUsernames 直接走 SQL：用户名在所有组织、以及用户与管理员之间都必须唯一，不能受组织范围拦截器影响。
+func (r *userImportRepo) TakenUsernames(ctx context.Context, normalized []string) (map[string]bool, error) {
+	out := make(map[string]bool)
+	if len(normalized) == 0 {
+		return out, nil
+	}
+	placeholders := make([]string, 0, len(normalized))
+	args := make([]any, 0, len(normalized))
+	for _, name := range normalized {
+		args = append(args, name)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+	}
+	in := strings.Join(placeholders, ", ")
+
+	rows, err := r.data.sqldb.QueryContext(
+		ctx,
+		`SELECT username_normalized FROM users WHERE username_normalized IN (`+in+`)
+		 UNION
+		 SELECT username_normalized FROM admin_users WHERE username_normalized IN (`+in+`)`,
+		args...,
+	)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("TakenUsernames failed count=%d err=%v", len(normalized), err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		out[name] = true
+	}
+	return out, rows.Err()
+}
+
+func toBizUserImportJob(row *ent.UserImportJob) *biz.UserImportJob {
+	job := &biz.UserImportJob{
+		ID:             row.ID,
+		Status:         row.Status,
+		DryRun:         row.DryRun,
+		OrganizationID: row.OrganizationID,
+		CreatedBy:      row.CreatedBy,
+		TotalRows:      row.TotalRows,
+		ProcessedRows:  row.ProcessedRows,
+		CreatedCount:   row.CreatedCount,
+		FailedCount:    row.FailedCount,
+		Error:          row.Error,
+		FinishedAt:     row.FinishedAt,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+	for _, e := range row.RowErrors {
+		job.RowErrors = append(job.RowErrors, biz.UserImportRowError{Row: e.Row, Username: e.Username, Message: e.Message})
+	}
+	return job
+}
@@ -0,0 +1,128 @@
+// server/internal/data/user_profile_repo.go
+package data
+
+import (
+	"context"
+	"errors"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	entuser "server/internal/data/model/ent/user"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 资料更新按 updated_at 做乐观并发控制，冲突时重读重试的次数。
+const userProfileUpdateAttempts = 3
+
+var errUserProfileUpdateConflict = errors.New("user profile concurrently modified")
+
+type userProfileRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewUserProfileRepo(data *Data, logger log.Logger) *userProfileRepo {
+	return &userProfileRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data.user_profile_repo")),
+	}
+}
+
+var _ biz.UserProfileRepo = (*userProfileRepo)(nil)
+
+func (r *userProfileRepo) GetUserProfile(ctx context.Context, userID int) (*biz.UserProfile, error) {
+	u, err := r.data.postgres.User.Query().Where(entuser.ID(userID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrUserNotFound
+		}
+		r.log.WithContext(ctx).Errorf("GetUserProfile failed user_id=%d err=%v", userID, err)
+		return nil, err
+	}
+	return toBizUserProfile(u), nil
+}
+
+// UpdateUserProfile 读出当前资料、合并属性后以 updated_at 为条件写回；
+// 条件不成立说明期间有其他写入，重读后再试，保证并发修改不同属性时不会互相覆盖。
+func (r *userProfileRepo) UpdateUserProfile(ctx context.Context, userID int, p *biz.UserProfilePatch) (*biz.UserProfile, error) {
+	l := r.log.WithContext(ctx)
+
+	for attempt := 0; attempt < userProfileUpdateAttempts; attempt++ {
+		cur, err := r.data.postgres.User.Query().Where(entuser.ID(userID)).Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, biz.ErrUserNotFound
+			}
+			l.Errorf("UpdateUserProfile load failed user_id=%d err=%v", userID, err)
+			return nil, err
+		}
+
+		m := r.data.postgres.User.Update().
+			Where(entuser.ID(userID), entuser.UpdatedAt(cur.UpdatedAt))
+		if scope, ok := tenantUserScope(ctx); ok {
+			m = m.Where(scope)
+		}
+		if p.DisplayName != nil {
+			m = m.SetDisplayName(*p.DisplayName)
+		}
+		if p.AvatarURL != nil {
+			m = m.SetAvatarURL(*p.AvatarURL)
+		}
+		if p.Locale != nil {
+			m = m.SetLocale(*p.Locale)
+		}
+		if p.Timezone != nil {
+			m = m.SetTimezone(*p.Timezone)
+		}
+		// 邮箱没变时保留验证状态；清空邮箱写 NULL，避免空字符串撞上唯一索引。
+		if p.Email != nil && *p.Email != derefString(cur.Email) {
+			if *p.Email == "" {
+				m = m.ClearEmail()
+			} else {
+				m = m.SetEmail(*p.Email)
+			}
+			m = m.ClearEmailVerifiedAt()
+		}
+		if len(p.Attributes) > 0 {
+			m = m.SetAttributes(p.MergeAttributes(cur.Attributes))
+		}
+
+		n, err := m.Save(ctx)
+		if err != nil {
+			if isDuplicateUniqueConstraint(err, "user_email", "users.email") {
+				l.Warnf("UpdateUserProfile email already used user_id=%d", userID)
+				return nil, biz.ErrVerificationContactConflict
+			}
+			l.Errorf("UpdateUserProfile failed user_id=%d err=%v", userID, err)
+			return nil, err
+		}
+		if n == 1 {
+			return r.GetUserProfile(ctx, userID)
+		}
+		l.Infof("UpdateUserProfile conflict, retrying user_id=%d attempt=%d", userID, attempt+1)
+	}
+
+	l.Warnf("UpdateUserProfile gave up after %d attempts user_id=%d", userProfileUpdateAttempts, userID)
+	return nil, errUserProfileUpdateConflict
+}
+
+func toBizUserProfile(u *ent.User) *biz.UserProfile {
+	email := derefString(u.Email)
+	attrs := u.Attributes
+	if attrs == nil {
+		attrs = map[string]any{}
+	}
+	return &biz.UserProfile{
+		UserID:        u.ID,
+		Username:      u.Username,
+		DisplayName:   u.DisplayName,
+		Email:         email,
+		EmailVerified: email != "" && u.EmailVerifiedAt != nil,
+		AvatarURL:     u.AvatarURL,
+		Locale:        u.Locale,
+		Timezone:      u.Timezone,
+		Attributes:    attrs,
+		UpdatedAt:     u.UpdatedAt,
+	}
+}
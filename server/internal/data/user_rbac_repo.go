@@ -0,0 +1,308 @@
+// server/internal/data/user_rbac_repo.go
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type userRBACRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewUserRBACRepo(data *Data, logger log.Logger) *userRBACRepo {
+	return &userRBACRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data.user_rbac_repo")),
+	}
+}
+
+var _ biz.UserRBACRepo = (*userRBACRepo)(nil)
+
+func (r *userRBACRepo) ListUserRoles(ctx context.Context) ([]biz.UserRoleSummary, error) {
+	rows, err := r.data.sqldb.QueryContext(
+		ctx,
+		`SELECT ur.id, ur.key, ur.name, ur.description,
+		        (SELECT COUNT(*) FROM user_role_bindings b WHERE b.user_role_id = ur.id),
+		        COALESCE((SELECT string_agg(p.permission_key, ',' ORDER BY p.permission_key)
+		                  FROM user_role_permissions p WHERE p.user_role_id = ur.id), '')
+		 FROM user_roles ur
+		 ORDER BY ur.key ASC`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.WithContext(ctx).Warnf("close user role rows failed err=%v", err)
+		}
+	}()
+
+	roles := make([]biz.UserRoleSummary, 0)
+	for rows.Next() {
+		role, err := scanUserRole(rows)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, *role)
+	}
+	return roles, rows.Err()
+}
+
+func (r *userRBACRepo) GetUserRole(ctx context.Context, id int) (*biz.UserRoleSummary, error) {
+	role, err := scanUserRole(r.data.sqldb.QueryRowContext(
+		ctx,
+		`SELECT ur.id, ur.key, ur.name, ur.description,
+		        (SELECT COUNT(*) FROM user_role_bindings b WHERE b.user_role_id = ur.id),
+		        COALESCE((SELECT string_agg(p.permission_key, ',' ORDER BY p.permission_key)
+		                  FROM user_role_permissions p WHERE p.user_role_id = ur.id), '')
+		 FROM user_roles ur
+		 WHERE ur.id = $1`,
+		id,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, biz.ErrRoleNotFound
+	}
+	return role, err
+}
+
+func (r *userRBACRepo) CreateUserRole(ctx context.Context, in *biz.UserRoleSummary) (*biz.UserRoleSummary, error) {
+	var id int
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		now := time.Now()
+		err := tx.QueryRowContext(
+			ctx,
+			`INSERT INTO user_roles (key, name, description, created_at, updated_at)
+			 VALUES ($1, $2, $3, $4, $4)
+			 ON CONFLICT (key) DO NOTHING
+			 RETURNING id`,
+			in.Key,
+			in.Name,
+			in.Description,
+			now,
+		).Scan(&id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return biz.ErrRoleExists
+		}
+		if err != nil {
+			return err
+		}
+		return replaceUserRolePermissionsTx(ctx, tx, id, in.Permissions)
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("CreateUserRole failed key=%s err=%v", in.Key, err)
+		return nil, err
+	}
+	return r.GetUserRole(ctx, id)
+}
+
+func (r *userRBACRepo) UpdateUserRole(ctx context.Context, id int, name, description string) (*biz.UserRoleSummary, error) {
+	result, err := r.data.sqldb.ExecContext(
+		ctx,
+		`UPDATE user_roles SET name = $1, description = $2, updated_at = $3 WHERE id = $4`,
+		name,
+		description,
+		time.Now(),
+		id,
+	)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("UpdateUserRole failed id=%d err=%v", id, err)
+		return nil, err
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return nil, biz.ErrRoleNotFound
+	}
+	return r.GetUserRole(ctx, id)
+}
+
+func (r *userRBACRepo) DeleteUserRole(ctx context.Context, id int) error {
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM user_role_permissions WHERE user_role_id = $1`, id); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM user_role_bindings WHERE user_role_id = $1`, id); err != nil {
+			return err
+		}
+		result, err := tx.ExecContext(ctx, `DELETE FROM user_roles WHERE id = $1`, id)
+		if err != nil {
+			return err
+		}
+		if n, err := result.RowsAffected(); err == nil && n == 0 {
+			return biz.ErrRoleNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("DeleteUserRole failed id=%d err=%v", id, err)
+	}
+	return err
+}
+
+func (r *userRBACRepo) SetUserRolePermissions(ctx context.Context, id int, permissionKeys []string) (*biz.UserRoleSummary, error) {
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM user_roles WHERE id = $1)`, id).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return biz.ErrRoleNotFound
+		}
+		return replaceUserRolePermissionsTx(ctx, tx, id, permissionKeys)
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("SetUserRolePermissions failed id=%d err=%v", id, err)
+		return nil, err
+	}
+	return r.GetUserRole(ctx, id)
+}
+
+func (r *userRBACRepo) SetUserRoles(ctx context.Context, userID int, roleKeys []string) ([]string, error) {
+	var before []string
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		// 锁住用户行，同一用户的并发分配串行执行，before 才准确；限定组织时不属于该组织的用户视为不存在。
+		scope, scopeArgs := tenantUserSQL(ctx, "id", 2)
+		var locked int
+		err := tx.QueryRowContext(ctx,
+			`SELECT id FROM users WHERE id = $1 AND deleted_at IS NULL`+scope+` FOR UPDATE`,
+			append([]any{userID}, scopeArgs...)...,
+		).Scan(&locked)
+		if errors.Is(err, sql.ErrNoRows) {
+			return biz.ErrUserNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		var joined string
+		if err := tx.QueryRowContext(
+			ctx,
+			`SELECT COALESCE(string_agg(ur.key, ',' ORDER BY ur.key), '')
+			 FROM user_role_bindings b
+			 JOIN user_roles ur ON ur.id = b.user_role_id
+			 WHERE b.user_id = $1`,
+			userID,
+		).Scan(&joined); err != nil {
+			return err
+		}
+		before = splitKeys(joined)
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM user_role_bindings WHERE user_id = $1`, userID); err != nil {
+			return err
+		}
+		now := time.Now()
+		for _, key := range roleKeys {
+			var roleID int
+			err := tx.QueryRowContext(ctx, `SELECT id FROM user_roles WHERE key = $1`, key).Scan(&roleID)
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%w: %s", biz.ErrRoleNotFound, key)
+			}
+			if err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(
+				ctx,
+				`INSERT INTO user_role_bindings (user_id, user_role_id, created_at) VALUES ($1, $2, $3)`,
+				userID,
+				roleID,
+				now,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("SetUserRoles failed user_id=%d roles=%v err=%v", userID, roleKeys, err)
+		return nil, err
+	}
+	if before == nil {
+		before = []string{}
+	}
+	return before, nil
+}
+
+func (r *userRBACRepo) GetUserAccess(ctx context.Context, userID int) ([]string, []string, error) {
+	// 限定组织时只返回该组织成员的角色，否则查不到行，按用户不存在处理。
+	scope, scopeArgs := tenantUserSQL(ctx, "$1", 2)
+	var roles, permissions string
+	err := r.data.sqldb.QueryRowContext(
+		ctx,
+		`SELECT
+		   COALESCE((SELECT string_agg(ur.key, ',' ORDER BY ur.key)
+		             FROM user_role_bindings b JOIN user_roles ur ON ur.id = b.user_role_id
+		             WHERE b.user_id = $1), ''),
+		   COALESCE((SELECT string_agg(DISTINCT p.permission_key, ',' ORDER BY p.permission_key)
+		             FROM user_role_bindings b JOIN user_role_permissions p ON p.user_role_id = b.user_role_id
+		             WHERE b.user_id = $1), '')
+		 WHERE TRUE`+scope,
+		append([]any{userID}, scopeArgs...)...,
+	).Scan(&roles, &permissions)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, biz.ErrUserNotFound
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("GetUserAccess failed user_id=%d err=%v", userID, err)
+		return nil, nil, err
+	}
+	return splitKeys(roles), splitKeys(permissions), nil
+}
+
+func (r *userRBACRepo) withTx(ctx context.Context, fn func(tx *sql.Tx) error) (err error) {
+	tx, err := r.data.sqldb.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				r.log.WithContext(ctx).Warnf("user rbac tx rollback failed err=%v", rbErr)
+			}
+		}
+	}()
+	if err = fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func replaceUserRolePermissionsTx(ctx context.Context, tx *sql.Tx, roleID int, permissionKeys []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_role_permissions WHERE user_role_id = $1`, roleID); err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, key := range permissionKeys {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO user_role_permissions (user_role_id, permission_key, created_at) VALUES ($1, $2, $3)`,
+			roleID,
+			key,
+			now,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func scanUserRole(row interface{ Scan(dest ...any) error }) (*biz.UserRoleSummary, error) {
+	var (
+		role        biz.UserRoleSummary
+		permissions string
+	)
+	if err := row.Scan(&role.ID, &role.Key, &role.Name, &role.Description, &role.UserCount, &permissions); err != nil {
+		return nil, err
+	}
+	role.Permissions = splitKeys(permissions)
+	if role.Permissions == nil {
+		role.Permissions = []string{}
+	}
+	return &role, nil
+}
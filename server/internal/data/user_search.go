@@ -0,0 +1,93 @@
+// server/internal/data/user_search.go
+package data
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"server/internal/data/model/ent/predicate"
+	"server/internal/data/model/ent/user"
+
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// userSearchColumns 是模糊搜索匹配的列，与迁移里的三元组索引一一对应。
+var userSearchColumns = []string{user.FieldUsername, user.FieldEmail, user.FieldDisplayName}
+
+// pgExtension 缓存某个 PostgreSQL 扩展是否已安装；查询失败不缓存，下次再查。
+// 安装扩展后需要重启服务才会生效。
+type pgExtension struct {
+	name string
+
+	mu        sync.Mutex
+	checked   bool
+	installed bool
+}
+
+func (e *pgExtension) Installed(ctx context.Context, db *sql.DB) (bool, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.checked {
+		return e.installed, nil
+	}
+	var ok bool
+	if err := db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = $1)`, e.name).Scan(&ok); err != nil {
+		return false, err
+	}
+	e.checked, e.installed = true, ok
+	return ok, nil
+}
+
+// userFuzzySearch 在用户名、邮箱与显示名上做不区分大小写的子串匹配；trgm 时再加上 pg_trgm 的相似度匹配（% 运算符，
+// 阈值为 pg_trgm.similarity_threshold），能容忍拼写错误。两种匹配都能用上三元组索引。
+func userFuzzySearch(search string, trgm bool) predicate.User {
+	return func(s *entsql.Selector) {
+		preds := make([]*entsql.Predicate, 0, 2*len(userSearchColumns))
+		for _, col := range userSearchColumns {
+			preds = append(preds, entsql.ContainsFold(s.C(col), search))
+		}
+		if trgm {
+			for _, col := range userSearchColumns {
+				c := s.C(col)
+				preds = append(preds, entsql.P(func(b *entsql.Builder) {
+					b.Ident(c).WriteString(" % ").Arg(search)
+				}))
+			}
+		}
+		s.Where(entsql.Or(preds...))
+	}
+}
+
+// userRelevanceOrder 按与 search 的相关度倒序，同分按 id 倒序。trgm 时取各列 similarity 的最大值；
+// 没有扩展时只能粗分三档：某列完全相同、某列以它开头、其余匹配。
+// 排序表达式带参数，要用 Predicate 承载：OrderExprFunc 只保留 SQL 文本，会丢掉参数。
+func userRelevanceOrder(search string, trgm bool) func(*entsql.Selector) {
+	return func(s *entsql.Selector) {
+		s.OrderExpr(entsql.P(func(b *entsql.Builder) {
+			if trgm {
+				b.WriteString("GREATEST(")
+				for i, col := range userSearchColumns {
+					if i > 0 {
+						b.WriteString(", ")
+					}
+					b.WriteString("similarity(COALESCE(").Ident(s.C(col)).WriteString(", ''), ").Arg(search).WriteString(")")
+				}
+				b.WriteString(") DESC")
+				return
+			}
+
+			exact := make([]*entsql.Predicate, 0, len(userSearchColumns))
+			prefix := make([]*entsql.Predicate, 0, len(userSearchColumns))
+			for _, col := range userSearchColumns {
+				exact = append(exact, entsql.EqualFold(s.C(col), search))
+				prefix = append(prefix, entsql.HasPrefixFold(s.C(col), search))
+			}
+			b.WriteString("CASE WHEN ").Join(entsql.Or(exact...)).
+				WriteString(" THEN 2 WHEN ").Join(entsql.Or(prefix...)).
+				WriteString(" THEN 1 ELSE 0 END DESC")
+		}))
+		s.OrderBy(entsql.Desc(s.C(user.FieldID)))
+	}
+}
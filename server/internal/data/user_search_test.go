@@ -0,0 +1,66 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestUserFuzzySearchSQL(t *testing.T) {
+	build := func(trgm bool) (string, []any) {
+		s := entsql.Dialect("postgres").Select("*").From(entsql.Table("users"))
+		userFuzzySearch("Al_i", trgm)(s)
+		userRelevanceOrder("Al_i", trgm)(s)
+		return s.Query()
+	}
+
+	q, args := build(true)
+	want := `SELECT * FROM "users" WHERE "users"."username" ILIKE $1 OR "users"."email" ILIKE $2 OR "users"."display_name" ILIKE $3` +
+		` OR "users"."username" % $4 OR "users"."email" % $5 OR "users"."display_name" % $6` +
+		` ORDER BY GREATEST(similarity(COALESCE("users"."username", ''), $7), similarity(COALESCE("users"."email", ''), $8), similarity(COALESCE("users"."display_name", ''), $9)) DESC, "users"."id" DESC`
+	if q != want || len(args) != 9 {
+		t.Fatalf("unexpected trgm query:\n got %s %v\nwant %s", q, args, want)
+	}
+	if args[0] != `%al\_i%` || args[3] != "Al_i" {
+		t.Fatalf("LIKE wildcards in search must be escaped, got %v", args)
+	}
+
+	q, args = build(false)
+	want = `SELECT * FROM "users" WHERE "users"."username" ILIKE $1 OR "users"."email" ILIKE $2 OR "users"."display_name" ILIKE $3` +
+		` ORDER BY CASE WHEN "users"."username" ILIKE $4 OR "users"."email" ILIKE $5 OR "users"."display_name" ILIKE $6 THEN 2` +
+		` WHEN "users"."username" ILIKE $7 OR "users"."email" ILIKE $8 OR "users"."display_name" ILIKE $9 THEN 1 ELSE 0 END DESC, "users"."id" DESC`
+	if q != want || len(args) != 9 {
+		t.Fatalf("unexpected fallback query:\n got %s %v\nwant %s", q, args, want)
+	}
+}
+
+func TestPgExtensionInstalledCachesResult(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+
+	mock.ExpectQuery("SELECT EXISTS").WithArgs("pg_trgm").WillReturnError(errors.New("conn reset"))
+	mock.ExpectQuery("SELECT EXISTS").WithArgs("pg_trgm").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectClose()
+
+	ext := &pgExtension{name: "pg_trgm"}
+	if _, err := ext.Installed(context.Background(), db); err == nil {
+		t.Fatal("expected query error")
+	}
+	for i := 0; i < 2; i++ {
+		ok, err := ext.Installed(context.Background(), db)
+		if err != nil || !ok {
+			t.Fatalf("Installed() = %v, %v", ok, err)
+		}
+	}
+	mustCloseDB(t, db)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("ExpectationsWereMet() error = %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+// server/internal/data/username_normalized_sync.go
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SyncUsernameNormalized 启动时按 biz.NormalizeUsername 修正迁移里用 SQL 近似回填的 username_normalized。
+// SQL 的 lower 不做完整的大小写折叠，只有含非 ASCII 或空白字符的用户名可能算得不同，所以只扫描这部分账号；
+// 修正后与其他账号重名的只告警跳过，不阻止启动；这类账号要人工改名后才能登录。
+func SyncUsernameNormalized(ctx context.Context, d *Data, l *log.Helper) error {
+	if d == nil || d.sqldb == nil {
+		return errors.New("SyncUsernameNormalized: missing db")
+	}
+	for _, table := range []string{"users", "admin_users"} {
+		if err := syncUsernameNormalized(ctx, d.sqldb, table, l); err != nil {
+			return fmt.Errorf("SyncUsernameNormalized %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
+func syncUsernameNormalized(ctx context.Context, db *sql.DB, table string, l *log.Helper) error {
+	type fix struct {
+		id       int
+		username string
+		want     string
+	}
+	rows, err := db.QueryContext(ctx, "SELECT id, username, COALESCE(username_normalized, '') FROM "+table+" WHERE username !~ '^[!-~]+$'")
+	if err != nil {
+		return err
+	}
+	var fixes []fix
+	for rows.Next() {
+		var (
+			f   fix
+			got string
+		)
+		if err := rows.Scan(&f.id, &f.username, &got); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		if f.want = biz.NormalizeUsername(f.username); f.want != got {
+			fixes = append(fixes, f)
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, f := range fixes {
+		_, err := db.ExecContext(ctx, "UPDATE "+table+" SET username_normalized = $1 WHERE id = $2", f.want, f.id)
+		var pgErr *pgconn.PgError
+		switch {
+		case err == nil:
+			l.Infof("username_normalized corrected table=%s id=%d username=%q", table, f.id, f.username)
+		case errors.As(err, &pgErr) && pgErr.Code == "23505":
+			l.Warnf("username_normalized collision, rename the account table=%s id=%d username=%q key=%q", table, f.id, f.username, f.want)
+		default:
+			return err
+		}
+	}
+	return nil
+}
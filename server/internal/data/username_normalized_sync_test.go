@@ -0,0 +1,44 @@
+package data
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestSyncUsernameNormalizedFixesSQLBackfill(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+
+	// SQL 的 lower 把 "Straße" 算成 "straße"，Go 的大小写折叠是 "strasse"；已经一致的行不更新，
+	// 修正后撞上已有账号（如 "masse"）的只告警跳过。
+	mock.ExpectQuery("SELECT id, username, COALESCE\\(username_normalized, ''\\) FROM users").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "username_normalized"}).
+			AddRow(1, "Straße", "straße").
+			AddRow(2, "Ｂｏｂ", "bob").
+			AddRow(4, "Maße", "maße"))
+	mock.ExpectExec("UPDATE users SET username_normalized").
+		WithArgs("strasse", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE users SET username_normalized").
+		WithArgs("masse", 4).
+		WillReturnError(&pgconn.PgError{Code: "23505"})
+	mock.ExpectQuery("SELECT id, username, COALESCE\\(username_normalized, ''\\) FROM admin_users").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "username_normalized"}))
+	mock.ExpectClose()
+
+	if err := SyncUsernameNormalized(context.Background(), &Data{sqldb: db}, log.NewHelper(log.NewStdLogger(io.Discard))); err != nil {
+		t.Fatalf("SyncUsernameNormalized() error = %v", err)
+	}
+	mustCloseDB(t, db)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("ExpectationsWereMet() error = %v", err)
+	}
+}
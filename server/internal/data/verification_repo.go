@@ -0,0 +1,184 @@
+// server/internal/data/verification_repo.go
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/data/model/ent"
+	"server/internal/data/model/ent/verificationcode"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type verificationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewVerificationRepo(data *Data, logger log.Logger) *verificationRepo {
+	return &verificationRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data.verification_repo")),
+	}
+}
+
+var _ biz.VerificationRepo = (*verificationRepo)(nil)
+
+func (r *verificationRepo) CreateVerificationCode(ctx context.Context, in *biz.VerificationCode) (*biz.VerificationCode, error) {
+	row, err := r.data.postgres.VerificationCode.
+		Create().
+		SetUserID(in.UserID).
+		SetChannel(in.Channel).
+		SetTarget(in.Target).
+		SetCodeHash(in.CodeHash).
+		SetExpiresAt(in.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("CreateVerificationCode failed user_id=%d channel=%s err=%v", in.UserID, in.Channel, err)
+		return nil, err
+	}
+	return toBizVerificationCode(row), nil
+}
+
+func (r *verificationRepo) CountVerificationCodesSince(ctx context.Context, userID int, channel string, since time.Time) (int, error) {
+	return r.data.postgres.VerificationCode.
+		Query().
+		Where(
+			verificationcode.UserID(userID),
+			verificationcode.Channel(channel),
+			verificationcode.CreatedAtGTE(since),
+		).
+		Count(ctx)
+}
+
+func (r *verificationRepo) LatestVerificationCode(ctx context.Context, userID int, channel string) (*biz.VerificationCode, error) {
+	row, err := r.data.postgres.VerificationCode.
+		Query().
+		Where(
+			verificationcode.UserID(userID),
+			verificationcode.Channel(channel),
+			verificationcode.ConsumedAtIsNil(),
+		).
+		Order(ent.Desc(verificationcode.FieldCreatedAt), ent.Desc(verificationcode.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Errorf("LatestVerificationCode failed user_id=%d channel=%s err=%v", userID, channel, err)
+		return nil, err
+	}
+	return toBizVerificationCode(row), nil
+}
+
+// ClaimVerificationAttempt 用一条条件 UPDATE 完成“检查上限 + 计数”，并发校验不会多算出尝试次数。
+func (r *verificationRepo) ClaimVerificationAttempt(ctx context.Context, id, max int) (bool, error) {
+	n, err := r.data.postgres.VerificationCode.
+		Update().
+		Where(
+			verificationcode.ID(id),
+			verificationcode.AttemptsLT(max),
+			verificationcode.ConsumedAtIsNil(),
+		).
+		AddAttempts(1).
+		Save(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ClaimVerificationAttempt failed code_id=%d err=%v", id, err)
+		return false, err
+	}
+	return n == 1, nil
+}
+
+func (r *verificationRepo) ListVerificationCodes(ctx context.Context, userID int) ([]*biz.VerificationCode, error) {
+	rows, err := r.data.postgres.VerificationCode.
+		Query().
+		Where(verificationcode.UserID(userID)).
+		Order(ent.Asc(verificationcode.FieldCreatedAt), ent.Asc(verificationcode.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("ListVerificationCodes failed user_id=%d err=%v", userID, err)
+		return nil, err
+	}
+	out := make([]*biz.VerificationCode, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toBizVerificationCode(row))
+	}
+	return out, nil
+}
+
+func (r *verificationRepo) ConsumeVerificationCode(ctx context.Context, code *biz.VerificationCode, at time.Time) error {
+	l := r.log.WithContext(ctx)
+
+	tx, err := r.data.postgres.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	rollback := func(cause error) error {
+		if e := tx.Rollback(); e != nil {
+			l.Errorf("ConsumeVerificationCode rollback failed err=%v", e)
+		}
+		return cause
+	}
+
+	// 条件更新保证同一验证码只能核销一次。
+	n, err := tx.VerificationCode.
+		Update().
+		Where(verificationcode.ID(code.ID), verificationcode.ConsumedAtIsNil()).
+		SetConsumedAt(at).
+		Save(ctx)
+	if err != nil {
+		return rollback(err)
+	}
+	if n == 0 {
+		return rollback(biz.ErrVerificationCodeInvalid)
+	}
+
+	u, err := tx.User.Get(ctx, code.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return rollback(biz.ErrUserNotFound)
+		}
+		return rollback(err)
+	}
+
+	// 新联系方式在验证通过后才写入，发码失败或未验证时保留原联系方式及其验证状态。
+	m := u.Update().SetUpdatedAt(at)
+	switch code.Channel {
+	case biz.VerificationChannelEmail:
+		m = m.SetEmail(code.Target).SetEmailVerifiedAt(at)
+	case biz.VerificationChannelPhone:
+		m = m.SetPhone(code.Target).SetPhoneVerifiedAt(at)
+	default:
+		return rollback(errors.New("unknown verification channel"))
+	}
+	if _, err := m.Save(ctx); err != nil {
+		if isDuplicateUniqueConstraint(err, "user_email", "user_phone", "users.email", "users.phone") {
+			l.Warnf("ConsumeVerificationCode contact already used user_id=%d channel=%s", code.UserID, code.Channel)
+			return rollback(biz.ErrVerificationContactConflict)
+		}
+		return rollback(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		l.Errorf("ConsumeVerificationCode commit failed code_id=%d err=%v", code.ID, err)
+		return err
+	}
+	return nil
+}
+
+func toBizVerificationCode(row *ent.VerificationCode) *biz.VerificationCode {
+	return &biz.VerificationCode{
+		ID:         row.ID,
+		UserID:     row.UserID,
+		Channel:    row.Channel,
+		Target:     row.Target,
+		CodeHash:   row.CodeHash,
+		Attempts:   row.Attempts,
+		ExpiresAt:  row.ExpiresAt,
+		ConsumedAt: row.ConsumedAt,
+		CreatedAt:  row.CreatedAt,
+	}
+}
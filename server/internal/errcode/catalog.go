@@ -12,16 +12,52 @@ var (
 
 	JSONRPCUnknownURL  = Definition{Name: "JSONRPCUnknownURL", Code: 40001, Message: "未知 RPC 域"}
 	InvalidParam       = Definition{Name: "InvalidParam", Code: 40010, Message: "参数不合法"}
+	PageInvalid        = Definition{Name: "PageInvalid", Code: 40011, Message: "分页参数不合法"}
+	PageCursorInvalid  = Definition{Name: "PageCursorInvalid", Code: 40012, Message: "分页游标无效，请从第一页重新查询"}
 	UnknownMethod      = Definition{Name: "UnknownMethod", Code: 40020, Message: "未知接口"}
 	UserInvalidParam   = Definition{Name: "UserInvalidParam", Code: 40030, Message: "参数不合法"}
 
 	UserSetDisabledInvalid        = Definition{Name: "UserSetDisabledInvalid", Code: 40071, Message: "参数错误：user_id 无效"}
+	UserListInvalid               = Definition{Name: "UserListInvalid", Code: 40072, Message: "参数错误：筛选或排序条件不合法"}
+	UserBulkTooMany               = Definition{Name: "UserBulkTooMany", Code: 40073, Message: "单次批量操作的用户数超过上限，请缩小范围后分批执行"}
+	UserImportInvalid             = Definition{Name: "UserImportInvalid", Code: 40074, Message: "导入文件不合法"}
+	UserImportJobNotFound         = Definition{Name: "UserImportJobNotFound", Code: 40075, Message: "导入任务不存在"}
+	UserProfileInvalid            = Definition{Name: "UserProfileInvalid", Code: 40076, Message: "资料字段不合法"}
+	UserRestoreConflict           = Definition{Name: "UserRestoreConflict", Code: 40077, Message: "原用户名已被占用，无法恢复"}
+
+	RBACRoleNotFound          = Definition{Name: "RBACRoleNotFound", Code: 40090, Message: "角色不存在"}
+	RBACRoleExists            = Definition{Name: "RBACRoleExists", Code: 40091, Message: "角色标识已存在"}
+	RBACRoleKeyInvalid        = Definition{Name: "RBACRoleKeyInvalid", Code: 40092, Message: "角色标识需为小写字母开头的 2-64 位小写字母、数字或下划线"}
+	RBACRoleBuiltin           = Definition{Name: "RBACRoleBuiltin", Code: 40093, Message: "内置角色不可删除"}
+	RBACPermissionUnknown     = Definition{Name: "RBACPermissionUnknown", Code: 40094, Message: "包含不存在的权限码"}
+	RBACSuperAdminPermissions = Definition{Name: "RBACSuperAdminPermissions", Code: 40095, Message: "超级管理员角色必须保留全部权限"}
+	RBACLastSuperAdmin        = Definition{Name: "RBACLastSuperAdmin", Code: 40096, Message: "该操作会导致没有可用的超级管理员"}
+	RBACRoleCycle             = Definition{Name: "RBACRoleCycle", Code: 40097, Message: "角色继承关系不能形成环"}
+	RBACPolicyInvalid         = Definition{Name: "RBACPolicyInvalid", Code: 40098, Message: "RBAC 策略文件不合法"}
+
+	AdminAccountNotFound     = Definition{Name: "AdminAccountNotFound", Code: 40101, Message: "管理员不存在"}
+	AdminSelfLockout         = Definition{Name: "AdminSelfLockout", Code: 40102, Message: "不能禁用自己或移除自己的管理员管理权限"}
+	AdminPasswordTooShort    = Definition{Name: "AdminPasswordTooShort", Code: 40103, Message: "管理员密码至少 8 位"}
+	AdminPrivilegeEscalation = Definition{Name: "AdminPrivilegeEscalation", Code: 40104, Message: "只能授予自己拥有的权限，超级管理员账号只能由超级管理员管理"}
+
+	AccessPolicyInvalid  = Definition{Name: "AccessPolicyInvalid", Code: 40110, Message: "访问策略表达式不合法"}
+	AccessPolicyNotFound = Definition{Name: "AccessPolicyNotFound", Code: 40111, Message: "访问策略不存在"}
+
+	OrganizationNotFound    = Definition{Name: "OrganizationNotFound", Code: 40120, Message: "组织不存在"}
+	OrganizationExists      = Definition{Name: "OrganizationExists", Code: 40121, Message: "组织标识已存在"}
+	OrganizationSlugInvalid = Definition{Name: "OrganizationSlugInvalid", Code: 40122, Message: "组织标识需为小写字母或数字开头的 2-64 位小写字母、数字或 -"}
+	OrganizationLastMember  = Definition{Name: "OrganizationLastMember", Code: 40123, Message: "不能移除管理员的最后一个组织"}
+	OrganizationRoleScope   = Definition{Name: "OrganizationRoleScope", Code: 40124, Message: "该角色只能平台级分配，不能在组织内绑定"}
 
 	AdminRequired    = Definition{Name: "AdminRequired", Code: 40301, Message: "需要管理员权限"}
 	AuthRequired     = Definition{Name: "AuthRequired", Code: 40302, Message: "未登录"}
 	AdminDisabled    = Definition{Name: "AdminDisabled", Code: 40303, Message: "管理员已禁用"}
 	PermissionDenied = Definition{Name: "PermissionDenied", Code: 40304, Message: "权限不足"}
 
+	AuthImpersonationBlocked = Definition{Name: "AuthImpersonationBlocked", Code: 40305, Message: "模拟登录状态下不允许该操作"}
+	AccessPolicyDenied       = Definition{Name: "AccessPolicyDenied", Code: 40306, Message: "访问策略不允许该操作"}
+	OrganizationForbidden    = Definition{Name: "OrganizationForbidden", Code: 40307, Message: "无权访问该组织"}
+
 	AuthUserNotFound    = Definition{Name: "AuthUserNotFound", Code: 10001, Message: "用户不存在"}
 	AuthInvalidPassword = Definition{Name: "AuthInvalidPassword", Code: 10002, Message: "密码错误"}
 	AuthUserDisabled    = Definition{Name: "AuthUserDisabled", Code: 10003, Message: "用户已被禁用"}
@@ -29,31 +65,98 @@ var (
 	AuthExpired         = Definition{Name: "AuthExpired", Code: 10005, Message: "登录已过期，请重新登录"}
 	AuthInvalid         = Definition{Name: "AuthInvalid", Code: 10006, Message: "登录无效，请重新登录"}
 
+	AuthRegistrationClosed = Definition{Name: "AuthRegistrationClosed", Code: 10007, Message: "当前未开放注册"}
+	AuthInviteRequired     = Definition{Name: "AuthInviteRequired", Code: 10008, Message: "需要邀请码才能注册"}
+	AuthInviteInvalid      = Definition{Name: "AuthInviteInvalid", Code: 10009, Message: "邀请码无效"}
+	AuthInviteExpired      = Definition{Name: "AuthInviteExpired", Code: 10010, Message: "邀请码已过期"}
+	AuthInviteExhausted    = Definition{Name: "AuthInviteExhausted", Code: 10011, Message: "邀请码已用完"}
+
+	AuthUserUnverified              = Definition{Name: "AuthUserUnverified", Code: 10012, Message: "请先完成邮箱或手机验证"}
+	AuthVerificationTooFrequent     = Definition{Name: "AuthVerificationTooFrequent", Code: 10013, Message: "验证码发送过于频繁，请稍后再试"}
+	AuthVerificationCodeInvalid     = Definition{Name: "AuthVerificationCodeInvalid", Code: 10014, Message: "验证码错误"}
+	AuthVerificationCodeExpired     = Definition{Name: "AuthVerificationCodeExpired", Code: 10015, Message: "验证码已过期"}
+	AuthVerificationContactInvalid  = Definition{Name: "AuthVerificationContactInvalid", Code: 10016, Message: "邮箱或手机号格式不正确"}
+	AuthVerificationContactConflict = Definition{Name: "AuthVerificationContactConflict", Code: 10017, Message: "邮箱或手机号已被其他账号使用"}
+	AuthUsernameInvalid             = Definition{Name: "AuthUsernameInvalid", Code: 10018, Message: "用户名需为 3-32 位字母、数字或 _ . -，且以字母或数字开头"}
+	AuthInvalidCredentials          = Definition{Name: "AuthInvalidCredentials", Code: 10019, Message: "用户名或密码错误"}
+	AuthLoginThrottled              = Definition{Name: "AuthLoginThrottled", Code: 10020, Message: "密码错误次数过多，请稍后再试"}
+	AuthPasswordChangeRequired      = Definition{Name: "AuthPasswordChangeRequired", Code: 10021, Message: "首次登录请先修改密码"}
+
 	Internal              = Definition{Name: "Internal", Code: 50000, Message: "服务器内部错误"}
 	AuthCurrentUserFailed = Definition{Name: "AuthCurrentUserFailed", Code: 50001, Message: "获取用户信息失败"}
 	UserListFailed        = Definition{Name: "UserListFailed", Code: 50020, Message: "获取用户列表失败"}
+	LoginHistoryFailed    = Definition{Name: "LoginHistoryFailed", Code: 50021, Message: "获取登录记录失败"}
+	UserDataExportFailed  = Definition{Name: "UserDataExportFailed", Code: 50022, Message: "导出账号数据失败"}
 )
 
 var definitions = []Definition{
 	OK,
 	JSONRPCUnknownURL,
 	InvalidParam,
+	PageInvalid,
+	PageCursorInvalid,
 	UnknownMethod,
 	UserInvalidParam,
 	UserSetDisabledInvalid,
+	UserListInvalid,
+	UserBulkTooMany,
+	UserImportInvalid,
+	UserImportJobNotFound,
+	UserProfileInvalid,
+	UserRestoreConflict,
+	RBACRoleNotFound,
+	RBACRoleExists,
+	RBACRoleKeyInvalid,
+	RBACRoleBuiltin,
+	RBACPermissionUnknown,
+	RBACSuperAdminPermissions,
+	RBACLastSuperAdmin,
+	RBACRoleCycle,
+	RBACPolicyInvalid,
+	AdminAccountNotFound,
+	AdminSelfLockout,
+	AdminPasswordTooShort,
+	AdminPrivilegeEscalation,
+	AccessPolicyInvalid,
+	AccessPolicyNotFound,
+	OrganizationNotFound,
+	OrganizationExists,
+	OrganizationSlugInvalid,
+	OrganizationLastMember,
+	OrganizationRoleScope,
 	AdminRequired,
 	AuthRequired,
 	AdminDisabled,
 	PermissionDenied,
+	AuthImpersonationBlocked,
+	AccessPolicyDenied,
+	OrganizationForbidden,
 	AuthUserNotFound,
 	AuthInvalidPassword,
 	AuthUserDisabled,
 	AuthUserExists,
 	AuthExpired,
 	AuthInvalid,
+	AuthRegistrationClosed,
+	AuthInviteRequired,
+	AuthInviteInvalid,
+	AuthInviteExpired,
+	AuthInviteExhausted,
+	AuthUserUnverified,
+	AuthVerificationTooFrequent,
+	AuthVerificationCodeInvalid,
+	AuthVerificationCodeExpired,
+	AuthVerificationContactInvalid,
+	AuthVerificationContactConflict,
+	AuthUsernameInvalid,
+	AuthInvalidCredentials,
+	AuthLoginThrottled,
+	AuthPasswordChangeRequired,
 	Internal,
 	AuthCurrentUserFailed,
 	UserListFailed,
+	LoginHistoryFailed,
+	UserDataExportFailed,
 }
 
 func Definitions() []Definition {
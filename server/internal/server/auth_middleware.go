@@ -27,6 +27,57 @@ func bearerToken(auth string) string {
 	return ""
 }
 
+// authClaimsFromJWT 把 token 声明映射到 biz 层，act 声明存在时带上真实操作的管理员。
+func authClaimsFromJWT(claims *jwtutil.Claims) *biz.AuthClaims {
+	c := &biz.AuthClaims{
+		UserID:         claims.UserID,
+		Username:       claims.Username,
+		Role:           biz.Role(claims.Role),
+		OrganizationID: claims.OrganizationID,
+	}
+	if claims.Actor != nil {
+		c.ActorID = claims.Actor.UserID
+		c.ActorUsername = claims.Actor.Username
+	}
+	return c
+}
+
+// newContextWithJWTClaims 注入登录态；token 带 org 声明时同时限定本次请求所在的组织。
+func newContextWithJWTClaims(ctx context.Context, claims *jwtutil.Claims) context.Context {
+	c := authClaimsFromJWT(claims)
+	ctx = biz.NewContextWithClaims(ctx, c)
+	if c.OrganizationID > 0 {
+		ctx = biz.NewContextWithTenant(ctx, c.OrganizationID)
+	}
+	return biz.WithAuthState(ctx, biz.AuthOK)
+}
+
+// contextWithBearerAuth 解析 Authorization 头并写入登录态：没带 token 为 AuthNone，
+// 解析失败按过期 / 无效区分。自定义 HTTP 路由不经过 kratos 中间件，也复用这里。
+func contextWithBearerAuth(ctx context.Context, secret []byte, authHeader string, helper *log.Helper) context.Context {
+	ctx = biz.WithAuthState(ctx, biz.AuthNone)
+
+	tok := bearerToken(authHeader)
+	if tok == "" {
+		return ctx
+	}
+
+	claims, err := jwtutil.ParseToken(secret, tok)
+	if err == nil && claims != nil {
+		return newContextWithJWTClaims(ctx, claims)
+	}
+
+	// 带了 token 但解析失败：过期 or 无效
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		ctx = biz.WithAuthState(ctx, biz.AuthExpired)
+		helper.WithContext(ctx).Warn("token expired")
+	} else {
+		ctx = biz.WithAuthState(ctx, biz.AuthInvalid)
+		helper.WithContext(ctx).Warnf("parse token failed: %v", err)
+	}
+	return ctx
+}
+
 // AuthClaimsMiddleware：解析 JWT -> 注入 ctx claims（不做授权）
 func AuthClaimsMiddleware(dc *conf.Data, logger log.Logger) middleware.Middleware {
 	helper := log.NewHelper(log.With(logger, "module", "server.auth"))
@@ -55,34 +106,7 @@ func AuthClaimsMiddleware(dc *conf.Data, logger log.Logger) middleware.Middlewar
 				return next(ctx, req)
 			}
 
-			auth := tr.RequestHeader().Get("Authorization")
-			tok := bearerToken(auth)
-			if tok == "" {
-				// 没带 token：AuthNone
-				return next(ctx, req)
-			}
-
-			claims, err := jwtutil.ParseToken(secret, tok)
-			if err == nil && claims != nil {
-				ctx = biz.NewContextWithClaims(ctx, &biz.AuthClaims{
-					UserID:   claims.UserID,
-					Username: claims.Username,
-					Role:     biz.Role(claims.Role),
-				})
-				ctx = biz.WithAuthState(ctx, biz.AuthOK)
-				return next(ctx, req)
-			}
-
-			// 带了 token 但解析失败：过期 or 无效
-			if errors.Is(err, jwt.ErrTokenExpired) {
-				ctx = biz.WithAuthState(ctx, biz.AuthExpired)
-				helper.WithContext(ctx).Warn("token expired")
-			} else {
-				ctx = biz.WithAuthState(ctx, biz.AuthInvalid)
-				helper.WithContext(ctx).Warnf("parse token failed: %v", err)
-			}
-
-			return next(ctx, req)
+			return next(contextWithBearerAuth(ctx, secret, tr.RequestHeader().Get("Authorization"), helper), req)
 		}
 	}
 }
@@ -113,32 +137,7 @@ func AdminAuthClaimsMiddleware(dc *conf.Data, logger log.Logger) middleware.Midd
 				return next(ctx, req)
 			}
 
-			auth := tr.RequestHeader().Get("Authorization")
-			tok := bearerToken(auth)
-			if tok == "" {
-				return next(ctx, req)
-			}
-
-			claims, err := jwtutil.ParseToken(secret, tok)
-			if err == nil && claims != nil {
-				ctx = biz.NewContextWithClaims(ctx, &biz.AuthClaims{
-					UserID:   claims.UserID,
-					Username: claims.Username,
-					Role:     biz.Role(claims.Role),
-				})
-				ctx = biz.WithAuthState(ctx, biz.AuthOK)
-				return next(ctx, req)
-			}
-
-			if errors.Is(err, jwt.ErrTokenExpired) {
-				ctx = biz.WithAuthState(ctx, biz.AuthExpired)
-				helper.WithContext(ctx).Warn("token expired")
-			} else {
-				ctx = biz.WithAuthState(ctx, biz.AuthInvalid)
-				helper.WithContext(ctx).Warnf("parse token failed: %v", err)
-			}
-
-			return next(ctx, req)
+			return next(contextWithBearerAuth(ctx, secret, tr.RequestHeader().Get("Authorization"), helper), req)
 		}
 	}
 }
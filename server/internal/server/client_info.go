@@ -0,0 +1,76 @@
+// server/internal/server/client_info.go
+package server
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	httpx "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientInfoMiddleware 解析请求方 IP 与 User-Agent 写入 ctx，供登录流水等审计类记录使用。
+func ClientInfoMiddleware() middleware.Middleware {
+	return func(next middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			var info biz.ClientInfo
+			if r, ok := httpx.RequestFromServerContext(ctx); ok && r != nil {
+				info.IP = clientIP(r.RemoteAddr, r.Header.Get("X-Real-Ip"), r.Header.Get("X-Forwarded-For"))
+				info.UserAgent = r.UserAgent()
+			} else {
+				if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+					info.IP = clientIP(p.Addr.String(), "", "")
+				}
+				if tr, ok := transport.FromServerContext(ctx); ok && tr != nil {
+					info.UserAgent = tr.RequestHeader().Get("User-Agent")
+				}
+			}
+			return next(biz.NewContextWithClientInfo(ctx, info), req)
+		}
+	}
+}
+
+// clientIP 只在直连方是本机/内网地址（即经过自家反向代理）时才采信转发头，
+// 避免公网客户端伪造 X-Forwarded-For 污染登录流水。
+// X-Real-Ip 须由自家代理覆盖写入（nginx: proxy_set_header X-Real-IP $remote_addr），是公网地址时直接采用；
+// 否则（多级代理时它只是上一跳代理的地址）从右往左走 X-Forwarded-For，跳过本机/内网的代理跳，取第一个公网地址。
+// 最左边的值由客户端随意填写，不能直接采信。
+func clientIP(remoteAddr, realIP, forwardedFor string) string {
+	host := remoteAddr
+	if h, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		host = h
+	}
+
+	remote := net.ParseIP(host)
+	if !trustedProxy(remote) {
+		return host
+	}
+
+	if ip := net.ParseIP(strings.TrimSpace(realIP)); ip != nil && !trustedProxy(ip) {
+		return ip.String()
+	}
+	client := host
+	hops := strings.Split(forwardedFor, ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip := net.ParseIP(strings.TrimSpace(hops[i]))
+		if ip == nil {
+			// 无法解析的一跳之后（更左边）的内容都不可信。
+			break
+		}
+		client = ip.String()
+		if !trustedProxy(ip) {
+			break
+		}
+	}
+	return client
+}
+
+// trustedProxy 把本机与内网地址视为自家代理。
+func trustedProxy(ip net.IP) bool {
+	return ip != nil && (ip.IsLoopback() || ip.IsPrivate())
+}
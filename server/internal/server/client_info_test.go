@@ -0,0 +1,27 @@
+package server
+
+import "testing"
+
+func TestClientIP(t *testing.T) {
+	cases := []struct {
+		name                             string
+		remoteAddr, realIP, forwardedFor string
+		want                             string
+	}{
+		{"direct public client", "198.51.100.9:5123", "", "", "198.51.100.9"},
+		{"public client cannot spoof forwarded header", "198.51.100.9:5123", "10.0.0.1", "1.2.3.4", "198.51.100.9"},
+		{"behind local proxy uses x-real-ip", "127.0.0.1:40000", "203.0.113.7", "1.2.3.4", "203.0.113.7"},
+		{"behind private proxy uses rightmost public hop", "10.0.0.5:40000", "", "203.0.113.8, 10.0.0.2", "203.0.113.8"},
+		{"client cannot prepend spoofed hops", "10.0.0.5:40000", "", "1.2.3.4, 203.0.113.8, 10.0.0.2", "203.0.113.8"},
+		{"private x-real-ip from chained proxy falls back to forwarded", "10.0.0.5:40000", "10.0.0.2", "1.2.3.4, 203.0.113.8", "203.0.113.8"},
+		{"all hops private uses leftmost", "10.0.0.5:40000", "", "10.0.0.9, 10.0.0.2", "10.0.0.9"},
+		{"garbage hop stops the walk", "10.0.0.5:40000", "", "1.2.3.4, not-an-ip, 10.0.0.2", "10.0.0.2"},
+		{"behind proxy without headers", "10.0.0.5:40000", "", "", "10.0.0.5"},
+		{"garbage forwarded header", "127.0.0.1:1", "", "not-an-ip", "127.0.0.1"},
+	}
+	for _, tc := range cases {
+		if got := clientIP(tc.remoteAddr, tc.realIP, tc.forwardedFor); got != tc.want {
+			t.Fatalf("%s: clientIP() = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
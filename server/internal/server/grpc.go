@@ -34,6 +34,7 @@ func NewGRPCServer(
 			),
 			logging.Server(logger),
 			ratelimit.Server(),
+			ClientInfoMiddleware(),
 		),
 	)
 
@@ -35,6 +35,8 @@ func NewHTTPServer(
 			logging.Server(log.With(logger, "logger.name", "server.http")),
 			// 默认 bbr limiter
 			ratelimit.Server(),
+			// 解析请求方 IP / User-Agent，登录流水依赖它。
+			ClientInfoMiddleware(),
 			// 统一从请求头解析 JWT，并把 AuthClaims 写入请求上下文。
 			AuthClaimsMiddleware(dc, logger),
 		),
@@ -61,6 +63,7 @@ func NewHTTPServer(
 	v1.RegisterJsonrpcHTTPServer(srv, jsonrpcSvc)
 
 	registerHealthRoutes(srv, logger, tp, data.SQLDB())
+	registerUserExportRoute(srv, logger, tp, dc, jsonrpcSvc)
 	registerStaticHandler(srv, logger, tp)
 
 	return srv
@@ -39,6 +39,11 @@ func (w *statusCapturingResponseWriter) Write(p []byte) (int, error) {
 	return w.ResponseWriter.Write(p)
 }
 
+// Unwrap 让 http.ResponseController 能找到底层 writer 的 Flush，流式下载依赖它。
+func (w *statusCapturingResponseWriter) Unwrap() stdhttp.ResponseWriter {
+	return w.ResponseWriter
+}
+
 func (w *statusCapturingResponseWriter) StatusCode() int {
 	if w.status == 0 {
 		return stdhttp.StatusOK
@@ -0,0 +1,176 @@
+// server/internal/server/http_user_export.go
+package server
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	stdhttp "net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/conf"
+	"server/internal/errcode"
+	"server/internal/service"
+	"server/pkg/xlsx"
+
+	"github.com/go-kratos/kratos/v2/log"
+	httpx "github.com/go-kratos/kratos/v2/transport/http"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// 每写出这么多行刷一次响应，让下载尽快开始，又不至于每行一次系统调用。
+	userExportFlushRows = 200
+	// 导出不受 server.http.timeout 约束（大目录远超普通请求耗时），单独设上限。
+	userExportTimeout = 30 * time.Minute
+)
+
+// userExportPreparer 是导出路由依赖的 service 能力（*service.JsonrpcService 实现），测试里可替换。
+type userExportPreparer interface {
+	PrepareUserExport(ctx context.Context, values url.Values) (*service.UserExport, *v1.JsonrpcResult)
+}
+
+// registerUserExportRoute 注册用户目录导出（GET，下载 CSV / XLSX）。
+// 自定义路由不经过 kratos 中间件，这里自己解析 Authorization；权限与访问策略由 service 统一校验。
+func registerUserExportRoute(srv *httpx.Server, logger log.Logger, tp *sdktrace.TracerProvider, dc *conf.Data, svc userExportPreparer) {
+	helper := log.NewHelper(log.With(logger, "logger.name", "server.http.export"))
+
+	var secret []byte
+	if dc != nil && dc.Auth != nil {
+		secret = []byte(dc.Auth.JwtSecret)
+	}
+
+	srv.Handle(service.UserExportPath, newObservedHTTPHandler(logger, tp, "server.http.export_users", func(ctx context.Context, w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		if r.Method != stdhttp.MethodGet {
+			w.Header().Set("Allow", stdhttp.MethodGet)
+			writePlainText(w, stdhttp.StatusMethodNotAllowed, stdhttp.StatusText(stdhttp.StatusMethodNotAllowed))
+			return
+		}
+
+		ctx = biz.WithAuthState(ctx, biz.AuthNone)
+		if len(secret) > 0 {
+			ctx = contextWithBearerAuth(ctx, secret, r.Header.Get("Authorization"), helper)
+		}
+
+		export, res := svc.PrepareUserExport(ctx, r.URL.Query())
+		if res != nil {
+			writeExportError(w, res)
+			return
+		}
+
+		// 客户端断开时写响应会失败，导出随之终止，所以这里只需去掉 server 的请求超时。
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), userExportTimeout)
+		defer cancel()
+		writeUserExport(ctx, w, export, helper)
+	}))
+}
+
+// writeUserExport 写响应头后逐行输出。开始写出后无法再改状态码，中途失败只能截断文件并记录日志。
+func writeUserExport(ctx context.Context, w stdhttp.ResponseWriter, export *service.UserExport, helper *log.Helper) {
+	filename := "users-" + time.Now().UTC().Format("20060102-150405") + "." + export.Format
+	if export.Format == service.UserExportXLSX {
+		w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	} else {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	}
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(stdhttp.StatusOK)
+
+	rc := stdhttp.NewResponseController(w)
+	var (
+		writeRow func(cells []string) error
+		flush    func() error
+		finish   func() error
+	)
+	if export.Format == service.UserExportXLSX {
+		xw, err := xlsx.NewStreamWriter(w, "users")
+		if err != nil {
+			helper.WithContext(ctx).Errorf("user export start xlsx failed err=%v", err)
+			return
+		}
+		writeRow = func(cells []string) error {
+			row := make([]any, len(cells))
+			for i, c := range cells {
+				row[i] = c
+			}
+			return xw.WriteRow(row...)
+		}
+		flush = xw.Flush
+		finish = xw.Close
+	} else {
+		// 带 BOM，Excel 直接打开时才能识别 UTF-8。
+		if _, err := w.Write([]byte("\ufeff")); err != nil {
+			return
+		}
+		cw := csv.NewWriter(w)
+		writeRow = func(cells []string) error {
+			for i, c := range cells {
+				cells[i] = csvSafeCell(c)
+			}
+			return cw.Write(cells)
+		}
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+		finish = flush
+	}
+
+	if err := writeRow(append([]string(nil), service.UserExportColumns...)); err != nil {
+		return
+	}
+	written := 0
+	rows, err := export.Run(ctx, func(u *biz.User) error {
+		if err := writeRow(service.UserExportRow(u)); err != nil {
+			return err
+		}
+		written++
+		if written%userExportFlushRows != 0 {
+			return nil
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		_ = rc.Flush()
+		return nil
+	})
+	if err != nil {
+		helper.WithContext(ctx).Warnf("user export aborted format=%s rows=%d err=%v", export.Format, rows, err)
+		return
+	}
+	if err := finish(); err != nil {
+		helper.WithContext(ctx).Warnf("user export finish failed format=%s rows=%d err=%v", export.Format, rows, err)
+		return
+	}
+	_ = rc.Flush()
+}
+
+// csvSafeCell 防止表格软件把用户名等字段当作公式执行（CSV 注入）：以公式字符开头时前置单引号。
+func csvSafeCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// writeExportError 以 JSON 返回拒绝原因，状态码按错误码归类，便于浏览器下载与脚本区分失败。
+func writeExportError(w stdhttp.ResponseWriter, res *v1.JsonrpcResult) {
+	status := stdhttp.StatusBadRequest
+	switch {
+	case res.Code == errcode.AuthRequired.Code || res.Code == errcode.AuthExpired.Code || res.Code == errcode.AuthInvalid.Code:
+		status = stdhttp.StatusUnauthorized
+	case res.Code/100 == 403:
+		status = stdhttp.StatusForbidden
+	case res.Code/100 == 500:
+		status = stdhttp.StatusInternalServerError
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{"code": res.Code, "message": res.Message})
+}
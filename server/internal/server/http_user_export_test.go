@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/conf"
+	"server/internal/errcode"
+	"server/internal/service"
+	jwtutil "server/pkg/jwt"
+
+	httpx "github.com/go-kratos/kratos/v2/transport/http"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type stubUserExportPreparer struct {
+	res *v1.JsonrpcResult
+
+	state  biz.AuthState
+	claims *biz.AuthClaims
+	values url.Values
+}
+
+func (s *stubUserExportPreparer) PrepareUserExport(ctx context.Context, values url.Values) (*service.UserExport, *v1.JsonrpcResult) {
+	s.state = biz.AuthStateFrom(ctx)
+	s.claims, _ = biz.GetClaimsFromContext(ctx)
+	s.values = values
+	return nil, s.res
+}
+
+func newUserExportTestServer(prep userExportPreparer) *httpx.Server {
+	srv := httpx.NewServer()
+	dc := &conf.Data{Auth: &conf.Data_Auth{JwtSecret: "export-secret"}}
+	registerUserExportRoute(srv, &captureLogger{}, sdktrace.NewTracerProvider(), dc, prep)
+	return srv
+}
+
+func TestUserExportRoute_RejectsNonGet(t *testing.T) {
+	srv := newUserExportTestServer(&stubUserExportPreparer{})
+
+	req := httptest.NewRequest(http.MethodPost, service.UserExportPath, nil)
+	recorder := httptest.NewRecorder()
+	srv.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status = %d, want %d", recorder.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestUserExportRoute_MapsRejectionToStatus(t *testing.T) {
+	cases := []struct {
+		def  errcode.Definition
+		want int
+	}{
+		{errcode.AuthRequired, http.StatusUnauthorized},
+		{errcode.AuthExpired, http.StatusUnauthorized},
+		{errcode.PermissionDenied, http.StatusForbidden},
+		{errcode.AccessPolicyDenied, http.StatusForbidden},
+		{errcode.UserListInvalid, http.StatusBadRequest},
+		{errcode.Internal, http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		prep := &stubUserExportPreparer{res: &v1.JsonrpcResult{Code: tc.def.Code, Message: tc.def.Message}}
+		srv := newUserExportTestServer(prep)
+
+		req := httptest.NewRequest(http.MethodGet, service.UserExportPath+"?format=xlsx", nil)
+		recorder := httptest.NewRecorder()
+		srv.ServeHTTP(recorder, req)
+
+		if recorder.Code != tc.want {
+			t.Fatalf("%s: status = %d, want %d", tc.def.Name, recorder.Code, tc.want)
+		}
+		var body struct {
+			Code    int32  `json:"code"`
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+			t.Fatalf("%s: decode body: %v", tc.def.Name, err)
+		}
+		if body.Code != tc.def.Code || body.Message != tc.def.Message {
+			t.Fatalf("%s: body = %+v", tc.def.Name, body)
+		}
+		if prep.values.Get("format") != "xlsx" {
+			t.Fatalf("%s: query not passed through: %v", tc.def.Name, prep.values)
+		}
+	}
+}
+
+func TestUserExportRoute_ParsesBearerToken(t *testing.T) {
+	tok, _, err := jwtutil.NewToken(jwtutil.Config{Secret: []byte("export-secret"), ExpireDuration: time.Hour}, 7, "alice", 1, 3)
+	if err != nil {
+		t.Fatalf("NewToken: %v", err)
+	}
+	prep := &stubUserExportPreparer{res: &v1.JsonrpcResult{Code: errcode.PermissionDenied.Code}}
+	srv := newUserExportTestServer(prep)
+
+	req := httptest.NewRequest(http.MethodGet, service.UserExportPath, nil)
+	req.Header.Set("Authorization", "Bearer "+tok)
+	srv.ServeHTTP(httptest.NewRecorder(), req)
+
+	if prep.state != biz.AuthOK || prep.claims == nil || prep.claims.UserID != 7 || prep.claims.OrganizationID != 3 {
+		t.Fatalf("state=%v claims=%+v", prep.state, prep.claims)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, service.UserExportPath, nil)
+	req.Header.Set("Authorization", "Bearer not-a-token")
+	srv.ServeHTTP(httptest.NewRecorder(), req)
+	if prep.state != biz.AuthInvalid {
+		t.Fatalf("state = %v, want AuthInvalid", prep.state)
+	}
+}
+
+func TestCSVSafeCell(t *testing.T) {
+	cases := map[string]string{
+		"alice":        "alice",
+		"=cmd|' /C'!A": "'=cmd|' /C'!A",
+		"+1":           "'+1",
+		"-2":           "'-2",
+		"@sum":         "'@sum",
+		"":             "",
+	}
+	for in, want := range cases {
+		if got := csvSafeCell(in); got != want {
+			t.Fatalf("csvSafeCell(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// server/internal/server/job.go
+package server
+
+import (
+	"context"
+	"time"
+
+	"server/internal/biz"
+	"server/pkg/taskgroup"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+const jobServerStopTimeout = 10 * time.Second
+
+// periodicJob 是一个按固定间隔执行的后台任务；启动后先立即跑一次。
+type periodicJob struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+}
+
+// JobServer 以 kratos transport.Server 的形式承载周期任务，跟随 App 启停，
+// 生命周期交给 taskgroup 收口。多副本部署时每个副本都会执行，任务本身需保证幂等。
+type JobServer struct {
+	jobs  []periodicJob
+	group *taskgroup.Group
+	log   *log.Helper
+}
+
+var _ transport.Server = (*JobServer)(nil)
+
+func NewJobServer(loginHistoryUC *biz.LoginHistoryUsecase, adminAccountUC *biz.AdminAccountUsecase, userDeletionUC *biz.UserDeletionUsecase, logger log.Logger) *JobServer {
+	s := &JobServer{
+		log: log.NewHelper(log.With(logger, "module", "server.job")),
+	}
+	if loginHistoryUC != nil {
+		s.jobs = append(s.jobs, periodicJob{
+			name:     "login-events-retention",
+			interval: time.Hour,
+			run: func(ctx context.Context) error {
+				_, err := loginHistoryUC.PurgeExpired(ctx, time.Now())
+				return err
+			},
+		})
+	}
+	if adminAccountUC != nil {
+		// 鉴权查询已忽略过期授权，这里只做清理与审计，分钟级足够。
+		s.jobs = append(s.jobs, periodicJob{
+			name:     "admin-role-grants-expiry",
+			interval: time.Minute,
+			run: func(ctx context.Context) error {
+				_, err := adminAccountUC.PurgeExpiredRoleGrants(ctx, time.Now())
+				return err
+			},
+		})
+	}
+	if userDeletionUC != nil {
+		s.jobs = append(s.jobs, periodicJob{
+			name:     "deleted-users-purge",
+			interval: time.Hour,
+			run: func(ctx context.Context) error {
+				_, err := userDeletionUC.PurgeExpired(ctx, time.Now())
+				return err
+			},
+		})
+		s.jobs = append(s.jobs, periodicJob{
+			name:     "self-deleted-users-erase",
+			interval: time.Hour,
+			run: func(ctx context.Context) error {
+				_, err := userDeletionUC.EraseScheduled(ctx, time.Now())
+				return err
+			},
+		})
+	}
+	return s
+}
+
+func (s *JobServer) Start(ctx context.Context) error {
+	s.group = taskgroup.New()
+	for _, job := range s.jobs {
+		job := job
+		jobCtx := taskgroup.WithOperation(ctx, "periodic-job")
+		jobCtx = taskgroup.WithTaskName(jobCtx, job.name)
+		s.group.Go(jobCtx, func(ctx context.Context) {
+			s.loop(ctx, job)
+		})
+	}
+	s.log.Infof("job server started jobs=%d", len(s.jobs))
+	return nil
+}
+
+func (s *JobServer) Stop(ctx context.Context) error {
+	if s.group == nil {
+		return nil
+	}
+	timeout := jobServerStopTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	s.group.Stop(true, timeout)
+	s.log.Info("job server stopped")
+	return nil
+}
+
+func (s *JobServer) loop(ctx context.Context, job periodicJob) {
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job.run(ctx); err != nil && ctx.Err() == nil {
+			s.log.WithContext(ctx).Warnf("job run failed name=%s err=%v", job.name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -23,12 +23,24 @@ func NewJsonrpcService(
 	authUC *biz.AuthUsecase,
 	adminAuthUC *biz.AdminAuthUsecase,
 	userAdminUC *biz.UserAdminUsecase,
+	userImportUC *biz.UserImportUsecase,
+	userProfileUC *biz.UserProfileUsecase,
+	userDeletionUC *biz.UserDeletionUsecase,
+	userDataUC *biz.UserDataUsecase,
 	rbacUC *biz.RBACUsecase,
+	userRBACUC *biz.UserRBACUsecase,
+	accessPolicyUC *biz.AccessPolicyUsecase,
+	organizationUC *biz.OrganizationUsecase,
+	impersonationUC *biz.ImpersonationUsecase,
+	inviteUC *biz.InviteUsecase,
+	verificationUC *biz.VerificationUsecase,
+	loginHistoryUC *biz.LoginHistoryUsecase,
+	adminAccountUC *biz.AdminAccountUsecase,
 	adminReader biz.AdminAccountReader,
 	logger log.Logger,
 ) *JsonrpcService {
 	return &JsonrpcService{
-		dispatcher: newJSONRPCDispatcher(logger, authUC, adminAuthUC, userAdminUC, rbacUC, adminReader),
+		dispatcher: newJSONRPCDispatcher(logger, authUC, adminAuthUC, userAdminUC, userImportUC, userProfileUC, userDeletionUC, userDataUC, rbacUC, userRBACUC, accessPolicyUC, organizationUC, impersonationUC, inviteUC, verificationUC, loginHistoryUC, adminAccountUC, adminReader),
 		log:        log.NewHelper(logger),
 	}
 }
@@ -0,0 +1,82 @@
+// server/internal/service/jsonrpc_access_policy_test.go
+package service
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"server/internal/biz"
+	"server/internal/errcode"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type memAccessPolicyRepoForData struct {
+	policies []biz.AccessPolicy
+}
+
+func (r *memAccessPolicyRepoForData) ListAccessPolicies(ctx context.Context) ([]biz.AccessPolicy, error) {
+	return r.policies, nil
+}
+
+func (r *memAccessPolicyRepoForData) UpsertAccessPolicy(ctx context.Context, in *biz.AccessPolicy) (*biz.AccessPolicy, error) {
+	in.ID = len(r.policies) + 1
+	r.policies = append(r.policies, *in)
+	return in, nil
+}
+
+func (r *memAccessPolicyRepoForData) DeleteAccessPolicy(ctx context.Context, url, method string) (*biz.AccessPolicy, error) {
+	return nil, biz.ErrAccessPolicyNotFound
+}
+
+func TestJsonrpcDispatcher_AccessPolicyAfterRBAC(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+
+	admins := newMemAdminAuthRepoForData()
+	if err := admins.putAdmin("root", "secret", false, []string{"ops"}, []string{biz.PermissionUserWrite, biz.PermissionAccessPolicyWrite}); err != nil {
+		t.Fatalf("putAdmin() error = %v", err)
+	}
+	admins.admins["root"].Attributes = map[string]any{"region": "cn"}
+
+	policyUC, err := biz.NewAccessPolicyUsecase(&memAccessPolicyRepoForData{}, nil, logger, tp)
+	if err != nil {
+		t.Fatalf("NewAccessPolicyUsecase() error = %v", err)
+	}
+	j := &jsonrpcDispatcher{
+		log:            log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
+		accessPolicyUC: policyUC,
+		adminReader:    admins,
+	}
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "root", Role: biz.RoleAdmin})
+
+	upsert, _ := structpb.NewStruct(map[string]any{"url": "user", "method": "set_disabled", "expression": "params.region =="})
+	_, res, _ := j.Handle(ctx, "access_policy", "2.0", "upsert", "1", upsert)
+	if res.Code != errcode.AccessPolicyInvalid.Code {
+		t.Fatalf("expected invalid expression to be rejected on save, got %+v", res)
+	}
+
+	upsert, _ = structpb.NewStruct(map[string]any{"url": "user", "method": "set_disabled", "expression": "params.region == admin.region"})
+	_, res, _ = j.Handle(ctx, "access_policy", "2.0", "upsert", "2", upsert)
+	if res.Code != errcode.OK.Code {
+		t.Fatalf("expected policy to be saved, got %+v", res)
+	}
+
+	check := func(region string) int32 {
+		params, _ := structpb.NewStruct(map[string]any{"user_id": 5, "region": region})
+		callCtx := newContextWithRPCCall(ctx, "user", "set_disabled", params)
+		if _, res := j.requireAdminPermission(callCtx, methodPermission("user", "set_disabled")); res != nil {
+			return res.Code
+		}
+		return errcode.OK.Code
+	}
+	if got := check("us"); got != errcode.AccessPolicyDenied.Code {
+		t.Fatalf("expected policy to deny other region, got code=%d", got)
+	}
+	if got := check("cn"); got != errcode.OK.Code {
+		t.Fatalf("expected policy to allow own region, got code=%d", got)
+	}
+}
@@ -0,0 +1,280 @@
+// server/internal/service/jsonrpc_admin.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/errcode"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = registerMethodPermissions("admin", map[string]string{
+	"list":           biz.PermissionAccountRead,
+	"create":         biz.PermissionAccountWrite,
+	"disable":        biz.PermissionAccountWrite,
+	"enable":         biz.PermissionAccountWrite,
+	"reset_password": biz.PermissionAccountWrite,
+	"assign_roles":   biz.PermissionAccountWrite,
+	"grant_role":     biz.PermissionAccountWrite,
+	"revoke_role":    biz.PermissionAccountWrite,
+	"set_attributes": biz.PermissionAccountWrite,
+})
+
+func (d *jsonrpcDispatcher) handleAdmin(
+	ctx context.Context,
+	method, id string,
+	params *structpb.Struct,
+) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+
+	pm := map[string]any{}
+	if params != nil {
+		pm = params.AsMap()
+	}
+
+	c, res := d.requireAdminPermission(ctx, methodPermission("admin", method))
+	if res != nil {
+		l.Warnf("[admin] require permission denied method=%s id=%s code=%d msg=%s", method, id, res.Code, res.Message)
+		return id, res, nil
+	}
+
+	switch method {
+	case "list":
+		limit := getInt(pm, "limit", 30)
+		offset := getInt(pm, "offset", 0)
+		search := strings.TrimSpace(getString(pm, "search"))
+
+		list, total, err := d.adminAccountUC.List(ctx, limit, offset, search)
+		if err != nil {
+			l.Errorf("[admin] list failed id=%s operator_uid=%d err=%v", id, c.UserID, err)
+			return id, d.mapAdminError(ctx, err), nil
+		}
+
+		arr := make([]any, 0, len(list))
+		for _, a := range list {
+			arr = append(arr, adminAccountResult(a))
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "获取管理员列表成功",
+			Data: newDataStruct(map[string]any{
+				"admins": arr,
+				"total":  total,
+				"limit":  limit,
+				"offset": offset,
+				"search": search,
+			}),
+		}, nil
+
+	case "create":
+		roles, ok := getStringSlice(pm, "roles")
+		if !ok {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：roles 必须是字符串数组"}, nil
+		}
+		username := getString(pm, "username")
+
+		l.Infof("[admin] create start id=%s operator_uid=%d username=%q roles=%v", id, c.UserID, username, roles)
+
+		admin, err := d.adminAccountUC.Create(ctx, username, getString(pm, "password"), roles)
+		if err != nil {
+			return id, d.mapAdminError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "管理员已创建",
+			Data:    newDataStruct(adminAccountResult(admin)),
+		}, nil
+
+	case "disable", "enable":
+		adminID := getInt(pm, "admin_id", 0)
+		disabled := method == "disable"
+
+		l.Infof("[admin] %s start id=%s operator_uid=%d admin_id=%d", method, id, c.UserID, adminID)
+
+		if err := d.adminAccountUC.SetDisabled(ctx, adminID, disabled); err != nil {
+			return id, d.mapAdminError(ctx, err), nil
+		}
+		msg := "启用成功"
+		if disabled {
+			msg = "禁用成功"
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: msg,
+			Data: newDataStruct(map[string]any{
+				"success":  true,
+				"admin_id": adminID,
+				"disabled": disabled,
+			}),
+		}, nil
+
+	case "reset_password":
+		adminID := getInt(pm, "admin_id", 0)
+
+		l.Infof("[admin] reset_password start id=%s operator_uid=%d admin_id=%d", id, c.UserID, adminID)
+
+		if err := d.adminAccountUC.ResetPassword(ctx, adminID, getString(pm, "password")); err != nil {
+			return id, d.mapAdminError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "密码已重置",
+			Data:    newDataStruct(map[string]any{"success": true, "admin_id": adminID}),
+		}, nil
+
+	case "assign_roles":
+		adminID := getInt(pm, "admin_id", 0)
+		roles, ok := getStringSlice(pm, "roles")
+		if !ok || roles == nil {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：roles 必须是字符串数组"}, nil
+		}
+
+		l.Infof("[admin] assign_roles start id=%s operator_uid=%d admin_id=%d roles=%v", id, c.UserID, adminID, roles)
+
+		admin, err := d.adminAccountUC.AssignRoles(ctx, adminID, roles)
+		if err != nil {
+			return id, d.mapAdminError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "角色已更新",
+			Data:    newDataStruct(adminAccountResult(admin)),
+		}, nil
+
+	case "grant_role":
+		adminID := getInt(pm, "admin_id", 0)
+		role := getString(pm, "role")
+		duration := time.Duration(getInt(pm, "duration_seconds", 0)) * time.Second
+		var startsAt time.Time
+		if ts := getInt(pm, "starts_at", 0); ts > 0 {
+			startsAt = time.Unix(int64(ts), 0)
+		}
+
+		l.Infof("[admin] grant_role start id=%s operator_uid=%d admin_id=%d role=%q duration=%s", id, c.UserID, adminID, role, duration)
+
+		admin, err := d.adminAccountUC.GrantRole(ctx, adminID, role, startsAt, duration)
+		if err != nil {
+			return id, d.mapAdminError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "临时角色已授予",
+			Data:    newDataStruct(adminAccountResult(admin)),
+		}, nil
+
+	case "revoke_role":
+		adminID := getInt(pm, "admin_id", 0)
+		role := getString(pm, "role")
+
+		l.Infof("[admin] revoke_role start id=%s operator_uid=%d admin_id=%d role=%q", id, c.UserID, adminID, role)
+
+		admin, err := d.adminAccountUC.RevokeRole(ctx, adminID, role)
+		if err != nil {
+			return id, d.mapAdminError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "角色已撤销",
+			Data:    newDataStruct(adminAccountResult(admin)),
+		}, nil
+
+	case "set_attributes":
+		adminID := getInt(pm, "admin_id", 0)
+		attributes, ok := getMap(pm, "attributes")
+		if !ok {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：attributes 必须是对象"}, nil
+		}
+
+		l.Infof("[admin] set_attributes start id=%s operator_uid=%d admin_id=%d keys=%d", id, c.UserID, adminID, len(attributes))
+
+		admin, err := d.adminAccountUC.SetAttributes(ctx, adminID, attributes)
+		if err != nil {
+			return id, d.mapAdminError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "管理员属性已更新",
+			Data:    newDataStruct(adminAccountResult(admin)),
+		}, nil
+
+	default:
+		l.Warnf("[admin] unknown method=%s id=%s", method, id)
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.UnknownMethod.Code,
+			Message: fmt.Sprintf("未知管理员接口 method=%s", method),
+		}, nil
+	}
+}
+
+func (d *jsonrpcDispatcher) mapAdminError(ctx context.Context, err error) *v1.JsonrpcResult {
+	def := errcode.Internal
+	switch {
+	case errors.Is(err, biz.ErrBadParam):
+		def = errcode.InvalidParam
+	case errors.Is(err, biz.ErrUsernameInvalid):
+		def = errcode.AuthUsernameInvalid
+	case errors.Is(err, biz.ErrUserExists):
+		def = errcode.AuthUserExists
+	case errors.Is(err, biz.ErrAdminNotFound):
+		def = errcode.AdminAccountNotFound
+	case errors.Is(err, biz.ErrAdminSelfLockout):
+		def = errcode.AdminSelfLockout
+	case errors.Is(err, biz.ErrAdminPasswordTooShort):
+		def = errcode.AdminPasswordTooShort
+	case errors.Is(err, biz.ErrAdminPrivilegeEscalation):
+		def = errcode.AdminPrivilegeEscalation
+	case errors.Is(err, biz.ErrForbidden):
+		def = errcode.PermissionDenied
+	case errors.Is(err, biz.ErrRoleNotFound):
+		def = errcode.RBACRoleNotFound
+	case errors.Is(err, biz.ErrLastSuperAdmin):
+		def = errcode.RBACLastSuperAdmin
+	default:
+		d.log.WithContext(ctx).Errorf("[admin] unexpected error err=%v", err)
+	}
+	return &v1.JsonrpcResult{Code: def.Code, Message: def.Message}
+}
+
+func adminAccountResult(a *biz.AdminUser) map[string]any {
+	lastLogin := int64(0)
+	if a.LastLoginAt != nil {
+		lastLogin = a.LastLoginAt.Unix()
+	}
+	roles := a.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+	attributes := a.Attributes
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+	temporary := make([]any, 0, len(a.TemporaryRoles))
+	for _, g := range a.TemporaryRoles {
+		startsAt := int64(0)
+		if g.StartsAt != nil {
+			startsAt = g.StartsAt.Unix()
+		}
+		temporary = append(temporary, map[string]any{
+			"role":       g.RoleKey,
+			"starts_at":  startsAt,
+			"expires_at": g.ExpiresAt.Unix(),
+		})
+	}
+	return map[string]any{
+		"id":              a.ID,
+		"username":        a.Username,
+		"disabled":        a.Disabled,
+		"roles":           roles,
+		"temporary_roles": temporary,
+		"attributes":      attributes,
+		"last_login_at":   lastLogin,
+		"created_at":      a.CreatedAt.Unix(),
+	}
+}
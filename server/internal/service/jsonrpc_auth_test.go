@@ -25,10 +25,10 @@ func TestJsonrpcDispatcher_AuthLogin_OK(t *testing.T) {
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
 
-	genTok := func(userID int, username string, role int8) (string, time.Time, error) {
+	genTok := func(userID int, username string, role int8, organizationID int) (string, time.Time, error) {
 		return "tok", time.Now().Add(time.Hour), nil
 	}
-	authUC := biz.NewAuthUsecase(repo, genTok, logger, tp)
+	authUC := biz.NewAuthUsecase(repo, genTok, nil, logger, tp)
 
 	j := &jsonrpcDispatcher{
 		log:    log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
@@ -65,7 +65,7 @@ func TestJsonrpcDispatcher_AdminLogin_OKReturnsTokenAndPermissions(t *testing.T)
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	adminAuthUC := biz.NewAdminAuthUsecase(repo, func(userID int, username string, role int8) (string, time.Time, error) {
+	adminAuthUC := biz.NewAdminAuthUsecase(repo, func(userID int, username string, role int8, organizationID int) (string, time.Time, error) {
 		return "admin-tok", time.Now().Add(time.Hour), nil
 	}, logger, tp)
 
@@ -104,9 +104,9 @@ func TestJsonrpcDispatcher_AuthRegister_Success(t *testing.T) {
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	authUC := biz.NewAuthUsecase(repo, func(int, string, int8) (string, time.Time, error) {
+	authUC := biz.NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
 		return "tok-reg", time.Now().Add(time.Hour), nil
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	j := &jsonrpcDispatcher{
 		log:    log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
@@ -139,9 +139,9 @@ func TestJsonrpcDispatcher_AuthRegister_MissingArgs(t *testing.T) {
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	authUC := biz.NewAuthUsecase(repo, func(int, string, int8) (string, time.Time, error) {
+	authUC := biz.NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
 		return "tok", time.Now().Add(time.Hour), nil
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	j := &jsonrpcDispatcher{
 		log:    log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
@@ -169,9 +169,9 @@ func TestJsonrpcDispatcher_AuthLogin_UserNotFound(t *testing.T) {
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	authUC := biz.NewAuthUsecase(repo, func(int, string, int8) (string, time.Time, error) {
+	authUC := biz.NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
 		return "tok", time.Now().Add(time.Hour), nil
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	j := &jsonrpcDispatcher{
 		log:    log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
@@ -201,9 +201,9 @@ func TestJsonrpcDispatcher_AuthLogin_InvalidPassword(t *testing.T) {
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	authUC := biz.NewAuthUsecase(repo, func(int, string, int8) (string, time.Time, error) {
+	authUC := biz.NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
 		return "tok", time.Now().Add(time.Hour), nil
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	j := &jsonrpcDispatcher{
 		log:    log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
@@ -227,14 +227,53 @@ func TestJsonrpcDispatcher_AuthLogin_InvalidPassword(t *testing.T) {
 	}
 }
 
+func TestJsonrpcDispatcher_AuthChangePassword_TemporaryPassword(t *testing.T) {
+	repo := newMemAuthRepoForData()
+	_ = repo.putUser("alice", "temp-pass-1", false)
+	repo.users["alice"].MustChangePassword = true
+
+	logger := log.NewStdLogger(io.Discard)
+	authUC := biz.NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
+		return "tok", time.Now().Add(time.Hour), nil
+	}, nil, logger, tracesdk.NewTracerProvider())
+	j := &jsonrpcDispatcher{
+		log:    log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
+		authUC: authUC,
+	}
+	call := func(method string, pm map[string]any) int32 {
+		params, _ := structpb.NewStruct(pm)
+		_, res, err := j.handleAuth(context.Background(), method, "1", params)
+		if err != nil {
+			t.Fatalf("%s: expected nil err, got %v", method, err)
+		}
+		return res.Code
+	}
+
+	if got := call("login", map[string]any{"username": "alice", "password": "temp-pass-1"}); got != errcode.AuthPasswordChangeRequired.Code {
+		t.Fatalf("login with temporary password: code=%d, want %d", got, errcode.AuthPasswordChangeRequired.Code)
+	}
+	if got := call("change_password", map[string]any{"old_password": "temp-pass-1", "new_password": "new-pass-1"}); got != errcode.AuthRequired.Code {
+		t.Fatalf("change_password without token or username: code=%d, want %d", got, errcode.AuthRequired.Code)
+	}
+	if got := call("change_password", map[string]any{"username": "alice", "old_password": "wrong", "new_password": "new-pass-1"}); got != errcode.AuthInvalidCredentials.Code {
+		t.Fatalf("change_password with wrong password: code=%d, want %d", got, errcode.AuthInvalidCredentials.Code)
+	}
+	if got := call("change_password", map[string]any{"username": "alice", "old_password": "temp-pass-1", "new_password": "new-pass-1"}); got != errcode.OK.Code {
+		t.Fatalf("change_password with username: code=%d, want OK", got)
+	}
+	if got := call("login", map[string]any{"username": "alice", "password": "new-pass-1"}); got != errcode.OK.Code {
+		t.Fatalf("login after password change: code=%d, want OK", got)
+	}
+}
+
 func TestJsonrpcDispatcher_AuthLogout(t *testing.T) {
 	repo := newMemAuthRepoForData()
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	authUC := biz.NewAuthUsecase(repo, func(int, string, int8) (string, time.Time, error) {
+	authUC := biz.NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
 		return "tok", time.Now().Add(time.Hour), nil
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	j := &jsonrpcDispatcher{
 		log:    log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
@@ -257,9 +296,9 @@ func TestJsonrpcDispatcher_AuthUnknownMethod(t *testing.T) {
 
 	logger := log.NewStdLogger(io.Discard)
 	tp := tracesdk.NewTracerProvider()
-	authUC := biz.NewAuthUsecase(repo, func(int, string, int8) (string, time.Time, error) {
+	authUC := biz.NewAuthUsecase(repo, func(int, string, int8, int) (string, time.Time, error) {
 		return "tok", time.Now().Add(time.Hour), nil
-	}, logger, tp)
+	}, nil, logger, tp)
 
 	j := &jsonrpcDispatcher{
 		log:    log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
@@ -356,6 +395,23 @@ func (r *memAuthRepoForData) UpdateUserLastLogin(ctx context.Context, id int, t
 	return nil
 }
 
+func (r *memAuthRepoForData) CreateUserWithInvite(ctx context.Context, u *biz.User, inviteCode string) (*biz.User, error) {
+	// 测试中不需要实现
+	return nil, biz.ErrInviteInvalid
+}
+
+func (r *memAuthRepoForData) UpdateUserPassword(ctx context.Context, id int, passwordHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.users {
+		if u.ID == id {
+			u.PasswordHash, u.MustChangePassword = passwordHash, false
+			return nil
+		}
+	}
+	return errors.New("not found")
+}
+
 type memAdminAuthRepoForData struct {
 	mu     sync.Mutex
 	admins map[string]*biz.AdminUser
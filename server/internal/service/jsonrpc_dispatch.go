@@ -14,6 +14,7 @@ import (
 	v1 "server/api/jsonrpc/v1"
 	"server/internal/biz"
 	"server/internal/errcode"
+	"server/pkg/taskgroup"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -27,6 +28,25 @@ type jsonrpcDispatcher struct {
 	adminAuthUC *biz.AdminAuthUsecase
 	userAdminUC *biz.UserAdminUsecase
 	rbacUC      *biz.RBACUsecase
+	userRBACUC  *biz.UserRBACUsecase
+	// userImportUC 的导入任务通过 runTask 放到后台执行（默认 taskgroup.Go）。
+	userImportUC *biz.UserImportUsecase
+	runTask      func(ctx context.Context, run func(ctx context.Context))
+	// userProfileUC 同时服务 auth.profile/auth.update_profile（本人）与 user.get_profile/user.update_profile（管理员）。
+	userProfileUC *biz.UserProfileUsecase
+	// userDeletionUC 负责 user.delete/user.restore/user.list_deleted；匿名化由 JobServer 调用。
+	userDeletionUC *biz.UserDeletionUsecase
+	// userDataUC 负责 auth.export_my_data；auth.delete_account 走 userDeletionUC。
+	userDataUC *biz.UserDataUsecase
+	// accessPolicyUC 在 RBAC 放行后再按接口上的访问策略判断一次。
+	accessPolicyUC *biz.AccessPolicyUsecase
+	organizationUC *biz.OrganizationUsecase
+
+	impersonationUC *biz.ImpersonationUsecase
+	inviteUC        *biz.InviteUsecase
+	verificationUC  *biz.VerificationUsecase
+	loginHistoryUC  *biz.LoginHistoryUsecase
+	adminAccountUC  *biz.AdminAccountUsecase
 
 	adminReader biz.AdminAccountReader
 }
@@ -36,7 +56,19 @@ func newJSONRPCDispatcher(
 	authUC *biz.AuthUsecase,
 	adminAuthUC *biz.AdminAuthUsecase,
 	userAdminUC *biz.UserAdminUsecase,
+	userImportUC *biz.UserImportUsecase,
+	userProfileUC *biz.UserProfileUsecase,
+	userDeletionUC *biz.UserDeletionUsecase,
+	userDataUC *biz.UserDataUsecase,
 	rbacUC *biz.RBACUsecase,
+	userRBACUC *biz.UserRBACUsecase,
+	accessPolicyUC *biz.AccessPolicyUsecase,
+	organizationUC *biz.OrganizationUsecase,
+	impersonationUC *biz.ImpersonationUsecase,
+	inviteUC *biz.InviteUsecase,
+	verificationUC *biz.VerificationUsecase,
+	loginHistoryUC *biz.LoginHistoryUsecase,
+	adminAccountUC *biz.AdminAccountUsecase,
 	adminReader biz.AdminAccountReader,
 ) *jsonrpcDispatcher {
 	helper := log.NewHelper(log.With(logger, "module", "service.jsonrpc.dispatcher"))
@@ -50,9 +82,45 @@ func newJSONRPCDispatcher(
 	if userAdminUC == nil {
 		panic("newJSONRPCDispatcher: userAdminUC is nil")
 	}
+	if userImportUC == nil {
+		panic("newJSONRPCDispatcher: userImportUC is nil")
+	}
+	if userProfileUC == nil {
+		panic("newJSONRPCDispatcher: userProfileUC is nil")
+	}
+	if userDeletionUC == nil {
+		panic("newJSONRPCDispatcher: userDeletionUC is nil")
+	}
+	if userDataUC == nil {
+		panic("newJSONRPCDispatcher: userDataUC is nil")
+	}
 	if rbacUC == nil {
 		panic("newJSONRPCDispatcher: rbacUC is nil")
 	}
+	if userRBACUC == nil {
+		panic("newJSONRPCDispatcher: userRBACUC is nil")
+	}
+	if accessPolicyUC == nil {
+		panic("newJSONRPCDispatcher: accessPolicyUC is nil")
+	}
+	if organizationUC == nil {
+		panic("newJSONRPCDispatcher: organizationUC is nil")
+	}
+	if impersonationUC == nil {
+		panic("newJSONRPCDispatcher: impersonationUC is nil")
+	}
+	if inviteUC == nil {
+		panic("newJSONRPCDispatcher: inviteUC is nil")
+	}
+	if verificationUC == nil {
+		panic("newJSONRPCDispatcher: verificationUC is nil")
+	}
+	if loginHistoryUC == nil {
+		panic("newJSONRPCDispatcher: loginHistoryUC is nil")
+	}
+	if adminAccountUC == nil {
+		panic("newJSONRPCDispatcher: adminAccountUC is nil")
+	}
 	if adminReader == nil {
 		panic("newJSONRPCDispatcher: adminReader is nil")
 	}
@@ -65,6 +133,24 @@ func newJSONRPCDispatcher(
 		adminAuthUC: adminAuthUC,
 		userAdminUC: userAdminUC,
 		rbacUC:      rbacUC,
+		userRBACUC:  userRBACUC,
+
+		userImportUC: userImportUC,
+		runTask: func(ctx context.Context, run func(ctx context.Context)) {
+			taskgroup.Go(ctx, run)
+		},
+		userProfileUC:  userProfileUC,
+		userDeletionUC: userDeletionUC,
+		userDataUC:     userDataUC,
+
+		accessPolicyUC:  accessPolicyUC,
+		organizationUC:  organizationUC,
+		impersonationUC: impersonationUC,
+		inviteUC:        inviteUC,
+		verificationUC:  verificationUC,
+		loginHistoryUC:  loginHistoryUC,
+		adminAccountUC:  adminAccountUC,
+
 		adminReader: adminReader,
 	}
 }
@@ -86,11 +172,23 @@ func (d *jsonrpcDispatcher) Handle(
 		d.log.WithContext(ctx).Infof("[jsonrpc] params=%s", string(b))
 	}
 
+	// 同一请求内的多次管理员校验共用一次查询结果。
+	ctx = biz.NewContextWithAdminAccessCache(ctx)
+	ctx = newContextWithRPCCall(ctx, url, method, params)
+
 	if !d.isPublic(url, method) {
 		if _, res := d.requireLogin(ctx); res != nil {
 			return id, res, nil
 		}
 	}
+	if res := d.guardImpersonation(ctx, url, method); res != nil {
+		return id, res, nil
+	}
+	if p := userMethodPermission(url, method); p != "" {
+		if res := d.requireUserPermission(ctx, p); res != nil {
+			return id, res, nil
+		}
+	}
 
 	switch url {
 	case "system":
@@ -101,6 +199,16 @@ func (d *jsonrpcDispatcher) Handle(
 		return d.handleUser(ctx, method, id, params)
 	case "rbac":
 		return d.handleRBAC(ctx, method, id, params)
+	case "user_rbac":
+		return d.handleUserRBAC(ctx, method, id, params)
+	case "access_policy":
+		return d.handleAccessPolicy(ctx, method, id, params)
+	case "organization":
+		return d.handleOrganization(ctx, method, id, params)
+	case "invite":
+		return d.handleInvite(ctx, method, id, params)
+	case "admin":
+		return d.handleAdmin(ctx, method, id, params)
 	default:
 		return id, &v1.JsonrpcResult{
 			Code:    errcode.JSONRPCUnknownURL.Code,
@@ -153,13 +261,20 @@ func (d *jsonrpcDispatcher) handleAuth(
 		password := getString(pm, "password")
 
 		if username == "" || password == "" {
+			d.recordLogin(ctx, biz.LoginAccountUser, 0, username, biz.ErrBadParam)
 			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "缺少用户名或密码"}, nil
 		}
 
+		if err := d.checkLoginThrottle(ctx, biz.LoginAccountUser, username); err != nil {
+			d.recordLogin(ctx, biz.LoginAccountUser, 0, username, err)
+			return id, d.mapAuthError(ctx, err), nil
+		}
 		token, expireAt, user, err := d.authUC.Login(ctx, username, password)
 		if err != nil {
+			d.recordLogin(ctx, biz.LoginAccountUser, 0, username, err)
 			return id, d.mapAuthError(ctx, err), nil
 		}
+		d.recordLogin(ctx, biz.LoginAccountUser, user.ID, user.Username, nil)
 
 		return id, &v1.JsonrpcResult{
 			Code:    errcode.OK.Code,
@@ -179,42 +294,64 @@ func (d *jsonrpcDispatcher) handleAuth(
 		password := getString(pm, "password")
 
 		if username == "" || password == "" {
+			d.recordLogin(ctx, biz.LoginAccountAdmin, 0, username, biz.ErrBadParam)
 			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "缺少用户名或密码"}, nil
 		}
 
+		if err := d.checkLoginThrottle(ctx, biz.LoginAccountAdmin, username); err != nil {
+			d.recordLogin(ctx, biz.LoginAccountAdmin, 0, username, err)
+			return id, d.mapAuthError(ctx, err), nil
+		}
 		token, expireAt, admin, err := d.adminAuthUC.Login(ctx, username, password)
 		if err != nil {
+			d.recordLogin(ctx, biz.LoginAccountAdmin, 0, username, err)
 			return id, d.mapAuthError(ctx, err), nil
 		}
+		d.recordLogin(ctx, biz.LoginAccountAdmin, admin.ID, admin.Username, nil)
 
 		return id, &v1.JsonrpcResult{
 			Code:    errcode.OK.Code,
 			Message: "登录成功",
 			Data: newDataStruct(map[string]any{
-				"user_id":      admin.ID,
-				"username":     admin.Username,
-				"roles":        admin.Roles,
-				"permissions":  admin.Permissions,
-				"access_token": token,
-				"expires_at":   expireAt.Unix(),
-				"token_type":   "Bearer",
-				"issued_at":    time.Now().Unix(),
+				"user_id":           admin.ID,
+				"username":          admin.Username,
+				"roles":             admin.Roles,
+				"permissions":       biz.NewPermissionSet(admin.Permissions).Expand(),
+				"permission_grants": admin.Permissions,
+				"access_token":      token,
+				"expires_at":        expireAt.Unix(),
+				"token_type":        "Bearer",
+				"issued_at":         time.Now().Unix(),
 			}),
 		}, nil
 
 	case "register":
 		username := getString(pm, "username")
 		password := getString(pm, "password")
+		inviteCode := getString(pm, "invite_code")
 
 		if username == "" || password == "" {
 			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "缺少用户名或密码"}, nil
 		}
 
-		token, expireAt, user, err := d.authUC.Register(ctx, username, password)
+		token, expireAt, user, err := d.authUC.RegisterWithInvite(ctx, username, password, inviteCode)
 		if err != nil {
 			return id, d.mapAuthError(ctx, err), nil
 		}
 
+		if token == "" {
+			// 要求验证时注册不发 token，前端引导用户走 send_verification/verify 后再登录。
+			return id, &v1.JsonrpcResult{
+				Code:    errcode.OK.Code,
+				Message: "注册成功，请完成验证后登录",
+				Data: newDataStruct(map[string]any{
+					"user_id":               user.ID,
+					"username":              user.Username,
+					"verification_required": true,
+				}),
+			}, nil
+		}
+
 		return id, &v1.JsonrpcResult{
 			Code:    errcode.OK.Code,
 			Message: "注册成功",
@@ -228,6 +365,16 @@ func (d *jsonrpcDispatcher) handleAuth(
 			}),
 		}, nil
 
+	case "register_options":
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: errcode.OK.Message,
+			Data: newDataStruct(map[string]any{
+				"mode":                  string(d.authUC.RegistrationMode()),
+				"verification_required": d.authUC.RequireVerification(),
+			}),
+		}, nil
+
 	case "logout":
 		claims, _ := biz.GetClaimsFromContext(ctx)
 		if claims != nil {
@@ -264,12 +411,17 @@ func (d *jsonrpcDispatcher) handleAuth(
 				Code:    errcode.OK.Code,
 				Message: errcode.OK.Message,
 				Data: newDataStruct(map[string]any{
-					"id":          admin.ID,
-					"username":    admin.Username,
-					"role":        int(biz.RoleAdmin),
-					"disabled":    admin.Disabled,
-					"roles":       admin.Roles,
-					"permissions": admin.Permissions,
+					"id":       admin.ID,
+					"username": admin.Username,
+					"role":     int(biz.RoleAdmin),
+					"disabled": admin.Disabled,
+					"roles":    admin.Roles,
+					// organization_id 是当前 token 所在组织（0 为不限定组织），organizations 是可进入的组织。
+					"organization_id": claims.OrganizationID,
+					"organizations":   nonNilInts(admin.Organizations),
+					// permissions 是展开后的具体权限码，前端直接 includes 判断；permission_grants 保留角色上的原始条目（含通配与拒绝）。
+					"permissions":       biz.NewPermissionSet(admin.Permissions).Expand(),
+					"permission_grants": admin.Permissions,
 				}),
 			}, nil
 		}
@@ -281,15 +433,43 @@ func (d *jsonrpcDispatcher) handleAuth(
 		}
 
 		data := map[string]any{
-			"id":         u.ID,
-			"username":   u.Username,
-			"role":       u.Role,
-			"disabled":   u.Disabled,
-			"created_at": u.CreatedAt.Unix(),
+			"id":             u.ID,
+			"username":       u.Username,
+			"role":           u.Role,
+			"disabled":       u.Disabled,
+			"created_at":     u.CreatedAt.Unix(),
+			"email":          u.Email,
+			"email_verified": u.Email != "" && u.EmailVerifiedAt != nil,
+			"phone":          u.Phone,
+			"phone_verified": u.Phone != "" && u.PhoneVerifiedAt != nil,
+			"display_name":   u.DisplayName,
+			"avatar_url":     u.AvatarURL,
+			"locale":         u.Locale,
+			"timezone":       u.Timezone,
+
+			"organization_id": claims.OrganizationID,
+			"organizations":   nonNilInts(u.OrganizationIDs),
 		}
+		roles, permissions, err := d.userRBACUC.Access(ctx, u.ID)
+		if err != nil {
+			d.log.WithContext(ctx).Warnf("auth.me load user roles failed uid=%d err=%v", u.ID, err)
+			return id, &v1.JsonrpcResult{Code: errcode.AuthCurrentUserFailed.Code, Message: errcode.AuthCurrentUserFailed.Message}, nil
+		}
+		data["roles"] = nonNilStrings(roles)
+		data["permissions"] = nonNilStrings(permissions)
 		if u.LastLoginAt != nil {
 			data["last_login_at"] = u.LastLoginAt.Unix()
 		}
+		// 已申请注销时前端据此提示并提供撤销入口。
+		if u.DeletionScheduledAt != nil {
+			data["deletion_scheduled_at"] = u.DeletionScheduledAt.Unix()
+		}
+		if claims.IsImpersonated() {
+			data["act"] = map[string]any{
+				"admin_id": claims.ActorID,
+				"username": claims.ActorUsername,
+			}
+		}
 
 		return id, &v1.JsonrpcResult{
 			Code:    errcode.OK.Code,
@@ -297,6 +477,131 @@ func (d *jsonrpcDispatcher) handleAuth(
 			Data:    newDataStruct(data),
 		}, nil
 
+	case "send_verification":
+		userID, res := d.verificationUser(ctx, pm)
+		if res != nil {
+			return id, res, nil
+		}
+
+		channel := getString(pm, "channel")
+		if channel != biz.VerificationChannelEmail && channel != biz.VerificationChannelPhone {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "channel 只能是 email 或 phone"}, nil
+		}
+
+		expireAt, err := d.verificationUC.SendVerification(ctx, userID, channel, getString(pm, "target"))
+		if err != nil {
+			return id, d.mapAuthError(ctx, err), nil
+		}
+
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "验证码已发送",
+			Data: newDataStruct(map[string]any{
+				"channel":    channel,
+				"expires_at": expireAt.Unix(),
+			}),
+		}, nil
+
+	case "verify":
+		userID, res := d.verificationUser(ctx, pm)
+		if res != nil {
+			return id, res, nil
+		}
+
+		channel := getString(pm, "channel")
+		code := getString(pm, "code")
+		if err := d.verificationUC.Verify(ctx, userID, channel, code); err != nil {
+			return id, d.mapAuthError(ctx, err), nil
+		}
+
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "验证成功",
+			Data: newDataStruct(map[string]any{
+				"channel":  channel,
+				"verified": true,
+			}),
+		}, nil
+
+	case "login_history":
+		limit := getInt(pm, "limit", 30)
+		offset := getInt(pm, "offset", 0)
+
+		list, total, err := d.loginHistoryUC.ListMine(ctx, limit, offset)
+		if err != nil {
+			if errors.Is(err, biz.ErrForbidden) {
+				return id, &v1.JsonrpcResult{Code: errcode.AuthRequired.Code, Message: errcode.AuthRequired.Message}, nil
+			}
+			d.log.WithContext(ctx).Errorf("[auth] login_history failed id=%s err=%v", id, err)
+			return id, &v1.JsonrpcResult{Code: errcode.LoginHistoryFailed.Code, Message: errcode.LoginHistoryFailed.Message}, nil
+		}
+
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: errcode.OK.Message,
+			Data:    newDataStruct(loginEventsResult(list, total, limit, offset)),
+		}, nil
+
+	case "switch_organization":
+		return d.switchOrganization(ctx, id, pm)
+
+	case "profile":
+		return d.getMyProfile(ctx, id)
+
+	case "update_profile":
+		return d.updateMyProfile(ctx, id, pm)
+
+	case "export_my_data":
+		return d.exportMyData(ctx, id)
+
+	case "delete_account":
+		return d.deleteMyAccount(ctx, id, pm)
+
+	case "cancel_delete_account":
+		return d.cancelDeleteMyAccount(ctx, id)
+
+	case "change_password":
+		// 临时密码拿不到 token，未登录时用 username + old_password 证明身份后改密。
+		claims, ok := biz.GetClaimsFromContext(ctx)
+		loggedIn := ok && claims != nil
+		username := getString(pm, "username")
+		if !loggedIn && username == "" {
+			return id, &v1.JsonrpcResult{Code: errcode.AuthRequired.Code, Message: errcode.AuthRequired.Message}, nil
+		}
+		if loggedIn && claims.Role != biz.RoleUser {
+			return id, &v1.JsonrpcResult{Code: errcode.PermissionDenied.Code, Message: errcode.PermissionDenied.Message}, nil
+		}
+
+		oldPassword := getString(pm, "old_password")
+		newPassword := getString(pm, "new_password")
+		if oldPassword == "" || newPassword == "" {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "缺少旧密码或新密码"}, nil
+		}
+
+		var userID int
+		if loggedIn {
+			userID = claims.UserID
+		} else {
+			uid, res := d.credentialUser(ctx, username, oldPassword)
+			if res != nil {
+				return id, res, nil
+			}
+			userID = uid
+		}
+
+		if err := d.authUC.ChangePassword(ctx, userID, oldPassword, newPassword); err != nil {
+			if errors.Is(err, biz.ErrBadParam) {
+				return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: errcode.InvalidParam.Message}, nil
+			}
+			return id, d.mapAuthError(ctx, err), nil
+		}
+
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "密码修改成功",
+			Data:    newDataStruct(map[string]any{"success": true}),
+		}, nil
+
 	default:
 		return id, &v1.JsonrpcResult{
 			Code:    errcode.UnknownMethod.Code,
@@ -308,7 +613,15 @@ func (d *jsonrpcDispatcher) handleAuth(
 func (d *jsonrpcDispatcher) mapAuthError(ctx context.Context, err error) *v1.JsonrpcResult {
 	logger := d.log.WithContext(ctx)
 
+	if errors.Is(err, biz.ErrInvalidCredentials) {
+		logger.Warn("[auth] invalid credentials")
+		return &v1.JsonrpcResult{Code: errcode.AuthInvalidCredentials.Code, Message: errcode.AuthInvalidCredentials.Message}
+	}
+
 	switch err {
+	case biz.ErrLoginThrottled:
+		logger.Warn("[auth] login throttled")
+		return &v1.JsonrpcResult{Code: errcode.AuthLoginThrottled.Code, Message: errcode.AuthLoginThrottled.Message}
 	case biz.ErrUserNotFound:
 		logger.Warn("[auth] user not found")
 		return &v1.JsonrpcResult{
@@ -333,6 +646,41 @@ func (d *jsonrpcDispatcher) mapAuthError(ctx context.Context, err error) *v1.Jso
 			Code:    errcode.AuthUserExists.Code,
 			Message: errcode.AuthUserExists.Message,
 		}
+	case biz.ErrRegistrationClosed:
+		logger.Warn("[auth] registration closed")
+		return &v1.JsonrpcResult{Code: errcode.AuthRegistrationClosed.Code, Message: errcode.AuthRegistrationClosed.Message}
+	case biz.ErrInviteRequired:
+		logger.Warn("[auth] invite code required")
+		return &v1.JsonrpcResult{Code: errcode.AuthInviteRequired.Code, Message: errcode.AuthInviteRequired.Message}
+	case biz.ErrInviteInvalid:
+		logger.Warn("[auth] invite code invalid")
+		return &v1.JsonrpcResult{Code: errcode.AuthInviteInvalid.Code, Message: errcode.AuthInviteInvalid.Message}
+	case biz.ErrInviteExpired:
+		logger.Warn("[auth] invite code expired")
+		return &v1.JsonrpcResult{Code: errcode.AuthInviteExpired.Code, Message: errcode.AuthInviteExpired.Message}
+	case biz.ErrInviteExhausted:
+		logger.Warn("[auth] invite code exhausted")
+		return &v1.JsonrpcResult{Code: errcode.AuthInviteExhausted.Code, Message: errcode.AuthInviteExhausted.Message}
+	case biz.ErrUserUnverified:
+		logger.Warn("[auth] user unverified")
+		return &v1.JsonrpcResult{Code: errcode.AuthUserUnverified.Code, Message: errcode.AuthUserUnverified.Message}
+	case biz.ErrPasswordChangeRequired:
+		logger.Warn("[auth] password change required")
+		return &v1.JsonrpcResult{Code: errcode.AuthPasswordChangeRequired.Code, Message: errcode.AuthPasswordChangeRequired.Message}
+	case biz.ErrVerificationTooFrequent:
+		return &v1.JsonrpcResult{Code: errcode.AuthVerificationTooFrequent.Code, Message: errcode.AuthVerificationTooFrequent.Message}
+	case biz.ErrVerificationCodeInvalid:
+		return &v1.JsonrpcResult{Code: errcode.AuthVerificationCodeInvalid.Code, Message: errcode.AuthVerificationCodeInvalid.Message}
+	case biz.ErrVerificationCodeExpired:
+		return &v1.JsonrpcResult{Code: errcode.AuthVerificationCodeExpired.Code, Message: errcode.AuthVerificationCodeExpired.Message}
+	case biz.ErrVerificationContactInvalid:
+		return &v1.JsonrpcResult{Code: errcode.AuthVerificationContactInvalid.Code, Message: errcode.AuthVerificationContactInvalid.Message}
+	case biz.ErrVerificationContactConflict:
+		return &v1.JsonrpcResult{Code: errcode.AuthVerificationContactConflict.Code, Message: errcode.AuthVerificationContactConflict.Message}
+	case biz.ErrUsernameInvalid:
+		return &v1.JsonrpcResult{Code: errcode.AuthUsernameInvalid.Code, Message: errcode.AuthUsernameInvalid.Message}
+	case biz.ErrBadParam:
+		return &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: errcode.InvalidParam.Message}
 	default:
 		logger.Errorf("[auth] internal error: %v", err)
 		return &v1.JsonrpcResult{
@@ -406,6 +754,58 @@ func getBool(m map[string]any, key string, def bool) bool {
 	return def
 }
 
+// getStringSlice 读取字符串数组参数；缺省返回 nil，元素非字符串时 ok=false。
+func getStringSlice(m map[string]any, key string) (out []string, ok bool) {
+	v, exists := m[key]
+	if !exists || v == nil {
+		return nil, true
+	}
+	arr, isArr := v.([]any)
+	if !isArr {
+		return nil, false
+	}
+	out = make([]string, 0, len(arr))
+	for _, item := range arr {
+		str, isStr := item.(string)
+		if !isStr {
+			return nil, false
+		}
+		out = append(out, str)
+	}
+	return out, true
+}
+
+// getIntSlice 读取整数数组参数；缺省返回 nil，元素不是整数时 ok=false。
+func getIntSlice(m map[string]any, key string) (out []int, ok bool) {
+	v, exists := m[key]
+	if !exists || v == nil {
+		return nil, true
+	}
+	arr, isArr := v.([]any)
+	if !isArr {
+		return nil, false
+	}
+	out = make([]int, 0, len(arr))
+	for _, item := range arr {
+		n, isNum := item.(float64)
+		if !isNum || n != float64(int(n)) {
+			return nil, false
+		}
+		out = append(out, int(n))
+	}
+	return out, true
+}
+
+// getMap 读取对象参数；缺省返回 nil，不是对象时 ok=false。
+func getMap(m map[string]any, key string) (out map[string]any, ok bool) {
+	v, exists := m[key]
+	if !exists || v == nil {
+		return nil, true
+	}
+	out, ok = v.(map[string]any)
+	return out, ok
+}
+
 func newDataStruct(m map[string]any) *structpb.Struct {
 	if m == nil {
 		return nil
@@ -448,6 +848,12 @@ func normalizeStructValue(v any) any {
 			out = append(out, item)
 		}
 		return out
+	case []any:
+		out := make([]any, 0, len(x))
+		for _, item := range x {
+			out = append(out, normalizeStructValue(item))
+		}
+		return out
 	case map[string]any:
 		out := make(map[string]any, len(x))
 		for k, item := range x {
@@ -475,55 +881,180 @@ func (d *jsonrpcDispatcher) requireLogin(ctx context.Context) (*biz.AuthClaims,
 }
 
 func (d *jsonrpcDispatcher) requireAdmin(ctx context.Context) (*biz.AuthClaims, *v1.JsonrpcResult) {
+	c, _, res := d.requireAdminAccount(ctx)
+	return c, res
+}
+
+// requireAdminAccount 校验管理员登录态并返回其状态、角色与权限快照，后续权限判断直接复用，不再回表。
+func (d *jsonrpcDispatcher) requireAdminAccount(ctx context.Context) (*biz.AuthClaims, *biz.AdminUser, *v1.JsonrpcResult) {
 	c, res := d.requireLogin(ctx)
 	if res != nil {
-		return nil, res
+		return nil, nil, res
 	}
 	if c.Role != biz.RoleAdmin {
-		return nil, &v1.JsonrpcResult{Code: errcode.AdminRequired.Code, Message: errcode.AdminRequired.Message}
+		return nil, nil, &v1.JsonrpcResult{Code: errcode.AdminRequired.Code, Message: errcode.AdminRequired.Message}
 	}
 
 	admin, err := d.getCurrentAdmin(ctx, c)
 	if err != nil {
 		switch {
 		case errors.Is(err, sql.ErrNoRows):
-			return nil, &v1.JsonrpcResult{Code: errcode.AdminRequired.Code, Message: errcode.AdminRequired.Message}
+			return nil, nil, &v1.JsonrpcResult{Code: errcode.AdminRequired.Code, Message: errcode.AdminRequired.Message}
 		case errors.Is(err, errAdminUsernameMismatch):
-			return nil, &v1.JsonrpcResult{Code: errcode.AdminRequired.Code, Message: errcode.AdminRequired.Message}
+			return nil, nil, &v1.JsonrpcResult{Code: errcode.AdminRequired.Code, Message: errcode.AdminRequired.Message}
 		default:
 			d.log.WithContext(ctx).Errorf("[auth] requireAdmin verify current admin failed err=%v", err)
-			return nil, &v1.JsonrpcResult{Code: errcode.Internal.Code, Message: errcode.Internal.Message}
+			return nil, nil, &v1.JsonrpcResult{Code: errcode.Internal.Code, Message: errcode.Internal.Message}
 		}
 	}
 	if admin.Disabled {
-		return nil, &v1.JsonrpcResult{Code: errcode.AdminDisabled.Code, Message: errcode.AdminDisabled.Message}
+		return nil, nil, &v1.JsonrpcResult{Code: errcode.AdminDisabled.Code, Message: errcode.AdminDisabled.Message}
+	}
+	// token 所在组织要么是管理员所属的组织，要么管理员持有跨组织权限；成员关系被移除后旧 token 随即失效。
+	orgID, _ := biz.TenantFromContext(ctx)
+	if err := biz.CheckAdminTenant(ctx, d.adminReader, admin, orgID); err != nil {
+		if errors.Is(err, biz.ErrOrganizationForbidden) {
+			d.log.WithContext(ctx).Warnf("[auth] organization denied admin_id=%d org_id=%d", c.UserID, orgID)
+			return nil, nil, &v1.JsonrpcResult{Code: errcode.OrganizationForbidden.Code, Message: errcode.OrganizationForbidden.Message}
+		}
+		d.log.WithContext(ctx).Errorf("[auth] requireAdmin check organization failed admin_id=%d org_id=%d err=%v", c.UserID, orgID, err)
+		return nil, nil, &v1.JsonrpcResult{Code: errcode.Internal.Code, Message: errcode.Internal.Message}
 	}
 
-	return c, nil
+	return c, admin, nil
 }
 
 func (d *jsonrpcDispatcher) requireAdminPermission(ctx context.Context, permission string) (*biz.AuthClaims, *v1.JsonrpcResult) {
-	c, res := d.requireAdmin(ctx)
+	c, admin, res := d.requireAdminAccount(ctx)
 	if res != nil {
 		return nil, res
 	}
+	if orgID, scoped := biz.TenantFromContext(ctx); scoped {
+		if call, ok := rpcCallFromContext(ctx); ok && platformOnly(call.url, call.method) {
+			d.log.WithContext(ctx).Warnf("[auth] platform-only method in organization admin_id=%d org_id=%d url=%s method=%s", c.UserID, orgID, call.url, call.method)
+			return nil, &v1.JsonrpcResult{Code: errcode.OrganizationForbidden.Code, Message: errcode.OrganizationForbidden.Message}
+		}
+	}
 	if permission == "" {
 		return c, nil
 	}
 
-	admin, err := d.getCurrentAdmin(ctx, c)
+	if !biz.NewPermissionSet(admin.Permissions).Allows(permission) {
+		d.log.WithContext(ctx).Warnf("[auth] permission denied admin_id=%d permission=%s", c.UserID, permission)
+		return nil, &v1.JsonrpcResult{Code: errcode.PermissionDenied.Code, Message: errcode.PermissionDenied.Message}
+	}
+	if res := d.checkAccessPolicy(ctx, c, admin); res != nil {
+		return nil, res
+	}
+	return c, nil
+}
+
+// checkAccessPolicy 在 RBAC 放行后执行当前接口上的访问策略；策略加载失败时按内部错误拒绝。
+func (d *jsonrpcDispatcher) checkAccessPolicy(ctx context.Context, c *biz.AuthClaims, admin *biz.AdminUser) *v1.JsonrpcResult {
+	call, ok := rpcCallFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	err := d.accessPolicyUC.Check(ctx, call.url, call.method, biz.AccessPolicyInput{
+		Claims: biz.PolicyClaims(c),
+		Admin:  admin.Attributes,
+		Params: call.params,
+	})
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, biz.ErrAccessPolicyDenied):
+		d.log.WithContext(ctx).Warnf("[auth] access policy denied admin_id=%d url=%s method=%s", c.UserID, call.url, call.method)
+		return &v1.JsonrpcResult{Code: errcode.AccessPolicyDenied.Code, Message: errcode.AccessPolicyDenied.Message}
+	default:
+		d.log.WithContext(ctx).Errorf("[auth] access policy check failed admin_id=%d url=%s method=%s err=%v", c.UserID, call.url, call.method, err)
+		return &v1.JsonrpcResult{Code: errcode.Internal.Code, Message: errcode.Internal.Message}
+	}
+}
+
+type rpcCall struct {
+	url    string
+	method string
+	params map[string]any
+}
+
+type rpcCallKey struct{}
+
+// newContextWithRPCCall 记录本次调用的 url/method/params，供访问策略读取。
+func newContextWithRPCCall(ctx context.Context, url, method string, params *structpb.Struct) context.Context {
+	pm := map[string]any{}
+	if params != nil {
+		pm = params.AsMap()
+	}
+	return context.WithValue(ctx, rpcCallKey{}, rpcCall{url: url, method: method, params: pm})
+}
+
+func rpcCallFromContext(ctx context.Context) (rpcCall, bool) {
+	call, ok := ctx.Value(rpcCallKey{}).(rpcCall)
+	return call, ok
+}
+
+// requireUserPermission 校验普通用户（含被代登录的用户）持有用户侧权限码；管理员令牌不参与用户侧权限。
+func (d *jsonrpcDispatcher) requireUserPermission(ctx context.Context, permission string) *v1.JsonrpcResult {
+	c, res := d.requireLogin(ctx)
+	if res != nil {
+		return res
+	}
+	if c.Role != biz.RoleUser {
+		return &v1.JsonrpcResult{Code: errcode.PermissionDenied.Code, Message: errcode.PermissionDenied.Message}
+	}
+
+	_, permissions, err := d.userRBACUC.Access(ctx, c.UserID)
+	if errors.Is(err, biz.ErrUserNotFound) {
+		// token 限定的组织已不再包含该用户。
+		d.log.WithContext(ctx).Warnf("[auth] user not in organization uid=%d", c.UserID)
+		return &v1.JsonrpcResult{Code: errcode.OrganizationForbidden.Code, Message: errcode.OrganizationForbidden.Message}
+	}
 	if err != nil {
-		d.log.WithContext(ctx).Errorf("[auth] requireAdminPermission load admin failed permission=%s err=%v", permission, err)
-		return nil, &v1.JsonrpcResult{Code: errcode.Internal.Code, Message: errcode.Internal.Message}
+		d.log.WithContext(ctx).Errorf("[auth] load user permissions failed uid=%d err=%v", c.UserID, err)
+		return &v1.JsonrpcResult{Code: errcode.Internal.Code, Message: errcode.Internal.Message}
+	}
+	if biz.NewPermissionSet(permissions).Allows(permission) {
+		return nil
 	}
-	for _, p := range admin.Permissions {
-		if p == permission {
-			return c, nil
+
+	d.log.WithContext(ctx).Warnf("[auth] user permission denied uid=%d permission=%s", c.UserID, permission)
+	return &v1.JsonrpcResult{Code: errcode.PermissionDenied.Code, Message: errcode.PermissionDenied.Message}
+}
+
+// verificationUser 确定验证码操作的用户：已登录用户直接用 token；
+// 要求验证时用户拿不到 token，允许用 username/password 证明身份。
+func (d *jsonrpcDispatcher) verificationUser(ctx context.Context, pm map[string]any) (int, *v1.JsonrpcResult) {
+	if c, ok := biz.GetClaimsFromContext(ctx); ok && c != nil {
+		if c.Role != biz.RoleUser {
+			return 0, &v1.JsonrpcResult{Code: errcode.PermissionDenied.Code, Message: errcode.PermissionDenied.Message}
 		}
+		return c.UserID, nil
 	}
 
-	d.log.WithContext(ctx).Warnf("[auth] permission denied admin_id=%d permission=%s", c.UserID, permission)
-	return nil, &v1.JsonrpcResult{Code: errcode.PermissionDenied.Code, Message: errcode.PermissionDenied.Message}
+	username := getString(pm, "username")
+	password := getString(pm, "password")
+	if username == "" || password == "" {
+		_, res := d.requireLogin(ctx)
+		return 0, res
+	}
+	return d.credentialUser(ctx, username, password)
+}
+
+// credentialUser 用用户名密码证明身份，不签发 token。
+func (d *jsonrpcDispatcher) credentialUser(ctx context.Context, username, password string) (int, *v1.JsonrpcResult) {
+	// 用户名密码校验与登录共用失败次数限制和登录流水，否则这里可以绕开限制无限猜密码。
+	if err := d.checkLoginThrottle(ctx, biz.LoginAccountUser, username); err != nil {
+		d.recordLogin(ctx, biz.LoginAccountUser, 0, username, err)
+		return 0, d.mapAuthError(ctx, err)
+	}
+	u, err := d.authUC.CheckCredentials(ctx, username, password)
+	if err != nil {
+		d.recordLogin(ctx, biz.LoginAccountUser, 0, username, err)
+		return 0, d.mapAuthError(ctx, err)
+	}
+	d.recordLogin(ctx, biz.LoginAccountUser, u.ID, u.Username, nil)
+	return u.ID, nil
 }
 
 var errAdminUsernameMismatch = errors.New("admin username mismatch")
@@ -554,12 +1085,65 @@ func (d *jsonrpcDispatcher) isPublic(url, method string) bool {
 	if url == "system" && (method == "ping" || method == "version") {
 		return true
 	}
-	if url == "auth" && (method == "login" || method == "admin_login" || method == "register" || method == "logout") {
+	if url == "auth" && (method == "login" || method == "admin_login" || method == "register" || method == "register_options" || method == "logout" ||
+		method == "send_verification" || method == "verify") {
 		return true
 	}
 	return false
 }
 
+// impersonationBlocked 列出模拟登录 token 不能调用的敏感方法，key 为 url.method。
+var impersonationBlocked = map[string]bool{
+	"auth.change_password":   true,
+	"auth.send_verification": true,
+	"auth.verify":            true,
+	// 资料里包含邮箱；管理员需要代改时使用有审计的 user.update_profile。
+	"auth.update_profile": true,
+	// 导出包含完整联系方式与登录流水；注销只能由用户本人决定。
+	"auth.export_my_data":        true,
+	"auth.delete_account":        true,
+	"auth.cancel_delete_account": true,
+	"user.impersonate":           true,
+	// 模拟登录 token 固定在签发时的组织，不能借切换组织扩大范围。
+	"auth.switch_organization": true,
+}
+
+// guardImpersonation 拦截模拟登录下的敏感方法，其余调用同时记录管理员和用户两个身份。
+func (d *jsonrpcDispatcher) guardImpersonation(ctx context.Context, url, method string) *v1.JsonrpcResult {
+	c, ok := biz.GetClaimsFromContext(ctx)
+	if !ok || !c.IsImpersonated() {
+		return nil
+	}
+
+	if impersonationBlocked[url+"."+method] {
+		d.log.WithContext(ctx).Warnf("[impersonation] blocked actor_admin_id=%d user_id=%d url=%s method=%s",
+			c.ActorID, c.UserID, url, method,
+		)
+		return &v1.JsonrpcResult{Code: errcode.AuthImpersonationBlocked.Code, Message: errcode.AuthImpersonationBlocked.Message}
+	}
+
+	if d.impersonationUC != nil {
+		d.impersonationUC.RecordImpersonatedCall(ctx, url, method)
+	}
+	return nil
+}
+
+var _ = registerMethodPermissions("user", map[string]string{
+	"list":              biz.PermissionUserRead,
+	"set_disabled":      biz.PermissionUserWrite,
+	"bulk_set_disabled": biz.PermissionUserWrite,
+	"export":            biz.PermissionUserExport,
+	"import":            biz.PermissionUserImport,
+	"import_job":        biz.PermissionUserImport,
+	"get_profile":       biz.PermissionUserRead,
+	"update_profile":    biz.PermissionUserWrite,
+	"delete":            biz.PermissionUserDelete,
+	"restore":           biz.PermissionUserDelete,
+	"list_deleted":      biz.PermissionUserRead,
+	"impersonate":       biz.PermissionUserImpersonate,
+	"login_events":      biz.PermissionUserRead,
+})
+
 func (d *jsonrpcDispatcher) handleUser(
 	ctx context.Context,
 	method, id string,
@@ -583,11 +1167,7 @@ func (d *jsonrpcDispatcher) handleUser(
 		method, id, opUID, opUname, opRole,
 	)
 
-	requiredPermission := map[string]string{
-		"list":         biz.PermissionUserRead,
-		"set_disabled": biz.PermissionUserWrite,
-	}[method]
-	if _, res := d.requireAdminPermission(ctx, requiredPermission); res != nil {
+	if _, res := d.requireAdminPermission(ctx, methodPermission("user", method)); res != nil {
 		l.Warnf("[user] requireAdmin denied method=%s id=%s operator_uid=%d code=%d msg=%s",
 			method, id, opUID, res.Code, res.Message,
 		)
@@ -596,51 +1176,47 @@ func (d *jsonrpcDispatcher) handleUser(
 
 	switch method {
 	case "list":
-		limit := getInt(pm, "limit", 30)
-		offset := getInt(pm, "offset", 0)
-		search := strings.TrimSpace(getString(pm, "search"))
+		q, err := parseUserListQuery(pm)
+		if err != nil {
+			l.Warnf("[user] list bad param id=%s operator_uid=%d err=%v", id, opUID, err)
+			return id, userListInvalidResult(err), nil
+		}
+		limit, offset, search := q.Limit, q.Offset, q.Filter.Search
 
-		l.Infof("[user] list start id=%s operator_uid=%d limit=%d offset=%d search=%q",
-			id, opUID, limit, offset, search,
+		l.Infof("[user] list start id=%s operator_uid=%d limit=%d offset=%d search=%q search_mode=%s sort=%s",
+			id, opUID, limit, offset, search, q.Filter.SearchMode, q.Sort.Field,
 		)
 
-		list, total, err := d.userAdminUC.List(ctx, limit, offset, search)
+		page, err := d.userAdminUC.List(ctx, q)
 		if err != nil {
+			if res := userListInvalidResult(err); res != nil {
+				l.Warnf("[user] list invalid query id=%s operator_uid=%d err=%v", id, opUID, err)
+				return id, res, nil
+			}
 			l.Errorf("[user] list failed id=%s operator_uid=%d limit=%d offset=%d search=%q err=%v",
 				id, opUID, limit, offset, search, err,
 			)
 			return id, &v1.JsonrpcResult{Code: errcode.UserListFailed.Code, Message: errcode.UserListFailed.Message}, nil
 		}
 
-		arr := make([]any, 0, len(list))
-		for _, u := range list {
-			lastLogin := int64(0)
-			if u.LastLoginAt != nil {
-				lastLogin = u.LastLoginAt.Unix()
-			}
-			arr = append(arr, map[string]any{
-				"id":            u.ID,
-				"username":      u.Username,
-				"disabled":      u.Disabled,
-				"last_login_at": lastLogin,
-				"created_at":    u.CreatedAt.Unix(),
-			})
+		arr := make([]any, 0, len(page.Users))
+		for _, u := range page.Users {
+			arr = append(arr, userListItem(u))
 		}
 
-		l.Infof("[user] list success id=%s operator_uid=%d count=%d total=%d search=%q",
-			id, opUID, len(list), total, search,
+		l.Infof("[user] list success id=%s operator_uid=%d count=%d total=%d search=%q has_next=%v",
+			id, opUID, len(page.Users), page.Total, search, page.NextCursor != "",
 		)
 
 		return id, &v1.JsonrpcResult{
 			Code:    errcode.OK.Code,
 			Message: "获取账号列表成功",
-			Data: newDataStruct(map[string]any{
-				"users":  arr,
-				"total":  total,
-				"limit":  limit,
-				"offset": offset,
-				"search": search,
-			}),
+			Data: newDataStruct(putPageResult(map[string]any{
+				"users":       arr,
+				"search":      search,
+				"search_mode": q.Filter.SearchMode,
+				"sort":        userListSortResult(q.Sort),
+			}, q.PageRequest, page.PageInfo)),
 		}, nil
 
 	case "set_disabled":
@@ -685,80 +1261,94 @@ func (d *jsonrpcDispatcher) handleUser(
 			}),
 		}, nil
 
-	default:
-		l.Warnf("[user] unknown method=%s id=%s operator_uid=%d", method, id, opUID)
-		return id, &v1.JsonrpcResult{
-			Code:    errcode.UnknownMethod.Code,
-			Message: fmt.Sprintf("未知用户接口 method=%s", method),
-		}, nil
-	}
-}
+	case "bulk_set_disabled":
+		return d.bulkSetUsersDisabled(ctx, id, pm, opUID)
 
-func (d *jsonrpcDispatcher) handleRBAC(
-	ctx context.Context,
-	method, id string,
-	_ *structpb.Struct,
-) (string, *v1.JsonrpcResult, error) {
-	l := d.log.WithContext(ctx)
+	case "export":
+		// 导出走 HTTP 流式下载，这里只登记权限码，便于挂访问策略。
+		return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "请通过 GET " + UserExportPath + " 下载导出文件"}, nil
 
-	if _, res := d.requireAdminPermission(ctx, biz.PermissionRBACRead); res != nil {
-		l.Warnf("[rbac] require permission denied method=%s id=%s code=%d msg=%s", method, id, res.Code, res.Message)
-		return id, res, nil
-	}
+	case "import":
+		return d.startUserImport(ctx, id, pm, opUID)
 
-	switch method {
-	case "overview":
-		overview, err := d.rbacUC.Overview(ctx)
+	case "import_job":
+		return d.getUserImportJob(ctx, id, pm, opUID)
+
+	case "get_profile":
+		return d.getUserProfile(ctx, id, pm)
+
+	case "update_profile":
+		return d.updateUserProfile(ctx, id, pm, opUID)
+
+	case "delete":
+		return d.deleteUser(ctx, id, pm, opUID)
+
+	case "restore":
+		return d.restoreUser(ctx, id, pm, opUID)
+
+	case "list_deleted":
+		return d.listDeletedUsers(ctx, id, pm)
+
+	case "impersonate":
+		userID := getInt(pm, "user_id", 0)
+		if userID <= 0 {
+			l.Warnf("[user] impersonate bad param id=%s operator_uid=%d user_id=%d", id, opUID, userID)
+			return id, &v1.JsonrpcResult{Code: errcode.UserInvalidParam.Code, Message: errcode.UserInvalidParam.Message}, nil
+		}
+		reason := strings.TrimSpace(getString(pm, "reason"))
+
+		l.Infof("[user] impersonate start id=%s operator_uid=%d target_uid=%d reason=%q",
+			id, opUID, userID, reason,
+		)
+
+		token, expireAt, user, err := d.impersonationUC.Impersonate(ctx, userID, reason)
 		if err != nil {
-			l.Errorf("[rbac] overview failed id=%s err=%v", id, err)
-			return id, &v1.JsonrpcResult{Code: errcode.Internal.Code, Message: errcode.Internal.Message}, nil
+			l.Warnf("[user] impersonate failed id=%s operator_uid=%d target_uid=%d err=%v",
+				id, opUID, userID, err,
+			)
+			switch {
+			case errors.Is(err, biz.ErrImpersonationNested):
+				return id, &v1.JsonrpcResult{Code: errcode.AuthImpersonationBlocked.Code, Message: errcode.AuthImpersonationBlocked.Message}, nil
+			case errors.Is(err, biz.ErrUserDisabled):
+				return id, &v1.JsonrpcResult{Code: errcode.AuthUserDisabled.Code, Message: errcode.AuthUserDisabled.Message}, nil
+			default:
+				return id, d.mapUserAdminError(ctx, err), nil
+			}
 		}
+
+		l.Infof("[user] impersonate success id=%s operator_uid=%d operator_uname=%s target_uid=%d target_uname=%s",
+			id, opUID, opUname, user.ID, user.Username,
+		)
+
 		return id, &v1.JsonrpcResult{
 			Code:    errcode.OK.Code,
-			Message: errcode.OK.Message,
+			Message: "已签发模拟登录 token",
 			Data: newDataStruct(map[string]any{
-				"roles":       rbacRoleResults(overview.Roles),
-				"permissions": rbacPermissionResults(overview.Permissions),
+				"user_id":      user.ID,
+				"username":     user.Username,
+				"access_token": token,
+				"expires_at":   expireAt.Unix(),
+				"token_type":   "Bearer",
+				"issued_at":    time.Now().Unix(),
+				"act": map[string]any{
+					"admin_id": opUID,
+					"username": opUname,
+				},
 			}),
 		}, nil
+
+	case "login_events":
+		return d.listLoginEvents(ctx, id, pm)
+
 	default:
-		l.Warnf("[rbac] unknown method=%s id=%s", method, id)
+		l.Warnf("[user] unknown method=%s id=%s operator_uid=%d", method, id, opUID)
 		return id, &v1.JsonrpcResult{
 			Code:    errcode.UnknownMethod.Code,
-			Message: fmt.Sprintf("未知权限接口 method=%s", method),
+			Message: fmt.Sprintf("未知用户接口 method=%s", method),
 		}, nil
 	}
 }
 
-func rbacRoleResults(roles []biz.RBACRoleSummary) []any {
-	out := make([]any, 0, len(roles))
-	for _, role := range roles {
-		out = append(out, map[string]any{
-			"id":          role.ID,
-			"key":         role.Key,
-			"name":        role.Name,
-			"description": role.Description,
-			"builtin":     role.Builtin,
-			"admin_count": role.AdminCount,
-		})
-	}
-	return out
-}
-
-func rbacPermissionResults(permissions []biz.RBACPermissionSummary) []any {
-	out := make([]any, 0, len(permissions))
-	for _, permission := range permissions {
-		out = append(out, map[string]any{
-			"key":         permission.Key,
-			"name":        permission.Name,
-			"group":       permission.Group,
-			"description": permission.Description,
-			"builtin":     permission.Builtin,
-		})
-	}
-	return out
-}
-
 func (d *jsonrpcDispatcher) mapUserAdminError(ctx context.Context, err error) *v1.JsonrpcResult {
 	l := d.log.WithContext(ctx)
 
@@ -0,0 +1,137 @@
+// server/internal/service/jsonrpc_impersonation_test.go
+package service
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/errcode"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type memAuditRepoForData struct {
+	mu     sync.Mutex
+	events []biz.AuditEvent
+}
+
+func (r *memAuditRepoForData) RecordAudit(ctx context.Context, e *biz.AuditEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, *e)
+	return nil
+}
+
+func (r *memAuditRepoForData) ListUserAuditEvents(ctx context.Context, userID, afterID, limit int) ([]*biz.AuditEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*biz.AuditEvent
+	for i := afterID; i < len(r.events) && len(out) < limit; i++ {
+		e := r.events[i]
+		if (e.ActorKind == biz.AuditActorUser && e.ActorID == userID) || (e.TargetKind == "user" && e.TargetID == userID) {
+			e.ID = i + 1
+			out = append(out, &e)
+		}
+	}
+	return out, nil
+}
+
+func newImpersonationDispatcherForTest(t *testing.T, adminPermissions []string) (*jsonrpcDispatcher, *memAuditRepoForData) {
+	t.Helper()
+
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+
+	users := newMemAuthRepoForData()
+	_ = users.putUser("alice", "p@ss", false)
+	admins := newMemAdminAuthRepoForData()
+	_ = admins.putAdmin("root", "rootroot", false, []string{"support"}, adminPermissions)
+
+	audit := &memAuditRepoForData{}
+	impUC := biz.NewImpersonationUsecase(users, audit, func(userID int, username string, role int8, organizationID int, actorID int, actorUsername string) (string, time.Time, error) {
+		return "imp-tok", time.Now().Add(15 * time.Minute), nil
+	}, logger, tp)
+
+	return &jsonrpcDispatcher{
+		log:             log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
+		authUC:          biz.NewAuthUsecase(users, nil, nil, logger, tp),
+		userRBACUC:      biz.NewUserRBACUsecase(newMemUserRBACRepoForData(), audit, logger, tp),
+		impersonationUC: impUC,
+		adminReader:     admins,
+	}, audit
+}
+
+func TestJsonrpcDispatcher_UserImpersonate_RequiresPermission(t *testing.T) {
+	j, audit := newImpersonationDispatcherForTest(t, []string{biz.PermissionUserRead})
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "root", Role: biz.RoleAdmin})
+	params, _ := structpb.NewStruct(map[string]any{"user_id": 1})
+
+	_, res, err := j.Handle(ctx, "user", "2.0", "impersonate", "1", params)
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if res.Code != errcode.PermissionDenied.Code {
+		t.Fatalf("expected permission denied, got %+v", res)
+	}
+	if len(audit.events) != 0 {
+		t.Fatalf("expected no audit events, got %d", len(audit.events))
+	}
+}
+
+func TestJsonrpcDispatcher_UserImpersonate_OK(t *testing.T) {
+	j, audit := newImpersonationDispatcherForTest(t, []string{biz.PermissionUserImpersonate})
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "root", Role: biz.RoleAdmin})
+	params, _ := structpb.NewStruct(map[string]any{"user_id": 1, "reason": "ticket-42"})
+
+	_, res, err := j.Handle(ctx, "user", "2.0", "impersonate", "1", params)
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if res.Code != errcode.OK.Code {
+		t.Fatalf("expected ok, got %+v", res)
+	}
+	m := res.Data.AsMap()
+	if m["access_token"] != "imp-tok" || m["username"] != "alice" {
+		t.Fatalf("unexpected data: %v", m)
+	}
+	if len(audit.events) != 1 || audit.events[0].Action != biz.AuditActionImpersonationStart {
+		t.Fatalf("expected impersonation audit event, got %+v", audit.events)
+	}
+}
+
+func TestJsonrpcDispatcher_ImpersonatedToken(t *testing.T) {
+	j, audit := newImpersonationDispatcherForTest(t, nil)
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{
+		UserID: 1, Username: "alice", Role: biz.RoleUser, ActorID: 1, ActorUsername: "root",
+	})
+
+	_, res, err := j.Handle(ctx, "auth", "2.0", "me", "1", nil)
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if res.Code != errcode.OK.Code {
+		t.Fatalf("expected ok, got %+v", res)
+	}
+	act, _ := res.Data.AsMap()["act"].(map[string]any)
+	if act == nil || act["username"] != "root" {
+		t.Fatalf("expected act in auth.me, got %v", res.Data.AsMap())
+	}
+	if len(audit.events) != 1 || audit.events[0].Action != biz.AuditActionImpersonatedCall || audit.events[0].ActorID != 1 {
+		t.Fatalf("expected impersonated call audit, got %+v", audit.events)
+	}
+
+	params, _ := structpb.NewStruct(map[string]any{"old_password": "p@ss", "new_password": "next"})
+	_, res, err = j.Handle(ctx, "auth", "2.0", "change_password", "2", params)
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if res.Code != errcode.AuthImpersonationBlocked.Code {
+		t.Fatalf("expected impersonation blocked, got %+v", res)
+	}
+}
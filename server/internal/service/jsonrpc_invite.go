@@ -0,0 +1,173 @@
+// server/internal/service/jsonrpc_invite.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/errcode"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = registerMethodPermissions("invite", map[string]string{
+	"list":   biz.PermissionInviteRead,
+	"create": biz.PermissionInviteWrite,
+	"revoke": biz.PermissionInviteWrite,
+})
+
+func (d *jsonrpcDispatcher) handleInvite(
+	ctx context.Context,
+	method, id string,
+	params *structpb.Struct,
+) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+
+	pm := map[string]any{}
+	if params != nil {
+		pm = params.AsMap()
+	}
+
+	c, res := d.requireAdminPermission(ctx, methodPermission("invite", method))
+	if res != nil {
+		l.Warnf("[invite] require permission denied method=%s id=%s code=%d msg=%s", method, id, res.Code, res.Message)
+		return id, res, nil
+	}
+
+	switch method {
+	case "list":
+		limit := getInt(pm, "limit", 30)
+		offset := getInt(pm, "offset", 0)
+		activeOnly := getBool(pm, "active_only", false)
+
+		list, total, err := d.inviteUC.List(ctx, limit, offset, activeOnly)
+		if err != nil {
+			l.Errorf("[invite] list failed id=%s operator_uid=%d err=%v", id, c.UserID, err)
+			return id, d.mapInviteError(ctx, err), nil
+		}
+
+		now := time.Now()
+		arr := make([]any, 0, len(list))
+		for _, invite := range list {
+			arr = append(arr, inviteResult(invite, now))
+		}
+
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: errcode.OK.Message,
+			Data: newDataStruct(map[string]any{
+				"invites": arr,
+				"total":   total,
+				"limit":   limit,
+				"offset":  offset,
+			}),
+		}, nil
+
+	case "create":
+		maxUses := getInt(pm, "max_uses", 1)
+		var expiresAt *time.Time
+		if ts := getInt64(pm, "expires_at", 0); ts > 0 {
+			t := time.Unix(ts, 0)
+			expiresAt = &t
+		}
+		roleKey := getString(pm, "role_key")
+		note := getString(pm, "note")
+
+		l.Infof("[invite] create start id=%s operator_uid=%d max_uses=%d role_key=%q", id, c.UserID, maxUses, roleKey)
+
+		// 预分配角色等同于直接给用户分配角色，要求与 user_rbac.assign_roles 相同的权限。
+		if strings.TrimSpace(roleKey) != "" {
+			if _, res := d.requireAdminPermission(ctx, methodPermission("user_rbac", "assign_roles")); res != nil {
+				l.Warnf("[invite] create with role denied id=%s operator_uid=%d role_key=%q code=%d", id, c.UserID, roleKey, res.Code)
+				return id, res, nil
+			}
+		}
+
+		invite, err := d.inviteUC.Create(ctx, maxUses, expiresAt, roleKey, note)
+		if err != nil {
+			l.Warnf("[invite] create failed id=%s operator_uid=%d err=%v", id, c.UserID, err)
+			return id, d.mapInviteError(ctx, err), nil
+		}
+
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "邀请码已创建",
+			Data:    newDataStruct(inviteResult(invite, time.Now())),
+		}, nil
+
+	case "revoke":
+		inviteID := getInt(pm, "invite_id", 0)
+		if inviteID <= 0 {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：invite_id 无效"}, nil
+		}
+
+		l.Infof("[invite] revoke start id=%s operator_uid=%d invite_id=%d", id, c.UserID, inviteID)
+
+		invite, err := d.inviteUC.Revoke(ctx, inviteID)
+		if err != nil {
+			l.Warnf("[invite] revoke failed id=%s operator_uid=%d invite_id=%d err=%v", id, c.UserID, inviteID, err)
+			return id, d.mapInviteError(ctx, err), nil
+		}
+
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "邀请码已撤销",
+			Data:    newDataStruct(inviteResult(invite, time.Now())),
+		}, nil
+
+	default:
+		l.Warnf("[invite] unknown method=%s id=%s", method, id)
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.UnknownMethod.Code,
+			Message: fmt.Sprintf("未知邀请码接口 method=%s", method),
+		}, nil
+	}
+}
+
+func inviteResult(invite *biz.InviteCode, now time.Time) map[string]any {
+	expiresAt := int64(0)
+	if invite.ExpiresAt != nil {
+		expiresAt = invite.ExpiresAt.Unix()
+	}
+	revokedAt := int64(0)
+	if invite.RevokedAt != nil {
+		revokedAt = invite.RevokedAt.Unix()
+	}
+	return map[string]any{
+		"id":              invite.ID,
+		"code":            invite.Code,
+		"max_uses":        invite.MaxUses,
+		"used_count":      invite.UsedCount,
+		"expires_at":      expiresAt,
+		"role_key":        invite.RoleKey,
+		"note":            invite.Note,
+		"organization_id": invite.OrganizationID,
+		"created_by":      invite.CreatedBy,
+		"revoked_at":      revokedAt,
+		"created_at":      invite.CreatedAt.Unix(),
+		"status":          invite.Status(now),
+	}
+}
+
+func (d *jsonrpcDispatcher) mapInviteError(ctx context.Context, err error) *v1.JsonrpcResult {
+	switch {
+	case errors.Is(err, biz.ErrBadParam):
+		return &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: errcode.InvalidParam.Message}
+	case errors.Is(err, biz.ErrInviteInvalid):
+		return &v1.JsonrpcResult{Code: errcode.AuthInviteInvalid.Code, Message: errcode.AuthInviteInvalid.Message}
+	case errors.Is(err, biz.ErrRoleNotFound):
+		return &v1.JsonrpcResult{Code: errcode.RBACRoleNotFound.Code, Message: errcode.RBACRoleNotFound.Message}
+	case errors.Is(err, biz.ErrRoleKeyInvalid):
+		return &v1.JsonrpcResult{Code: errcode.RBACRoleKeyInvalid.Code, Message: errcode.RBACRoleKeyInvalid.Message}
+	case errors.Is(err, biz.ErrForbidden):
+		return &v1.JsonrpcResult{Code: errcode.AdminRequired.Code, Message: errcode.AdminRequired.Message}
+	default:
+		d.log.WithContext(ctx).Errorf("[invite] internal err=%v", err)
+		return &v1.JsonrpcResult{Code: errcode.Internal.Code, Message: errcode.Internal.Message}
+	}
+}
@@ -0,0 +1,93 @@
+// server/internal/service/jsonrpc_login_history.go
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/errcode"
+)
+
+// recordLogin 在登录接口返回前写登录流水；测试里直接构造的 dispatcher 可能没有注入 usecase。
+func (d *jsonrpcDispatcher) recordLogin(ctx context.Context, accountKind string, accountID int, username string, err error) {
+	if d.loginHistoryUC == nil {
+		return
+	}
+	d.loginHistoryUC.RecordLogin(ctx, accountKind, accountID, username, err)
+}
+
+// checkLoginThrottle 在校验密码前调用，账号近期密码错误过多时返回 biz.ErrLoginThrottled。
+func (d *jsonrpcDispatcher) checkLoginThrottle(ctx context.Context, accountKind, username string) error {
+	if d.loginHistoryUC == nil {
+		return nil
+	}
+	return d.loginHistoryUC.CheckThrottle(ctx, accountKind, username)
+}
+
+// listLoginEvents 对应 user.login_events，权限已在 handleUser 入口校验。
+func (d *jsonrpcDispatcher) listLoginEvents(ctx context.Context, id string, pm map[string]any) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+
+	f := biz.LoginEventFilter{
+		AccountKind: strings.TrimSpace(getString(pm, "account_kind")),
+		AccountID:   getInt(pm, "account_id", 0),
+		Username:    strings.TrimSpace(getString(pm, "username")),
+		IP:          strings.TrimSpace(getString(pm, "ip")),
+		Limit:       getInt(pm, "limit", 30),
+		Offset:      getInt(pm, "offset", 0),
+	}
+	if v, ok := pm["success"].(bool); ok {
+		f.Success = &v
+	}
+	if ts := getInt64(pm, "since", 0); ts > 0 {
+		t := time.Unix(ts, 0)
+		f.Since = &t
+	}
+	if ts := getInt64(pm, "until", 0); ts > 0 {
+		t := time.Unix(ts, 0)
+		f.Until = &t
+	}
+
+	list, total, err := d.loginHistoryUC.List(ctx, f)
+	if err != nil {
+		if errors.Is(err, biz.ErrBadParam) {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：account_kind 只能是 user 或 admin"}, nil
+		}
+		l.Errorf("[user] login_events failed id=%s err=%v", id, err)
+		return id, &v1.JsonrpcResult{Code: errcode.LoginHistoryFailed.Code, Message: errcode.LoginHistoryFailed.Message}, nil
+	}
+
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: errcode.OK.Message,
+		Data:    newDataStruct(loginEventsResult(list, total, f.Limit, f.Offset)),
+	}, nil
+}
+
+func loginEventsResult(list []*biz.LoginEvent, total, limit, offset int) map[string]any {
+	arr := make([]any, 0, len(list))
+	for _, e := range list {
+		arr = append(arr, map[string]any{
+			"id":           e.ID,
+			"account_kind": e.AccountKind,
+			"account_id":   e.AccountID,
+			"username":     e.Username,
+			"success":      e.Success,
+			"reason":       e.Reason,
+			"ip":           e.IP,
+			"user_agent":   e.UserAgent,
+			"request_id":   e.RequestID,
+			"created_at":   e.CreatedAt.Unix(),
+		})
+	}
+	return map[string]any{
+		"events": arr,
+		"total":  total,
+		"limit":  limit,
+		"offset": offset,
+	}
+}
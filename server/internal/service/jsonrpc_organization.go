@@ -0,0 +1,222 @@
+// server/internal/service/jsonrpc_organization.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/errcode"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = registerMethodPermissions("organization", map[string]string{
+	"list":            biz.PermissionOrganizationRead,
+	"members":         biz.PermissionOrganizationRead,
+	"create":          biz.PermissionOrganizationWrite,
+	"add_member":      biz.PermissionOrganizationWrite,
+	"remove_member":   biz.PermissionOrganizationWrite,
+	"set_admin_roles": biz.PermissionOrganizationWrite,
+})
+
+// handleOrganization 管理组织与成员。限定了组织的管理员只能操作当前组织，新建组织只能在平台级进行。
+func (d *jsonrpcDispatcher) handleOrganization(
+	ctx context.Context,
+	method, id string,
+	params *structpb.Struct,
+) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+
+	pm := map[string]any{}
+	if params != nil {
+		pm = params.AsMap()
+	}
+
+	c, res := d.requireAdminPermission(ctx, methodPermission("organization", method))
+	if res != nil {
+		l.Warnf("[organization] require permission denied method=%s id=%s code=%d msg=%s", method, id, res.Code, res.Message)
+		return id, res, nil
+	}
+
+	switch method {
+	case "list":
+		list, err := d.organizationUC.List(ctx)
+		if err != nil {
+			return id, d.mapOrganizationError(ctx, err), nil
+		}
+		orgs := make([]any, 0, len(list))
+		for _, o := range list {
+			orgs = append(orgs, organizationResult(&o))
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: errcode.OK.Message,
+			Data:    newDataStruct(map[string]any{"organizations": orgs}),
+		}, nil
+
+	case "create":
+		l.Infof("[organization] create start id=%s operator_uid=%d slug=%s", id, c.UserID, getString(pm, "slug"))
+
+		org, err := d.organizationUC.Create(ctx, getString(pm, "slug"), getString(pm, "name"))
+		if err != nil {
+			return id, d.mapOrganizationError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "组织已创建",
+			Data:    newDataStruct(organizationResult(org)),
+		}, nil
+
+	case "members":
+		orgID := getInt(pm, "organization_id", 0)
+		list, err := d.organizationUC.Members(ctx, orgID)
+		if err != nil {
+			return id, d.mapOrganizationError(ctx, err), nil
+		}
+		members := make([]any, 0, len(list))
+		for _, m := range list {
+			members = append(members, map[string]any{
+				"kind":       m.Kind,
+				"member_id":  m.MemberID,
+				"username":   m.Username,
+				"roles":      nonNilStrings(m.Roles),
+				"created_at": m.CreatedAt.Unix(),
+			})
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: errcode.OK.Message,
+			Data:    newDataStruct(map[string]any{"organization_id": orgID, "members": members}),
+		}, nil
+
+	case "add_member", "remove_member":
+		orgID := getInt(pm, "organization_id", 0)
+		kind := getString(pm, "kind")
+		memberID := getInt(pm, "member_id", 0)
+
+		l.Infof("[organization] %s start id=%s operator_uid=%d org_id=%d kind=%s member_id=%d", method, id, c.UserID, orgID, kind, memberID)
+
+		var err error
+		msg := "成员已加入组织"
+		if method == "add_member" {
+			err = d.organizationUC.AddMember(ctx, orgID, kind, memberID)
+		} else {
+			err = d.organizationUC.RemoveMember(ctx, orgID, kind, memberID)
+			msg = "成员已移出组织"
+		}
+		if err != nil {
+			return id, d.mapOrganizationError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: msg,
+			Data: newDataStruct(map[string]any{
+				"organization_id": orgID,
+				"kind":            kind,
+				"member_id":       memberID,
+			}),
+		}, nil
+
+	case "set_admin_roles":
+		orgID := getInt(pm, "organization_id", 0)
+		adminID := getInt(pm, "admin_id", 0)
+		roleKeys, ok := getStringSlice(pm, "roles")
+		if !ok {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：roles 必须是字符串数组"}, nil
+		}
+
+		l.Infof("[organization] set_admin_roles start id=%s operator_uid=%d org_id=%d admin_id=%d roles=%v", id, c.UserID, orgID, adminID, roleKeys)
+
+		roles, err := d.organizationUC.SetAdminRoles(ctx, orgID, adminID, roleKeys)
+		if err != nil {
+			return id, d.mapOrganizationError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "组织内角色已更新",
+			Data: newDataStruct(map[string]any{
+				"organization_id": orgID,
+				"admin_id":        adminID,
+				"roles":           nonNilStrings(roles),
+			}),
+		}, nil
+
+	default:
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.UnknownMethod.Code,
+			Message: fmt.Sprintf("organization: 未知方法=%s", method),
+		}, nil
+	}
+}
+
+// switchOrganization 处理 auth.switch_organization：签发进入目标组织（0 为不限定组织）的新 token。
+func (d *jsonrpcDispatcher) switchOrganization(ctx context.Context, id string, pm map[string]any) (string, *v1.JsonrpcResult, error) {
+	orgID := getInt(pm, "organization_id", 0)
+	token, expireAt, err := d.organizationUC.Switch(ctx, orgID)
+	if err != nil {
+		return id, d.mapOrganizationError(ctx, err), nil
+	}
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: "已切换组织",
+		Data: newDataStruct(map[string]any{
+			"organization_id": orgID,
+			"access_token":    token,
+			"expires_at":      expireAt.Unix(),
+			"token_type":      "Bearer",
+		}),
+	}, nil
+}
+
+func (d *jsonrpcDispatcher) mapOrganizationError(ctx context.Context, err error) *v1.JsonrpcResult {
+	def := errcode.Internal
+	switch {
+	case errors.Is(err, biz.ErrBadParam):
+		def = errcode.InvalidParam
+	case errors.Is(err, biz.ErrOrganizationNotFound):
+		def = errcode.OrganizationNotFound
+	case errors.Is(err, biz.ErrOrganizationExists):
+		def = errcode.OrganizationExists
+	case errors.Is(err, biz.ErrOrganizationSlugInvalid):
+		def = errcode.OrganizationSlugInvalid
+	case errors.Is(err, biz.ErrOrganizationForbidden), errors.Is(err, biz.ErrForbidden):
+		def = errcode.OrganizationForbidden
+	case errors.Is(err, biz.ErrOrganizationLastMembership):
+		def = errcode.OrganizationLastMember
+	case errors.Is(err, biz.ErrOrganizationRoleScope):
+		def = errcode.OrganizationRoleScope
+	case errors.Is(err, biz.ErrUserNotFound):
+		def = errcode.AuthUserNotFound
+	case errors.Is(err, biz.ErrAdminNotFound):
+		def = errcode.AdminAccountNotFound
+	case errors.Is(err, biz.ErrAdminSelfLockout):
+		def = errcode.AdminSelfLockout
+	case errors.Is(err, biz.ErrAdminPrivilegeEscalation):
+		def = errcode.AdminPrivilegeEscalation
+	case errors.Is(err, biz.ErrRoleNotFound):
+		def = errcode.RBACRoleNotFound
+	default:
+		d.log.WithContext(ctx).Errorf("[organization] unexpected error err=%v", err)
+	}
+	return &v1.JsonrpcResult{Code: def.Code, Message: def.Message}
+}
+
+func organizationResult(o *biz.Organization) map[string]any {
+	return map[string]any{
+		"id":         o.ID,
+		"slug":       o.Slug,
+		"name":       o.Name,
+		"created_at": o.CreatedAt.Unix(),
+		"updated_at": o.UpdatedAt.Unix(),
+	}
+}
+
+func nonNilInts(in []int) []int {
+	if in == nil {
+		return []int{}
+	}
+	return in
+}
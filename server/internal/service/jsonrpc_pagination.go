@@ -0,0 +1,60 @@
+// server/internal/service/jsonrpc_pagination.go
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/errcode"
+)
+
+// parsePageRequest 读取列表方法通用的分页参数：limit、offset、cursor、total。
+// 传了 cursor（首页传空字符串）即切换为游标分页。
+func parsePageRequest(pm map[string]any) (biz.PageRequest, error) {
+	p := biz.PageRequest{
+		Limit:  getInt(pm, "limit", 0),
+		Offset: getInt(pm, "offset", 0),
+		Total:  biz.TotalMode(strings.TrimSpace(getString(pm, "total"))),
+	}
+	if v, exists := pm["cursor"]; exists && v != nil {
+		cursor, isStr := v.(string)
+		if !isStr {
+			return p, fmt.Errorf("%w: cursor 必须是字符串", biz.ErrPageInvalid)
+		}
+		p.UseCursor = true
+		p.Cursor = strings.TrimSpace(cursor)
+	}
+	return p, nil
+}
+
+// putPageResult 把分页结果写入返回数据：游标分页返回 next_cursor（空字符串表示没有下一页），
+// 计算了总数时返回 total，估算值额外带 total_estimated=true。
+func putPageResult(data map[string]any, p biz.PageRequest, info biz.PageInfo) map[string]any {
+	data["limit"] = p.Limit
+	if p.UseCursor {
+		data["next_cursor"] = info.NextCursor
+	} else {
+		data["offset"] = p.Offset
+	}
+	if p.Total != biz.TotalNone {
+		data["total"] = info.Total
+		data["total_estimated"] = info.TotalEstimated
+	}
+	return data
+}
+
+// pageInvalidResult 把分页参数错误映射为错误码；err 不是分页错误时返回 nil。
+func pageInvalidResult(err error) *v1.JsonrpcResult {
+	switch {
+	case errors.Is(err, biz.ErrCursorInvalid):
+		return &v1.JsonrpcResult{Code: errcode.PageCursorInvalid.Code, Message: errcode.PageCursorInvalid.Message}
+	case errors.Is(err, biz.ErrPageInvalid):
+		detail := strings.TrimPrefix(err.Error(), biz.ErrPageInvalid.Error()+": ")
+		return &v1.JsonrpcResult{Code: errcode.PageInvalid.Code, Message: errcode.PageInvalid.Message + "：" + detail}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,93 @@
+// server/internal/service/jsonrpc_permissions.go
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"server/internal/biz"
+)
+
+// methodPermissions 按 url -> method 声明后台方法要求的权限码，各业务域在自己的 handler 文件里登记。
+// 未登记的方法只要求管理员登录态；cmd/permcheck 和单测会校验这里引用的权限码都已在 biz 注册。
+var methodPermissions = map[string]map[string]string{}
+
+func registerMethodPermissions(url string, perms map[string]string) map[string]string {
+	if _, ok := methodPermissions[url]; ok {
+		panic("registerMethodPermissions: duplicate url " + url)
+	}
+	methodPermissions[url] = perms
+	return perms
+}
+
+func methodPermission(url, method string) string {
+	return methodPermissions[url][method]
+}
+
+// userMethodPermissions 按 url -> method 声明普通用户调用时要求的用户侧权限码（app.*），由 Handle 在分发前统一校验。
+// 与 methodPermissions 互不影响：同一个方法可以只声明其中一种。
+var userMethodPermissions = map[string]map[string]string{}
+
+func registerUserMethodPermissions(url string, perms map[string]string) map[string]string {
+	if _, ok := userMethodPermissions[url]; ok {
+		panic("registerUserMethodPermissions: duplicate url " + url)
+	}
+	userMethodPermissions[url] = perms
+	return perms
+}
+
+func userMethodPermission(url, method string) string {
+	return userMethodPermissions[url][method]
+}
+
+// platformOnlyMethods 列出只能在平台级（token 不限定组织）调用的后台方法，"*" 表示整个域。
+// 这些方法读写管理员、角色、访问策略和用户角色定义等全局数据，没有组织边界可言。
+var platformOnlyMethods = map[string][]string{
+	"admin":         {"*"},
+	"rbac":          {"*"},
+	"access_policy": {"*"},
+	"user_rbac":     {"create_role", "update_role", "delete_role", "set_role_permissions"},
+}
+
+func platformOnly(url, method string) bool {
+	for _, m := range platformOnlyMethods[url] {
+		if m == "*" || m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// MethodPermissions 返回全部方法权限声明的副本。
+func MethodPermissions() map[string]map[string]string {
+	out := make(map[string]map[string]string, len(methodPermissions))
+	for url, perms := range methodPermissions {
+		cp := make(map[string]string, len(perms))
+		for method, p := range perms {
+			cp[method] = p
+		}
+		out[url] = cp
+	}
+	return out
+}
+
+// UnregisteredMethodPermissions 列出引用了未注册权限码的方法，格式为 "url.method -> permission"。
+func UnregisteredMethodPermissions() []string {
+	var out []string
+	for url, perms := range methodPermissions {
+		for method, p := range perms {
+			if !biz.IsAdminPermissionRegistered(p) {
+				out = append(out, fmt.Sprintf("%s.%s -> %s", url, method, p))
+			}
+		}
+	}
+	for url, perms := range userMethodPermissions {
+		for method, p := range perms {
+			if !biz.IsUserPermissionRegistered(p) {
+				out = append(out, fmt.Sprintf("%s.%s -> %s", url, method, p))
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/errcode"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestMethodPermissionsAreRegistered(t *testing.T) {
+	if got := UnregisteredMethodPermissions(); len(got) > 0 {
+		t.Fatalf("methods reference unregistered permissions: %v", got)
+	}
+	for _, url := range []string{"user", "rbac", "user_rbac", "access_policy", "organization", "invite", "admin"} {
+		if len(MethodPermissions()[url]) == 0 {
+			t.Fatalf("expected permission declarations for url=%s", url)
+		}
+	}
+}
+
+func TestRequireAdminPermission_PlatformOnlyMethodsInOrganization(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+
+	admins := newMemAdminAuthRepoForData()
+	if err := admins.putAdmin("root", "secret", false, []string{"ops"}, []string{"admin.*", "rbac.*", "user.*", "user_role.*"}); err != nil {
+		t.Fatalf("putAdmin() error = %v", err)
+	}
+	admins.admins["root"].Organizations = []int{7}
+
+	policyUC, err := biz.NewAccessPolicyUsecase(&memAccessPolicyRepoForData{}, nil, logger, tracesdk.NewTracerProvider())
+	if err != nil {
+		t.Fatalf("NewAccessPolicyUsecase() error = %v", err)
+	}
+	j := &jsonrpcDispatcher{
+		log:            log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
+		accessPolicyUC: policyUC,
+		adminReader:    admins,
+	}
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "root", Role: biz.RoleAdmin})
+	ctx = biz.NewContextWithTenant(ctx, 7)
+
+	check := func(url, method string) int32 {
+		callCtx := newContextWithRPCCall(ctx, url, method, nil)
+		if _, res := j.requireAdminPermission(callCtx, methodPermission(url, method)); res != nil {
+			return res.Code
+		}
+		return errcode.OK.Code
+	}
+	for _, m := range [][2]string{{"admin", "list"}, {"rbac", "overview"}, {"user_rbac", "create_role"}} {
+		if got := check(m[0], m[1]); got != errcode.OrganizationForbidden.Code {
+			t.Fatalf("%s.%s in organization: code=%d, want OrganizationForbidden", m[0], m[1], got)
+		}
+	}
+	for _, m := range [][2]string{{"user", "list"}, {"user_rbac", "assign_roles"}} {
+		if got := check(m[0], m[1]); got != errcode.OK.Code {
+			t.Fatalf("%s.%s in organization: code=%d, want OK", m[0], m[1], got)
+		}
+	}
+}
+
+type stubInviteRepo struct{ created int }
+
+func (r *stubInviteRepo) CreateInvite(ctx context.Context, in *biz.InviteCode) (*biz.InviteCode, error) {
+	r.created++
+	cp := *in
+	cp.ID = r.created
+	return &cp, nil
+}
+
+func (r *stubInviteRepo) ListInvites(ctx context.Context, limit, offset int, activeOnly bool) ([]*biz.InviteCode, int, error) {
+	return nil, 0, nil
+}
+
+func (r *stubInviteRepo) RevokeInvite(ctx context.Context, id int, at time.Time) (*biz.InviteCode, error) {
+	return nil, biz.ErrInviteInvalid
+}
+
+func (r *stubInviteRepo) ListUserInviteRedemptions(ctx context.Context, userID int) ([]biz.InviteRedemption, error) {
+	return nil, nil
+}
+
+func TestInviteCreateWithRoleRequiresUserRoleWrite(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+
+	admins := newMemAdminAuthRepoForData()
+	if err := admins.putAdmin("ops", "secret", false, []string{"ops"}, []string{biz.PermissionInviteWrite}); err != nil {
+		t.Fatalf("putAdmin() error = %v", err)
+	}
+	policyUC, err := biz.NewAccessPolicyUsecase(&memAccessPolicyRepoForData{}, nil, logger, tracesdk.NewTracerProvider())
+	if err != nil {
+		t.Fatalf("NewAccessPolicyUsecase() error = %v", err)
+	}
+	repo := &stubInviteRepo{}
+	j := &jsonrpcDispatcher{
+		log:            log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
+		accessPolicyUC: policyUC,
+		adminReader:    admins,
+		inviteUC:       biz.NewInviteUsecase(repo, nil, logger, tracesdk.NewTracerProvider()),
+	}
+	op := admins.admins["ops"]
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: op.ID, Username: "ops", Role: biz.RoleAdmin})
+
+	create := func(roleKey string) int32 {
+		params, _ := structpb.NewStruct(map[string]any{"max_uses": 1, "role_key": roleKey})
+		_, res, err := j.handleInvite(newContextWithRPCCall(ctx, "invite", "create", nil), "create", "1", params)
+		if err != nil {
+			t.Fatalf("handleInvite() error = %v", err)
+		}
+		return res.Code
+	}
+
+	if got := create("vip"); got != errcode.PermissionDenied.Code || repo.created != 0 {
+		t.Fatalf("create with role_key: code=%d created=%d, want PermissionDenied", got, repo.created)
+	}
+	if got := create(""); got != errcode.OK.Code || repo.created != 1 {
+		t.Fatalf("create without role_key: code=%d created=%d, want OK", got, repo.created)
+	}
+
+	op.Permissions = append(op.Permissions, biz.PermissionUserRoleWrite)
+	if got := create("vip"); got != errcode.OK.Code || repo.created != 2 {
+		t.Fatalf("create with role_key and %s: code=%d, want OK", biz.PermissionUserRoleWrite, got)
+	}
+}
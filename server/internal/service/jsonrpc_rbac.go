@@ -0,0 +1,359 @@
+// server/internal/service/jsonrpc_rbac.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/errcode"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = registerMethodPermissions("rbac", map[string]string{
+	"overview":             biz.PermissionRBACRead,
+	"permissions_diff":     biz.PermissionRBACRead,
+	"create_role":          biz.PermissionRBACWrite,
+	"update_role":          biz.PermissionRBACWrite,
+	"delete_role":          biz.PermissionRBACWrite,
+	"set_role_permissions": biz.PermissionRBACWrite,
+	"set_role_parents":     biz.PermissionRBACWrite,
+	"export_policy":        biz.PermissionRBACRead,
+	"import_policy":        biz.PermissionRBACWrite,
+})
+
+func (d *jsonrpcDispatcher) handleRBAC(
+	ctx context.Context,
+	method, id string,
+	params *structpb.Struct,
+) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+
+	pm := map[string]any{}
+	if params != nil {
+		pm = params.AsMap()
+	}
+
+	c, res := d.requireAdminPermission(ctx, methodPermission("rbac", method))
+	if res != nil {
+		l.Warnf("[rbac] require permission denied method=%s id=%s code=%d msg=%s", method, id, res.Code, res.Message)
+		return id, res, nil
+	}
+
+	switch method {
+	case "overview":
+		overview, err := d.rbacUC.Overview(ctx)
+		if err != nil {
+			l.Errorf("[rbac] overview failed id=%s err=%v", id, err)
+			return id, &v1.JsonrpcResult{Code: errcode.Internal.Code, Message: errcode.Internal.Message}, nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: errcode.OK.Message,
+			Data: newDataStruct(map[string]any{
+				"roles":       rbacRoleResults(overview.Roles),
+				"permissions": rbacPermissionResults(overview.Permissions),
+			}),
+		}, nil
+
+	case "permissions_diff":
+		diff, err := d.rbacUC.PermissionsDiff(ctx)
+		if err != nil {
+			l.Errorf("[rbac] permissions_diff failed id=%s err=%v", id, err)
+			return id, &v1.JsonrpcResult{Code: errcode.Internal.Code, Message: errcode.Internal.Message}, nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: errcode.OK.Message,
+			Data: newDataStruct(map[string]any{
+				"in_sync":            diff.Empty(),
+				"missing":            adminPermissionResults(diff.Missing),
+				"changed":            adminPermissionResults(diff.Changed),
+				"orphaned":           rbacPermissionResults(diff.Orphaned),
+				"unregistered_usage": UnregisteredMethodPermissions(),
+			}),
+		}, nil
+
+	case "create_role":
+		permissions, ok := getStringSlice(pm, "permissions")
+		if !ok {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：permissions 必须是字符串数组"}, nil
+		}
+		key := getString(pm, "key")
+
+		l.Infof("[rbac] create_role start id=%s operator_uid=%d key=%q", id, c.UserID, key)
+
+		role, err := d.rbacUC.CreateRole(ctx, key, getString(pm, "name"), getString(pm, "description"), permissions)
+		if err != nil {
+			return id, d.mapRBACError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "角色已创建",
+			Data:    newDataStruct(rbacRoleResult(*role)),
+		}, nil
+
+	case "update_role":
+		roleID := getInt(pm, "role_id", 0)
+
+		l.Infof("[rbac] update_role start id=%s operator_uid=%d role_id=%d", id, c.UserID, roleID)
+
+		role, err := d.rbacUC.UpdateRole(ctx, roleID, getString(pm, "name"), getString(pm, "description"))
+		if err != nil {
+			return id, d.mapRBACError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "角色已更新",
+			Data:    newDataStruct(rbacRoleResult(*role)),
+		}, nil
+
+	case "delete_role":
+		roleID := getInt(pm, "role_id", 0)
+
+		l.Infof("[rbac] delete_role start id=%s operator_uid=%d role_id=%d", id, c.UserID, roleID)
+
+		if err := d.rbacUC.DeleteRole(ctx, roleID); err != nil {
+			return id, d.mapRBACError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "角色已删除",
+			Data:    newDataStruct(map[string]any{"success": true, "role_id": roleID}),
+		}, nil
+
+	case "set_role_permissions":
+		roleID := getInt(pm, "role_id", 0)
+		permissions, ok := getStringSlice(pm, "permissions")
+		if !ok || permissions == nil {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：permissions 必须是字符串数组"}, nil
+		}
+
+		l.Infof("[rbac] set_role_permissions start id=%s operator_uid=%d role_id=%d count=%d", id, c.UserID, roleID, len(permissions))
+
+		role, err := d.rbacUC.SetRolePermissions(ctx, roleID, permissions)
+		if err != nil {
+			return id, d.mapRBACError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "角色权限已更新",
+			Data:    newDataStruct(rbacRoleResult(*role)),
+		}, nil
+
+	case "set_role_parents":
+		roleID := getInt(pm, "role_id", 0)
+		parents, ok := getStringSlice(pm, "parents")
+		if !ok || parents == nil {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：parents 必须是字符串数组"}, nil
+		}
+
+		l.Infof("[rbac] set_role_parents start id=%s operator_uid=%d role_id=%d parents=%v", id, c.UserID, roleID, parents)
+
+		role, err := d.rbacUC.SetRoleParents(ctx, roleID, parents)
+		if err != nil {
+			return id, d.mapRBACError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "角色继承已更新",
+			Data:    newDataStruct(rbacRoleResult(*role)),
+		}, nil
+
+	case "export_policy":
+		policy, err := d.rbacUC.ExportPolicy(ctx)
+		if err != nil {
+			return id, d.mapRBACError(ctx, err), nil
+		}
+		raw, err := biz.MarshalRBACPolicy(policy)
+		if err != nil {
+			l.Errorf("[rbac] export_policy marshal failed id=%s err=%v", id, err)
+			return id, &v1.JsonrpcResult{Code: errcode.Internal.Code, Message: errcode.Internal.Message}, nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: errcode.OK.Message,
+			Data:    newDataStruct(map[string]any{"yaml": string(raw)}),
+		}, nil
+
+	case "import_policy":
+		// 默认只预览，显式传 dry_run=false 才落库。
+		opts := biz.RBACPolicyImportOptions{
+			DryRun: getBool(pm, "dry_run", true),
+			Prune:  getBool(pm, "prune", false),
+		}
+		policy, err := biz.ParseRBACPolicy([]byte(getString(pm, "yaml")))
+		if err != nil {
+			return id, d.mapRBACError(ctx, err), nil
+		}
+
+		l.Infof("[rbac] import_policy start id=%s operator_uid=%d dry_run=%v prune=%v roles=%d bindings=%d",
+			id, c.UserID, opts.DryRun, opts.Prune, len(policy.Roles), len(policy.Bindings))
+
+		// 文件里带管理员绑定时，真正应用还需要管理员管理权限，与 admin.assign_roles 保持一致。
+		if !opts.DryRun && len(policy.Bindings) > 0 {
+			if _, res := d.requireAdminPermission(ctx, biz.PermissionAccountWrite); res != nil {
+				return id, res, nil
+			}
+		}
+
+		plan, err := d.rbacUC.ImportPolicy(ctx, policy, opts)
+		if err != nil {
+			return id, d.mapRBACError(ctx, err), nil
+		}
+		msg := "预览完成，未做任何修改"
+		if !opts.DryRun {
+			msg = "策略已应用"
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: msg,
+			Data:    newDataStruct(rbacPolicyPlanResult(plan, opts)),
+		}, nil
+
+	default:
+		l.Warnf("[rbac] unknown method=%s id=%s", method, id)
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.UnknownMethod.Code,
+			Message: fmt.Sprintf("未知权限接口 method=%s", method),
+		}, nil
+	}
+}
+
+func (d *jsonrpcDispatcher) mapRBACError(ctx context.Context, err error) *v1.JsonrpcResult {
+	def := errcode.Internal
+	switch {
+	case errors.Is(err, biz.ErrBadParam):
+		def = errcode.InvalidParam
+	case errors.Is(err, biz.ErrRoleNotFound):
+		def = errcode.RBACRoleNotFound
+	case errors.Is(err, biz.ErrRoleExists):
+		def = errcode.RBACRoleExists
+	case errors.Is(err, biz.ErrRoleKeyInvalid):
+		def = errcode.RBACRoleKeyInvalid
+	case errors.Is(err, biz.ErrRoleBuiltin):
+		def = errcode.RBACRoleBuiltin
+	case errors.Is(err, biz.ErrPermissionUnknown):
+		def = errcode.RBACPermissionUnknown
+	case errors.Is(err, biz.ErrSuperAdminPermissions):
+		def = errcode.RBACSuperAdminPermissions
+	case errors.Is(err, biz.ErrLastSuperAdmin):
+		def = errcode.RBACLastSuperAdmin
+	case errors.Is(err, biz.ErrRoleCycle):
+		def = errcode.RBACRoleCycle
+	case errors.Is(err, biz.ErrAdminPrivilegeEscalation):
+		def = errcode.AdminPrivilegeEscalation
+	case errors.Is(err, biz.ErrForbidden):
+		def = errcode.PermissionDenied
+	case errors.Is(err, biz.ErrPolicyInvalid):
+		// 校验失败的具体原因（哪个角色、哪个权限码）直接返回，方便在 CI 或命令行里定位。
+		detail := strings.TrimPrefix(err.Error(), biz.ErrPolicyInvalid.Error()+": ")
+		return &v1.JsonrpcResult{Code: errcode.RBACPolicyInvalid.Code, Message: errcode.RBACPolicyInvalid.Message + "：" + detail}
+	default:
+		d.log.WithContext(ctx).Errorf("[rbac] unexpected error err=%v", err)
+	}
+	return &v1.JsonrpcResult{Code: def.Code, Message: def.Message}
+}
+
+func rbacRoleResult(role biz.RBACRoleSummary) map[string]any {
+	return map[string]any{
+		"id":                    role.ID,
+		"key":                   role.Key,
+		"name":                  role.Name,
+		"description":           role.Description,
+		"builtin":               role.Builtin,
+		"admin_count":           role.AdminCount,
+		"permissions":           nonNilStrings(role.Permissions),
+		"parents":               nonNilStrings(role.Parents),
+		"inherited_permissions": nonNilStrings(role.InheritedPermissions),
+	}
+}
+
+func rbacPolicyPlanResult(plan *biz.RBACPolicyPlan, opts biz.RBACPolicyImportOptions) map[string]any {
+	created := make([]any, 0, len(plan.CreateRoles))
+	for _, r := range plan.CreateRoles {
+		created = append(created, rbacPolicyRoleResult(r))
+	}
+	updated := make([]any, 0, len(plan.UpdateRoles))
+	for _, c := range plan.UpdateRoles {
+		updated = append(updated, map[string]any{
+			"key":    c.After.Key,
+			"before": rbacPolicyRoleResult(c.Before),
+			"after":  rbacPolicyRoleResult(c.After),
+		})
+	}
+	bindings := make([]any, 0, len(plan.Bindings))
+	for _, b := range plan.Bindings {
+		bindings = append(bindings, map[string]any{
+			"admin":  b.Admin,
+			"before": nonNilStrings(b.Before),
+			"after":  nonNilStrings(b.After),
+		})
+	}
+	return map[string]any{
+		"dry_run":        opts.DryRun,
+		"prune":          opts.Prune,
+		"in_sync":        plan.Empty(),
+		"create_roles":   created,
+		"update_roles":   updated,
+		"delete_roles":   nonNilStrings(plan.DeleteRoles),
+		"bindings":       bindings,
+		"unknown_admins": nonNilStrings(plan.UnknownAdmins),
+	}
+}
+
+func rbacPolicyRoleResult(r biz.RBACPolicyRole) map[string]any {
+	return map[string]any{
+		"key":         r.Key,
+		"name":        r.Name,
+		"description": r.Description,
+		"parents":     nonNilStrings(r.Parents),
+		"permissions": nonNilStrings(r.Permissions),
+	}
+}
+
+func nonNilStrings(in []string) []string {
+	if in == nil {
+		return []string{}
+	}
+	return in
+}
+
+func rbacRoleResults(roles []biz.RBACRoleSummary) []any {
+	out := make([]any, 0, len(roles))
+	for _, role := range roles {
+		out = append(out, rbacRoleResult(role))
+	}
+	return out
+}
+
+func adminPermissionResults(permissions []biz.AdminPermission) []any {
+	out := make([]any, 0, len(permissions))
+	for _, permission := range permissions {
+		out = append(out, map[string]any{
+			"key":         permission.Key,
+			"name":        permission.Name,
+			"group":       permission.Group,
+			"description": permission.Description,
+		})
+	}
+	return out
+}
+
+func rbacPermissionResults(permissions []biz.RBACPermissionSummary) []any {
+	out := make([]any, 0, len(permissions))
+	for _, permission := range permissions {
+		out = append(out, map[string]any{
+			"key":         permission.Key,
+			"name":        permission.Name,
+			"group":       permission.Group,
+			"description": permission.Description,
+			"builtin":     permission.Builtin,
+		})
+	}
+	return out
+}
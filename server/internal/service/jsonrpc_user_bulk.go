@@ -0,0 +1,86 @@
+// server/internal/service/jsonrpc_user_bulk.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/errcode"
+)
+
+// bulkSetUsersDisabled 处理 user.bulk_set_disabled：ids 与 filter（同 user.list）二选一，dry_run 只统计不修改。
+func (d *jsonrpcDispatcher) bulkSetUsersDisabled(ctx context.Context, id string, pm map[string]any, opUID int) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+
+	disabled, isBool := pm["disabled"].(bool)
+	if !isBool {
+		return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：disabled 必须是布尔值"}, nil
+	}
+	ids, ok := getIntSlice(pm, "ids")
+	if !ok {
+		return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：ids 必须是整数数组"}, nil
+	}
+	req := biz.UserBulkSetDisabledRequest{
+		IDs:      ids,
+		Disabled: disabled,
+		DryRun:   getBool(pm, "dry_run", false),
+	}
+
+	filter, ok := getMap(pm, "filter")
+	if !ok {
+		return id, userListInvalidResult(fmt.Errorf("%w: filter 必须是对象", biz.ErrUserListInvalid)), nil
+	}
+	if filter != nil {
+		req.Filter = &biz.UserListFilter{}
+		if err := parseUserListFilter(filter, req.Filter); err != nil {
+			return id, userListInvalidResult(err), nil
+		}
+	}
+
+	l.Infof("[user] bulk_set_disabled start id=%s operator_uid=%d ids=%d by_filter=%v disabled=%v dry_run=%v",
+		id, opUID, len(req.IDs), req.Filter != nil, req.Disabled, req.DryRun,
+	)
+
+	res, err := d.userAdminUC.BulkSetDisabled(ctx, req)
+	if err != nil {
+		l.Warnf("[user] bulk_set_disabled failed id=%s operator_uid=%d err=%v", id, opUID, err)
+		if r := userListInvalidResult(err); r != nil {
+			return id, r, nil
+		}
+		if errors.Is(err, biz.ErrUserBulkTooMany) {
+			return id, &v1.JsonrpcResult{
+				Code:    errcode.UserBulkTooMany.Code,
+				Message: fmt.Sprintf("%s（上限 %d）", errcode.UserBulkTooMany.Message, biz.UserBulkMaxTargets),
+			}, nil
+		}
+		return id, d.mapUserAdminError(ctx, err), nil
+	}
+
+	outcomes := make([]any, 0, len(res.Outcomes))
+	for _, o := range res.Outcomes {
+		outcomes = append(outcomes, map[string]any{"user_id": o.UserID, "status": o.Status})
+	}
+
+	l.Infof("[user] bulk_set_disabled success id=%s operator_uid=%d matched=%d changed=%d dry_run=%v",
+		id, opUID, res.Matched, res.Changed, res.DryRun,
+	)
+
+	msg := "批量操作完成"
+	if res.DryRun {
+		msg = "试运行完成，未做任何修改"
+	}
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: msg,
+		Data: newDataStruct(map[string]any{
+			"disabled": req.Disabled,
+			"dry_run":  res.DryRun,
+			"matched":  res.Matched,
+			"changed":  res.Changed,
+			"results":  outcomes,
+		}),
+	}, nil
+}
@@ -0,0 +1,96 @@
+// server/internal/service/jsonrpc_user_data.go
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/errcode"
+)
+
+// userDataExportVersion 是导出包的格式版本，字段有不兼容调整时递增。
+const userDataExportVersion = 1
+
+// exportMyData 处理 auth.export_my_data：返回各模块登记的本人数据。
+// 各节经过一次 JSON 编解码，时间为 RFC 3339 字符串，与导出包作为文件保存时一致。
+func (d *jsonrpcDispatcher) exportMyData(ctx context.Context, id string) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+
+	export, err := d.userDataUC.ExportMine(ctx)
+	if err != nil {
+		if errors.Is(err, biz.ErrForbidden) {
+			return id, &v1.JsonrpcResult{Code: errcode.PermissionDenied.Code, Message: errcode.PermissionDenied.Message}, nil
+		}
+		l.Errorf("[auth] export_my_data failed id=%s err=%v", id, err)
+		return id, &v1.JsonrpcResult{Code: errcode.UserDataExportFailed.Code, Message: errcode.UserDataExportFailed.Message}, nil
+	}
+
+	raw, err := json.Marshal(export.Sections)
+	var sections map[string]any
+	if err == nil {
+		err = json.Unmarshal(raw, &sections)
+	}
+	if err != nil {
+		l.Errorf("[auth] export_my_data encode failed id=%s user_id=%d err=%v", id, export.UserID, err)
+		return id, &v1.JsonrpcResult{Code: errcode.UserDataExportFailed.Code, Message: errcode.UserDataExportFailed.Message}, nil
+	}
+
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: errcode.OK.Message,
+		Data: newDataStruct(map[string]any{
+			"version":      userDataExportVersion,
+			"user_id":      export.UserID,
+			"generated_at": export.GeneratedAt.Unix(),
+			"sections":     sections,
+		}),
+	}, nil
+}
+
+// deleteMyAccount 处理 auth.delete_account：校验密码后进入宽限期，到期由后台任务抹除。
+func (d *jsonrpcDispatcher) deleteMyAccount(ctx context.Context, id string, pm map[string]any) (string, *v1.JsonrpcResult, error) {
+	password := getString(pm, "password")
+	if password == "" {
+		return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：请输入密码确认"}, nil
+	}
+
+	at, err := d.userDeletionUC.RequestSelfDeletion(ctx, password, getString(pm, "reason"))
+	if err != nil {
+		return id, d.mapSelfDeletionError(ctx, err), nil
+	}
+
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: "已申请注销",
+		Data: newDataStruct(map[string]any{
+			"deletion_scheduled_at": at.Unix(),
+		}),
+	}, nil
+}
+
+// cancelDeleteMyAccount 处理 auth.cancel_delete_account；没有待执行的申请时 cancelled=false。
+func (d *jsonrpcDispatcher) cancelDeleteMyAccount(ctx context.Context, id string) (string, *v1.JsonrpcResult, error) {
+	cancelled, err := d.userDeletionUC.CancelSelfDeletion(ctx)
+	if err != nil {
+		return id, d.mapSelfDeletionError(ctx, err), nil
+	}
+
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: errcode.OK.Message,
+		Data:    newDataStruct(map[string]any{"cancelled": cancelled}),
+	}, nil
+}
+
+func (d *jsonrpcDispatcher) mapSelfDeletionError(ctx context.Context, err error) *v1.JsonrpcResult {
+	switch {
+	case errors.Is(err, biz.ErrForbidden):
+		// 只有普通用户本人能注销，管理员删除用户走 user.delete。
+		return &v1.JsonrpcResult{Code: errcode.PermissionDenied.Code, Message: errcode.PermissionDenied.Message}
+	default:
+		return d.mapAuthError(ctx, err)
+	}
+}
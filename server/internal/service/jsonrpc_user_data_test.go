@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/errcode"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type memLoginEventRepoForData struct {
+	events []*biz.LoginEvent
+}
+
+func (r *memLoginEventRepoForData) RecordLoginEvent(ctx context.Context, e *biz.LoginEvent) error {
+	r.events = append(r.events, e)
+	return nil
+}
+
+func (r *memLoginEventRepoForData) ListLoginEvents(ctx context.Context, f biz.LoginEventFilter) ([]*biz.LoginEvent, int, error) {
+	var out []*biz.LoginEvent
+	for _, e := range r.events {
+		if e.AccountKind == f.AccountKind && e.AccountID == f.AccountID {
+			out = append(out, e)
+		}
+	}
+	total := len(out)
+	if f.Offset >= len(out) {
+		return nil, total, nil
+	}
+	out = out[f.Offset:]
+	if len(out) > f.Limit {
+		out = out[:f.Limit]
+	}
+	return out, total, nil
+}
+
+func (r *memLoginEventRepoForData) PurgeLoginEventsBefore(ctx context.Context, before time.Time) (int, error) {
+	return 0, nil
+}
+
+func (r *memLoginEventRepoForData) CountLoginFailures(ctx context.Context, accountKind string, accountID int, since time.Time) (int, error) {
+	return 0, nil
+}
+
+// emptyUserDataRepos 让组织、邀请码、验证码三节导出为空。
+type emptyUserDataRepos struct {
+	biz.OrganizationRepo
+	biz.InviteRepo
+	biz.VerificationRepo
+}
+
+func (emptyUserDataRepos) ListMemberOrganizations(ctx context.Context, kind string, memberID int) ([]biz.OrganizationMembership, error) {
+	return nil, nil
+}
+
+func (emptyUserDataRepos) ListUserInviteRedemptions(ctx context.Context, userID int) ([]biz.InviteRedemption, error) {
+	return nil, nil
+}
+
+func (emptyUserDataRepos) ListVerificationCodes(ctx context.Context, userID int) ([]*biz.VerificationCode, error) {
+	return nil, nil
+}
+
+func TestJsonrpcDispatcher_UserDataSelfService(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+	users := newMemAuthRepoForData()
+	_ = users.putUser("alice", "alicepw", false)
+	alice, _ := users.GetUserByUsername(context.Background(), "alice")
+	audit := &memAuditRepoForData{}
+	logins := &memLoginEventRepoForData{events: []*biz.LoginEvent{
+		{AccountKind: biz.LoginAccountUser, AccountID: alice.ID, Success: true, Reason: biz.LoginReasonOK, IP: "10.0.0.1", CreatedAt: time.Now()},
+	}}
+	deletions := &memUserDeletionRepoForData{deleted: map[int]time.Time{}, taken: map[int]bool{}, scheduled: map[int]time.Time{}}
+
+	authUC := biz.NewAuthUsecase(users, nil, nil, logger, tp)
+	j := &jsonrpcDispatcher{
+		log:    log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
+		authUC: authUC,
+		userDataUC: biz.NewUserDataUsecase(
+			authUC,
+			biz.NewUserProfileUsecase(&memUserProfileRepoForData{profiles: map[int]biz.UserProfile{
+				alice.ID: {UserID: alice.ID, Username: "alice", Timezone: "Asia/Shanghai"},
+			}}, audit, logger, tp),
+			biz.NewLoginHistoryUsecase(logins, users, nil, nil, logger, tp),
+			biz.NewUserRBACUsecase(newMemUserRBACRepoForData(), audit, logger, tp),
+			biz.NewOrganizationUsecase(emptyUserDataRepos{}, users, nil, nil, audit, nil, nil, logger, tp),
+			biz.NewInviteUsecase(emptyUserDataRepos{}, audit, logger, tp),
+			biz.NewVerificationUsecase(emptyUserDataRepos{}, users, nil, nil, nil, logger, tp),
+			audit, logger, tp,
+		),
+		userDeletionUC: biz.NewUserDeletionUsecase(deletions, users, audit,
+			&biz.AuthPolicy{AccountDeletionGrace: 24 * time.Hour}, logger, tp),
+	}
+	userCtx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: alice.ID, Username: "alice", Role: biz.RoleUser})
+
+	_, res, err := j.Handle(userCtx, "auth", "2.0", "export_my_data", "1", nil)
+	if err != nil || res.Code != errcode.OK.Code {
+		t.Fatalf("export_my_data failed: %+v err=%v", res, err)
+	}
+	sections, _ := res.Data.AsMap()["sections"].(map[string]any)
+	account, _ := sections["account"].(map[string]any)
+	if account["username"] != "alice" {
+		t.Fatalf("unexpected account section %v", account)
+	}
+	if _, err := time.Parse(time.RFC3339, account["created_at"].(string)); err != nil {
+		t.Fatalf("times should be exported as RFC 3339 strings, got %v", account["created_at"])
+	}
+	if history, _ := sections["login_history"].([]any); len(history) != 1 {
+		t.Fatalf("unexpected login_history section %v", sections["login_history"])
+	}
+
+	impersonated := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{
+		UserID: alice.ID, Username: "alice", Role: biz.RoleUser, ActorID: 9, ActorUsername: "root",
+	})
+	for _, method := range []string{"export_my_data", "delete_account", "cancel_delete_account"} {
+		_, res, _ = j.Handle(impersonated, "auth", "2.0", method, "2", nil)
+		if res.Code != errcode.AuthImpersonationBlocked.Code {
+			t.Fatalf("impersonated %s should be blocked, got %+v", method, res)
+		}
+	}
+
+	params, _ := structpb.NewStruct(map[string]any{"password": "wrong"})
+	_, res, _ = j.Handle(userCtx, "auth", "2.0", "delete_account", "3", params)
+	if res.Code != errcode.AuthInvalidPassword.Code {
+		t.Fatalf("expected AuthInvalidPassword, got %+v", res)
+	}
+
+	params, _ = structpb.NewStruct(map[string]any{"password": "alicepw", "reason": "bye"})
+	_, res, _ = j.Handle(userCtx, "auth", "2.0", "delete_account", "4", params)
+	if res.Code != errcode.OK.Code {
+		t.Fatalf("delete_account failed: %+v", res)
+	}
+	scheduledAt, _ := res.Data.AsMap()["deletion_scheduled_at"].(float64)
+	if d := time.Until(time.Unix(int64(scheduledAt), 0)); d < 23*time.Hour || d > 25*time.Hour {
+		t.Fatalf("deletion should be scheduled about 24h later, got %v", res.Data.AsMap())
+	}
+
+	_, res, _ = j.Handle(userCtx, "auth", "2.0", "cancel_delete_account", "5", nil)
+	if res.Code != errcode.OK.Code || res.Data.AsMap()["cancelled"] != true {
+		t.Fatalf("cancel_delete_account failed: %+v", res)
+	}
+}
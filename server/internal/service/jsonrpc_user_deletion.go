@@ -0,0 +1,107 @@
+// server/internal/service/jsonrpc_user_deletion.go
+package service
+
+import (
+	"context"
+	"errors"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/errcode"
+)
+
+// deleteUser 处理 user.delete：软删除，保留期内可用 user.restore 恢复。
+func (d *jsonrpcDispatcher) deleteUser(ctx context.Context, id string, pm map[string]any, opUID int) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+
+	userID := getInt(pm, "user_id", 0)
+	if userID <= 0 {
+		return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：user_id 无效"}, nil
+	}
+
+	deleted, err := d.userDeletionUC.Delete(ctx, userID, getString(pm, "reason"))
+	if err != nil {
+		l.Warnf("[user] delete failed id=%s operator_uid=%d user_id=%d err=%v", id, opUID, userID, err)
+		return id, d.mapUserAdminError(ctx, err), nil
+	}
+
+	l.Infof("[user] delete success id=%s operator_uid=%d user_id=%d", id, opUID, userID)
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: "删除成功",
+		Data:    newDataStruct(d.deletedUserData(deleted)),
+	}, nil
+}
+
+// restoreUser 处理 user.restore。
+func (d *jsonrpcDispatcher) restoreUser(ctx context.Context, id string, pm map[string]any, opUID int) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+
+	userID := getInt(pm, "user_id", 0)
+	if userID <= 0 {
+		return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：user_id 无效"}, nil
+	}
+
+	u, err := d.userDeletionUC.Restore(ctx, userID)
+	if err != nil {
+		l.Warnf("[user] restore failed id=%s operator_uid=%d user_id=%d err=%v", id, opUID, userID, err)
+		if errors.Is(err, biz.ErrUserRestoreConflict) {
+			return id, &v1.JsonrpcResult{Code: errcode.UserRestoreConflict.Code, Message: errcode.UserRestoreConflict.Message}, nil
+		}
+		return id, d.mapUserAdminError(ctx, err), nil
+	}
+
+	l.Infof("[user] restore success id=%s operator_uid=%d user_id=%d", id, opUID, userID)
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: "恢复成功",
+		Data: newDataStruct(map[string]any{
+			"user_id":  u.ID,
+			"username": u.Username,
+			"disabled": u.Disabled,
+		}),
+	}, nil
+}
+
+// listDeletedUsers 处理 user.list_deleted：回收站中尚未匿名化的用户。
+func (d *jsonrpcDispatcher) listDeletedUsers(ctx context.Context, id string, pm map[string]any) (string, *v1.JsonrpcResult, error) {
+	limit := getInt(pm, "limit", 30)
+	offset := getInt(pm, "offset", 0)
+
+	list, total, err := d.userDeletionUC.ListDeleted(ctx, limit, offset)
+	if err != nil {
+		return id, d.mapUserAdminError(ctx, err), nil
+	}
+
+	users := make([]any, 0, len(list))
+	for _, u := range list {
+		users = append(users, d.deletedUserData(u))
+	}
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: errcode.OK.Message,
+		Data: newDataStruct(map[string]any{
+			"users":  users,
+			"total":  total,
+			"limit":  limit,
+			"offset": offset,
+		}),
+	}, nil
+}
+
+// deletedUserData 的 purge_at 是预计匿名化时间，0 表示不会自动匿名化。
+func (d *jsonrpcDispatcher) deletedUserData(u *biz.DeletedUser) map[string]any {
+	purgeAt := int64(0)
+	if retention := d.userDeletionUC.Retention(); retention > 0 {
+		purgeAt = u.DeletedAt.Add(retention).Unix()
+	}
+	return map[string]any{
+		"user_id":           u.ID,
+		"username":          u.Username,
+		"username_released": u.UsernameReleased,
+		"disabled":          u.Disabled,
+		"created_at":        u.CreatedAt.Unix(),
+		"deleted_at":        u.DeletedAt.Unix(),
+		"purge_at":          purgeAt,
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/errcode"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type memUserDeletionRepoForData struct {
+	deleted   map[int]time.Time
+	taken     map[int]bool
+	scheduled map[int]time.Time
+}
+
+func (r *memUserDeletionRepoForData) SoftDeleteUser(ctx context.Context, userID int, at time.Time, release bool) (*biz.DeletedUser, error) {
+	if _, ok := r.deleted[userID]; ok {
+		return nil, biz.ErrUserNotFound
+	}
+	r.deleted[userID] = at
+	return &biz.DeletedUser{ID: userID, Username: "alice", UsernameReleased: release, DeletedAt: at}, nil
+}
+
+func (r *memUserDeletionRepoForData) RestoreUser(ctx context.Context, userID int) (*biz.User, error) {
+	if _, ok := r.deleted[userID]; !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	if r.taken[userID] {
+		return nil, biz.ErrUserRestoreConflict
+	}
+	delete(r.deleted, userID)
+	return &biz.User{ID: userID, Username: "alice"}, nil
+}
+
+func (r *memUserDeletionRepoForData) ListDeletedUsers(ctx context.Context, limit, offset int) ([]*biz.DeletedUser, int, error) {
+	out := make([]*biz.DeletedUser, 0, len(r.deleted))
+	for id, at := range r.deleted {
+		out = append(out, &biz.DeletedUser{ID: id, Username: "alice", DeletedAt: at})
+	}
+	return out, len(out), nil
+}
+
+func (r *memUserDeletionRepoForData) PurgeDeletedUsers(ctx context.Context, before time.Time, limit int) ([]int, error) {
+	return nil, nil
+}
+
+func (r *memUserDeletionRepoForData) ScheduleUserDeletion(ctx context.Context, userID int, at time.Time) (time.Time, error) {
+	if cur, ok := r.scheduled[userID]; ok {
+		return cur, nil
+	}
+	r.scheduled[userID] = at
+	return at, nil
+}
+
+func (r *memUserDeletionRepoForData) CancelUserDeletion(ctx context.Context, userID int) (bool, error) {
+	_, ok := r.scheduled[userID]
+	delete(r.scheduled, userID)
+	return ok, nil
+}
+
+func (r *memUserDeletionRepoForData) EraseScheduledUsers(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	return nil, nil
+}
+
+func TestJsonrpcDispatcher_UserDeletion(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+	admins := newMemAdminAuthRepoForData()
+	_ = admins.putAdmin("viewer", "viewerpw", false, []string{"ops"}, []string{biz.PermissionUserRead})
+	_ = admins.putAdmin("cleaner", "cleanerpw", false, []string{"ops"}, []string{biz.PermissionUserRead, biz.PermissionUserDelete})
+	audit := &memAuditRepoForData{}
+	repo := &memUserDeletionRepoForData{deleted: map[int]time.Time{}, taken: map[int]bool{}, scheduled: map[int]time.Time{}}
+
+	j := &jsonrpcDispatcher{
+		log: log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
+		userDeletionUC: biz.NewUserDeletionUsecase(repo, newMemAuthRepoForData(), audit,
+			&biz.AuthPolicy{DeletedUserRetention: 24 * time.Hour}, logger, tp),
+		adminReader: admins,
+	}
+	claimsFor := func(username string) context.Context {
+		a, _ := admins.GetAdminByUsername(context.Background(), username)
+		return biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: a.ID, Username: username, Role: biz.RoleAdmin})
+	}
+	viewer, cleaner := claimsFor("viewer"), claimsFor("cleaner")
+
+	params, _ := structpb.NewStruct(map[string]any{"user_id": 1, "reason": "spam"})
+	_, res, _ := j.Handle(viewer, "user", "2.0", "delete", "1", params)
+	if res.Code != errcode.PermissionDenied.Code {
+		t.Fatalf("delete without admin.user.delete should be denied, got %+v", res)
+	}
+
+	_, res, err := j.Handle(cleaner, "user", "2.0", "delete", "2", params)
+	if err != nil || res.Code != errcode.OK.Code {
+		t.Fatalf("delete failed: %+v err=%v", res, err)
+	}
+	data := res.Data.AsMap()
+	deletedAt, _ := data["deleted_at"].(float64)
+	purgeAt, _ := data["purge_at"].(float64)
+	if data["username"] != "alice" || purgeAt-deletedAt != (24*time.Hour).Seconds() {
+		t.Fatalf("unexpected delete data %v", data)
+	}
+
+	_, res, _ = j.Handle(viewer, "user", "2.0", "list_deleted", "3", nil)
+	if res.Code != errcode.OK.Code || res.Data.AsMap()["total"] != float64(1) {
+		t.Fatalf("list_deleted failed: %+v", res)
+	}
+
+	repo.taken[1] = true
+	params, _ = structpb.NewStruct(map[string]any{"user_id": 1})
+	_, res, _ = j.Handle(cleaner, "user", "2.0", "restore", "4", params)
+	if res.Code != errcode.UserRestoreConflict.Code {
+		t.Fatalf("expected UserRestoreConflict, got %+v", res)
+	}
+
+	repo.taken[1] = false
+	_, res, _ = j.Handle(cleaner, "user", "2.0", "restore", "5", params)
+	if res.Code != errcode.OK.Code {
+		t.Fatalf("restore failed: %+v", res)
+	}
+
+	if len(audit.events) != 2 {
+		t.Fatalf("expected delete and restore audits, got %d", len(audit.events))
+	}
+}
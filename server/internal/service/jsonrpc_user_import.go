@@ -0,0 +1,108 @@
+// server/internal/service/jsonrpc_user_import.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/errcode"
+	"server/pkg/taskgroup"
+)
+
+// startUserImport 处理 user.import：校验文件结构、登记任务后立即返回 job_id，逐行校验与建号在后台执行。
+func (d *jsonrpcDispatcher) startUserImport(ctx context.Context, id string, pm map[string]any, opUID int) (res string, out *v1.JsonrpcResult, err error) {
+	l := d.log.WithContext(ctx)
+
+	data, isStr := pm["csv"].(string)
+	if !isStr || strings.TrimSpace(data) == "" {
+		return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：csv 必须是非空字符串"}, nil
+	}
+	dryRun := getBool(pm, "dry_run", false)
+
+	job, run, err := d.userImportUC.Start(ctx, data, dryRun)
+	if err != nil {
+		if errors.Is(err, biz.ErrUserImportInvalid) {
+			detail := strings.TrimPrefix(err.Error(), biz.ErrUserImportInvalid.Error()+": ")
+			l.Infof("[user] import rejected id=%s operator_uid=%d reason=%s", id, opUID, detail)
+			return id, &v1.JsonrpcResult{Code: errcode.UserImportInvalid.Code, Message: errcode.UserImportInvalid.Message + "：" + detail}, nil
+		}
+		l.Warnf("[user] import start failed id=%s operator_uid=%d err=%v", id, opUID, err)
+		return id, d.mapUserAdminError(ctx, err), nil
+	}
+
+	// 服务正在退出时 taskgroup 会拒绝新任务（panic ErrStopped）；任务停在 pending，查询时会按中断处理。
+	defer func() {
+		if r := recover(); r != nil {
+			l.Errorf("[user] import schedule failed id=%s job_id=%d err=%v", id, job.ID, r)
+			res, out, err = id, &v1.JsonrpcResult{Code: errcode.Internal.Code, Message: errcode.Internal.Message}, nil
+		}
+	}()
+	taskCtx := taskgroup.WithOperation(ctx, "user-import")
+	taskCtx = taskgroup.WithTaskName(taskCtx, fmt.Sprintf("user-import-%d", job.ID))
+	d.runTask(taskCtx, run)
+
+	l.Infof("[user] import scheduled id=%s operator_uid=%d job_id=%d rows=%d dry_run=%v", id, opUID, job.ID, job.TotalRows, dryRun)
+
+	msg := "导入任务已创建"
+	if dryRun {
+		msg = "试运行任务已创建，不会创建任何用户"
+	}
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: msg,
+		Data:    newDataStruct(userImportJobData(job)),
+	}, nil
+}
+
+// getUserImportJob 处理 user.import_job：按 job_id 查询进度与行级错误。
+func (d *jsonrpcDispatcher) getUserImportJob(ctx context.Context, id string, pm map[string]any, opUID int) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+
+	jobID := getInt(pm, "job_id", 0)
+	if jobID <= 0 {
+		return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：job_id 无效"}, nil
+	}
+
+	job, err := d.userImportUC.Job(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, biz.ErrUserImportJobNotFound) {
+			return id, &v1.JsonrpcResult{Code: errcode.UserImportJobNotFound.Code, Message: errcode.UserImportJobNotFound.Message}, nil
+		}
+		l.Warnf("[user] import_job failed id=%s operator_uid=%d job_id=%d err=%v", id, opUID, jobID, err)
+		return id, d.mapUserAdminError(ctx, err), nil
+	}
+
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: "获取导入任务成功",
+		Data:    newDataStruct(userImportJobData(job)),
+	}, nil
+}
+
+func userImportJobData(job *biz.UserImportJob) map[string]any {
+	rowErrors := make([]any, 0, len(job.RowErrors))
+	for _, e := range job.RowErrors {
+		rowErrors = append(rowErrors, map[string]any{"row": e.Row, "username": e.Username, "message": e.Message})
+	}
+	finishedAt := int64(0)
+	if job.FinishedAt != nil {
+		finishedAt = job.FinishedAt.Unix()
+	}
+	return map[string]any{
+		"job_id":         job.ID,
+		"status":         job.Status,
+		"dry_run":        job.DryRun,
+		"total_rows":     job.TotalRows,
+		"processed_rows": job.ProcessedRows,
+		"created":        job.CreatedCount,
+		"failed":         job.FailedCount,
+		"errors":         rowErrors,
+		"error":          job.Error,
+		"created_at":     job.CreatedAt.Unix(),
+		"finished_at":    finishedAt,
+	}
+}
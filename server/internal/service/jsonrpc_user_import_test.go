@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	"server/internal/biz"
+	"server/internal/errcode"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type memUserImportRepoForData struct {
+	mu   sync.Mutex
+	jobs map[int]biz.UserImportJob
+}
+
+func (r *memUserImportRepoForData) CreateImportJob(ctx context.Context, job *biz.UserImportJob) (*biz.UserImportJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cp := *job
+	cp.ID = len(r.jobs) + 1
+	r.jobs[cp.ID] = cp
+	return &cp, nil
+}
+
+func (r *memUserImportRepoForData) UpdateImportJob(ctx context.Context, job *biz.UserImportJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs[job.ID] = *job
+	return nil
+}
+
+func (r *memUserImportRepoForData) GetImportJob(ctx context.Context, id int) (*biz.UserImportJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil, biz.ErrUserImportJobNotFound
+	}
+	return &job, nil
+}
+
+func (r *memUserImportRepoForData) TakenUsernames(ctx context.Context, normalized []string) (map[string]bool, error) {
+	return map[string]bool{}, nil
+}
+
+func TestJsonrpcDispatcher_UserImport(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+	admins := newMemAdminAuthRepoForData()
+	_ = admins.putAdmin("root", "rootroot", false, []string{"ops"}, []string{biz.PermissionUserImport})
+	users := newMemAuthRepoForData()
+
+	var scheduled []func(ctx context.Context)
+	j := &jsonrpcDispatcher{
+		log:          log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
+		authUC:       biz.NewAuthUsecase(users, nil, nil, logger, tp),
+		userImportUC: biz.NewUserImportUsecase(&memUserImportRepoForData{jobs: map[int]biz.UserImportJob{}}, users, nil, logger, tp),
+		runTask: func(ctx context.Context, run func(ctx context.Context)) {
+			scheduled = append(scheduled, run)
+		},
+		adminReader: admins,
+	}
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "root", Role: biz.RoleAdmin})
+
+	params, _ := structpb.NewStruct(map[string]any{"csv": "username,email\nalice,a@example.com\n"})
+	_, res, err := j.Handle(ctx, "user", "2.0", "import", "1", params)
+	if err != nil || res.Code != errcode.UserImportInvalid.Code || len(scheduled) != 0 {
+		t.Fatalf("expected invalid file result, got %+v err=%v", res, err)
+	}
+
+	params, _ = structpb.NewStruct(map[string]any{"csv": "username,initial_password\nalice,temp-pass-1\nbad user,temp-pass-1\n"})
+	_, res, err = j.Handle(ctx, "user", "2.0", "import", "2", params)
+	if err != nil || res.Code != errcode.OK.Code || len(scheduled) != 1 {
+		t.Fatalf("expected job scheduled, got %+v err=%v", res, err)
+	}
+	data := res.Data.AsMap()
+	if data["status"] != biz.UserImportPending || data["total_rows"] != float64(2) {
+		t.Fatalf("unexpected job data: %v", data)
+	}
+
+	scheduled[0](ctx)
+
+	params, _ = structpb.NewStruct(map[string]any{"job_id": data["job_id"]})
+	_, res, err = j.Handle(ctx, "user", "2.0", "import_job", "3", params)
+	if err != nil || res.Code != errcode.OK.Code {
+		t.Fatalf("expected job result, got %+v err=%v", res, err)
+	}
+	data = res.Data.AsMap()
+	rowErrors, _ := data["errors"].([]any)
+	if data["status"] != biz.UserImportSucceeded || data["created"] != float64(1) || data["failed"] != float64(1) || len(rowErrors) != 1 {
+		t.Fatalf("unexpected job data: %v", data)
+	}
+	if first, _ := rowErrors[0].(map[string]any); first["row"] != float64(3) {
+		t.Fatalf("unexpected row error: %v", rowErrors[0])
+	}
+
+	params, _ = structpb.NewStruct(map[string]any{"job_id": 99})
+	_, res, _ = j.Handle(ctx, "user", "2.0", "import_job", "4", params)
+	if res.Code != errcode.UserImportJobNotFound.Code {
+		t.Fatalf("expected job not found, got %+v", res)
+	}
+}
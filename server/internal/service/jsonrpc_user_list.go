@@ -0,0 +1,130 @@
+// server/internal/service/jsonrpc_user_list.go
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/errcode"
+)
+
+// parseUserListQuery 解析 user.list 的分页（见 parsePageRequest）、搜索（search 与 search_mode）、筛选（filter 对象）与排序（sort 对象）参数。
+// 类型校验之后再按 biz.UserListQuery.Validate 补全默认值，返回里回显的是实际生效的条件。
+func parseUserListQuery(pm map[string]any) (biz.UserListQuery, error) {
+	page, err := parsePageRequest(pm)
+	if err != nil {
+		return biz.UserListQuery{}, err
+	}
+	q := biz.UserListQuery{PageRequest: page}
+	q.Filter.Search = strings.TrimSpace(getString(pm, "search"))
+	q.Filter.SearchMode = strings.ToLower(strings.TrimSpace(getString(pm, "search_mode")))
+
+	filter, ok := getMap(pm, "filter")
+	if !ok {
+		return q, fmt.Errorf("%w: filter 必须是对象", biz.ErrUserListInvalid)
+	}
+	if filter != nil {
+		if err := parseUserListFilter(filter, &q.Filter); err != nil {
+			return q, err
+		}
+	}
+
+	sort, ok := getMap(pm, "sort")
+	if !ok {
+		return q, fmt.Errorf("%w: sort 必须是对象", biz.ErrUserListInvalid)
+	}
+	if sort != nil {
+		q.Sort.Field = strings.TrimSpace(getString(sort, "field"))
+		switch order := strings.ToLower(strings.TrimSpace(getString(sort, "order"))); order {
+		case "", "desc":
+			q.Sort.Desc = true
+		case "asc":
+			q.Sort.Desc = false
+		default:
+			return q, fmt.Errorf("%w: sort.order 只能是 asc 或 desc", biz.ErrUserListInvalid)
+		}
+	}
+	return q, q.Validate()
+}
+
+func userListSortResult(s biz.UserListSort) map[string]any {
+	order := "asc"
+	if s.Desc {
+		order = "desc"
+	}
+	return map[string]any{"field": s.Field, "order": order}
+}
+
+func parseUserListFilter(m map[string]any, f *biz.UserListFilter) error {
+	ids, ok := getIntSlice(m, "ids")
+	if !ok {
+		return fmt.Errorf("%w: filter.ids 必须是整数数组", biz.ErrUserListInvalid)
+	}
+	f.IDs = ids
+
+	if v, exists := m["disabled"]; exists && v != nil {
+		b, isBool := v.(bool)
+		if !isBool {
+			return fmt.Errorf("%w: filter.disabled 必须是布尔值", biz.ErrUserListInvalid)
+		}
+		f.Disabled = &b
+	}
+	if v, exists := m["never_logged_in"]; exists && v != nil {
+		b, isBool := v.(bool)
+		if !isBool {
+			return fmt.Errorf("%w: filter.never_logged_in 必须是布尔值", biz.ErrUserListInvalid)
+		}
+		f.NeverLoggedIn = b
+	}
+
+	for _, r := range []struct {
+		key string
+		dst **time.Time
+	}{
+		{"created_from", &f.CreatedFrom},
+		{"created_to", &f.CreatedTo},
+		{"last_login_from", &f.LastLoginFrom},
+		{"last_login_to", &f.LastLoginTo},
+	} {
+		t, err := getUnixTime(m, r.key)
+		if err != nil {
+			return err
+		}
+		*r.dst = t
+	}
+	return nil
+}
+
+// getUnixTime 读取 unix 秒时间戳；缺省返回 nil。
+func getUnixTime(m map[string]any, key string) (*time.Time, error) {
+	v, exists := m[key]
+	if !exists || v == nil {
+		return nil, nil
+	}
+	n, isNum := v.(float64)
+	if !isNum || n <= 0 || n != float64(int64(n)) {
+		return nil, fmt.Errorf("%w: filter.%s 必须是 unix 秒时间戳", biz.ErrUserListInvalid, key)
+	}
+	t := time.Unix(int64(n), 0)
+	return &t, nil
+}
+
+// userListInvalidResult 把具体的校验原因拼进返回信息，便于调用方定位是哪个条件写错了；
+// err 不是参数错误时返回 nil。
+func userListInvalidResult(err error) *v1.JsonrpcResult {
+	if res := pageInvalidResult(err); res != nil {
+		return res
+	}
+	if !errors.Is(err, biz.ErrUserListInvalid) {
+		return nil
+	}
+	detail := strings.TrimPrefix(err.Error(), biz.ErrUserListInvalid.Error()+": ")
+	if detail == err.Error() {
+		return &v1.JsonrpcResult{Code: errcode.UserListInvalid.Code, Message: errcode.UserListInvalid.Message}
+	}
+	return &v1.JsonrpcResult{Code: errcode.UserListInvalid.Code, Message: "参数错误：" + detail}
+}
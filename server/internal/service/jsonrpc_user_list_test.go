@@ -0,0 +1,103 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"server/internal/biz"
+	"server/internal/errcode"
+)
+
+func TestParseUserListQuery(t *testing.T) {
+	q, err := parseUserListQuery(map[string]any{
+		"limit":  float64(20),
+		"search": " al ",
+		"filter": map[string]any{
+			"ids":             []any{float64(3), float64(5)},
+			"disabled":        false,
+			"created_from":    float64(1700000000),
+			"never_logged_in": true,
+		},
+		"sort": map[string]any{"field": "last_login_at", "order": "ASC"},
+	})
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	f := q.Filter
+	if q.Limit != 20 || f.Search != "al" || len(f.IDs) != 2 || f.Disabled == nil || *f.Disabled || !f.NeverLoggedIn {
+		t.Fatalf("unexpected query: %+v", q)
+	}
+	if f.CreatedFrom == nil || f.CreatedFrom.Unix() != 1700000000 || f.CreatedTo != nil {
+		t.Fatalf("unexpected created range: %+v", f)
+	}
+	if q.Sort.Field != biz.UserSortLastLoginAt || q.Sort.Desc {
+		t.Fatalf("unexpected sort: %+v", q.Sort)
+	}
+
+	q, err = parseUserListQuery(map[string]any{})
+	if err != nil || q.Sort.Field != biz.UserSortID || !q.Sort.Desc {
+		t.Fatalf("expected default sort id desc, got %+v err=%v", q.Sort, err)
+	}
+
+	q, err = parseUserListQuery(map[string]any{"search": "alce", "search_mode": "Fuzzy"})
+	if err != nil || q.Filter.SearchMode != biz.UserSearchFuzzy || q.Sort.Field != biz.UserSortRelevance || !q.Sort.Desc {
+		t.Fatalf("expected fuzzy search sorted by relevance, got %+v err=%v", q, err)
+	}
+}
+
+func TestParseUserListQuery_Invalid(t *testing.T) {
+	cases := []map[string]any{
+		{"filter": "disabled"},
+		{"filter": map[string]any{"ids": []any{"1"}}},
+		{"filter": map[string]any{"ids": []any{float64(1.5)}}},
+		{"filter": map[string]any{"disabled": "yes"}},
+		{"filter": map[string]any{"last_login_to": "yesterday"}},
+		{"sort": map[string]any{"field": "id", "order": "up"}},
+		{"sort": map[string]any{"field": "email"}},
+		{"search": "al", "search_mode": "prefix"},
+		{"search": "al", "sort": map[string]any{"field": "relevance"}},
+		{"search": "al", "search_mode": "fuzzy", "sort": map[string]any{"field": "relevance", "order": "asc"}},
+	}
+	for i, pm := range cases {
+		_, err := parseUserListQuery(pm)
+		if !errors.Is(err, biz.ErrUserListInvalid) {
+			t.Fatalf("case %d: expected ErrUserListInvalid, got %v", i, err)
+		}
+		res := userListInvalidResult(err)
+		if res == nil || res.Code != errcode.UserListInvalid.Code {
+			t.Fatalf("case %d: expected code %d, got %+v", i, errcode.UserListInvalid.Code, res)
+		}
+	}
+}
+
+func TestParseUserListQuery_Cursor(t *testing.T) {
+	q, err := parseUserListQuery(map[string]any{"cursor": "", "limit": float64(10)})
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if !q.UseCursor || q.Total != biz.TotalNone {
+		t.Fatalf("expected cursor mode without total, got %+v", q.PageRequest)
+	}
+	data := putPageResult(map[string]any{}, q.PageRequest, biz.PageInfo{NextCursor: "next"})
+	if data["next_cursor"] != "next" || data["limit"] != 10 {
+		t.Fatalf("unexpected page result: %v", data)
+	}
+	if _, ok := data["total"]; ok {
+		t.Fatalf("expected total to be omitted, got %v", data)
+	}
+	if _, ok := data["offset"]; ok {
+		t.Fatalf("expected offset to be omitted in cursor mode, got %v", data)
+	}
+
+	for i, pm := range []map[string]any{
+		{"cursor": float64(1)},
+		{"cursor": "", "offset": float64(20)},
+		{"total": "approx"},
+	} {
+		_, err := parseUserListQuery(pm)
+		res := userListInvalidResult(err)
+		if res == nil || res.Code != errcode.PageInvalid.Code {
+			t.Fatalf("case %d: expected code %d, got %+v err=%v", i, errcode.PageInvalid.Code, res, err)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// server/internal/service/jsonrpc_user_profile.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/errcode"
+)
+
+// 资料中可以直接修改的字符串字段，参数名与返回字段名一致。
+var userProfileStringParams = []string{"display_name", "email", "avatar_url", "locale", "timezone"}
+
+// parseUserProfilePatch 只收集出现在参数里的字段；字段值为空字符串表示清空，attributes 里值为 null 表示删除。
+func parseUserProfilePatch(pm map[string]any) (*biz.UserProfilePatch, string) {
+	p := &biz.UserProfilePatch{}
+	for _, key := range userProfileStringParams {
+		v, ok := pm[key]
+		if !ok {
+			continue
+		}
+		s, isStr := v.(string)
+		if !isStr {
+			return nil, fmt.Sprintf("参数错误：%s 必须是字符串", key)
+		}
+		switch key {
+		case "display_name":
+			p.DisplayName = &s
+		case "email":
+			p.Email = &s
+		case "avatar_url":
+			p.AvatarURL = &s
+		case "locale":
+			p.Locale = &s
+		case "timezone":
+			p.Timezone = &s
+		}
+	}
+	if v, ok := pm["attributes"]; ok && v != nil {
+		attrs, isMap := v.(map[string]any)
+		if !isMap {
+			return nil, "参数错误：attributes 必须是对象"
+		}
+		p.Attributes = attrs
+	}
+	if p.Empty() {
+		return nil, "参数错误：没有需要修改的字段"
+	}
+	return p, ""
+}
+
+// getMyProfile 处理 auth.profile：返回本人资料与自定义字段定义（供前端渲染表单）。
+func (d *jsonrpcDispatcher) getMyProfile(ctx context.Context, id string) (string, *v1.JsonrpcResult, error) {
+	profile, err := d.userProfileUC.Mine(ctx)
+	if err != nil {
+		return id, d.mapUserProfileError(ctx, err, true), nil
+	}
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: errcode.OK.Message,
+		Data:    newDataStruct(userProfileData(profile, true)),
+	}, nil
+}
+
+// updateMyProfile 处理 auth.update_profile：只能修改内置字段和 self_editable 的自定义字段。
+func (d *jsonrpcDispatcher) updateMyProfile(ctx context.Context, id string, pm map[string]any) (string, *v1.JsonrpcResult, error) {
+	patch, msg := parseUserProfilePatch(pm)
+	if patch == nil {
+		return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: msg}, nil
+	}
+
+	profile, err := d.userProfileUC.UpdateMine(ctx, patch)
+	if err != nil {
+		return id, d.mapUserProfileError(ctx, err, true), nil
+	}
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: "资料已更新",
+		Data:    newDataStruct(userProfileData(profile, true)),
+	}, nil
+}
+
+// getUserProfile 处理 user.get_profile。
+func (d *jsonrpcDispatcher) getUserProfile(ctx context.Context, id string, pm map[string]any) (string, *v1.JsonrpcResult, error) {
+	userID := getInt(pm, "user_id", 0)
+	if userID <= 0 {
+		return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：user_id 无效"}, nil
+	}
+
+	profile, err := d.userProfileUC.Get(ctx, userID)
+	if err != nil {
+		return id, d.mapUserProfileError(ctx, err, false), nil
+	}
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: errcode.OK.Message,
+		Data:    newDataStruct(userProfileData(profile, false)),
+	}, nil
+}
+
+// updateUserProfile 处理 user.update_profile：管理员可以修改全部自定义字段，变更写入审计。
+func (d *jsonrpcDispatcher) updateUserProfile(ctx context.Context, id string, pm map[string]any, opUID int) (string, *v1.JsonrpcResult, error) {
+	userID := getInt(pm, "user_id", 0)
+	if userID <= 0 {
+		return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：user_id 无效"}, nil
+	}
+	patch, msg := parseUserProfilePatch(pm)
+	if patch == nil {
+		return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: msg}, nil
+	}
+
+	profile, err := d.userProfileUC.Update(ctx, userID, patch)
+	if err != nil {
+		d.log.WithContext(ctx).Infof("[user] update_profile failed id=%s operator_uid=%d user_id=%d err=%v", id, opUID, userID, err)
+		return id, d.mapUserProfileError(ctx, err, false), nil
+	}
+	return id, &v1.JsonrpcResult{
+		Code:    errcode.OK.Code,
+		Message: "资料已更新",
+		Data:    newDataStruct(userProfileData(profile, false)),
+	}, nil
+}
+
+func (d *jsonrpcDispatcher) mapUserProfileError(ctx context.Context, err error, self bool) *v1.JsonrpcResult {
+	switch {
+	case errors.Is(err, biz.ErrUserProfileInvalid):
+		detail := strings.TrimPrefix(err.Error(), biz.ErrUserProfileInvalid.Error()+": ")
+		return &v1.JsonrpcResult{Code: errcode.UserProfileInvalid.Code, Message: errcode.UserProfileInvalid.Message + "：" + detail}
+	case errors.Is(err, biz.ErrVerificationContactConflict):
+		return &v1.JsonrpcResult{Code: errcode.AuthVerificationContactConflict.Code, Message: errcode.AuthVerificationContactConflict.Message}
+	case self && errors.Is(err, biz.ErrForbidden):
+		// 本人接口只对普通用户开放，管理员 token 走 user.get_profile。
+		return &v1.JsonrpcResult{Code: errcode.PermissionDenied.Code, Message: errcode.PermissionDenied.Message}
+	default:
+		return d.mapUserAdminError(ctx, err)
+	}
+}
+
+// userProfileData 组装资料返回值；fields 是当前注册的自定义字段定义，self 时 editable 取 self_editable。
+func userProfileData(p *biz.UserProfile, self bool) map[string]any {
+	attrs := p.Attributes
+	if attrs == nil {
+		attrs = map[string]any{}
+	}
+
+	defs := biz.UserAttributes()
+	fields := make([]any, 0, len(defs))
+	for _, f := range defs {
+		fields = append(fields, map[string]any{
+			"key":         f.Key,
+			"type":        string(f.Type),
+			"label":       f.Label,
+			"description": f.Description,
+			"max_len":     f.MaxLen,
+			"options":     nonNilStrings(f.Options),
+			"editable":    !self || f.SelfEditable,
+		})
+	}
+
+	return map[string]any{
+		"user_id":        p.UserID,
+		"username":       p.Username,
+		"display_name":   p.DisplayName,
+		"email":          p.Email,
+		"email_verified": p.EmailVerified,
+		"avatar_url":     p.AvatarURL,
+		"locale":         p.Locale,
+		"timezone":       p.Timezone,
+		"attributes":     attrs,
+		"fields":         fields,
+		"updated_at":     p.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"server/internal/biz"
+	"server/internal/errcode"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type memUserProfileRepoForData struct {
+	profiles map[int]biz.UserProfile
+}
+
+func (r *memUserProfileRepoForData) GetUserProfile(ctx context.Context, userID int) (*biz.UserProfile, error) {
+	p, ok := r.profiles[userID]
+	if !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	p.Attributes = (&biz.UserProfilePatch{}).MergeAttributes(p.Attributes)
+	return &p, nil
+}
+
+func (r *memUserProfileRepoForData) UpdateUserProfile(ctx context.Context, userID int, patch *biz.UserProfilePatch) (*biz.UserProfile, error) {
+	p, ok := r.profiles[userID]
+	if !ok {
+		return nil, biz.ErrUserNotFound
+	}
+	if patch.DisplayName != nil {
+		p.DisplayName = *patch.DisplayName
+	}
+	if patch.Timezone != nil {
+		p.Timezone = *patch.Timezone
+	}
+	p.Attributes = patch.MergeAttributes(p.Attributes)
+	p.UpdatedAt = time.Now()
+	r.profiles[userID] = p
+	return r.GetUserProfile(ctx, userID)
+}
+
+func TestJsonrpcDispatcher_UserProfile(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+	admins := newMemAdminAuthRepoForData()
+	_ = admins.putAdmin("viewer", "viewerpw", false, []string{"ops"}, []string{biz.PermissionUserRead})
+	audit := &memAuditRepoForData{}
+
+	j := &jsonrpcDispatcher{
+		log: log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
+		userProfileUC: biz.NewUserProfileUsecase(&memUserProfileRepoForData{profiles: map[int]biz.UserProfile{
+			1: {UserID: 1, Username: "alice"},
+		}}, audit, logger, tp),
+		adminReader: admins,
+	}
+	userCtx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "alice", Role: biz.RoleUser})
+
+	params, _ := structpb.NewStruct(map[string]any{"display_name": " Alice ", "timezone": "Asia/Shanghai"})
+	_, res, err := j.Handle(userCtx, "auth", "2.0", "update_profile", "1", params)
+	if err != nil || res.Code != errcode.OK.Code {
+		t.Fatalf("update_profile failed: %+v err=%v", res, err)
+	}
+	data := res.Data.AsMap()
+	if data["display_name"] != "Alice" || data["timezone"] != "Asia/Shanghai" {
+		t.Fatalf("unexpected profile data %v", data)
+	}
+	if _, ok := data["fields"].([]any); !ok {
+		t.Fatalf("profile should list attribute fields, got %v", data["fields"])
+	}
+
+	params, _ = structpb.NewStruct(map[string]any{"timezone": "Mars/Olympus"})
+	_, res, _ = j.Handle(userCtx, "auth", "2.0", "update_profile", "2", params)
+	if res.Code != errcode.UserProfileInvalid.Code {
+		t.Fatalf("expected UserProfileInvalid, got %+v", res)
+	}
+
+	params, _ = structpb.NewStruct(map[string]any{"display_name": 1})
+	_, res, _ = j.Handle(userCtx, "auth", "2.0", "update_profile", "3", params)
+	if res.Code != errcode.InvalidParam.Code {
+		t.Fatalf("expected InvalidParam for non-string field, got %+v", res)
+	}
+
+	impersonated := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{
+		UserID: 1, Username: "alice", Role: biz.RoleUser, ActorID: 9, ActorUsername: "root",
+	})
+	params, _ = structpb.NewStruct(map[string]any{"display_name": "Mallory"})
+	_, res, _ = j.Handle(impersonated, "auth", "2.0", "update_profile", "4", params)
+	if res.Code != errcode.AuthImpersonationBlocked.Code {
+		t.Fatalf("impersonated update_profile should be blocked, got %+v", res)
+	}
+
+	viewer, _ := admins.GetAdminByUsername(context.Background(), "viewer")
+	adminCtx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: viewer.ID, Username: "viewer", Role: biz.RoleAdmin})
+
+	params, _ = structpb.NewStruct(map[string]any{"user_id": 1})
+	_, res, _ = j.Handle(adminCtx, "user", "2.0", "get_profile", "5", params)
+	if res.Code != errcode.OK.Code || res.Data.AsMap()["display_name"] != "Alice" {
+		t.Fatalf("get_profile failed: %+v", res)
+	}
+
+	params, _ = structpb.NewStruct(map[string]any{"user_id": 1, "display_name": "Bob"})
+	_, res, _ = j.Handle(adminCtx, "user", "2.0", "update_profile", "6", params)
+	if res.Code != errcode.PermissionDenied.Code {
+		t.Fatalf("update_profile without admin.user.write should be denied, got %+v", res)
+	}
+	if len(audit.events) != 0 {
+		t.Fatalf("denied update should not be audited, got %d events", len(audit.events))
+	}
+}
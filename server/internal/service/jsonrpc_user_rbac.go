@@ -0,0 +1,210 @@
+// server/internal/service/jsonrpc_user_rbac.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/errcode"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = registerMethodPermissions("user_rbac", map[string]string{
+	"overview":             biz.PermissionUserRoleRead,
+	"user_roles":           biz.PermissionUserRoleRead,
+	"create_role":          biz.PermissionUserRoleWrite,
+	"update_role":          biz.PermissionUserRoleWrite,
+	"delete_role":          biz.PermissionUserRoleWrite,
+	"set_role_permissions": biz.PermissionUserRoleWrite,
+	"assign_roles":         biz.PermissionUserRoleWrite,
+})
+
+// handleUserRBAC 是管理员维护普通用户角色的入口；用户自己的角色与权限通过 auth.me 返回。
+func (d *jsonrpcDispatcher) handleUserRBAC(
+	ctx context.Context,
+	method, id string,
+	params *structpb.Struct,
+) (string, *v1.JsonrpcResult, error) {
+	l := d.log.WithContext(ctx)
+
+	pm := map[string]any{}
+	if params != nil {
+		pm = params.AsMap()
+	}
+
+	c, res := d.requireAdminPermission(ctx, methodPermission("user_rbac", method))
+	if res != nil {
+		l.Warnf("[user_rbac] require permission denied method=%s id=%s code=%d msg=%s", method, id, res.Code, res.Message)
+		return id, res, nil
+	}
+
+	switch method {
+	case "overview":
+		overview, err := d.userRBACUC.Overview(ctx)
+		if err != nil {
+			l.Errorf("[user_rbac] overview failed id=%s err=%v", id, err)
+			return id, &v1.JsonrpcResult{Code: errcode.Internal.Code, Message: errcode.Internal.Message}, nil
+		}
+		roles := make([]any, 0, len(overview.Roles))
+		for _, role := range overview.Roles {
+			roles = append(roles, userRoleResult(role))
+		}
+		permissions := make([]any, 0, len(overview.Permissions))
+		for _, p := range overview.Permissions {
+			permissions = append(permissions, map[string]any{
+				"key":         p.Key,
+				"name":        p.Name,
+				"group":       p.Group,
+				"description": p.Description,
+			})
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: errcode.OK.Message,
+			Data: newDataStruct(map[string]any{
+				"roles":       roles,
+				"permissions": permissions,
+			}),
+		}, nil
+
+	case "user_roles":
+		userID := getInt(pm, "user_id", 0)
+		if userID <= 0 {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: errcode.InvalidParam.Message}, nil
+		}
+		roles, permissions, err := d.userRBACUC.Access(ctx, userID)
+		if errors.Is(err, biz.ErrUserNotFound) {
+			return id, &v1.JsonrpcResult{Code: errcode.AuthUserNotFound.Code, Message: errcode.AuthUserNotFound.Message}, nil
+		}
+		if err != nil {
+			l.Errorf("[user_rbac] user_roles failed id=%s user_id=%d err=%v", id, userID, err)
+			return id, &v1.JsonrpcResult{Code: errcode.Internal.Code, Message: errcode.Internal.Message}, nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: errcode.OK.Message,
+			Data: newDataStruct(map[string]any{
+				"user_id":     userID,
+				"roles":       nonNilStrings(roles),
+				"permissions": nonNilStrings(permissions),
+			}),
+		}, nil
+
+	case "create_role":
+		permissions, ok := getStringSlice(pm, "permissions")
+		if !ok {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：permissions 必须是字符串数组"}, nil
+		}
+		key := getString(pm, "key")
+
+		l.Infof("[user_rbac] create_role start id=%s operator_uid=%d key=%q", id, c.UserID, key)
+
+		role, err := d.userRBACUC.CreateRole(ctx, key, getString(pm, "name"), getString(pm, "description"), permissions)
+		if err != nil {
+			return id, d.mapUserRBACError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "角色已创建",
+			Data:    newDataStruct(userRoleResult(*role)),
+		}, nil
+
+	case "update_role":
+		roleID := getInt(pm, "role_id", 0)
+
+		l.Infof("[user_rbac] update_role start id=%s operator_uid=%d role_id=%d", id, c.UserID, roleID)
+
+		role, err := d.userRBACUC.UpdateRole(ctx, roleID, getString(pm, "name"), getString(pm, "description"))
+		if err != nil {
+			return id, d.mapUserRBACError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "角色已更新",
+			Data:    newDataStruct(userRoleResult(*role)),
+		}, nil
+
+	case "delete_role":
+		roleID := getInt(pm, "role_id", 0)
+
+		l.Infof("[user_rbac] delete_role start id=%s operator_uid=%d role_id=%d", id, c.UserID, roleID)
+
+		if err := d.userRBACUC.DeleteRole(ctx, roleID); err != nil {
+			return id, d.mapUserRBACError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "角色已删除",
+			Data:    newDataStruct(map[string]any{"role_id": roleID}),
+		}, nil
+
+	case "set_role_permissions":
+		roleID := getInt(pm, "role_id", 0)
+		permissions, ok := getStringSlice(pm, "permissions")
+		if !ok {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：permissions 必须是字符串数组"}, nil
+		}
+
+		l.Infof("[user_rbac] set_role_permissions start id=%s operator_uid=%d role_id=%d permissions=%d", id, c.UserID, roleID, len(permissions))
+
+		role, err := d.userRBACUC.SetRolePermissions(ctx, roleID, permissions)
+		if err != nil {
+			return id, d.mapUserRBACError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "角色权限已更新",
+			Data:    newDataStruct(userRoleResult(*role)),
+		}, nil
+
+	case "assign_roles":
+		userID := getInt(pm, "user_id", 0)
+		roles, ok := getStringSlice(pm, "roles")
+		if !ok {
+			return id, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：roles 必须是字符串数组"}, nil
+		}
+
+		l.Infof("[user_rbac] assign_roles start id=%s operator_uid=%d user_id=%d roles=%v", id, c.UserID, userID, roles)
+
+		after, err := d.userRBACUC.AssignRoles(ctx, userID, roles)
+		if err != nil {
+			return id, d.mapUserRBACError(ctx, err), nil
+		}
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.OK.Code,
+			Message: "用户角色已更新",
+			Data: newDataStruct(map[string]any{
+				"user_id": userID,
+				"roles":   nonNilStrings(after),
+			}),
+		}, nil
+
+	default:
+		return id, &v1.JsonrpcResult{
+			Code:    errcode.UnknownMethod.Code,
+			Message: fmt.Sprintf("user_rbac: 未知方法=%s", method),
+		}, nil
+	}
+}
+
+func (d *jsonrpcDispatcher) mapUserRBACError(ctx context.Context, err error) *v1.JsonrpcResult {
+	if errors.Is(err, biz.ErrUserNotFound) {
+		return &v1.JsonrpcResult{Code: errcode.AuthUserNotFound.Code, Message: errcode.AuthUserNotFound.Message}
+	}
+	return d.mapRBACError(ctx, err)
+}
+
+func userRoleResult(role biz.UserRoleSummary) map[string]any {
+	return map[string]any{
+		"id":          role.ID,
+		"key":         role.Key,
+		"name":        role.Name,
+		"description": role.Description,
+		"user_count":  role.UserCount,
+		"permissions": nonNilStrings(role.Permissions),
+	}
+}
@@ -0,0 +1,96 @@
+// server/internal/service/jsonrpc_user_rbac_test.go
+package service
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"server/internal/biz"
+	"server/internal/errcode"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type memUserRBACRepoForData struct {
+	biz.UserRBACRepo
+	roles       map[int][]string
+	permissions map[int][]string
+}
+
+func newMemUserRBACRepoForData() *memUserRBACRepoForData {
+	return &memUserRBACRepoForData{roles: map[int][]string{}, permissions: map[int][]string{}}
+}
+
+func (r *memUserRBACRepoForData) GetUserAccess(ctx context.Context, userID int) ([]string, []string, error) {
+	return r.roles[userID], r.permissions[userID], nil
+}
+
+// 测试专用的用户侧权限声明，只在本文件注册一次。
+var _ = registerUserMethodPermissions("test_user_perm", map[string]string{
+	"me": biz.UserPermissionModerate,
+})
+
+func newUserRBACDispatcherForTest(repo *memUserRBACRepoForData) *jsonrpcDispatcher {
+	logger := log.NewStdLogger(io.Discard)
+	tp := tracesdk.NewTracerProvider()
+
+	users := newMemAuthRepoForData()
+	_ = users.putUser("alice", "p@ss", false)
+
+	return &jsonrpcDispatcher{
+		log:         log.NewHelper(log.With(logger, "module", "service.jsonrpc.test")),
+		authUC:      biz.NewAuthUsecase(users, nil, nil, logger, tp),
+		userRBACUC:  biz.NewUserRBACUsecase(repo, nil, logger, tp),
+		adminReader: newMemAdminAuthRepoForData(),
+	}
+}
+
+func TestJsonrpcDispatcher_UserMethodPermission(t *testing.T) {
+	repo := newMemUserRBACRepoForData()
+	j := newUserRBACDispatcherForTest(repo)
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "alice", Role: biz.RoleUser})
+
+	_, res, err := j.Handle(ctx, "test_user_perm", "2.0", "me", "1", nil)
+	if err != nil {
+		t.Fatalf("expected nil err, got %v", err)
+	}
+	if res.Code != errcode.PermissionDenied.Code {
+		t.Fatalf("expected permission denied without role, got %+v", res)
+	}
+
+	repo.roles[1] = []string{"moderator"}
+	repo.permissions[1] = []string{biz.UserPermissionModerate}
+	_, res, _ = j.Handle(ctx, "test_user_perm", "2.0", "me", "1", nil)
+	// 权限校验通过后才会落到 url 分发，未知 url 说明已放行。
+	if res.Code != errcode.JSONRPCUnknownURL.Code {
+		t.Fatalf("expected permission check to pass, got %+v", res)
+	}
+
+	adminCtx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "root", Role: biz.RoleAdmin})
+	_, res, _ = j.Handle(adminCtx, "test_user_perm", "2.0", "me", "1", nil)
+	if res.Code != errcode.PermissionDenied.Code {
+		t.Fatalf("expected admin token to be rejected for user permission, got %+v", res)
+	}
+}
+
+func TestJsonrpcDispatcher_AuthMeIncludesUserPermissions(t *testing.T) {
+	repo := newMemUserRBACRepoForData()
+	repo.roles[1] = []string{"pro"}
+	repo.permissions[1] = []string{biz.UserPermissionPremium}
+	j := newUserRBACDispatcherForTest(repo)
+	ctx := biz.NewContextWithClaims(context.Background(), &biz.AuthClaims{UserID: 1, Username: "alice", Role: biz.RoleUser})
+
+	_, res, err := j.Handle(ctx, "auth", "2.0", "me", "1", &structpb.Struct{})
+	if err != nil || res.Code != errcode.OK.Code {
+		t.Fatalf("expected ok, got res=%+v err=%v", res, err)
+	}
+	m := res.Data.AsMap()
+	roles, _ := m["roles"].([]any)
+	permissions, _ := m["permissions"].([]any)
+	if len(roles) != 1 || roles[0] != "pro" || len(permissions) != 1 || permissions[0] != biz.UserPermissionPremium {
+		t.Fatalf("unexpected roles/permissions: %v / %v", m["roles"], m["permissions"])
+	}
+}
@@ -0,0 +1,119 @@
+// server/internal/service/user_export.go
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "server/api/jsonrpc/v1"
+	"server/internal/biz"
+	"server/internal/errcode"
+)
+
+// UserExportPath 用户目录导出的 HTTP 路径，由 server 层注册。
+const UserExportPath = "/export/users"
+
+// 支持的导出格式。
+const (
+	UserExportCSV  = "csv"
+	UserExportXLSX = "xlsx"
+)
+
+// UserExportColumns 导出文件的表头，与 user.list 返回的字段一致（见 userListItem）。
+var UserExportColumns = []string{"id", "username", "email", "display_name", "disabled", "created_at", "last_login_at"}
+
+// UserExportRow 按 UserExportColumns 的顺序给出一行；时间为 UTC 的 RFC3339，从未登录时为空。
+func UserExportRow(u *biz.User) []string {
+	lastLogin := ""
+	if u.LastLoginAt != nil {
+		lastLogin = u.LastLoginAt.UTC().Format(time.RFC3339)
+	}
+	return []string{
+		strconv.Itoa(u.ID),
+		u.Username,
+		u.Email,
+		u.DisplayName,
+		strconv.FormatBool(u.Disabled),
+		u.CreatedAt.UTC().Format(time.RFC3339),
+		lastLogin,
+	}
+}
+
+// userListItem 是 user.list 返回的单个用户；增减字段时同步 UserExportColumns。
+func userListItem(u *biz.User) map[string]any {
+	lastLogin := int64(0)
+	if u.LastLoginAt != nil {
+		lastLogin = u.LastLoginAt.Unix()
+	}
+	return map[string]any{
+		"id":            u.ID,
+		"username":      u.Username,
+		"email":         u.Email,
+		"display_name":  u.DisplayName,
+		"disabled":      u.Disabled,
+		"last_login_at": lastLogin,
+		"created_at":    u.CreatedAt.Unix(),
+	}
+}
+
+// UserExport 是通过鉴权和参数校验、尚未开始写出的一次导出。
+type UserExport struct {
+	Format string
+
+	query biz.UserListQuery
+	uc    *biz.UserAdminUsecase
+}
+
+// PrepareUserExport 校验导出权限（含访问策略，按 user.export 匹配）并解析查询参数：
+// format、search，以及 JSON 编码的 filter、sort（取值同 user.list）。返回非 nil 的 JsonrpcResult 表示拒绝。
+func (s *JsonrpcService) PrepareUserExport(ctx context.Context, values url.Values) (*UserExport, *v1.JsonrpcResult) {
+	d := s.dispatcher
+	l := d.log.WithContext(ctx)
+
+	format := strings.ToLower(strings.TrimSpace(values.Get("format")))
+	if format == "" {
+		format = UserExportCSV
+	}
+
+	pm := map[string]any{"search": values.Get("search"), "search_mode": values.Get("search_mode")}
+	for _, key := range []string{"filter", "sort"} {
+		raw := strings.TrimSpace(values.Get(key))
+		if raw == "" {
+			continue
+		}
+		var v any
+		if err := json.Unmarshal([]byte(raw), &v); err != nil {
+			return nil, userListInvalidResult(fmt.Errorf("%w: %s 必须是 JSON 对象", biz.ErrUserListInvalid, key))
+		}
+		pm[key] = v
+	}
+
+	ctx = biz.NewContextWithAdminAccessCache(ctx)
+	ctx = context.WithValue(ctx, rpcCallKey{}, rpcCall{url: "user", method: "export", params: pm})
+	c, res := d.requireAdminPermission(ctx, methodPermission("user", "export"))
+	if res != nil {
+		l.Warnf("[user] export denied code=%d msg=%s", res.Code, res.Message)
+		return nil, res
+	}
+	if format != UserExportCSV && format != UserExportXLSX {
+		return nil, &v1.JsonrpcResult{Code: errcode.InvalidParam.Code, Message: "参数错误：format 只能是 csv 或 xlsx"}
+	}
+
+	q, err := parseUserListQuery(pm)
+	if err != nil {
+		return nil, userListInvalidResult(err)
+	}
+
+	l.Infof("[user] export prepared operator_uid=%d format=%s search=%q sort=%s", c.UserID, format, q.Filter.Search, q.Sort.Field)
+	return &UserExport{Format: format, query: q, uc: d.userAdminUC}, nil
+}
+
+// Run 逐行把用户交给 emit，返回写出的行数。
+func (e *UserExport) Run(ctx context.Context, emit func(*biz.User) error) (int, error) {
+	return e.uc.ExportUsers(ctx, e.query, e.Format, emit)
+}
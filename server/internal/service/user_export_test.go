@@ -0,0 +1,32 @@
+package service
+
+import (
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"server/internal/biz"
+)
+
+func TestUserExportColumnsMatchUserList(t *testing.T) {
+	now := time.Now()
+	u := &biz.User{ID: 3, Username: "alice", Email: "alice@example.com", DisplayName: "Alice", CreatedAt: now, LastLoginAt: &now}
+
+	listFields := slices.Sorted(maps.Keys(userListItem(u)))
+	columns := slices.Clone(UserExportColumns)
+	slices.Sort(columns)
+	if !slices.Equal(columns, listFields) {
+		t.Fatalf("export columns %v do not match user.list fields %v", UserExportColumns, listFields)
+	}
+
+	row := UserExportRow(u)
+	if len(row) != len(UserExportColumns) {
+		t.Fatalf("row has %d cells, header has %d", len(row), len(UserExportColumns))
+	}
+	for i, col := range UserExportColumns {
+		if col == "email" && row[i] != u.Email || col == "display_name" && row[i] != u.DisplayName {
+			t.Fatalf("column %s = %q", col, row[i])
+		}
+	}
+}
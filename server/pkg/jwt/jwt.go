@@ -14,26 +14,53 @@ type Claims struct {
 	// 0=user, 1=admin
 	Role int8 `json:"role"`
 
+	// OrganizationID 是 token 所属的组织（租户）；0 表示不限定组织。
+	OrganizationID int `json:"org,omitempty"`
+
+	// Actor 仅在管理员模拟登录签发的 token 中出现，记录真实操作人。
+	Actor *Actor `json:"act,omitempty"`
+
 	jwt.RegisteredClaims
 }
 
+// Actor 对应 RFC 8693 的 act 声明，这里只保留管理员 id 和用户名。
+type Actor struct {
+	UserID   int    `json:"uid"`
+	Username string `json:"uname"`
+}
+
 type Config struct {
 	Secret         []byte        // HMAC 秘钥
 	ExpireDuration time.Duration // 过期时间，比如 7 * 24 * time.Hour
 }
 
-func NewToken(cfg Config, userID int, username string, role int8) (string, time.Time, error) {
+func NewToken(cfg Config, userID int, username string, role int8, organizationID int) (string, time.Time, error) {
+	return newToken(cfg, &Claims{
+		UserID:         userID,
+		Username:       username,
+		Role:           role,
+		OrganizationID: organizationID,
+	})
+}
+
+// NewImpersonationToken 签发带 act 声明的短期 token，供管理员以用户身份复现问题。
+func NewImpersonationToken(cfg Config, userID int, username string, role int8, organizationID int, actor Actor) (string, time.Time, error) {
+	return newToken(cfg, &Claims{
+		UserID:         userID,
+		Username:       username,
+		Role:           role,
+		OrganizationID: organizationID,
+		Actor:          &actor,
+	})
+}
+
+func newToken(cfg Config, claims *Claims) (string, time.Time, error) {
 	expireAt := time.Now().Add(cfg.ExpireDuration)
 
-	claims := &Claims{
-		UserID:   userID,
-		Username: username,
-		Role:     role,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expireAt),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			Subject:   "access_token",
-		},
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(expireAt),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		Subject:   "access_token",
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
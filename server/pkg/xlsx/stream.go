@@ -0,0 +1,169 @@
+// 以流式方式写出只有一个工作表的 xlsx 文件：行直接写进 zip，不在内存里攒整张表。
+// 只支持导出所需的最小子集：文本与数字单元格，不支持样式、公式与多工作表。
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var errClosed = errors.New("xlsx: writer closed")
+
+// 除工作表外的固定部件，必须在工作表之前写入：zip 条目只能顺序写，工作表要留到最后一路写到底。
+var staticParts = []struct {
+	name string
+	body string
+}{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+		`</Relationships>`},
+	{"xl/styles.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+		`<fills count="1"><fill><patternFill patternType="none"/></fill></fills>` +
+		`<borders count="1"><border/></borders>` +
+		`<cellStyleXfs count="1"><xf/></cellStyleXfs>` +
+		`<cellXfs count="1"><xf/></cellXfs>` +
+		`</styleSheet>`},
+}
+
+// StreamWriter 逐行写出工作表。调用方必须调用 Close，否则文件不完整。
+type StreamWriter struct {
+	zw     *zip.Writer
+	sheet  *bufio.Writer
+	row    int
+	closed bool
+}
+
+// NewStreamWriter 在 w 上开始一个 xlsx 文件，sheetName 为工作表名（最长 31 个字符，由调用方保证）。
+func NewStreamWriter(w io.Writer, sheetName string) (*StreamWriter, error) {
+	zw := zip.NewWriter(w)
+	for _, p := range staticParts {
+		if err := writeZipEntry(zw, p.name, p.body); err != nil {
+			return nil, err
+		}
+	}
+
+	var name bytes.Buffer
+	escapeText(&name, sheetName)
+	workbook := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="` + name.String() + `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	if err := writeZipEntry(zw, "xl/workbook.xml", workbook); err != nil {
+		return nil, err
+	}
+
+	f, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	sheet := bufio.NewWriter(f)
+	if _, err := sheet.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`); err != nil {
+		return nil, err
+	}
+	return &StreamWriter{zw: zw, sheet: sheet}, nil
+}
+
+// WriteRow 追加一行。支持 string、整数与浮点数，其余类型按 fmt 的默认格式写成文本。
+func (w *StreamWriter) WriteRow(cells ...any) error {
+	if w.closed {
+		return errClosed
+	}
+	w.row++
+	var b bytes.Buffer
+	b.WriteString(`<row r="` + strconv.Itoa(w.row) + `">`)
+	for i, v := range cells {
+		ref := ColumnName(i) + strconv.Itoa(w.row)
+		switch x := v.(type) {
+		case int:
+			b.WriteString(`<c r="` + ref + `"><v>` + strconv.Itoa(x) + `</v></c>`)
+		case int64:
+			b.WriteString(`<c r="` + ref + `"><v>` + strconv.FormatInt(x, 10) + `</v></c>`)
+		case float64:
+			b.WriteString(`<c r="` + ref + `"><v>` + strconv.FormatFloat(x, 'f', -1, 64) + `</v></c>`)
+		case string:
+			inlineString(&b, ref, x)
+		default:
+			inlineString(&b, ref, fmt.Sprint(x))
+		}
+	}
+	b.WriteString(`</row>`)
+	_, err := w.sheet.Write(b.Bytes())
+	return err
+}
+
+// Flush 把已写的行推给底层 writer，便于 HTTP 响应边写边发。
+func (w *StreamWriter) Flush() error {
+	if w.closed {
+		return errClosed
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.zw.Flush()
+}
+
+// Close 结束工作表并写出 zip 目录；不会关闭底层 writer。
+func (w *StreamWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if _, err := w.sheet.WriteString(`</sheetData></worksheet>`); err != nil {
+		return err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.zw.Close()
+}
+
+// ColumnName 把从 0 开始的列序号转换为 A、B、…、Z、AA 这样的列名。
+func ColumnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+func writeZipEntry(zw *zip.Writer, name, body string) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(f, body)
+	return err
+}
+
+// escapeText 追加转义后的文本；xml.EscapeText 会把 XML 不允许的控制字符替换成 U+FFFD。
+func escapeText(b *bytes.Buffer, s string) {
+	_ = xml.EscapeText(b, []byte(s))
+}
+
+func inlineString(b *bytes.Buffer, ref, s string) {
+	b.WriteString(`<c r="` + ref + `" t="inlineStr"><is><t xml:space="preserve">`)
+	escapeText(b, s)
+	b.WriteString(`</t></is></c>`)
+}
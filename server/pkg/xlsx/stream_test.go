@@ -0,0 +1,75 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestColumnName(t *testing.T) {
+	for i, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		if got := ColumnName(i); got != want {
+			t.Fatalf("ColumnName(%d)=%s want %s", i, got, want)
+		}
+	}
+}
+
+func TestStreamWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewStreamWriter(&buf, "users")
+	if err != nil {
+		t.Fatalf("NewStreamWriter err=%v", err)
+	}
+	if err := w.WriteRow("id", "username"); err != nil {
+		t.Fatalf("WriteRow err=%v", err)
+	}
+	if err := w.WriteRow(7, "a<b>&\x01"); err != nil {
+		t.Fatalf("WriteRow err=%v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close err=%v", err)
+	}
+	if err := w.WriteRow("late"); err == nil {
+		t.Fatalf("expected error after Close")
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("invalid zip: %v", err)
+	}
+	parts := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+		b, _ := io.ReadAll(rc)
+		rc.Close()
+		parts[f.Name] = string(b)
+	}
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/styles.xml", "xl/worksheets/sheet1.xml"} {
+		body, ok := parts[name]
+		if !ok {
+			t.Fatalf("missing part %s", name)
+		}
+		dec := xml.NewDecoder(strings.NewReader(body))
+		for {
+			if _, err := dec.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("part %s is not well-formed: %v", name, err)
+			}
+		}
+	}
+
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	if !strings.Contains(sheet, `<c r="A2"><v>7</v></c>`) {
+		t.Fatalf("expected numeric cell, got %s", sheet)
+	}
+	if !strings.Contains(sheet, "a&lt;b&gt;&amp;�") {
+		t.Fatalf("expected escaped text, got %s", sheet)
+	}
+}
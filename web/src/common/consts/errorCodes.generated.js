@@ -4,20 +4,68 @@ export const RpcErrorCode = Object.freeze({
   OK: 0,
   JSONRPC_UNKNOWN_URL: 40001,
   INVALID_PARAM: 40010,
+  PAGE_INVALID: 40011,
+  PAGE_CURSOR_INVALID: 40012,
   UNKNOWN_METHOD: 40020,
   USER_INVALID_PARAM: 40030,
   USER_SET_DISABLED_INVALID: 40071,
+  USER_LIST_INVALID: 40072,
+  USER_BULK_TOO_MANY: 40073,
+  USER_IMPORT_INVALID: 40074,
+  USER_IMPORT_JOB_NOT_FOUND: 40075,
+  USER_PROFILE_INVALID: 40076,
+  USER_RESTORE_CONFLICT: 40077,
+  RBAC_ROLE_NOT_FOUND: 40090,
+  RBAC_ROLE_EXISTS: 40091,
+  RBAC_ROLE_KEY_INVALID: 40092,
+  RBAC_ROLE_BUILTIN: 40093,
+  RBAC_PERMISSION_UNKNOWN: 40094,
+  RBAC_SUPER_ADMIN_PERMISSIONS: 40095,
+  RBAC_LAST_SUPER_ADMIN: 40096,
+  RBAC_ROLE_CYCLE: 40097,
+  RBAC_POLICY_INVALID: 40098,
+  ADMIN_ACCOUNT_NOT_FOUND: 40101,
+  ADMIN_SELF_LOCKOUT: 40102,
+  ADMIN_PASSWORD_TOO_SHORT: 40103,
+  ADMIN_PRIVILEGE_ESCALATION: 40104,
+  ACCESS_POLICY_INVALID: 40110,
+  ACCESS_POLICY_NOT_FOUND: 40111,
+  ORGANIZATION_NOT_FOUND: 40120,
+  ORGANIZATION_EXISTS: 40121,
+  ORGANIZATION_SLUG_INVALID: 40122,
+  ORGANIZATION_LAST_MEMBER: 40123,
+  ORGANIZATION_ROLE_SCOPE: 40124,
   ADMIN_REQUIRED: 40301,
   AUTH_REQUIRED: 40302,
   ADMIN_DISABLED: 40303,
   PERMISSION_DENIED: 40304,
+  AUTH_IMPERSONATION_BLOCKED: 40305,
+  ACCESS_POLICY_DENIED: 40306,
+  ORGANIZATION_FORBIDDEN: 40307,
   AUTH_USER_NOT_FOUND: 10001,
   AUTH_INVALID_PASSWORD: 10002,
   AUTH_USER_DISABLED: 10003,
   AUTH_USER_EXISTS: 10004,
   AUTH_EXPIRED: 10005,
   AUTH_INVALID: 10006,
+  AUTH_REGISTRATION_CLOSED: 10007,
+  AUTH_INVITE_REQUIRED: 10008,
+  AUTH_INVITE_INVALID: 10009,
+  AUTH_INVITE_EXPIRED: 10010,
+  AUTH_INVITE_EXHAUSTED: 10011,
+  AUTH_USER_UNVERIFIED: 10012,
+  AUTH_VERIFICATION_TOO_FREQUENT: 10013,
+  AUTH_VERIFICATION_CODE_INVALID: 10014,
+  AUTH_VERIFICATION_CODE_EXPIRED: 10015,
+  AUTH_VERIFICATION_CONTACT_INVALID: 10016,
+  AUTH_VERIFICATION_CONTACT_CONFLICT: 10017,
+  AUTH_USERNAME_INVALID: 10018,
+  AUTH_INVALID_CREDENTIALS: 10019,
+  AUTH_LOGIN_THROTTLED: 10020,
+  AUTH_PASSWORD_CHANGE_REQUIRED: 10021,
   INTERNAL: 50000,
   AUTH_CURRENT_USER_FAILED: 50001,
   USER_LIST_FAILED: 50020,
+  LOGIN_HISTORY_FAILED: 50021,
+  USER_DATA_EXPORT_FAILED: 50022,
 })